@@ -0,0 +1,96 @@
+package main
+
+import (
+	"time"
+
+	"github.com/marksauter/markus-ninja-api/pkg/data"
+	"github.com/marksauter/markus-ninja-api/pkg/mylog"
+	"github.com/marksauter/markus-ninja-api/pkg/service"
+	"github.com/marksauter/markus-ninja-api/pkg/util"
+	"github.com/sirupsen/logrus"
+)
+
+var assetGCCommand = &command{
+	name:  "asset gc",
+	usage: "Delete the assets no user asset, lesson, comment or profile refers to, and their stored objects.",
+	run:   runAssetGC,
+}
+
+type assetGCItem struct {
+	ID     int64  `json:"id"`
+	Key    string `json:"key"`
+	Size   int64  `json:"size"`
+	UserID string `json:"userId"`
+}
+
+type assetGCResult struct {
+	Assets         []assetGCItem `json:"assets"`
+	DryRun         bool          `json:"dryRun"`
+	ObjectsRemoved int           `json:"objectsRemoved"`
+	BytesFreed     int64         `json:"bytesFreed"`
+}
+
+func runAssetGC(c *cli, cmd *command, args []string) error {
+	fs := c.flagSet(cmd)
+	olderThan := fs.Duration(
+		"older-than",
+		24*time.Hour,
+		"only delete assets uploaded longer ago than this, so that assets being embedded are kept",
+	)
+	fs.Parse(args)
+
+	db, err := c.openRootDB()
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	assets, err := data.GetUnreferencedAssets(db, time.Now().Add(-*olderThan))
+	if err != nil {
+		return err
+	}
+
+	result := &assetGCResult{
+		Assets: make([]assetGCItem, len(assets)),
+		DryRun: c.dryRun,
+	}
+	for i, a := range assets {
+		result.Assets[i] = assetGCItem{
+			ID:     a.ID.Int,
+			Key:    a.Key.String,
+			Size:   a.Size.Int,
+			UserID: a.UserID.String,
+		}
+		result.BytesFreed += a.Size.Int
+	}
+
+	if !c.dryRun && len(assets) > 0 {
+		storageSvc, err := service.NewStorageService(c.conf)
+		if err != nil {
+			return err
+		}
+		// Delete the row before the objects, so that an asset is never left
+		// pointing at missing objects.
+		for _, a := range assets {
+			if err := data.DeleteAsset(db, a.ID.Int); err != nil {
+				return err
+			}
+			n, err := storageSvc.Delete(&a.UserID, a.Key.String)
+			if err != nil {
+				mylog.Log.WithError(err).WithFields(logrus.Fields{
+					"id":  a.ID.Int,
+					"key": a.Key.String,
+				}).Error(util.Trace("failed to remove objects of deleted asset"))
+				return err
+			}
+			result.ObjectsRemoved += n
+		}
+	}
+
+	return c.report(
+		result,
+		"deleted %d assets, freeing %d bytes",
+		len(result.Assets),
+		result.BytesFreed,
+	)
+}
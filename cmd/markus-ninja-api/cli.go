@@ -0,0 +1,203 @@
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/jackc/pgx"
+	"github.com/marksauter/markus-ninja-api/pkg/data"
+	"github.com/marksauter/markus-ninja-api/pkg/myconf"
+	"github.com/marksauter/markus-ninja-api/pkg/mydb"
+	"github.com/marksauter/markus-ninja-api/pkg/mylog"
+	"github.com/marksauter/markus-ninja-api/pkg/util"
+)
+
+// command is a subcommand of the binary. Names of nested subcommands are
+// separated by a space, e.g. "permissions sync".
+type command struct {
+	name  string
+	args  string
+	usage string
+	run   func(c *cli, cmd *command, args []string) error
+}
+
+var commands = []*command{
+	serveCommand,
+	migrateCommand,
+	permissionsSyncCommand,
+	userCreateCommand,
+	userGrantRoleCommand,
+	userRevokeRoleCommand,
+	userVerifyCommand,
+	userResetPasswordCommand,
+	reindexCommand,
+	assetGCCommand,
+}
+
+// findCommand returns the command named by the leading args, and the
+// remaining args.
+func findCommand(args []string) (*command, []string) {
+	for _, n := range []int{2, 1} {
+		if len(args) < n {
+			continue
+		}
+		name := strings.Join(args[:n], " ")
+		for _, cmd := range commands {
+			if cmd.name == name {
+				return cmd, args[n:]
+			}
+		}
+	}
+	return nil, args
+}
+
+func usage(w io.Writer) {
+	fmt.Fprintf(w, "Usage: markus-ninja-api [command] [flags]\n\n")
+	fmt.Fprintf(w, "Commands:\n")
+	names := make([]string, len(commands))
+	for i, cmd := range commands {
+		names[i] = cmd.name
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		cmd, _ := findCommand(strings.Split(name, " "))
+		fmt.Fprintf(w, "  %-24s %s\n", cmd.name, cmd.usage)
+	}
+	fmt.Fprintf(w, "\nWithout a command, serve is run.\n")
+	fmt.Fprintf(w, "Run 'markus-ninja-api [command] -h' for the flags of a command.\n")
+}
+
+// cli holds the state shared by the commands.
+type cli struct {
+	branch string
+	conf   *myconf.Config
+	out    io.Writer
+
+	dryRun bool
+	json   bool
+}
+
+// flagSet returns the flag set of cmd, with the --dry-run and --json flags
+// every command supports.
+func (c *cli) flagSet(cmd *command) *flag.FlagSet {
+	fs := flag.NewFlagSet(cmd.name, flag.ExitOnError)
+	fs.BoolVar(&c.dryRun, "dry-run", false, "report what would be done, without doing it")
+	fs.BoolVar(&c.json, "json", false, "write the result as JSON")
+	fs.Usage = func() {
+		fmt.Fprintf(fs.Output(), "Usage: markus-ninja-api %s [flags] %s\n\n", cmd.name, cmd.args)
+		fmt.Fprintf(fs.Output(), "%s\n\nFlags:\n", cmd.usage)
+		fs.PrintDefaults()
+	}
+	return fs
+}
+
+// report writes result to the output, as JSON if --json was passed, or else as
+// the text formatted from format and a.
+func (c *cli) report(result interface{}, format string, a ...interface{}) error {
+	if c.json {
+		enc := json.NewEncoder(c.out)
+		enc.SetIndent("", "  ")
+		return enc.Encode(result)
+	}
+	if c.dryRun {
+		format = "(dry run) " + format
+	}
+	_, err := fmt.Fprintf(c.out, format+"\n", a...)
+	return err
+}
+
+func (c *cli) dbConfig() pgx.ConnConfig {
+	var dbUser, dbPassword string
+	if c.branch == "production" || c.branch == "development" {
+		dbUser = util.GetRequiredEnv("DB_USERNAME")
+		dbPassword = util.GetRequiredEnv("DB_PASSWORD")
+	} else {
+		dbUser = c.conf.DBUser
+		dbPassword = c.conf.DBPassword
+	}
+
+	return pgx.ConnConfig{
+		User:     dbUser,
+		Password: dbPassword,
+		Host:     c.conf.DBHost,
+		Port:     c.conf.DBPort,
+		Database: c.conf.DBName,
+	}
+}
+
+func (c *cli) rootDBConfig() pgx.ConnConfig {
+	var dbRootUser, dbRootPassword string
+	if c.branch == "production" || c.branch == "development" {
+		dbRootUser = util.GetRequiredEnv("DB_ROOT_USERNAME")
+		dbRootPassword = util.GetRequiredEnv("DB_ROOT_PASSWORD")
+	} else {
+		dbRootUser = c.conf.DBRootUser
+		dbRootPassword = c.conf.DBRootPassword
+	}
+
+	return pgx.ConnConfig{
+		User:     dbRootUser,
+		Password: dbRootPassword,
+		Host:     c.conf.DBHost,
+		Port:     c.conf.DBPort,
+		Database: c.conf.DBName,
+	}
+}
+
+// openRootDB connects to the database as the root user, which the
+// administrative commands run as.
+func (c *cli) openRootDB() (*mydb.DB, error) {
+	db, err := mydb.OpenRoot(c.rootDBConfig())
+	if err != nil {
+		mylog.Log.WithError(err).Error(util.Trace("unable to connect to database"))
+		return nil, err
+	}
+	return db, nil
+}
+
+// inTransaction runs fn in a transaction on db, which is committed if fn
+// succeeds, unless this is a dry run, in which case it is always rolled back.
+func (c *cli) inTransaction(db data.Queryer, fn func(tx data.Queryer) error) error {
+	tx, err, newTx := data.BeginTransaction(db)
+	if err != nil {
+		mylog.Log.WithError(err).Error(util.Trace(""))
+		return err
+	}
+	if newTx {
+		defer data.RollbackTransaction(tx)
+	}
+
+	if err := fn(tx); err != nil {
+		return err
+	}
+
+	if newTx && !c.dryRun {
+		if err := data.CommitTransaction(tx); err != nil {
+			mylog.Log.WithError(err).Error(util.Trace(""))
+			return err
+		}
+	}
+	return nil
+}
+
+// newCLI loads the config of the current branch, and configures the logger.
+func newCLI() *cli {
+	branch := util.GetRequiredEnv("BRANCH")
+	confFilename := fmt.Sprintf("config.%s", branch)
+	conf := myconf.Load(confFilename)
+
+	if err := mylog.Log.Configure(conf.LogLevel, conf.LogFormat); err != nil {
+		mylog.Log.WithError(err).Fatal(util.Trace("invalid log config"))
+	}
+
+	return &cli{
+		branch: branch,
+		conf:   conf,
+		out:    os.Stdout,
+	}
+}
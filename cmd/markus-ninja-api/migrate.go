@@ -0,0 +1,180 @@
+package main
+
+import (
+	"github.com/marksauter/markus-ninja-api/pkg/data"
+	"github.com/marksauter/markus-ninja-api/pkg/mylog"
+	"github.com/marksauter/markus-ninja-api/pkg/util"
+)
+
+var migrateCommand = &command{
+	name:  "migrate",
+	usage: "Apply data/init_database.sql, and create the database roles and seed users.",
+	run:   runMigrate,
+}
+
+type migrateResult struct {
+	DryRun            bool     `json:"dryRun"`
+	FromSchemaVersion int32    `json:"fromSchemaVersion"`
+	ToSchemaVersion   int32    `json:"toSchemaVersion"`
+	UsersCreated      []string `json:"usersCreated"`
+}
+
+func runMigrate(c *cli, cmd *command, args []string) error {
+	fs := c.flagSet(cmd)
+	fs.Parse(args)
+
+	db, err := c.openRootDB()
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	result, err := migrate(c, db)
+	if err != nil {
+		return err
+	}
+	return c.report(
+		result,
+		"migrated schema from version %d to %d, created users: %v",
+		result.FromSchemaVersion,
+		result.ToSchemaVersion,
+		result.UsersCreated,
+	)
+}
+
+// seedUser is a user the application expects to exist.
+type seedUser struct {
+	login, password, email, role string
+}
+
+func migrate(c *cli, db data.Queryer) (*migrateResult, error) {
+	result := &migrateResult{
+		DryRun:       c.dryRun,
+		UsersCreated: []string{},
+	}
+	// The schema_version table does not exist before the first migration.
+	if version, err := data.GetSchemaVersion(db); err == nil {
+		result.FromSchemaVersion = version
+	}
+
+	err := c.inTransaction(db, func(tx data.Queryer) error {
+		if err := data.Initialize(tx); err != nil {
+			return err
+		}
+
+		createRoleWWWSQL := `
+			DO $$
+			BEGIN
+				IF NOT EXISTS (
+					SELECT
+					FROM pg_catalog.pg_roles
+					WHERE rolname = 'client') THEN
+					CREATE ROLE www NOINHERIT LOGIN PASSWORD '` + c.dbConfig().Password + `';
+					GRANT client TO www;
+				END IF;
+			END
+			$$
+		`
+		if _, err := tx.Exec(createRoleWWWSQL); err != nil {
+			mylog.Log.WithError(err).Error(util.Trace("failed to create role"))
+			return err
+		}
+
+		seeds := []seedUser{
+			{"guest", "", "guest@rkus.ninja", ""},
+			{"markus", "", "m@rkus.ninja", data.AdminRole},
+		}
+		for i := range seeds {
+			password, err := newPassword()
+			if err != nil {
+				return err
+			}
+			seeds[i].password = password
+		}
+		if c.branch != "production" {
+			seeds = append(seeds, seedUser{"user", "user", "user@example.com", data.UserRole})
+		}
+		if c.branch == "test" {
+			seeds = append(seeds, seedUser{"test", "test", "test@example.com", data.UserRole})
+		}
+		for _, s := range seeds {
+			created, err := ensureUser(tx, s.login, s.password, s.email, s.role)
+			if err != nil {
+				return err
+			}
+			if created {
+				result.UsersCreated = append(result.UsersCreated, s.login)
+			}
+		}
+
+		version, err := data.GetSchemaVersion(tx)
+		if err != nil {
+			return err
+		}
+		result.ToSchemaVersion = version
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	mylog.Log.Info("database migrated")
+	return result, nil
+}
+
+// ensureUser creates the user login, unless it exists, and grants it role.
+func ensureUser(db data.Queryer, login, password, email, role string) (bool, error) {
+	created := false
+	user, err := data.GetUserByLogin(db, login)
+	if err == data.ErrNotFound {
+		user = &data.User{}
+		if err := user.Login.Set(login); err != nil {
+			mylog.Log.WithError(err).Error(util.Trace(""))
+			return false, err
+		}
+		if err := user.Password.Set(password); err != nil {
+			mylog.Log.WithError(err).Error(util.Trace(""))
+			return false, err
+		}
+		if err := user.PrimaryEmail.Set(email); err != nil {
+			mylog.Log.WithError(err).Error(util.Trace(""))
+			return false, err
+		}
+		if user, err = data.CreateUser(db, user); err != nil {
+			mylog.Log.WithError(err).Error(util.Trace("failed to create " + login))
+			return false, err
+		}
+		created = true
+	} else if err != nil {
+		mylog.Log.WithError(err).Error(util.Trace(""))
+		return false, err
+	}
+
+	if role != "" {
+		if err := data.GrantUserRoles(db, user.ID.String, role); err != nil {
+			mylog.Log.WithError(err).Error(util.Trace(""))
+			return false, err
+		}
+	}
+	return created, nil
+}
+
+// initDB migrates the database, and syncs the permissions, as the server does
+// on start.
+func initDB(c *cli) error {
+	db, err := c.openRootDB()
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	if _, err := migrate(c, db); err != nil {
+		return err
+	}
+	if _, err := syncPermissions(c, db); err != nil {
+		return err
+	}
+
+	mylog.Log.Info("database initialized")
+	return nil
+}
@@ -0,0 +1,114 @@
+package main
+
+import (
+	"github.com/marksauter/markus-ninja-api/pkg/data"
+	"github.com/marksauter/markus-ninja-api/pkg/myconf"
+	"github.com/marksauter/markus-ninja-api/pkg/mylog"
+	"github.com/marksauter/markus-ninja-api/pkg/mytype"
+	"github.com/marksauter/markus-ninja-api/pkg/util"
+)
+
+var permissionsSyncCommand = &command{
+	name:  "permissions sync",
+	usage: "Recreate the permission suite of every model, and apply permissions.yml.",
+	run:   runPermissionsSync,
+}
+
+// permissionModels are the models a permission suite is created for.
+var permissionModels = []interface{}{
+	new(data.Activity),
+	new(data.ActivityAsset),
+	new(data.Appled),
+	new(data.Asset),
+	new(data.Comment),
+	new(data.CommentDraftBackup),
+	new(data.Course),
+	new(data.CourseLesson),
+	new(data.Email),
+	new(data.EVT),
+	new(data.Enrolled),
+	new(data.Event),
+	new(data.Label),
+	new(data.Labeled),
+	new(data.Lesson),
+	new(data.LessonDraftBackup),
+	new(data.LessonPrerequisite),
+	new(data.Notification),
+	new(data.PRT),
+	new(data.Study),
+	new(data.Topic),
+	new(data.Topiced),
+	new(data.User),
+	new(data.UserAsset),
+}
+
+type permissionsSyncResult struct {
+	DryRun     bool `json:"dryRun"`
+	Models     int  `json:"models"`
+	Operations int  `json:"operations"`
+}
+
+func runPermissionsSync(c *cli, cmd *command, args []string) error {
+	fs := c.flagSet(cmd)
+	fs.Parse(args)
+
+	db, err := c.openRootDB()
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	result, err := syncPermissions(c, db)
+	if err != nil {
+		return err
+	}
+	return c.report(
+		result,
+		"synced permissions of %d models, and %d operations",
+		result.Models,
+		result.Operations,
+	)
+}
+
+func syncPermissions(c *cli, db data.Queryer) (*permissionsSyncResult, error) {
+	permissions, err := myconf.LoadPermissions()
+	if err != nil {
+		mylog.Log.WithError(err).Error(util.Trace(""))
+		return nil, err
+	}
+
+	err = c.inTransaction(db, func(tx data.Queryer) error {
+		for _, model := range permissionModels {
+			if err := data.CreatePermissionSuite(tx, model); err != nil {
+				mylog.Log.WithError(err).Error(util.Trace(""))
+				return err
+			}
+		}
+
+		for _, p := range permissions.Permissions {
+			if !p.Authenticated {
+				err := data.UpdatePermissionAudience(tx, &p.Operation, mytype.Everyone, p.Fields)
+				if err != nil {
+					mylog.Log.WithError(err).Error(util.Trace(""))
+					return err
+				}
+			} else {
+				err := data.ConnectRolePermissions(tx, &p.Operation, p.Fields, p.Roles)
+				if err != nil {
+					mylog.Log.WithError(err).Error(util.Trace(""))
+					return err
+				}
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &permissionsSyncResult{
+		DryRun:     c.dryRun,
+		Models:     len(permissionModels),
+		Operations: len(permissions.Permissions),
+	}, nil
+}
@@ -0,0 +1,59 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/marksauter/markus-ninja-api/pkg/data"
+)
+
+var reindexCommand = &command{
+	name:  "reindex",
+	args:  "[index]...",
+	usage: "Rebuild the search indexes, or only the named ones, e.g. lesson.",
+	run:   runReindex,
+}
+
+type reindexResult struct {
+	DryRun  bool             `json:"dryRun"`
+	Indexes map[string]int64 `json:"indexes"`
+}
+
+func runReindex(c *cli, cmd *command, args []string) error {
+	fs := c.flagSet(cmd)
+	fs.Parse(args)
+	indexes := fs.Args()
+	if len(indexes) == 0 {
+		indexes = data.SearchIndexes
+	}
+
+	db, err := c.openRootDB()
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	result := &reindexResult{
+		DryRun:  c.dryRun,
+		Indexes: make(map[string]int64, len(indexes)),
+	}
+	err = c.inTransaction(db, func(tx data.Queryer) error {
+		for _, index := range indexes {
+			n, err := data.ReindexSearchIndex(tx, index)
+			if err != nil {
+				return err
+			}
+			result.Indexes[index] = n
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	counts := make([]string, len(indexes))
+	for i, index := range indexes {
+		counts[i] = fmt.Sprintf("%s_search_index: %d rows", index, result.Indexes[index])
+	}
+	return c.report(result, "reindexed %s", strings.Join(counts, ", "))
+}
@@ -0,0 +1,311 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	_ "net/http/pprof"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/gorilla/mux"
+	graphql "github.com/marksauter/graphql-go"
+	"github.com/marksauter/markus-ninja-api/pkg/data"
+	"github.com/marksauter/markus-ninja-api/pkg/myconf"
+	"github.com/marksauter/markus-ninja-api/pkg/mydb"
+	"github.com/marksauter/markus-ninja-api/pkg/myhttp"
+	"github.com/marksauter/markus-ninja-api/pkg/mylog"
+	"github.com/marksauter/markus-ninja-api/pkg/mymetrics"
+	"github.com/marksauter/markus-ninja-api/pkg/mytrace"
+	"github.com/marksauter/markus-ninja-api/pkg/repo"
+	"github.com/marksauter/markus-ninja-api/pkg/resolver"
+	"github.com/marksauter/markus-ninja-api/pkg/scheduler"
+	"github.com/marksauter/markus-ninja-api/pkg/schema"
+	"github.com/marksauter/markus-ninja-api/pkg/server/middleware"
+	"github.com/marksauter/markus-ninja-api/pkg/server/route"
+	"github.com/marksauter/markus-ninja-api/pkg/service"
+	"github.com/marksauter/markus-ninja-api/pkg/util"
+)
+
+var serveCommand = &command{
+	name:  "serve",
+	usage: "Run the API server.",
+	run:   runServe,
+}
+
+func runServe(c *cli, cmd *command, args []string) error {
+	fs := c.flagSet(cmd)
+	initialize := fs.Bool(
+		"init",
+		true,
+		"migrate the database and sync permissions before serving",
+	)
+	fs.Parse(args)
+	branch := c.branch
+	conf := c.conf
+
+	exporter, err := newTraceExporter(conf)
+	if err != nil {
+		mylog.Log.WithError(err).Fatal(util.Trace("unable to start trace exporter"))
+	}
+	if exporter != nil {
+		mytrace.SetExporter(exporter)
+		defer exporter.Close()
+	}
+
+	if *initialize {
+		if err := initDB(c); err != nil {
+			mylog.Log.WithField("error", err).Fatal("error initializing database")
+		}
+	}
+
+	data.SetSearchRankWeights(searchRankWeights(conf))
+
+	db, err := mydb.Open(c.dbConfig())
+	if err != nil {
+		mylog.Log.WithError(err).Fatal(util.Trace("unable to connect to database"))
+	}
+	defer db.Close()
+	if err := mymetrics.RegisterDBPool(db.ConnPool); err != nil {
+		mylog.Log.WithError(err).Fatal(util.Trace("unable to register database metrics"))
+	}
+
+	svcs, err := service.NewServices(conf)
+	if err != nil {
+		mylog.Log.WithField("error", err).Fatal(util.Trace("unable to start services"))
+	}
+
+	repos := repo.NewRepos(db, conf)
+	schema := graphql.MustParseSchema(
+		schema.GetRootSchema(),
+		&resolver.RootResolver{
+			Conf:        conf,
+			Repos:       repos,
+			Svcs:        svcs,
+			Suggestions: util.NewLRUCache(conf.SearchSuggestCacheSize, conf.SearchSuggestCacheTTL),
+		},
+		graphql.Tracer(mytrace.GraphQLTracer{}),
+	)
+
+	r := mux.NewRouter()
+	authMiddleware := middleware.Authenticate{Db: db, AuthSvc: svcs.Auth}
+
+	graphQLHandler := route.GraphQLHandler{Conf: conf, Schema: schema, Repos: repos}
+	graphQLSchemaHandler := route.GraphQLSchemaHandler{Conf: conf, Schema: schema}
+	confirmVerificationHandler := route.ConfirmVerificationHandler{Conf: conf, Db: db}
+	feedHandler := route.FeedHandler{Conf: conf, Db: db, Repos: repos}
+	previewHandler := route.PreviewHandler{Conf: conf, Repos: repos}
+	tokenHandler := route.TokenHandler{AuthSvc: svcs.Auth, Conf: conf, Db: db}
+	removeTokenHandler := route.RemoveTokenHandler{Conf: conf}
+	signupHandler := route.SignupHandler{AuthSvc: svcs.Auth, Conf: conf, Db: db}
+	uploadAssetsHandler := route.UploadAssetsHandler{Conf: conf, Repos: repos, StorageSvc: svcs.Storage}
+	userAssetsHandler := route.UserAssetsHandler{Conf: conf, StorageSvc: svcs.Storage}
+	metricsHandler := route.MetricsHandler{Conf: conf}
+
+	graphql := middleware.CommonMiddleware.Append(
+		mymetrics.Middleware("/graphql"),
+		graphQLHandler.Cors().Handler,
+		authMiddleware.Use,
+		repos.Use,
+	).Then(graphQLHandler)
+	graphQLSchema := middleware.CommonMiddleware.Append(
+		mymetrics.Middleware("/graphql/schema"),
+		graphQLSchemaHandler.Cors().Handler,
+		authMiddleware.Use,
+	).Then(graphQLSchemaHandler)
+	confirmVerification := middleware.CommonMiddleware.Append(
+		mymetrics.Middleware("/user/{login}/emails/{id}/confirm_verification/{token}"),
+		confirmVerificationHandler.Cors().Handler,
+		authMiddleware.Use,
+	).Then(confirmVerificationHandler)
+	feed := middleware.CommonMiddleware.Append(
+		mymetrics.Middleware("/feeds"),
+		repos.Use,
+	).Then(feedHandler)
+	preview := middleware.CommonMiddleware.Append(
+		mymetrics.Middleware("/preview"),
+		previewHandler.Cors().Handler,
+		authMiddleware.Use,
+		repos.Use,
+	).Then(previewHandler)
+	token := middleware.CommonMiddleware.Append(
+		mymetrics.Middleware("/token"),
+		tokenHandler.Cors().Handler,
+	).Then(tokenHandler)
+	removeToken := middleware.CommonMiddleware.Append(
+		mymetrics.Middleware("/remove_token"),
+		removeTokenHandler.Cors().Handler,
+		authMiddleware.Use,
+	).Then(removeTokenHandler)
+	signup := middleware.CommonMiddleware.Append(
+		mymetrics.Middleware("/signup"),
+		signupHandler.Cors().Handler,
+		authMiddleware.Use,
+	).Then(signupHandler)
+	uploadAssets := middleware.CommonMiddleware.Append(
+		mymetrics.Middleware("/upload/assets"),
+		uploadAssetsHandler.Cors().Handler,
+		authMiddleware.Use,
+		repos.Use,
+	).Then(uploadAssetsHandler)
+	userAssets := middleware.CommonMiddleware.Append(
+		mymetrics.Middleware("/user/assets/{user_id}/{key}"),
+		userAssetsHandler.Cors().Handler,
+		authMiddleware.Use,
+		repos.Use,
+	).Then(userAssetsHandler)
+	metrics := middleware.CommonMiddleware.Then(metricsHandler)
+
+	r.Handle("/graphql", graphql)
+	r.Handle("/graphql/schema", graphQLSchema)
+	r.Handle("/preview", preview)
+	r.Handle("/feeds/private/{token}/topics/{topic}.atom", feed)
+	r.Handle("/feeds/private/{token}/{owner}/{study}.atom", feed)
+	r.Handle("/feeds/private/{token}/{login}.atom", feed)
+	r.Handle("/feeds/topics/{topic}.atom", feed)
+	r.Handle("/feeds/{owner}/{study}.atom", feed)
+	r.Handle("/feeds/{login}.atom", feed)
+	r.Handle("/signup", signup)
+	r.Handle("/token", token)
+	r.Handle("/remove_token", removeToken)
+	r.Handle("/upload/assets", uploadAssets)
+	r.Handle("/user/assets/{user_id}/{key}", userAssets)
+	r.Handle("/metrics", metrics)
+	r.Handle("/user/{login}/emails/{id}/confirm_verification/{token}",
+		confirmVerification,
+	)
+
+	indexHandler := route.IndexHandler{}
+	graphiQLHandler := route.GraphiQLHandler{Conf: conf}
+	index := middleware.CommonMiddleware.Append(
+		mymetrics.Middleware("/"),
+	).Then(indexHandler)
+	graphiql := middleware.CommonMiddleware.Append(
+		mymetrics.Middleware("/graphiql"),
+		graphiQLHandler.Cors().Handler,
+		authMiddleware.Use,
+	).Then(graphiQLHandler)
+	r.Handle("/", index)
+	r.Handle("/graphiql", graphiql)
+
+	if branch == "development.local" || branch == "test" {
+		r.PathPrefix("/debug/").Handler(http.DefaultServeMux)
+	}
+
+	if branch == "development.local" || branch == "test" {
+		r.Handle("/db", middleware.CommonMiddleware.ThenFunc(
+			func(rw http.ResponseWriter, req *http.Request) {
+				// Connect and check the server version
+				var version string
+				err := db.QueryRow("SELECT VERSION()").Scan(&version)
+				if err != nil {
+					mylog.Log.WithError(err).Error(util.Trace(""))
+					response := myhttp.InternalServerErrorResponse(err.Error())
+					myhttp.WriteResponseTo(rw, response)
+					return
+				}
+				fmt.Fprintf(rw, "Connected to: %s", version)
+			},
+		))
+	}
+
+	// Probes skip the common middleware, so they do not flood the access log.
+	r.Handle("/healthz", route.HealthzHandler{})
+	r.Handle("/readyz", route.ReadyzHandler{Db: db, StorageSvc: svcs.Storage})
+
+	timeoutMiddleware := middleware.Timeout{
+		Default: conf.ServerHandlerTimeout,
+		Routes:  conf.ServerRouteTimeouts,
+	}
+	r.Use(timeoutMiddleware.Use)
+
+	// The server timeouts must allow for the longest route timeout, or the
+	// connection would be cut before the route handler times out.
+	readTimeout := conf.ServerReadTimeout
+	writeTimeout := conf.ServerWriteTimeout
+	for _, timeout := range conf.ServerRouteTimeouts {
+		if timeout > readTimeout {
+			readTimeout = timeout
+		}
+		if timeout > writeTimeout {
+			writeTimeout = timeout
+		}
+	}
+
+	port := util.GetOptionalEnv("PORT", "5000")
+	if c.dryRun {
+		return c.report(
+			map[string]interface{}{"addr": ":" + port, "dryRun": true},
+			"server ready to listen on :%s",
+			port,
+		)
+	}
+
+	srv := &http.Server{
+		Addr:              ":" + port,
+		Handler:           r,
+		IdleTimeout:       conf.ServerIdleTimeout,
+		ReadHeaderTimeout: conf.ServerReadTimeout,
+		ReadTimeout:       readTimeout,
+		WriteTimeout:      writeTimeout,
+	}
+
+	sched := scheduler.NewScheduler(
+		conf.SchedulerInterval,
+		scheduler.NewPublishJob(db, conf),
+		scheduler.NewDataExportJob(db, conf, svcs),
+		scheduler.NewAccountDeletionJob(db, conf, svcs),
+		scheduler.NewStudyDiscoveryJob(db, conf),
+	)
+	sched.Start()
+
+	go func() {
+		if err := srv.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+			mylog.Log.WithError(err).Fatal(util.Trace("server failed"))
+		}
+	}()
+
+	stop := make(chan os.Signal, 1)
+	signal.Notify(stop, syscall.SIGTERM, os.Interrupt)
+	<-stop
+
+	mylog.Log.Info("Shutting down server...")
+	ctx, cancel := context.WithTimeout(context.Background(), conf.ServerShutdownTimeout)
+	defer cancel()
+	if err := srv.Shutdown(ctx); err != nil {
+		mylog.Log.WithError(err).Error(util.Trace("failed to drain server"))
+	}
+	sched.Stop()
+	mylog.Log.Info("Server stopped")
+	return nil
+}
+
+func newTraceExporter(conf *myconf.Config) (mytrace.Exporter, error) {
+	switch conf.TracingExporter {
+	case "":
+		return nil, nil
+	case "otlp":
+		return mytrace.NewOTLPExporter(conf.TracingOTLPEndpoint, conf.AppName), nil
+	case "stdout":
+		return mytrace.NewStdoutExporter(), nil
+	case "file":
+		return mytrace.NewFileExporter(conf.TracingFilePath)
+	default:
+		return nil, fmt.Errorf("invalid tracing exporter: %q", conf.TracingExporter)
+	}
+}
+
+// searchRankWeights returns the default search rank weights overridden by
+// those configured.
+func searchRankWeights(conf *myconf.Config) data.SearchRankWeights {
+	weights := data.DefaultSearchRankWeights()
+	for name, weight := range conf.SearchRankWeights {
+		if name == "text" {
+			weights.Text = weight
+		} else {
+			weights.Popularity[name] = weight
+		}
+	}
+	return weights
+}
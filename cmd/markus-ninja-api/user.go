@@ -0,0 +1,292 @@
+package main
+
+import (
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/pgtype"
+	"github.com/marksauter/markus-ninja-api/pkg/data"
+	"github.com/marksauter/markus-ninja-api/pkg/mylog"
+	"github.com/marksauter/markus-ninja-api/pkg/mytype"
+	"github.com/marksauter/markus-ninja-api/pkg/util"
+)
+
+var (
+	userCreateCommand = &command{
+		name:  "user create",
+		args:  "<login> <email>",
+		usage: "Create a user. A password is generated unless --password is passed.",
+		run:   runUserCreate,
+	}
+	userGrantRoleCommand = &command{
+		name:  "user grant-role",
+		args:  "<login> <role>...",
+		usage: "Grant roles, e.g. ADMIN, to a user.",
+		run:   runUserGrantRole,
+	}
+	userRevokeRoleCommand = &command{
+		name:  "user revoke-role",
+		args:  "<login> <role>...",
+		usage: "Revoke roles from a user.",
+		run:   runUserRevokeRole,
+	}
+	userVerifyCommand = &command{
+		name:  "user verify",
+		args:  "<login>",
+		usage: "Mark the primary email of a user as verified.",
+		run:   runUserVerify,
+	}
+	userResetPasswordCommand = &command{
+		name:  "user reset-password",
+		args:  "<login>",
+		usage: "Reset the password of a user. A password is generated unless --password is passed.",
+		run:   runUserResetPassword,
+	}
+)
+
+type userResult struct {
+	DryRun   bool     `json:"dryRun"`
+	ID       string   `json:"id,omitempty"`
+	Login    string   `json:"login"`
+	Password string   `json:"password,omitempty"`
+	Roles    []string `json:"roles,omitempty"`
+}
+
+var errUsage = errors.New("invalid arguments")
+
+func runUserCreate(c *cli, cmd *command, args []string) error {
+	fs := c.flagSet(cmd)
+	password := fs.String("password", "", "password of the user")
+	roles := fs.String("roles", data.UserRole, "comma-separated roles to grant the user")
+	fs.Parse(args)
+	if fs.NArg() != 2 {
+		fs.Usage()
+		return errUsage
+	}
+	login, email := fs.Arg(0), fs.Arg(1)
+
+	result := &userResult{DryRun: c.dryRun, Login: login}
+	if *password == "" {
+		generated, err := newPassword()
+		if err != nil {
+			return err
+		}
+		*password = generated
+		result.Password = *password
+	}
+	if *roles != "" {
+		result.Roles = strings.Split(strings.ToUpper(*roles), ",")
+	}
+
+	user := &data.User{}
+	if err := user.Login.Set(login); err != nil {
+		return err
+	}
+	if err := user.Password.Set(*password); err != nil {
+		return err
+	}
+	if err := user.PrimaryEmail.Set(email); err != nil {
+		return err
+	}
+
+	db, err := c.openRootDB()
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	err = c.inTransaction(db, func(tx data.Queryer) error {
+		user, err := data.CreateUser(tx, user)
+		if err != nil {
+			return err
+		}
+		result.ID = user.ID.String
+		return data.GrantUserRoles(tx, user.ID.String, result.Roles...)
+	})
+	if err != nil {
+		return err
+	}
+
+	if result.Password != "" {
+		return c.report(result, "created user %s (%s) with password %s", login, result.ID, result.Password)
+	}
+	return c.report(result, "created user %s (%s)", login, result.ID)
+}
+
+func runUserGrantRole(c *cli, cmd *command, args []string) error {
+	return runUserRoles(c, cmd, args, data.GrantUserRoles, "granted")
+}
+
+func runUserRevokeRole(c *cli, cmd *command, args []string) error {
+	return runUserRoles(c, cmd, args, data.RevokeUserRoles, "revoked")
+}
+
+func runUserRoles(
+	c *cli,
+	cmd *command,
+	args []string,
+	update func(db data.Queryer, userID string, roles ...string) error,
+	verb string,
+) error {
+	fs := c.flagSet(cmd)
+	fs.Parse(args)
+	if fs.NArg() < 2 {
+		fs.Usage()
+		return errUsage
+	}
+	login := fs.Arg(0)
+	roles := make([]string, fs.NArg()-1)
+	for i, r := range fs.Args()[1:] {
+		roles[i] = strings.ToUpper(r)
+	}
+
+	db, err := c.openRootDB()
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	result := &userResult{DryRun: c.dryRun, Login: login}
+	err = c.inTransaction(db, func(tx data.Queryer) error {
+		user, err := data.GetUserByLogin(tx, login)
+		if err != nil {
+			return err
+		}
+		result.ID = user.ID.String
+		if err := update(tx, user.ID.String, roles...); err != nil {
+			return err
+		}
+		userRoles, err := data.GetRoleByUser(tx, user.ID.String, nil)
+		if err != nil {
+			return err
+		}
+		result.Roles = make([]string, len(userRoles))
+		for i, r := range userRoles {
+			result.Roles[i] = r.Name.String
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	return c.report(result, "%s roles %v, %s now has roles %v", verb, roles, login, result.Roles)
+}
+
+func runUserVerify(c *cli, cmd *command, args []string) error {
+	fs := c.flagSet(cmd)
+	fs.Parse(args)
+	if fs.NArg() != 1 {
+		fs.Usage()
+		return errUsage
+	}
+	login := fs.Arg(0)
+
+	db, err := c.openRootDB()
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	result := &userResult{DryRun: c.dryRun, Login: login}
+	err = c.inTransaction(db, func(tx data.Queryer) error {
+		user, err := data.GetUserByLogin(tx, login)
+		if err != nil {
+			return err
+		}
+		result.ID = user.ID.String
+		emails, err := data.GetEmailByUser(
+			tx,
+			user.ID.String,
+			nil,
+			&data.EmailFilterOptions{Types: &[]string{mytype.PrimaryEmail.String()}},
+		)
+		if err != nil {
+			return err
+		}
+		if len(emails) == 0 {
+			return errors.New("user has no primary email")
+		}
+		email := emails[0]
+		if email.VerifiedAt.Status == pgtype.Present {
+			return nil
+		}
+		if err := email.VerifiedAt.Set(time.Now()); err != nil {
+			mylog.Log.WithError(err).Error(util.Trace(""))
+			return err
+		}
+		_, err = data.UpdateEmail(tx, email)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	return c.report(result, "verified the primary email of %s", login)
+}
+
+func runUserResetPassword(c *cli, cmd *command, args []string) error {
+	fs := c.flagSet(cmd)
+	password := fs.String("password", "", "new password of the user")
+	fs.Parse(args)
+	if fs.NArg() != 1 {
+		fs.Usage()
+		return errUsage
+	}
+	login := fs.Arg(0)
+
+	result := &userResult{DryRun: c.dryRun, Login: login}
+	if *password == "" {
+		generated, err := newPassword()
+		if err != nil {
+			return err
+		}
+		*password = generated
+		result.Password = *password
+	}
+
+	db, err := c.openRootDB()
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	err = c.inTransaction(db, func(tx data.Queryer) error {
+		user, err := data.GetUserByLogin(tx, login)
+		if err != nil {
+			return err
+		}
+		result.ID = user.ID.String
+		update := &data.User{}
+		if err := update.ID.Set(&user.ID); err != nil {
+			return err
+		}
+		if err := update.Password.Set(*password); err != nil {
+			return err
+		}
+		_, err = data.UpdateUserAccount(tx, update)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	if result.Password != "" {
+		return c.report(result, "reset the password of %s to %s", login, result.Password)
+	}
+	return c.report(result, "reset the password of %s", login)
+}
+
+// newPassword returns a random password, for users created or reset without
+// one.
+func newPassword() (string, error) {
+	b := make([]byte, 18)
+	if _, err := rand.Read(b); err != nil {
+		mylog.Log.WithError(err).Error(util.Trace(""))
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
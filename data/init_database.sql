@@ -335,6 +335,136 @@ CREATE TABLE IF NOT EXISTS password_reset_token(
     ON UPDATE NO ACTION ON DELETE CASCADE
 );
 
+CREATE TABLE IF NOT EXISTS feed_token(
+  created_at    TIMESTAMPTZ   DEFAULT statement_timestamp(),
+  token         VARCHAR(64)   NOT NULL,
+  user_id       VARCHAR(100)  PRIMARY KEY,
+  FOREIGN KEY (user_id)
+    REFERENCES account (id)
+    ON UPDATE NO ACTION ON DELETE CASCADE
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS feed_token_token_key
+  ON feed_token (token);
+
+CREATE TABLE IF NOT EXISTS data_export(
+  created_at    TIMESTAMPTZ   DEFAULT statement_timestamp(),
+  ended_at      TIMESTAMPTZ,
+  expires_at    TIMESTAMPTZ,
+  id            VARCHAR(100)  PRIMARY KEY,
+  object_path   TEXT,
+  started_at    TIMESTAMPTZ,
+  status        VARCHAR(20)   NOT NULL DEFAULT 'pending'
+    CHECK (status IN ('pending', 'running', 'succeeded', 'failed')),
+  user_id       VARCHAR(100)  NOT NULL,
+  FOREIGN KEY (user_id)
+    REFERENCES account (id)
+    ON UPDATE NO ACTION ON DELETE CASCADE
+);
+
+-- Only one export may be pending or running per user at a time.
+CREATE UNIQUE INDEX IF NOT EXISTS data_export_unique_active_user_id_idx
+  ON data_export (user_id)
+  WHERE status IN ('pending', 'running');
+
+CREATE TABLE IF NOT EXISTS account_deletion(
+  requested_at  TIMESTAMPTZ   DEFAULT statement_timestamp(),
+  scheduled_for TIMESTAMPTZ   NOT NULL,
+  user_id       VARCHAR(100)  PRIMARY KEY,
+  FOREIGN KEY (user_id)
+    REFERENCES account (id)
+    ON UPDATE NO ACTION ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS account_deletion_scheduled_for_idx
+  ON account_deletion (scheduled_for);
+
+-- Audit record of deleted accounts. It outlives the account on purpose, so
+-- there is no foreign key to account.
+CREATE TABLE IF NOT EXISTS account_deletion_log(
+  assets_deleted      INT           NOT NULL DEFAULT 0,
+  comments_deleted    INT           NOT NULL DEFAULT 0,
+  comments_reassigned INT           NOT NULL DEFAULT 0,
+  courses_deleted     INT           NOT NULL DEFAULT 0,
+  deleted_at          TIMESTAMPTZ   DEFAULT statement_timestamp(),
+  lessons_deleted     INT           NOT NULL DEFAULT 0,
+  login               VARCHAR(40)   NOT NULL,
+  objects_removed     INT           NOT NULL DEFAULT 0,
+  requested_at        TIMESTAMPTZ,
+  studies_deleted     INT           NOT NULL DEFAULT 0,
+  user_id             VARCHAR(100)  PRIMARY KEY
+);
+
+CREATE TABLE IF NOT EXISTS content_report(
+  created_at    TIMESTAMPTZ   DEFAULT statement_timestamp(),
+  id            VARCHAR(100)  PRIMARY KEY,
+  note          TEXT,
+  reason        VARCHAR(20)   NOT NULL
+    CHECK (reason IN ('ABUSE', 'COPYRIGHT', 'HARASSMENT', 'OTHER', 'SPAM')),
+  reporter_id   VARCHAR(100)  NOT NULL,
+  resolved_at   TIMESTAMPTZ,
+  status        VARCHAR(20)   NOT NULL DEFAULT 'OPEN'
+    CHECK (status IN ('DISMISSED', 'OPEN', 'RESOLVED')),
+  subject_id    VARCHAR(100)  NOT NULL,
+  FOREIGN KEY (reporter_id)
+    REFERENCES account (id)
+    ON UPDATE NO ACTION ON DELETE CASCADE
+);
+
+-- A user may only have one open report per subject.
+CREATE UNIQUE INDEX IF NOT EXISTS content_report_unique_open_reporter_id_subject_id_idx
+  ON content_report (reporter_id, subject_id)
+  WHERE status = 'OPEN';
+CREATE INDEX IF NOT EXISTS content_report_status_created_at_idx
+  ON content_report (status, created_at);
+CREATE INDEX IF NOT EXISTS content_report_subject_id_idx
+  ON content_report (subject_id);
+
+-- Audit trail of the actions taken by admins. It outlives the subjects and
+-- users involved on purpose, so there are no foreign keys.
+CREATE TABLE IF NOT EXISTS moderation_action(
+  action        VARCHAR(20)   NOT NULL
+    CHECK (action IN ('DELETE', 'DISMISS', 'HIDE', 'RESTORE', 'SUSPEND', 'WARN')),
+  actor_id      VARCHAR(100)  NOT NULL,
+  created_at    TIMESTAMPTZ   DEFAULT statement_timestamp(),
+  id            VARCHAR(100)  PRIMARY KEY,
+  note          TEXT,
+  subject_id    VARCHAR(100)  NOT NULL,
+  user_id       VARCHAR(100)
+);
+
+CREATE INDEX IF NOT EXISTS moderation_action_subject_id_idx
+  ON moderation_action (subject_id);
+
+CREATE TABLE IF NOT EXISTS hidden_content(
+  hidden_at     TIMESTAMPTZ   DEFAULT statement_timestamp(),
+  subject_id    VARCHAR(100)  PRIMARY KEY
+);
+
+CREATE TABLE IF NOT EXISTS user_suspension(
+  suspended_at  TIMESTAMPTZ   DEFAULT statement_timestamp(),
+  user_id       VARCHAR(100)  PRIMARY KEY,
+  FOREIGN KEY (user_id)
+    REFERENCES account (id)
+    ON UPDATE NO ACTION ON DELETE CASCADE
+);
+
+CREATE TABLE IF NOT EXISTS user_block(
+  blocked_id    VARCHAR(100)  NOT NULL,
+  blocker_id    VARCHAR(100)  NOT NULL,
+  created_at    TIMESTAMPTZ   DEFAULT statement_timestamp(),
+  PRIMARY KEY (blocker_id, blocked_id),
+  FOREIGN KEY (blocked_id)
+    REFERENCES account (id)
+    ON UPDATE NO ACTION ON DELETE CASCADE,
+  FOREIGN KEY (blocker_id)
+    REFERENCES account (id)
+    ON UPDATE NO ACTION ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS user_block_blocked_id_idx
+  ON user_block (blocked_id);
+
 CREATE TABLE IF NOT EXISTS study(
   advanced_at   TIMESTAMPTZ,
   created_at    TIMESTAMPTZ   DEFAULT statement_timestamp(),
@@ -350,6 +480,41 @@ CREATE TABLE IF NOT EXISTS study(
     ON UPDATE NO ACTION ON DELETE CASCADE
 );
 
+-- The text search configuration the descriptions and bodies of the study's
+-- content are stemmed with.
+ALTER TABLE study
+  ADD COLUMN IF NOT EXISTS search_language VARCHAR(20) NOT NULL DEFAULT 'english'
+    CHECK (search_language IN (
+      'danish',
+      'dutch',
+      'english',
+      'finnish',
+      'french',
+      'german',
+      'hungarian',
+      'italian',
+      'norwegian',
+      'portuguese',
+      'romanian',
+      'russian',
+      'simple',
+      'spanish',
+      'swedish',
+      'turkish'
+    ));
+
+CREATE OR REPLACE FUNCTION study_search_config(_study_id VARCHAR)
+  RETURNS REGCONFIG
+  SECURITY DEFINER
+  LANGUAGE sql
+  STABLE
+AS $$
+  SELECT coalesce(
+    (SELECT search_language FROM study WHERE id = _study_id),
+    'english'
+  )::regconfig;
+$$;
+
 CREATE OR REPLACE FUNCTION study_will_update()
   RETURNS TRIGGER 
   SECURITY DEFINER
@@ -386,6 +551,47 @@ AS $$
   WHERE study.id = _study_id;
 $$;
 
+CREATE TABLE IF NOT EXISTS study_invitation(
+  access        VARCHAR(10)   NOT NULL CHECK (access IN ('ENROLL', 'READ')),
+  created_at    TIMESTAMPTZ   DEFAULT statement_timestamp(),
+  email         VARCHAR(40),
+  expires_at    TIMESTAMPTZ,
+  id            VARCHAR(100)  PRIMARY KEY,
+  inviter_id    VARCHAR(100)  NOT NULL,
+  max_uses      INT           CHECK (max_uses > 0),
+  revoked_at    TIMESTAMPTZ,
+  study_id      VARCHAR(100)  NOT NULL,
+  token         VARCHAR(40)   NOT NULL,
+  use_count     INT           NOT NULL DEFAULT 0,
+  FOREIGN KEY (inviter_id)
+    REFERENCES account (id)
+    ON UPDATE NO ACTION ON DELETE CASCADE,
+  FOREIGN KEY (study_id)
+    REFERENCES study (id)
+    ON UPDATE NO ACTION ON DELETE CASCADE
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS study_invitation_unique_token_idx
+  ON study_invitation (token);
+
+CREATE INDEX IF NOT EXISTS study_invitation_study_id_idx
+  ON study_invitation (study_id);
+
+CREATE TABLE IF NOT EXISTS study_access(
+  access        VARCHAR(10)   NOT NULL CHECK (access IN ('ENROLL', 'READ')),
+  created_at    TIMESTAMPTZ   DEFAULT statement_timestamp(),
+  invitation_id VARCHAR(100),
+  study_id      VARCHAR(100)  NOT NULL,
+  user_id       VARCHAR(100)  NOT NULL,
+  PRIMARY KEY (user_id, study_id),
+  FOREIGN KEY (study_id)
+    REFERENCES study (id)
+    ON UPDATE NO ACTION ON DELETE CASCADE,
+  FOREIGN KEY (user_id)
+    REFERENCES account (id)
+    ON UPDATE NO ACTION ON DELETE CASCADE
+);
+
 CREATE TABLE IF NOT EXISTS lesson(
   body            TEXT,
   created_at      TIMESTAMPTZ  DEFAULT statement_timestamp(),
@@ -394,11 +600,15 @@ CREATE TABLE IF NOT EXISTS lesson(
   last_edited_at  TIMESTAMPTZ  DEFAULT statement_timestamp(),
   number          INT          NOT NULL CHECK(number > 0),
   published_at    TIMESTAMPTZ,
+  reading_time_minutes INT     NOT NULL DEFAULT 0,
+  scheduled_publish_at TIMESTAMPTZ,
   study_id        VARCHAR(100) NOT NULL,    
   title           TEXT         NOT NULL,
   title_tokens    TEXT         NOT NULL,
+  toc             JSONB        NOT NULL DEFAULT '[]',
   updated_at      TIMESTAMPTZ  DEFAULT statement_timestamp(),
   user_id         VARCHAR(100) NOT NULL,
+  word_count      INT          NOT NULL DEFAULT 0,
   FOREIGN KEY (study_id)
     REFERENCES study (id)
     ON UPDATE NO ACTION ON DELETE CASCADE,
@@ -407,6 +617,16 @@ CREATE TABLE IF NOT EXISTS lesson(
     ON UPDATE NO ACTION ON DELETE CASCADE
 );
 
+ALTER TABLE lesson
+  ADD COLUMN IF NOT EXISTS reading_time_minutes INT NOT NULL DEFAULT 0,
+  ADD COLUMN IF NOT EXISTS scheduled_publish_at TIMESTAMPTZ,
+  ADD COLUMN IF NOT EXISTS toc JSONB NOT NULL DEFAULT '[]',
+  ADD COLUMN IF NOT EXISTS word_count INT NOT NULL DEFAULT 0;
+
+CREATE INDEX IF NOT EXISTS lesson_scheduled_publish_at_idx
+  ON lesson (scheduled_publish_at)
+  WHERE scheduled_publish_at IS NOT NULL;
+
 CREATE OR REPLACE FUNCTION lesson_will_insert()
   RETURNS TRIGGER
   SECURITY DEFINER
@@ -549,6 +769,7 @@ CREATE TABLE IF NOT EXISTS course(
   name_tokens   TEXT          NOT NULL,
   number        INT           CHECK(number > 0),
   published_at  TIMESTAMPTZ,
+  scheduled_publish_at TIMESTAMPTZ,
   status        course_status DEFAULT 'ADVANCING',
   study_id      VARCHAR(100)  NOT NULL,
   updated_at    TIMESTAMPTZ   DEFAULT statement_timestamp(),
@@ -561,6 +782,13 @@ CREATE TABLE IF NOT EXISTS course(
     ON UPDATE NO ACTION ON DELETE CASCADE
 );
 
+ALTER TABLE course
+  ADD COLUMN IF NOT EXISTS scheduled_publish_at TIMESTAMPTZ;
+
+CREATE INDEX IF NOT EXISTS course_scheduled_publish_at_idx
+  ON course (scheduled_publish_at)
+  WHERE scheduled_publish_at IS NOT NULL;
+
 CREATE OR REPLACE FUNCTION course_will_insert()
   RETURNS TRIGGER
   SECURITY DEFINER
@@ -670,6 +898,67 @@ END IF;
 END;
 $$ language 'plpgsql';
 
+CREATE TABLE IF NOT EXISTS lesson_prerequisite(
+  created_at      TIMESTAMPTZ  DEFAULT statement_timestamp(),
+  lesson_id       VARCHAR(100) NOT NULL,
+  prerequisite_id VARCHAR(100) NOT NULL,
+  PRIMARY KEY (lesson_id, prerequisite_id),
+  FOREIGN KEY (lesson_id)
+    REFERENCES lesson (id)
+    ON UPDATE NO ACTION ON DELETE CASCADE,
+  FOREIGN KEY (prerequisite_id)
+    REFERENCES lesson (id)
+    ON UPDATE NO ACTION ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS lesson_prerequisite_prerequisite_id_idx
+  ON lesson_prerequisite (prerequisite_id);
+
+-- Prerequisites must form a DAG, so a prerequisite may not be the lesson
+-- itself, nor depend on the lesson through other prerequisites. Inserts are
+-- serialized, so that concurrent inserts cannot each close half of a cycle.
+CREATE OR REPLACE FUNCTION lesson_prerequisite_will_insert()
+  RETURNS TRIGGER
+  SECURITY DEFINER
+  LANGUAGE plpgsql
+AS $$
+BEGIN
+  PERFORM pg_advisory_xact_lock(hashtext('lesson_prerequisite'));
+
+  IF EXISTS(
+    WITH RECURSIVE ancestor(id) AS (
+      SELECT NEW.prerequisite_id
+      UNION
+      SELECT lesson_prerequisite.prerequisite_id
+      FROM lesson_prerequisite
+      JOIN ancestor ON ancestor.id = lesson_prerequisite.lesson_id
+    )
+    SELECT 1 FROM ancestor WHERE id = NEW.lesson_id
+  ) THEN
+    RAISE EXCEPTION 'lesson prerequisite would create a cycle'
+      USING ERRCODE = 'check_violation',
+        CONSTRAINT = 'lesson_prerequisite_acyclic';
+  END IF;
+
+  RETURN NEW;
+END;
+$$;
+
+DO $$
+BEGIN
+IF NOT EXISTS(
+  SELECT *
+    FROM information_schema.triggers
+    WHERE event_object_table = 'lesson_prerequisite'
+    AND trigger_name = 'before_lesson_prerequisite_insert'
+) THEN
+  CREATE TRIGGER before_lesson_prerequisite_insert
+    BEFORE INSERT ON lesson_prerequisite
+    FOR EACH ROW EXECUTE PROCEDURE lesson_prerequisite_will_insert();
+END IF;
+END;
+$$ language 'plpgsql';
+
 DO $$
 BEGIN
   IF NOT EXISTS (SELECT 1 FROM pg_type WHERE typname = 'commentable_type') THEN
@@ -831,6 +1120,12 @@ CREATE UNIQUE INDEX IF NOT EXISTS label_unique_study_id_name_idx
 CREATE INDEX IF NOT EXISTS label_fts_idx
   ON label USING gin(document);
 
+CREATE INDEX IF NOT EXISTS label_name_trgm_idx
+  ON label USING gin(name gin_trgm_ops);
+
+CREATE INDEX IF NOT EXISTS label_study_id_name_prefix_idx
+  ON label (study_id, lower(name) text_pattern_ops);
+
 CREATE OR REPLACE FUNCTION label_will_insert()
   RETURNS TRIGGER
   SECURITY DEFINER
@@ -946,6 +1241,10 @@ CREATE OR REPLACE FUNCTION topic_will_insert()
 AS $$
 BEGIN
   NEW.name = lower(NEW.name);
+  IF EXISTS (SELECT 1 FROM topic_alias WHERE name = NEW.name) THEN
+    RAISE EXCEPTION 'topic name % is an alias', NEW.name
+      USING ERRCODE = 'unique_violation';
+  END IF;
   RETURN NEW;
 END;
 $$;
@@ -1127,6 +1426,30 @@ BEGIN
       VALUES (NEW.id, NEW.commentable_id);
   END CASE;
 
+  INSERT INTO comment_search_index(
+    body,
+    commentable_id,
+    created_at,
+    document,
+    id,
+    published_at,
+    study_id,
+    type,
+    updated_at,
+    user_id
+  ) VALUES (
+    NEW.body,
+    NEW.commentable_id,
+    NEW.created_at,
+    setweight(to_tsvector(study_search_config(NEW.study_id), coalesce(NEW.body, '')), 'B'),
+    NEW.id,
+    NEW.published_at,
+    NEW.study_id,
+    NEW.type,
+    NEW.updated_at,
+    NEW.user_id
+  );
+
   RETURN NEW;
 END;
 $$;
@@ -2772,6 +3095,15 @@ CREATE UNIQUE INDEX IF NOT EXISTS user_search_index_login_idx
 CREATE INDEX IF NOT EXISTS user_search_index_fts_idx
   ON user_search_index USING gin(document);
 
+CREATE INDEX IF NOT EXISTS user_search_index_login_trgm_idx
+  ON user_search_index USING gin(login gin_trgm_ops);
+
+CREATE INDEX IF NOT EXISTS user_search_index_name_trgm_idx
+  ON user_search_index USING gin(name gin_trgm_ops);
+
+CREATE INDEX IF NOT EXISTS user_search_index_login_prefix_idx
+  ON user_search_index (lower(login) text_pattern_ops);
+
 CREATE INDEX IF NOT EXISTS user_search_index_created_at_idx
   ON user_search_index (created_at);
 
@@ -3201,6 +3533,9 @@ CREATE TABLE IF NOT EXISTS activity_search_index (
 CREATE INDEX IF NOT EXISTS activity_search_index_fts_idx
   ON activity_search_index USING gin(document);
 
+CREATE INDEX IF NOT EXISTS activity_search_index_name_trgm_idx
+  ON activity_search_index USING gin(name gin_trgm_ops);
+
 CREATE INDEX IF NOT EXISTS activity_search_index_advanced_at_idx
   ON activity_search_index (advanced_at);
 
@@ -3256,7 +3591,7 @@ AS $$
       NEW.created_at,
       NEW.description,
       setweight(to_tsvector('simple', NEW.name_tokens), 'A') ||
-      setweight(to_tsvector('english', coalesce(NEW.description, '')), 'B'),
+      setweight(to_tsvector(study_search_config(NEW.study_id), coalesce(NEW.description, '')), 'B'),
       NEW.id,
       NEW.lesson_id,
       NEW.name,
@@ -3295,7 +3630,7 @@ AS $$
   BEGIN
     IF NEW.name != OLD.name OR NEW.description != OLD.description THEN
       doc = setweight(to_tsvector('simple', NEW.name_tokens), 'A') ||
-        setweight(to_tsvector('english', coalesce(NEW.description, '')), 'B');
+        setweight(to_tsvector(study_search_config(NEW.study_id), coalesce(NEW.description, '')), 'B');
     ELSE
       doc = (SELECT document FROM activity_search_index WHERE id = NEW.id); 
     END IF;
@@ -3357,6 +3692,8 @@ CREATE TABLE IF NOT EXISTS course_search_index (
   name_tokens   TEXT         NOT NULL,
   number        INT          NOT NULL CHECK(number > 0),
   published_at  TIMESTAMPTZ,
+  reading_time_minutes BIGINT NOT NULL DEFAULT 0,
+  scheduled_publish_at TIMESTAMPTZ,
   status        course_status NOT NULL,
   study_id      VARCHAR(100) NOT NULL,
   topics        TSVECTOR     NOT NULL,   
@@ -3373,6 +3710,10 @@ CREATE TABLE IF NOT EXISTS course_search_index (
     ON UPDATE CASCADE ON DELETE CASCADE
 );
 
+ALTER TABLE course_search_index
+  ADD COLUMN IF NOT EXISTS reading_time_minutes BIGINT NOT NULL DEFAULT 0,
+  ADD COLUMN IF NOT EXISTS scheduled_publish_at TIMESTAMPTZ;
+
 CREATE UNIQUE INDEX IF NOT EXISTS course_search_index_study_id_name_idx
   ON course_search_index (study_id, lower(name));
 
@@ -3382,6 +3723,9 @@ CREATE UNIQUE INDEX IF NOT EXISTS course_search_index_study_id_number_idx
 CREATE INDEX IF NOT EXISTS course_search_index_fts_idx
   ON course_search_index USING gin(document);
 
+CREATE INDEX IF NOT EXISTS course_search_index_name_trgm_idx
+  ON course_search_index USING gin(name gin_trgm_ops);
+
 CREATE INDEX IF NOT EXISTS course_search_index_topics_idx
   ON course_search_index USING gin(topics);
 
@@ -3432,7 +3776,7 @@ AS $$
       NEW.created_at,
       NEW.description,
       setweight(to_tsvector('simple', NEW.name_tokens), 'A') ||
-      setweight(to_tsvector('english', coalesce(NEW.description, '')), 'B'),
+      setweight(to_tsvector(study_search_config(NEW.study_id), coalesce(NEW.description, '')), 'B'),
       NEW.id,
       NEW.name,
       NEW.name_tokens,
@@ -3472,7 +3816,7 @@ AS $$
   BEGIN
     IF NEW.name != OLD.name OR NEW.description != OLD.description THEN
       doc = setweight(to_tsvector('simple', NEW.name_tokens), 'A') ||
-        setweight(to_tsvector('english', coalesce(NEW.description, '')), 'B');
+        setweight(to_tsvector(study_search_config(NEW.study_id), coalesce(NEW.description, '')), 'B');
     ELSE
       doc = (SELECT document FROM course_search_index WHERE id = NEW.id); 
     END IF;
@@ -3486,6 +3830,7 @@ AS $$
       published_at = NEW.published_at,
       name = NEW.name,
       name_tokens = NEW.name_tokens,
+      scheduled_publish_at = NEW.scheduled_publish_at,
       status = NEW.status,
       updated_at = NEW.updated_at
     WHERE id = NEW.id;
@@ -3523,6 +3868,21 @@ AS $$
   WHERE id = _course_id;
 $$;
 
+CREATE OR REPLACE FUNCTION refresh_course_search_index_reading_time(_course_id VARCHAR)
+  RETURNS VOID 
+  SECURITY DEFINER
+  LANGUAGE sql
+AS $$
+  UPDATE course_search_index
+  SET reading_time_minutes = (
+    SELECT coalesce(sum(lesson.reading_time_minutes), 0) reading_time_minutes
+    FROM course_lesson
+    JOIN lesson ON lesson.id = course_lesson.lesson_id
+    WHERE course_lesson.course_id = _course_id
+  )
+  WHERE id = _course_id;
+$$;
+
 CREATE OR REPLACE FUNCTION refresh_course_search_index_apple_count(_course_id VARCHAR)
   RETURNS VOID 
   SECURITY DEFINER
@@ -3576,9 +3936,18 @@ CREATE TABLE IF NOT EXISTS study_search_index (
     ON UPDATE CASCADE ON DELETE CASCADE
 );
 
+ALTER TABLE study_search_index
+  ADD COLUMN IF NOT EXISTS search_language VARCHAR(20) NOT NULL DEFAULT 'english';
+
 CREATE INDEX IF NOT EXISTS study_search_index_fts_idx
   ON study_search_index USING gin(document);
 
+CREATE INDEX IF NOT EXISTS study_search_index_name_trgm_idx
+  ON study_search_index USING gin(name gin_trgm_ops);
+
+CREATE INDEX IF NOT EXISTS study_search_index_name_prefix_idx
+  ON study_search_index (lower(name) text_pattern_ops);
+
 CREATE INDEX IF NOT EXISTS study_search_index_topics_idx
   ON study_search_index USING gin(topics);
 
@@ -3617,6 +3986,7 @@ AS $$
       name,
       name_tokens,
       private,
+      search_language,
       topics,
       updated_at,
       user_id
@@ -3624,11 +3994,12 @@ AS $$
       NEW.created_at,
       NEW.description,
       setweight(to_tsvector('simple', NEW.name_tokens), 'A') ||
-      setweight(to_tsvector('english', coalesce(NEW.description, '')), 'B'),
+      setweight(to_tsvector(NEW.search_language::regconfig, coalesce(NEW.description, '')), 'B'),
       NEW.id,
       NEW.name,
       NEW.name_tokens,
       NEW.private,
+      NEW.search_language,
       setweight(to_tsvector('simple', ''), 'A'),
       NEW.updated_at,
       NEW.user_id
@@ -3661,9 +4032,10 @@ AS $$
   DECLARE
     doc TSVECTOR;
   BEGIN
-    IF NEW.name != OLD.name OR NEW.description != OLD.description THEN
+    IF NEW.name != OLD.name OR NEW.description != OLD.description OR
+      NEW.search_language != OLD.search_language THEN
       doc = setweight(to_tsvector('simple', NEW.name_tokens), 'A') ||
-        setweight(to_tsvector('english', coalesce(NEW.description, '')), 'B');
+        setweight(to_tsvector(NEW.search_language::regconfig, coalesce(NEW.description, '')), 'B');
     ELSE
       doc = (SELECT document FROM study_search_index WHERE id = NEW.id); 
     END IF;
@@ -3676,9 +4048,14 @@ AS $$
       name = NEW.name,
       name_tokens = NEW.name_tokens,
       private = NEW.private,
+      search_language = NEW.search_language,
       updated_at = NEW.updated_at
     WHERE id = NEW.id;
 
+    IF NEW.search_language != OLD.search_language THEN
+      PERFORM refresh_study_content_documents(NEW.id);
+    END IF;
+
     RETURN NEW;
   END;
 $$;
@@ -3915,11 +4292,15 @@ CREATE TABLE IF NOT EXISTS lesson_search_index (
   last_edited_at  TIMESTAMPTZ  DEFAULT statement_timestamp(),
   number          INT          NOT NULL CHECK(number > 0),
   published_at    TIMESTAMPTZ,
+  reading_time_minutes INT     NOT NULL DEFAULT 0,
+  scheduled_publish_at TIMESTAMPTZ,
   study_id        VARCHAR(100) NOT NULL,    
   title           TEXT         NOT NULL,
   title_tokens    TEXT         NOT NULL,
+  toc             JSONB        NOT NULL DEFAULT '[]',
   updated_at      TIMESTAMPTZ  DEFAULT statement_timestamp(),
   user_id         VARCHAR(100) NOT NULL,
+  word_count      INT          NOT NULL DEFAULT 0,
   FOREIGN KEY (course_id)
     REFERENCES course (id)
     ON UPDATE CASCADE ON DELETE NO ACTION,
@@ -3934,9 +4315,21 @@ CREATE TABLE IF NOT EXISTS lesson_search_index (
     ON UPDATE CASCADE ON DELETE CASCADE
 );
 
+ALTER TABLE lesson_search_index
+  ADD COLUMN IF NOT EXISTS reading_time_minutes INT NOT NULL DEFAULT 0,
+  ADD COLUMN IF NOT EXISTS scheduled_publish_at TIMESTAMPTZ,
+  ADD COLUMN IF NOT EXISTS toc JSONB NOT NULL DEFAULT '[]',
+  ADD COLUMN IF NOT EXISTS word_count INT NOT NULL DEFAULT 0;
+
 CREATE INDEX IF NOT EXISTS lesson_search_index_fts_idx
   ON lesson_search_index USING gin(document);
 
+CREATE INDEX IF NOT EXISTS lesson_search_index_title_trgm_idx
+  ON lesson_search_index USING gin(title gin_trgm_ops);
+
+CREATE INDEX IF NOT EXISTS lesson_search_index_study_id_title_prefix_idx
+  ON lesson_search_index (study_id, lower(title) text_pattern_ops);
+
 CREATE INDEX IF NOT EXISTS lesson_search_index_labels_idx
   ON lesson_search_index USING gin(labels);
 
@@ -3996,25 +4389,31 @@ AS $$
       id,
       labels,
       number,
+      reading_time_minutes,
       study_id,
       title,
       title_tokens,
+      toc,
       updated_at,
-      user_id
+      user_id,
+      word_count
     ) VALUES (
       NEW.created_at,
       NEW.body,
       setweight(to_tsvector('simple', NEW.title_tokens), 'A') ||
-      setweight(to_tsvector('english', coalesce(NEW.body, '')), 'B'),
+      setweight(to_tsvector(study_search_config(NEW.study_id), coalesce(NEW.body, '')), 'B'),
       NEW.draft,
       NEW.id,
       setweight(to_tsvector('simple', ''), 'A'),
       NEW.number,
+      NEW.reading_time_minutes,
       NEW.study_id,
       NEW.title,
       NEW.title_tokens,
+      NEW.toc,
       NEW.updated_at,
-      NEW.user_id
+      NEW.user_id,
+      NEW.word_count
     );
 
     RETURN NEW;
@@ -4046,7 +4445,7 @@ AS $$
   BEGIN
     IF NEW.title != OLD.title OR NEW.body != OLD.body THEN
       doc = setweight(to_tsvector('simple', NEW.title_tokens), 'A') ||
-        setweight(to_tsvector('english', coalesce(NEW.body, '')), 'B'); 
+        setweight(to_tsvector(study_search_config(NEW.study_id), coalesce(NEW.body, '')), 'B'); 
     ELSE
       doc = (SELECT document FROM lesson_search_index WHERE id = NEW.id); 
     END IF;
@@ -4059,11 +4458,21 @@ AS $$
       last_edited_at = NEW.last_edited_at,
       number = NEW.number,
       published_at = NEW.published_at,
+      reading_time_minutes = NEW.reading_time_minutes,
+      scheduled_publish_at = NEW.scheduled_publish_at,
       title = NEW.title,
       title_tokens = NEW.title_tokens,
-      updated_at = NEW.updated_at
+      toc = NEW.toc,
+      updated_at = NEW.updated_at,
+      word_count = NEW.word_count
     WHERE id = NEW.id;
 
+    IF NEW.reading_time_minutes != OLD.reading_time_minutes THEN
+      PERFORM refresh_course_search_index_reading_time(course_lesson.course_id)
+      FROM course_lesson
+      WHERE course_lesson.lesson_id = NEW.id;
+    END IF;
+
     RETURN NEW;
   END;
 $$;
@@ -4116,6 +4525,7 @@ AS $$
   BEGIN
     PERFORM advance_course(NEW.course_id);
     PERFORM refresh_course_search_index_lesson_count(NEW.course_id);
+    PERFORM refresh_course_search_index_reading_time(NEW.course_id);
     PERFORM refresh_lesson_search_index_course_info(NEW.lesson_id);
     RETURN NEW;
   END;
@@ -4152,6 +4562,7 @@ AS $$
     WHERE id = OLD.lesson_id;
 
     PERFORM refresh_course_search_index_lesson_count(OLD.course_id);
+    PERFORM refresh_course_search_index_reading_time(OLD.course_id);
     RETURN OLD;
   END;
 $$;
@@ -4197,6 +4608,43 @@ END IF;
 END;
 $$ language 'plpgsql';
 
+CREATE TABLE IF NOT EXISTS comment_search_index (
+  body            TEXT,
+  commentable_id  VARCHAR(100) NOT NULL,
+  created_at      TIMESTAMPTZ  DEFAULT statement_timestamp(),
+  document        TSVECTOR     NOT NULL,
+  id              VARCHAR(100) PRIMARY KEY,
+  published_at    TIMESTAMPTZ,
+  study_id        VARCHAR(100) NOT NULL,
+  type            commentable_type NOT NULL,
+  updated_at      TIMESTAMPTZ  DEFAULT statement_timestamp(),
+  user_id         VARCHAR(100) NOT NULL,
+  FOREIGN KEY (id)
+    REFERENCES comment (id)
+    ON UPDATE CASCADE ON DELETE CASCADE,
+  FOREIGN KEY (study_id)
+    REFERENCES study (id)
+    ON UPDATE CASCADE ON DELETE CASCADE,
+  FOREIGN KEY (user_id)
+    REFERENCES account (id)
+    ON UPDATE CASCADE ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS comment_search_index_fts_idx
+  ON comment_search_index USING gin(document);
+
+CREATE INDEX IF NOT EXISTS comment_search_index_created_at_idx
+  ON comment_search_index (created_at);
+
+CREATE INDEX IF NOT EXISTS comment_search_index_updated_at_idx
+  ON comment_search_index (updated_at);
+
+CREATE INDEX IF NOT EXISTS comment_search_index_study_id_created_at_idx
+  ON comment_search_index (study_id, created_at);
+
+CREATE INDEX IF NOT EXISTS comment_search_index_user_id_created_at_idx
+  ON comment_search_index (user_id, created_at);
+
 CREATE OR REPLACE FUNCTION refresh_lesson_search_index_comment_count(_lesson_id VARCHAR)
   RETURNS VOID 
   SECURITY DEFINER
@@ -4230,7 +4678,23 @@ CREATE OR REPLACE FUNCTION comment_updated()
   SECURITY DEFINER
   LANGUAGE plpgsql
 AS $$
+  DECLARE
+    doc TSVECTOR;
   BEGIN
+    IF NEW.body IS DISTINCT FROM OLD.body THEN
+      doc = setweight(to_tsvector(study_search_config(NEW.study_id), coalesce(NEW.body, '')), 'B');
+    ELSE
+      doc = (SELECT document FROM comment_search_index WHERE id = NEW.id);
+    END IF;
+
+    UPDATE comment_search_index
+    SET
+      body = NEW.body,
+      document = doc,
+      published_at = NEW.published_at,
+      updated_at = NEW.updated_at
+    WHERE id = NEW.id;
+
     IF OLD.published_at IS NULL AND NEW.published_at IS NOT NULL THEN
       CASE NEW.type
         WHEN 'Lesson' THEN
@@ -4397,6 +4861,74 @@ END IF;
 END;
 $$ language 'plpgsql';
 
+ALTER TABLE topic
+  ADD COLUMN IF NOT EXISTS display_name VARCHAR(80),
+  ADD COLUMN IF NOT EXISTS featured_at TIMESTAMPTZ,
+  ADD COLUMN IF NOT EXISTS logo_id VARCHAR(100)
+    REFERENCES user_asset (id) ON UPDATE NO ACTION ON DELETE SET NULL;
+
+CREATE INDEX IF NOT EXISTS topic_featured_at_idx
+  ON topic (featured_at)
+  WHERE featured_at IS NOT NULL;
+
+-- Topic aliases are other names of a topic, e.g. 'golang' for 'go'. Topics are
+-- looked up, and created, by their aliases as if by their name.
+CREATE TABLE IF NOT EXISTS topic_alias(
+  created_at  TIMESTAMPTZ  DEFAULT statement_timestamp(),
+  name        VARCHAR(40)  PRIMARY KEY CHECK(name ~ '^[a-z0-9-]{1,39}$'),
+  topic_id    VARCHAR(100) NOT NULL,
+  FOREIGN KEY (topic_id)
+    REFERENCES topic (id)
+    ON UPDATE NO ACTION ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS topic_alias_topic_id_idx
+  ON topic_alias (topic_id);
+
+CREATE OR REPLACE FUNCTION topic_alias_will_insert()
+  RETURNS TRIGGER
+  SECURITY DEFINER
+  LANGUAGE plpgsql
+AS $$
+BEGIN
+  NEW.name = lower(NEW.name);
+  IF EXISTS (SELECT 1 FROM topic WHERE lower(name) = NEW.name) THEN
+    RAISE EXCEPTION 'topic alias % is a topic name', NEW.name
+      USING ERRCODE = 'unique_violation';
+  END IF;
+  RETURN NEW;
+END;
+$$;
+
+DO $$
+BEGIN
+IF NOT EXISTS(
+  SELECT *
+    FROM information_schema.triggers
+    WHERE event_object_table = 'topic_alias'
+    AND trigger_name = 'before_topic_alias_insert'
+) THEN
+  CREATE TRIGGER before_topic_alias_insert
+    BEFORE INSERT ON topic_alias
+    FOR EACH ROW EXECUTE PROCEDURE topic_alias_will_insert();
+END IF;
+END;
+$$ language 'plpgsql';
+
+CREATE TABLE IF NOT EXISTS topic_relation(
+  created_at  TIMESTAMPTZ  DEFAULT statement_timestamp(),
+  related_id  VARCHAR(100) NOT NULL,
+  topic_id    VARCHAR(100) NOT NULL,
+  PRIMARY KEY (topic_id, related_id),
+  CHECK (related_id != topic_id),
+  FOREIGN KEY (related_id)
+    REFERENCES topic (id)
+    ON UPDATE NO ACTION ON DELETE CASCADE,
+  FOREIGN KEY (topic_id)
+    REFERENCES topic (id)
+    ON UPDATE NO ACTION ON DELETE CASCADE
+);
+
 CREATE TABLE IF NOT EXISTS topic_search_index (
   created_at    TIMESTAMPTZ  NOT NULL,
   description   TEXT,
@@ -4416,12 +4948,47 @@ CREATE UNIQUE INDEX IF NOT EXISTS topic_search_index_name_idx
 CREATE INDEX IF NOT EXISTS topic_search_index_fts_idx
   ON topic_search_index USING gin(document);
 
+CREATE INDEX IF NOT EXISTS topic_search_index_name_trgm_idx
+  ON topic_search_index USING gin(name gin_trgm_ops);
+
+CREATE INDEX IF NOT EXISTS topic_search_index_name_prefix_idx
+  ON topic_search_index (lower(name) text_pattern_ops);
+
 CREATE INDEX IF NOT EXISTS topic_search_index_created_at_idx
   ON topic_search_index (created_at);
 
 CREATE INDEX IF NOT EXISTS topic_search_index_topiced_count_idx
   ON topic_search_index (topiced_count DESC);
 
+ALTER TABLE topic_search_index
+  ADD COLUMN IF NOT EXISTS display_name VARCHAR(80),
+  ADD COLUMN IF NOT EXISTS featured_at TIMESTAMPTZ,
+  ADD COLUMN IF NOT EXISTS logo_id VARCHAR(100);
+
+CREATE INDEX IF NOT EXISTS topic_search_index_featured_at_idx
+  ON topic_search_index (featured_at)
+  WHERE featured_at IS NOT NULL;
+
+-- Topics are matched by their aliases, as well as by their names.
+CREATE OR REPLACE FUNCTION refresh_topic_search_index_document(_topic_id VARCHAR)
+  RETURNS VOID
+  SECURITY DEFINER
+  LANGUAGE sql
+AS $$
+  UPDATE topic_search_index
+  SET document =
+    setweight(to_tsvector('simple', topic.name), 'A') ||
+    setweight(to_tsvector('simple', coalesce(topic.display_name, '')), 'A') ||
+    setweight(to_tsvector('simple', coalesce((
+      SELECT string_agg(topic_alias.name, ' ')
+      FROM topic_alias
+      WHERE topic_alias.topic_id = topic.id
+    ), '')), 'A') ||
+    setweight(to_tsvector('english', coalesce(topic.description, '')), 'B')
+  FROM topic
+  WHERE topic.id = _topic_id AND topic_search_index.id = _topic_id;
+$$;
+
 CREATE OR REPLACE FUNCTION topic_inserted()
   RETURNS TRIGGER 
   SECURITY DEFINER
@@ -4431,16 +4998,23 @@ AS $$
     INSERT INTO topic_search_index(
       created_at,
       description,
+      display_name,
       document,
+      featured_at,
       id,
+      logo_id,
       name,
       updated_at
     ) VALUES (
       NEW.created_at,
       NEW.description,
+      NEW.display_name,
       setweight(to_tsvector('simple', NEW.name), 'A') ||
+      setweight(to_tsvector('simple', coalesce(NEW.display_name, '')), 'A') ||
       setweight(to_tsvector('english', coalesce(NEW.description, '')), 'B'),
+      NEW.featured_at,
       NEW.id,
+      NEW.logo_id,
       NEW.name,
       NEW.updated_at
     );
@@ -4469,24 +5043,23 @@ CREATE OR REPLACE FUNCTION topic_updated()
   SECURITY DEFINER
   LANGUAGE plpgsql
 AS $$
-  DECLARE
-    doc TSVECTOR;
   BEGIN
-    IF NEW.name != OLD.name OR NEW.description != OLD.description THEN
-      doc = setweight(to_tsvector('simple', NEW.name), 'A') ||
-        setweight(to_tsvector('english', coalesce(NEW.description, '')), 'B'); 
-    ELSE
-      doc = (SELECT document FROM topic_search_index WHERE id = NEW.id); 
-    END IF;
-
     UPDATE topic_search_index
     SET 
-      document = doc,
       description = NEW.description,
+      display_name = NEW.display_name,
+      featured_at = NEW.featured_at,
+      logo_id = NEW.logo_id,
       name = NEW.name,
       updated_at = NEW.updated_at
     WHERE id = NEW.id;
 
+    IF NEW.name != OLD.name OR
+      NEW.description IS DISTINCT FROM OLD.description OR
+      NEW.display_name IS DISTINCT FROM OLD.display_name THEN
+      PERFORM refresh_topic_search_index_document(NEW.id);
+    END IF;
+
     RETURN NEW;
   END;
 $$;
@@ -4593,6 +5166,129 @@ END IF;
 END;
 $$ language 'plpgsql';
 
+CREATE OR REPLACE FUNCTION topic_alias_inserted()
+  RETURNS TRIGGER
+  SECURITY DEFINER
+  LANGUAGE plpgsql
+AS $$
+  BEGIN
+    PERFORM refresh_topic_search_index_document(NEW.topic_id);
+
+    RETURN NEW;
+  END;
+$$;
+
+DO $$
+BEGIN
+IF NOT EXISTS(
+  SELECT *
+    FROM information_schema.triggers
+    WHERE event_object_table = 'topic_alias'
+    AND trigger_name = 'after_topic_alias_insert'
+) THEN
+  CREATE TRIGGER after_topic_alias_insert
+    AFTER INSERT ON topic_alias
+    FOR EACH ROW EXECUTE PROCEDURE topic_alias_inserted();
+END IF;
+END;
+$$ language 'plpgsql';
+
+CREATE OR REPLACE FUNCTION topic_alias_deleted()
+  RETURNS TRIGGER
+  SECURITY DEFINER
+  LANGUAGE plpgsql
+AS $$
+  BEGIN
+    PERFORM refresh_topic_search_index_document(OLD.topic_id);
+
+    RETURN OLD;
+  END;
+$$;
+
+DO $$
+BEGIN
+IF NOT EXISTS(
+  SELECT *
+    FROM information_schema.triggers
+    WHERE event_object_table = 'topic_alias'
+    AND trigger_name = 'after_topic_alias_delete'
+) THEN
+  CREATE TRIGGER after_topic_alias_delete
+    AFTER DELETE ON topic_alias
+    FOR EACH ROW EXECUTE PROCEDURE topic_alias_deleted();
+END IF;
+END;
+$$ language 'plpgsql';
+
+-- Merges the source topic into the target. The source's topicables, aliases
+-- and related topics move to the target, and the source is deleted, leaving
+-- its name as an alias of the target. Returns the number of topicables moved.
+CREATE OR REPLACE FUNCTION merge_topics(_source_id VARCHAR, _target_id VARCHAR)
+  RETURNS BIGINT
+  SECURITY DEFINER
+  LANGUAGE plpgsql
+AS $$
+  DECLARE
+    _source_name VARCHAR;
+    _topiced RECORD;
+    n BIGINT := 0;
+  BEGIN
+    SELECT name INTO STRICT _source_name
+    FROM topic
+    WHERE id = _source_id
+    FOR UPDATE;
+
+    -- Topicables of both topics keep only the target.
+    DELETE FROM topiced
+    WHERE topic_id = _source_id
+      AND topicable_id IN (
+        SELECT topicable_id FROM topiced WHERE topic_id = _target_id
+      );
+
+    -- topiced has no update trigger, so the topics of the moved topicables are
+    -- refreshed here.
+    FOR _topiced IN
+      UPDATE topiced
+      SET topic_id = _target_id
+      WHERE topic_id = _source_id
+      RETURNING topicable_id, type
+    LOOP
+      CASE _topiced.type
+        WHEN 'Course' THEN
+          PERFORM refresh_course_search_index_topics(_topiced.topicable_id);
+        WHEN 'Study' THEN
+          PERFORM refresh_study_search_index_topics(_topiced.topicable_id);
+      END CASE;
+      n = n + 1;
+    END LOOP;
+
+    PERFORM refresh_topic_search_index_topiced_count(_target_id);
+
+    UPDATE topic_alias
+    SET topic_id = _target_id
+    WHERE topic_id = _source_id;
+
+    INSERT INTO topic_relation(related_id, topic_id)
+    SELECT related_id, _target_id
+    FROM topic_relation
+    WHERE topic_id = _source_id AND related_id != _target_id
+    ON CONFLICT DO NOTHING;
+
+    INSERT INTO topic_relation(related_id, topic_id)
+    SELECT _target_id, topic_id
+    FROM topic_relation
+    WHERE related_id = _source_id AND topic_id != _target_id
+    ON CONFLICT DO NOTHING;
+
+    DELETE FROM topic WHERE id = _source_id;
+
+    INSERT INTO topic_alias(name, topic_id)
+    VALUES (_source_name, _target_id);
+
+    RETURN n;
+  END;
+$$;
+
 CREATE TABLE IF NOT EXISTS user_asset_search_index (
   activity_id     VARCHAR(100),
   activity_number INT,
@@ -4633,6 +5329,9 @@ CREATE TABLE IF NOT EXISTS user_asset_search_index (
 CREATE INDEX IF NOT EXISTS user_asset_search_index_fts_idx
   ON user_asset_search_index USING gin(document);
 
+CREATE INDEX IF NOT EXISTS user_asset_search_index_name_trgm_idx
+  ON user_asset_search_index USING gin(name gin_trgm_ops);
+
 CREATE INDEX IF NOT EXISTS user_asset_search_index_labels_idx
   ON user_asset_search_index USING gin(labels);
 
@@ -4682,7 +5381,7 @@ AS $$
       asset.created_at,
       NEW.description,
       setweight(to_tsvector('simple', NEW.name_tokens), 'A') ||
-      setweight(to_tsvector('english', coalesce(NEW.description, '')), 'B'),
+      setweight(to_tsvector(study_search_config(NEW.study_id), coalesce(NEW.description, '')), 'B'),
       NEW.id,
       asset.key,
       setweight(to_tsvector('simple', ''), 'A'),
@@ -4727,7 +5426,7 @@ AS $$
   BEGIN
     IF NEW.name != OLD.name OR NEW.description != OLD.description THEN
       doc = setweight(to_tsvector('simple', NEW.name_tokens), 'A') ||
-        setweight(to_tsvector('english', coalesce(NEW.description, '')), 'B');
+        setweight(to_tsvector(study_search_config(NEW.study_id), coalesce(NEW.description, '')), 'B');
     ELSE
       doc = (SELECT document FROM user_asset_search_index WHERE id = NEW.id); 
     END IF;
@@ -4885,6 +5584,71 @@ END IF;
 END;
 $$ language 'plpgsql';
 
+-- Restems the descriptions and bodies of the study's content with the study's
+-- search language, after it changes.
+CREATE OR REPLACE FUNCTION refresh_study_content_documents(_study_id VARCHAR)
+  RETURNS VOID
+  SECURITY DEFINER
+  LANGUAGE sql
+AS $$
+  UPDATE activity_search_index
+  SET document =
+    setweight(to_tsvector('simple', name_tokens), 'A') ||
+    setweight(to_tsvector(study_search_config(study_id), coalesce(description, '')), 'B')
+  WHERE study_id = _study_id;
+
+  UPDATE course_search_index
+  SET document =
+    setweight(to_tsvector('simple', name_tokens), 'A') ||
+    setweight(to_tsvector(study_search_config(study_id), coalesce(description, '')), 'B')
+  WHERE study_id = _study_id;
+
+  UPDATE lesson_search_index
+  SET document =
+    setweight(to_tsvector('simple', title_tokens), 'A') ||
+    setweight(to_tsvector(study_search_config(study_id), coalesce(body, '')), 'B')
+  WHERE study_id = _study_id;
+
+  UPDATE user_asset_search_index
+  SET document =
+    setweight(to_tsvector('simple', name_tokens), 'A') ||
+    setweight(to_tsvector(study_search_config(study_id), coalesce(description, '')), 'B')
+  WHERE study_id = _study_id;
+
+  UPDATE comment_search_index
+  SET document =
+    setweight(to_tsvector(study_search_config(study_id), coalesce(body, '')), 'B')
+  WHERE study_id = _study_id;
+$$;
+
+-- Views selecting * from a search index have their columns fixed when they
+-- are created, and cannot have columns inserted by CREATE OR REPLACE, so
+-- those missing a column of their index are dropped to be created anew.
+DO $$
+DECLARE
+  stale RECORD;
+BEGIN
+FOR stale IN
+  SELECT DISTINCT usage.view_name
+  FROM information_schema.view_table_usage usage
+  JOIN information_schema.columns index_column
+    ON index_column.table_schema = usage.table_schema
+    AND index_column.table_name = usage.table_name
+  WHERE usage.view_schema = 'public'
+    AND usage.table_name LIKE '%_search_index'
+    AND NOT EXISTS(
+      SELECT *
+      FROM information_schema.columns view_column
+      WHERE view_column.table_schema = usage.view_schema
+        AND view_column.table_name = usage.view_name
+        AND view_column.column_name = index_column.column_name
+    )
+LOOP
+  EXECUTE format('DROP VIEW %I', stale.view_name);
+END LOOP;
+END;
+$$ language 'plpgsql';
+
 CREATE OR REPLACE VIEW apple_giver AS
 SELECT
   user_search_index.*,
@@ -4937,6 +5701,14 @@ FROM enrolled
 JOIN study_search_index ON study_search_index.id = enrolled.enrollable_id
 WHERE enrolled.type = 'Study' AND enrolled.status = 'ENROLLED'; 
 
+CREATE OR REPLACE VIEW blocked_user AS
+SELECT
+  user_search_index.*,
+  user_block.blocker_id,
+  user_block.created_at blocked_at
+FROM user_block
+JOIN user_search_index ON user_search_index.id = user_block.blocked_id;
+
 CREATE OR REPLACE VIEW enrolled_user AS
 SELECT
   user_search_index.*,
@@ -4954,6 +5726,76 @@ FROM label
 LEFT JOIN labeled ON labeled.label_id = label.id
 GROUP BY label.id;
 
+-- The published content of studies, searched together within a study.
+CREATE OR REPLACE VIEW study_content_search_index AS
+SELECT
+  0::BIGINT AS apple_count,
+  0::BIGINT AS comment_count,
+  created_at,
+  document,
+  id,
+  study_id,
+  name::TEXT AS title,
+  'Activity'::TEXT AS type,
+  updated_at,
+  user_id
+FROM activity_search_index
+UNION ALL
+SELECT
+  0::BIGINT AS apple_count,
+  0::BIGINT AS comment_count,
+  created_at,
+  document,
+  id,
+  study_id,
+  NULL::TEXT AS title,
+  'Comment'::TEXT AS type,
+  updated_at,
+  user_id
+FROM comment_search_index
+WHERE published_at IS NOT NULL
+UNION ALL
+SELECT
+  apple_count,
+  0::BIGINT AS comment_count,
+  created_at,
+  document,
+  id,
+  study_id,
+  name::TEXT AS title,
+  'Course'::TEXT AS type,
+  updated_at,
+  user_id
+FROM course_search_index
+WHERE published_at IS NOT NULL
+UNION ALL
+SELECT
+  0::BIGINT AS apple_count,
+  comment_count,
+  created_at,
+  document,
+  id,
+  study_id,
+  title,
+  'Lesson'::TEXT AS type,
+  updated_at,
+  user_id
+FROM lesson_search_index
+WHERE published_at IS NOT NULL
+UNION ALL
+SELECT
+  0::BIGINT AS apple_count,
+  comment_count::BIGINT,
+  created_at,
+  document,
+  id,
+  study_id,
+  name::TEXT AS title,
+  'UserAsset'::TEXT AS type,
+  updated_at,
+  user_id
+FROM user_asset_search_index;
+
 CREATE OR REPLACE VIEW labelable_label AS
 SELECT
   label_search_index.*,
@@ -4997,6 +5839,14 @@ SELECT
 FROM topic_search_index
 JOIN topiced ON topiced.topic_id = topic_search_index.id;
 
+CREATE OR REPLACE VIEW related_topic AS
+SELECT
+  topic_search_index.*,
+  topic_relation.created_at related_at,
+  topic_relation.topic_id related_to_id
+FROM topic_relation
+JOIN topic_search_index ON topic_search_index.id = topic_relation.related_id;
+
 CREATE OR REPLACE VIEW topiced_course AS
 SELECT
   course_search_index.*,
@@ -5015,6 +5865,597 @@ FROM topiced
 JOIN study_search_index ON study_search_index.id = topiced.topicable_id
 WHERE topiced.type = 'Study';
 
+CREATE OR REPLACE VIEW prerequisite_lesson AS
+SELECT
+  lesson_search_index.*,
+  lesson_prerequisite.lesson_id dependent_id,
+  lesson_prerequisite.created_at prerequisite_at
+FROM lesson_prerequisite
+JOIN lesson_search_index ON lesson_search_index.id = lesson_prerequisite.prerequisite_id;
+
+CREATE OR REPLACE VIEW dependent_lesson AS
+SELECT
+  lesson_search_index.*,
+  lesson_prerequisite.prerequisite_id,
+  lesson_prerequisite.created_at prerequisite_at
+FROM lesson_prerequisite
+JOIN lesson_search_index ON lesson_search_index.id = lesson_prerequisite.lesson_id;
+
+DO $$
+BEGIN
+  IF NOT EXISTS (SELECT 1 FROM pg_type WHERE typname = 'trending_period') THEN
+    CREATE TYPE trending_period AS ENUM('DAY', 'WEEK', 'MONTH');
+  END IF;
+END
+$$ language 'plpgsql';
+
+-- Trending studies are recomputed periodically by refresh_trending_studies, as
+-- scoring them on every query would be too slow.
+CREATE TABLE IF NOT EXISTS study_trend(
+  computed_at TIMESTAMPTZ     DEFAULT statement_timestamp(),
+  period      trending_period NOT NULL,
+  score       FLOAT8          NOT NULL,
+  study_id    VARCHAR(100)    NOT NULL,
+  PRIMARY KEY (period, study_id),
+  FOREIGN KEY (study_id)
+    REFERENCES study (id)
+    ON UPDATE NO ACTION ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS study_trend_period_score_idx
+  ON study_trend (period, score DESC);
+
+-- Recommended studies are recomputed periodically by
+-- refresh_recommended_studies.
+CREATE TABLE IF NOT EXISTS study_recommendation(
+  computed_at TIMESTAMPTZ   DEFAULT statement_timestamp(),
+  score       FLOAT8        NOT NULL,
+  study_id    VARCHAR(100)  NOT NULL,
+  user_id     VARCHAR(100)  NOT NULL,
+  PRIMARY KEY (user_id, study_id),
+  FOREIGN KEY (study_id)
+    REFERENCES study (id)
+    ON UPDATE NO ACTION ON DELETE CASCADE,
+  FOREIGN KEY (user_id)
+    REFERENCES account (id)
+    ON UPDATE NO ACTION ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS study_recommendation_user_id_score_idx
+  ON study_recommendation (user_id, score DESC);
+
+CREATE INDEX IF NOT EXISTS event_type_action_created_at_idx
+  ON event (type, (payload->>'action'), created_at);
+
+-- Scores the public studies by their apples, enrollments and lesson publishes
+-- during each period. Each counts half as much for every quarter of the period
+-- since it happened.
+CREATE OR REPLACE FUNCTION refresh_trending_studies()
+  RETURNS BIGINT
+  SECURITY DEFINER
+  LANGUAGE plpgsql
+AS $$
+  DECLARE
+    n BIGINT;
+  BEGIN
+    DELETE FROM study_trend;
+
+    WITH period AS (
+      SELECT 'DAY'::trending_period AS name, INTERVAL '1 day' AS length
+      UNION ALL SELECT 'WEEK', INTERVAL '7 days'
+      UNION ALL SELECT 'MONTH', INTERVAL '30 days'
+    ), activity AS (
+      SELECT event.study_id, event.created_at, 1::float8 AS weight
+      FROM event
+      WHERE event.type IN ('CourseEvent', 'StudyEvent')
+        AND event.payload->>'action' = 'appled'
+        AND event.created_at > statement_timestamp() - INTERVAL '30 days'
+      UNION ALL
+      SELECT enrolled.enrollable_id, enrolled.created_at, 2::float8
+      FROM enrolled
+      WHERE enrolled.type = 'Study'
+        AND enrolled.status = 'ENROLLED'
+        AND enrolled.created_at > statement_timestamp() - INTERVAL '30 days'
+      UNION ALL
+      SELECT event.study_id, event.created_at, 0.5::float8
+      FROM event
+      WHERE event.type = 'LessonEvent'
+        AND event.payload->>'action' = 'published'
+        AND event.created_at > statement_timestamp() - INTERVAL '30 days'
+    )
+    INSERT INTO study_trend(period, score, study_id)
+    SELECT
+      period.name,
+      sum(activity.weight * power(0.5::float8, (
+        extract(epoch FROM statement_timestamp() - activity.created_at) /
+        extract(epoch FROM period.length / 4)
+      )::float8)),
+      activity.study_id
+    FROM activity
+    JOIN period ON activity.created_at > statement_timestamp() - period.length
+    JOIN study ON study.id = activity.study_id
+    WHERE study.private IS NOT TRUE
+      AND NOT EXISTS (
+        SELECT 1 FROM hidden_content WHERE hidden_content.subject_id = study.id
+      )
+    GROUP BY period.name, activity.study_id;
+    GET DIAGNOSTICS n = ROW_COUNT;
+
+    RETURN n;
+  END;
+$$;
+
+-- Recommends to each user up to _limit public studies sharing topics or
+-- enrollees with the studies the user has appled or enrolled in. The user's
+-- own studies, those they have already appled or enrolled in, hidden studies,
+-- and studies of users who have blocked the user, or whom the user has
+-- blocked, are not recommended.
+CREATE OR REPLACE FUNCTION refresh_recommended_studies(_limit INT)
+  RETURNS BIGINT
+  SECURITY DEFINER
+  LANGUAGE plpgsql
+AS $$
+  DECLARE
+    n BIGINT;
+  BEGIN
+    DELETE FROM study_recommendation;
+
+    WITH seed AS (
+      SELECT appled.user_id, appled.appleable_id AS study_id
+      FROM appled
+      WHERE appled.type = 'Study'
+      UNION
+      SELECT enrolled.user_id, enrolled.enrollable_id
+      FROM enrolled
+      WHERE enrolled.type = 'Study' AND enrolled.status = 'ENROLLED'
+    ), candidate AS (
+      SELECT seed.user_id, other.topicable_id AS study_id, 1::float8 AS weight
+      FROM seed
+      JOIN topiced ON topiced.topicable_id = seed.study_id AND topiced.type = 'Study'
+      JOIN topiced other ON other.topic_id = topiced.topic_id AND other.type = 'Study'
+      UNION ALL
+      SELECT seed.user_id, other.enrollable_id, 0.5::float8
+      FROM seed
+      JOIN enrolled ON enrolled.enrollable_id = seed.study_id
+        AND enrolled.type = 'Study'
+        AND enrolled.status = 'ENROLLED'
+        AND enrolled.user_id != seed.user_id
+      JOIN enrolled other ON other.user_id = enrolled.user_id
+        AND other.type = 'Study'
+        AND other.status = 'ENROLLED'
+    ), scored AS (
+      SELECT
+        candidate.user_id,
+        candidate.study_id,
+        sum(candidate.weight) AS score,
+        row_number() OVER (
+          PARTITION BY candidate.user_id
+          ORDER BY sum(candidate.weight) DESC, candidate.study_id
+        ) AS rank
+      FROM candidate
+      JOIN study ON study.id = candidate.study_id
+      WHERE study.private IS NOT TRUE
+        AND study.user_id != candidate.user_id
+        AND NOT EXISTS (
+          SELECT 1 FROM seed
+          WHERE seed.user_id = candidate.user_id AND seed.study_id = candidate.study_id
+        )
+        AND NOT EXISTS (
+          SELECT 1 FROM hidden_content WHERE hidden_content.subject_id = study.id
+        )
+        AND NOT EXISTS (
+          SELECT 1 FROM user_block
+          WHERE (user_block.blocker_id = study.user_id AND user_block.blocked_id = candidate.user_id)
+            OR (user_block.blocker_id = candidate.user_id AND user_block.blocked_id = study.user_id)
+        )
+      GROUP BY candidate.user_id, candidate.study_id
+    )
+    INSERT INTO study_recommendation(score, study_id, user_id)
+    SELECT scored.score, scored.study_id, scored.user_id
+    FROM scored
+    WHERE scored.rank <= _limit;
+    GET DIAGNOSTICS n = ROW_COUNT;
+
+    RETURN n;
+  END;
+$$;
+
+-- study_discovery holds the single row recording when the trending and
+-- recommended studies were last refreshed.
+CREATE TABLE IF NOT EXISTS study_discovery(
+  id            BOOLEAN     PRIMARY KEY DEFAULT TRUE CHECK (id),
+  refreshed_at  TIMESTAMPTZ
+);
+
+INSERT INTO study_discovery (id) VALUES (TRUE) ON CONFLICT DO NOTHING;
+
+-- Refreshes the trending and recommended studies unless they were refreshed
+-- within _interval, and returns whether they were. The row lock keeps other
+-- instances from refreshing them at the same time.
+CREATE OR REPLACE FUNCTION refresh_study_discovery(_limit INT, _interval INTERVAL)
+  RETURNS BOOLEAN
+  SECURITY DEFINER
+  LANGUAGE plpgsql
+AS $$
+  DECLARE
+    _refreshed_at TIMESTAMPTZ;
+  BEGIN
+    SELECT refreshed_at INTO _refreshed_at
+    FROM study_discovery
+    FOR UPDATE;
+
+    IF _refreshed_at > statement_timestamp() - _interval THEN
+      RETURN FALSE;
+    END IF;
+
+    PERFORM refresh_trending_studies();
+    PERFORM refresh_recommended_studies(_limit);
+
+    UPDATE study_discovery
+    SET refreshed_at = statement_timestamp();
+
+    RETURN TRUE;
+  END;
+$$;
+
+-- Studies made private since the trends and recommendations were computed are
+-- left out until the next refresh drops them.
+CREATE OR REPLACE VIEW trending_study AS
+SELECT
+  study_search_index.*,
+  study_trend.period,
+  study_trend.score trending_score
+FROM study_trend
+JOIN study_search_index ON study_search_index.id = study_trend.study_id
+WHERE study_search_index.private IS NOT TRUE
+  AND NOT EXISTS (
+    SELECT 1 FROM hidden_content WHERE hidden_content.subject_id = study_search_index.id
+  );
+
+CREATE OR REPLACE VIEW recommended_study AS
+SELECT
+  study_search_index.*,
+  study_recommendation.score recommendation_score,
+  study_recommendation.user_id recommendee_id
+FROM study_recommendation
+JOIN study_search_index ON study_search_index.id = study_recommendation.study_id
+WHERE study_search_index.private IS NOT TRUE
+  AND NOT EXISTS (
+    SELECT 1 FROM hidden_content WHERE hidden_content.subject_id = study_search_index.id
+  )
+  AND NOT EXISTS (
+    SELECT 1 FROM user_block
+    WHERE (user_block.blocker_id = study_search_index.user_id
+        AND user_block.blocked_id = study_recommendation.user_id)
+      OR (user_block.blocker_id = study_recommendation.user_id
+        AND user_block.blocked_id = study_search_index.user_id)
+  );
+
+CREATE TABLE IF NOT EXISTS schema_version(
+  applied_at  TIMESTAMPTZ DEFAULT statement_timestamp(),
+  version     INT         PRIMARY KEY
+);
+
+INSERT INTO schema_version (version) VALUES (1) ON CONFLICT DO NOTHING;
+
+CREATE OR REPLACE FUNCTION reindex_user_search_index()
+  RETURNS BIGINT
+  SECURITY DEFINER
+  LANGUAGE plpgsql
+AS $$
+  DECLARE
+    n BIGINT;
+  BEGIN
+    UPDATE user_search_index
+    SET
+      account_updated_at = account.updated_at,
+      bio = user_profile.bio,
+      document =
+        setweight(to_tsvector('simple', account.login), 'A') ||
+        setweight(to_tsvector('simple', coalesce(user_profile.name, '')), 'A') ||
+        setweight(to_tsvector('simple', coalesce(user_profile.bio, '')), 'B') ||
+        setweight(to_tsvector('simple', coalesce(email.value, '')), 'B'),
+      login = account.login,
+      name = user_profile.name,
+      profile_email_id = user_profile.email_id,
+      profile_updated_at = user_profile.updated_at
+    FROM account
+    JOIN user_profile ON user_profile.user_id = account.id
+    LEFT JOIN email ON email.id = user_profile.email_id
+    WHERE user_search_index.id = account.id;
+    GET DIAGNOSTICS n = ROW_COUNT;
+
+    PERFORM
+      refresh_user_search_index_roles(id),
+      refresh_user_search_index_study_count(id),
+      refresh_user_search_index_enrollee_count(id)
+    FROM user_search_index;
+
+    RETURN n;
+  END;
+$$;
+
+CREATE OR REPLACE FUNCTION reindex_activity_search_index()
+  RETURNS BIGINT
+  SECURITY DEFINER
+  LANGUAGE plpgsql
+AS $$
+  DECLARE
+    n BIGINT;
+  BEGIN
+    UPDATE activity_search_index
+    SET
+      advanced_at = activity.advanced_at,
+      description = activity.description,
+      document =
+        setweight(to_tsvector('simple', activity.name_tokens), 'A') ||
+        setweight(to_tsvector(study_search_config(activity.study_id), coalesce(activity.description, '')), 'B'),
+      lesson_id = activity.lesson_id,
+      name = activity.name,
+      name_tokens = activity.name_tokens,
+      updated_at = activity.updated_at
+    FROM activity
+    WHERE activity_search_index.id = activity.id;
+    GET DIAGNOSTICS n = ROW_COUNT;
+
+    PERFORM refresh_activity_search_index_asset_count(id)
+    FROM activity_search_index;
+
+    RETURN n;
+  END;
+$$;
+
+-- Comments are also inserted into the index here, as the index was added after
+-- them.
+CREATE OR REPLACE FUNCTION reindex_comment_search_index()
+  RETURNS BIGINT
+  SECURITY DEFINER
+  LANGUAGE plpgsql
+AS $$
+  DECLARE
+    n BIGINT;
+  BEGIN
+    INSERT INTO comment_search_index(
+      body,
+      commentable_id,
+      created_at,
+      document,
+      id,
+      published_at,
+      study_id,
+      type,
+      updated_at,
+      user_id
+    )
+    SELECT
+      comment.body,
+      comment.commentable_id,
+      comment.created_at,
+      setweight(to_tsvector(study_search_config(comment.study_id), coalesce(comment.body, '')), 'B'),
+      comment.id,
+      comment.published_at,
+      comment.study_id,
+      comment.type,
+      comment.updated_at,
+      comment.user_id
+    FROM comment
+    ON CONFLICT (id) DO UPDATE
+    SET
+      body = EXCLUDED.body,
+      document = EXCLUDED.document,
+      published_at = EXCLUDED.published_at,
+      updated_at = EXCLUDED.updated_at;
+    GET DIAGNOSTICS n = ROW_COUNT;
+
+    RETURN n;
+  END;
+$$;
+
+CREATE OR REPLACE FUNCTION reindex_course_search_index()
+  RETURNS BIGINT
+  SECURITY DEFINER
+  LANGUAGE plpgsql
+AS $$
+  DECLARE
+    n BIGINT;
+  BEGIN
+    UPDATE course_search_index
+    SET
+      advanced_at = course.advanced_at,
+      completed_at = course.completed_at,
+      description = course.description,
+      document =
+        setweight(to_tsvector('simple', course.name_tokens), 'A') ||
+        setweight(to_tsvector(study_search_config(course.study_id), coalesce(course.description, '')), 'B'),
+      published_at = course.published_at,
+      name = course.name,
+      name_tokens = course.name_tokens,
+      scheduled_publish_at = course.scheduled_publish_at,
+      status = course.status,
+      updated_at = course.updated_at
+    FROM course
+    WHERE course_search_index.id = course.id;
+    GET DIAGNOSTICS n = ROW_COUNT;
+
+    PERFORM
+      refresh_course_search_index_lesson_count(id),
+      refresh_course_search_index_reading_time(id),
+      refresh_course_search_index_apple_count(id),
+      refresh_course_search_index_topics(id)
+    FROM course_search_index;
+
+    RETURN n;
+  END;
+$$;
+
+CREATE OR REPLACE FUNCTION reindex_study_search_index()
+  RETURNS BIGINT
+  SECURITY DEFINER
+  LANGUAGE plpgsql
+AS $$
+  DECLARE
+    n BIGINT;
+  BEGIN
+    UPDATE study_search_index
+    SET
+      advanced_at = study.advanced_at,
+      description = study.description,
+      document =
+        setweight(to_tsvector('simple', study.name_tokens), 'A') ||
+        setweight(to_tsvector(study.search_language::regconfig, coalesce(study.description, '')), 'B'),
+      name = study.name,
+      name_tokens = study.name_tokens,
+      private = study.private,
+      search_language = study.search_language,
+      updated_at = study.updated_at
+    FROM study
+    WHERE study_search_index.id = study.id;
+    GET DIAGNOSTICS n = ROW_COUNT;
+
+    PERFORM
+      refresh_study_search_index_lesson_count(id),
+      refresh_study_search_index_apple_count(id),
+      refresh_study_search_index_topics(id)
+    FROM study_search_index;
+
+    RETURN n;
+  END;
+$$;
+
+CREATE OR REPLACE FUNCTION reindex_lesson_search_index()
+  RETURNS BIGINT
+  SECURITY DEFINER
+  LANGUAGE plpgsql
+AS $$
+  DECLARE
+    n BIGINT;
+  BEGIN
+    UPDATE lesson_search_index
+    SET
+      body = lesson.body,
+      document =
+        setweight(to_tsvector('simple', lesson.title_tokens), 'A') ||
+        setweight(to_tsvector(study_search_config(lesson.study_id), coalesce(lesson.body, '')), 'B'),
+      draft = lesson.draft,
+      last_edited_at = lesson.last_edited_at,
+      number = lesson.number,
+      published_at = lesson.published_at,
+      reading_time_minutes = lesson.reading_time_minutes,
+      scheduled_publish_at = lesson.scheduled_publish_at,
+      title = lesson.title,
+      title_tokens = lesson.title_tokens,
+      toc = lesson.toc,
+      updated_at = lesson.updated_at,
+      word_count = lesson.word_count
+    FROM lesson
+    WHERE lesson_search_index.id = lesson.id;
+    GET DIAGNOSTICS n = ROW_COUNT;
+
+    PERFORM
+      refresh_lesson_search_index_course_info(id),
+      refresh_lesson_search_index_comment_count(id),
+      refresh_lesson_search_index_labels(id)
+    FROM lesson_search_index;
+
+    RETURN n;
+  END;
+$$;
+
+CREATE OR REPLACE FUNCTION reindex_topic_search_index()
+  RETURNS BIGINT
+  SECURITY DEFINER
+  LANGUAGE plpgsql
+AS $$
+  DECLARE
+    n BIGINT;
+  BEGIN
+    UPDATE topic_search_index
+    SET
+      description = topic.description,
+      document =
+        setweight(to_tsvector('simple', topic.name), 'A') ||
+        setweight(to_tsvector('english', coalesce(topic.description, '')), 'B'),
+      name = topic.name,
+      updated_at = topic.updated_at
+    FROM topic
+    WHERE topic_search_index.id = topic.id;
+    GET DIAGNOSTICS n = ROW_COUNT;
+
+    PERFORM refresh_topic_search_index_topiced_count(id)
+    FROM topic_search_index;
+
+    RETURN n;
+  END;
+$$;
+
+CREATE OR REPLACE FUNCTION reindex_user_asset_search_index()
+  RETURNS BIGINT
+  SECURITY DEFINER
+  LANGUAGE plpgsql
+AS $$
+  DECLARE
+    n BIGINT;
+  BEGIN
+    UPDATE user_asset_search_index
+    SET
+      description = user_asset.description,
+      document =
+        setweight(to_tsvector('simple', user_asset.name_tokens), 'A') ||
+        setweight(to_tsvector(study_search_config(user_asset.study_id), coalesce(user_asset.description, '')), 'B'),
+      name = user_asset.name,
+      name_tokens = user_asset.name_tokens,
+      updated_at = user_asset.updated_at
+    FROM user_asset
+    WHERE user_asset_search_index.id = user_asset.id;
+    GET DIAGNOSTICS n = ROW_COUNT;
+
+    PERFORM
+      refresh_user_asset_search_index_activity_info(id),
+      refresh_user_asset_search_index_comment_count(id),
+      refresh_user_asset_search_index_labels(id)
+    FROM user_asset_search_index;
+
+    RETURN n;
+  END;
+$$;
+
+-- The ghost is the account that the comments of deleted accounts are
+-- reassigned to. It is identified by its fixed ID, so that an account that
+-- happens to be named ghost is never mistaken for it, and its password is not a
+-- bcrypt hash, so that no one can log in as it.
+DO $$
+BEGIN
+  IF EXISTS (
+    SELECT 1
+    FROM account
+    WHERE lower(login) = 'ghost'
+      AND id != 'MDA0VXNlcjAwMDAwMDAwMDAwMDAwMGdob3N0'
+  ) THEN
+    RAISE EXCEPTION 'the login ghost is reserved for the ghost account, rename the account using it first';
+  END IF;
+  INSERT INTO account(id, login, password)
+  VALUES ('MDA0VXNlcjAwMDAwMDAwMDAwMDAwMGdob3N0', 'ghost', '\x21')
+  ON CONFLICT (id) DO NOTHING;
+END;
+$$ language 'plpgsql';
+
+INSERT INTO schema_version (version) VALUES (2) ON CONFLICT DO NOTHING;
+INSERT INTO schema_version (version) VALUES (3) ON CONFLICT DO NOTHING;
+INSERT INTO schema_version (version) VALUES (4) ON CONFLICT DO NOTHING;
+INSERT INTO schema_version (version) VALUES (5) ON CONFLICT DO NOTHING;
+INSERT INTO schema_version (version) VALUES (6) ON CONFLICT DO NOTHING;
+INSERT INTO schema_version (version) VALUES (7) ON CONFLICT DO NOTHING;
+INSERT INTO schema_version (version) VALUES (8) ON CONFLICT DO NOTHING;
+INSERT INTO schema_version (version) VALUES (9) ON CONFLICT DO NOTHING;
+INSERT INTO schema_version (version) VALUES (10) ON CONFLICT DO NOTHING;
+INSERT INTO schema_version (version) VALUES (11) ON CONFLICT DO NOTHING;
+INSERT INTO schema_version (version) VALUES (12) ON CONFLICT DO NOTHING;
+INSERT INTO schema_version (version) VALUES (13) ON CONFLICT DO NOTHING;
+INSERT INTO schema_version (version) VALUES (14) ON CONFLICT DO NOTHING;
+INSERT INTO schema_version (version) VALUES (15) ON CONFLICT DO NOTHING;
+INSERT INTO schema_version (version) VALUES (16) ON CONFLICT DO NOTHING;
+INSERT INTO schema_version (version) VALUES (17) ON CONFLICT DO NOTHING;
+
 GRANT CONNECT ON DATABASE markusninja TO client;
 GRANT USAGE ON SCHEMA public TO client;
 GRANT USAGE, SELECT ON ALL SEQUENCES IN SCHEMA public TO client;
@@ -5029,11 +6470,25 @@ GRANT SELECT ON role_permission TO client;
 GRANT SELECT ON role_permission_master TO client;
 GRANT SELECT, INSERT, UPDATE, DELETE ON email_verification_token TO client;
 GRANT SELECT, INSERT, UPDATE, DELETE ON password_reset_token TO client;
+GRANT SELECT, INSERT, UPDATE, DELETE ON feed_token TO client;
+GRANT SELECT, INSERT, UPDATE ON data_export TO client;
+GRANT SELECT, INSERT, UPDATE, DELETE ON account_deletion TO client;
+GRANT SELECT, INSERT ON account_deletion_log TO client;
+GRANT SELECT, INSERT, UPDATE ON content_report TO client;
+GRANT SELECT, INSERT ON moderation_action TO client;
+GRANT SELECT, INSERT, DELETE ON hidden_content TO client;
+GRANT SELECT, INSERT, DELETE ON user_suspension TO client;
+GRANT SELECT, INSERT, DELETE ON user_block TO client;
+GRANT SELECT, INSERT, UPDATE ON study_invitation TO client;
+GRANT SELECT, INSERT, UPDATE, DELETE ON study_access TO client;
 GRANT SELECT, INSERT, UPDATE, DELETE ON study TO client;
 GRANT SELECT, INSERT, UPDATE, DELETE ON lesson TO client;
 GRANT SELECT, UPDATE ON lesson_draft_backup TO client;
 GRANT SELECT, INSERT, UPDATE, DELETE ON course TO client;
 GRANT SELECT, INSERT, UPDATE, DELETE ON course_lesson TO client;
+GRANT SELECT, INSERT, DELETE ON lesson_prerequisite TO client;
+GRANT SELECT ON prerequisite_lesson TO client;
+GRANT SELECT ON dependent_lesson TO client;
 GRANT SELECT, INSERT, UPDATE, DELETE ON comment TO client;
 GRANT SELECT, UPDATE ON comment_draft_backup TO client;
 GRANT SELECT, INSERT, UPDATE, DELETE ON label TO client;
@@ -5045,6 +6500,9 @@ GRANT SELECT ON labeled_user_asset TO client;
 GRANT SELECT, INSERT, UPDATE, DELETE ON topic TO client;
 GRANT SELECT, INSERT, UPDATE, DELETE ON topiced TO client;
 GRANT SELECT ON topicable_topic TO client;
+GRANT SELECT, INSERT, DELETE ON topic_alias TO client;
+GRANT SELECT, INSERT, DELETE ON topic_relation TO client;
+GRANT SELECT ON related_topic TO client;
 GRANT SELECT ON topiced_course TO client;
 GRANT SELECT ON topiced_study TO client;
 GRANT SELECT, INSERT, UPDATE, DELETE ON asset TO client;
@@ -5060,6 +6518,7 @@ GRANT SELECT, INSERT, UPDATE, DELETE ON enrolled TO client;
 GRANT SELECT ON enrollee TO client;
 GRANT SELECT ON enrolled_lesson TO client;
 GRANT SELECT ON enrolled_study TO client;
+GRANT SELECT ON blocked_user TO client;
 GRANT SELECT ON enrolled_user TO client;
 GRANT SELECT ON event_type TO client;
 GRANT SELECT, INSERT, UPDATE, DELETE ON event TO client;
@@ -5079,3 +6538,10 @@ GRANT SELECT ON lesson_search_index TO client;
 GRANT SELECT ON label_search_index TO client;
 GRANT SELECT ON topic_search_index TO client;
 GRANT SELECT ON user_asset_search_index TO client;
+GRANT SELECT ON comment_search_index TO client;
+GRANT SELECT ON study_content_search_index TO client;
+GRANT SELECT ON study_trend TO client;
+GRANT SELECT ON trending_study TO client;
+GRANT SELECT ON study_recommendation TO client;
+GRANT SELECT ON recommended_study TO client;
+GRANT SELECT ON schema_version TO client;
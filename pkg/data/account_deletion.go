@@ -0,0 +1,230 @@
+package data
+
+import (
+	"time"
+
+	"github.com/jackc/pgx"
+	"github.com/jackc/pgx/pgtype"
+	"github.com/marksauter/markus-ninja-api/pkg/mylog"
+	"github.com/marksauter/markus-ninja-api/pkg/mytype"
+	"github.com/marksauter/markus-ninja-api/pkg/util"
+)
+
+// AccountDeletion is a user's request to delete their account. The account is
+// deleted once the scheduled time has passed, and until then the request may
+// be cancelled.
+type AccountDeletion struct {
+	RequestedAt  pgtype.Timestamptz `db:"requested_at"`
+	ScheduledFor pgtype.Timestamptz `db:"scheduled_for"`
+	UserID       mytype.OID         `db:"user_id"`
+}
+
+func getAccountDeletion(
+	db Queryer,
+	name string,
+	sql string,
+	args ...interface{},
+) (*AccountDeletion, error) {
+	var row AccountDeletion
+	err := prepareQueryRow(db, name, sql, args...).Scan(
+		&row.RequestedAt,
+		&row.ScheduledFor,
+		&row.UserID,
+	)
+	if err == pgx.ErrNoRows {
+		mylog.Log.WithError(err).Debug(util.Trace(""))
+		return nil, ErrNotFound
+	} else if err != nil {
+		mylog.Log.WithError(err).Error(util.Trace(""))
+		return nil, err
+	}
+
+	return &row, nil
+}
+
+const getAccountDeletionByUserSQL = `
+	SELECT
+		requested_at,
+		scheduled_for,
+		user_id
+	FROM account_deletion
+	WHERE user_id = $1
+`
+
+func GetAccountDeletionByUser(
+	db Queryer,
+	userID string,
+) (*AccountDeletion, error) {
+	accountDeletion, err := getAccountDeletion(
+		db,
+		"getAccountDeletionByUser",
+		getAccountDeletionByUserSQL,
+		userID,
+	)
+	if err != nil {
+		mylog.Log.WithField("user_id", userID).WithError(err).Error(util.Trace(""))
+	} else {
+		mylog.Log.WithField("user_id", userID).Info(util.Trace("account deletion found"))
+	}
+	return accountDeletion, err
+}
+
+// Requesting a deletion again never postpones the one already scheduled.
+const scheduleAccountDeletionSQL = `
+	INSERT INTO account_deletion(scheduled_for, user_id)
+	VALUES ($1, $2)
+	ON CONFLICT (user_id) DO UPDATE
+	SET scheduled_for = LEAST(account_deletion.scheduled_for, EXCLUDED.scheduled_for)
+	RETURNING
+		requested_at,
+		scheduled_for,
+		user_id
+`
+
+// ScheduleAccountDeletion schedules the deletion of the user's account at
+// scheduledFor.
+func ScheduleAccountDeletion(
+	db Queryer,
+	userID string,
+	scheduledFor time.Time,
+) (*AccountDeletion, error) {
+	accountDeletion, err := getAccountDeletion(
+		db,
+		"scheduleAccountDeletion",
+		scheduleAccountDeletionSQL,
+		scheduledFor,
+		userID,
+	)
+	if err != nil {
+		if pgErr, ok := err.(pgx.PgError); ok {
+			mylog.Log.WithError(pgErr).Error(util.Trace(""))
+			return nil, handlePSQLError(pgErr)
+		}
+		mylog.Log.WithError(err).Error(util.Trace(""))
+		return nil, err
+	}
+
+	mylog.Log.WithField("user_id", userID).Info(util.Trace("account deletion scheduled"))
+	return accountDeletion, nil
+}
+
+// The claimed row stays locked until the transaction ends, so only one
+// instance deletes a given account.
+const claimAccountDeletionSQL = `
+	SELECT
+		requested_at,
+		scheduled_for,
+		user_id
+	FROM account_deletion
+	WHERE scheduled_for <= statement_timestamp()
+		AND NOT (user_id = ANY($1::text[]))
+	ORDER BY scheduled_for ASC
+	LIMIT 1
+	FOR UPDATE SKIP LOCKED
+`
+
+// ClaimAccountDeletion locks and returns the earliest deletion that is due,
+// other than those of the users in skip. It must be called within a
+// transaction, and returns ErrNotFound when there is no deletion to claim.
+func ClaimAccountDeletion(
+	db Queryer,
+	skip []string,
+) (*AccountDeletion, error) {
+	accountDeletion, err := getAccountDeletion(db, "claimAccountDeletion", claimAccountDeletionSQL, skip)
+	if err != nil {
+		if err != ErrNotFound {
+			mylog.Log.WithError(err).Error(util.Trace(""))
+		}
+		return nil, err
+	}
+
+	mylog.Log.WithField("user_id", accountDeletion.UserID.String).Info(util.Trace("account deletion claimed"))
+	return accountDeletion, nil
+}
+
+const cancelAccountDeletionSQL = `
+	DELETE FROM account_deletion
+	WHERE user_id = $1
+`
+
+func CancelAccountDeletion(
+	db Queryer,
+	userID string,
+) error {
+	commandTag, err := prepareExec(db, "cancelAccountDeletion", cancelAccountDeletionSQL, userID)
+	if err != nil {
+		mylog.Log.WithError(err).Error(util.Trace(""))
+		return err
+	}
+	if commandTag.RowsAffected() != 1 {
+		err := ErrNotFound
+		mylog.Log.WithField("user_id", userID).WithError(err).Error(util.Trace(""))
+		return err
+	}
+
+	mylog.Log.WithField("user_id", userID).Info(util.Trace("account deletion cancelled"))
+	return nil
+}
+
+// AccountDeletionLog is the audit record of a deleted account.
+type AccountDeletionLog struct {
+	AssetsDeleted      pgtype.Int4        `db:"assets_deleted"`
+	CommentsDeleted    pgtype.Int4        `db:"comments_deleted"`
+	CommentsReassigned pgtype.Int4        `db:"comments_reassigned"`
+	CoursesDeleted     pgtype.Int4        `db:"courses_deleted"`
+	DeletedAt          pgtype.Timestamptz `db:"deleted_at"`
+	LessonsDeleted     pgtype.Int4        `db:"lessons_deleted"`
+	Login              pgtype.Varchar     `db:"login"`
+	ObjectsRemoved     pgtype.Int4        `db:"objects_removed"`
+	RequestedAt        pgtype.Timestamptz `db:"requested_at"`
+	StudiesDeleted     pgtype.Int4        `db:"studies_deleted"`
+	UserID             mytype.OID         `db:"user_id"`
+}
+
+const createAccountDeletionLogSQL = `
+	INSERT INTO account_deletion_log(
+		assets_deleted,
+		comments_deleted,
+		comments_reassigned,
+		courses_deleted,
+		lessons_deleted,
+		login,
+		objects_removed,
+		requested_at,
+		studies_deleted,
+		user_id
+	)
+	VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+`
+
+func CreateAccountDeletionLog(
+	db Queryer,
+	row *AccountDeletionLog,
+) error {
+	_, err := prepareExec(
+		db,
+		"createAccountDeletionLog",
+		createAccountDeletionLogSQL,
+		&row.AssetsDeleted,
+		&row.CommentsDeleted,
+		&row.CommentsReassigned,
+		&row.CoursesDeleted,
+		&row.LessonsDeleted,
+		&row.Login,
+		&row.ObjectsRemoved,
+		&row.RequestedAt,
+		&row.StudiesDeleted,
+		&row.UserID,
+	)
+	if err != nil {
+		if pgErr, ok := err.(pgx.PgError); ok {
+			mylog.Log.WithError(pgErr).Error(util.Trace(""))
+			return handlePSQLError(pgErr)
+		}
+		mylog.Log.WithError(err).Error(util.Trace(""))
+		return err
+	}
+
+	mylog.Log.WithField("user_id", row.UserID.String).Info(util.Trace("account deletion logged"))
+	return nil
+}
@@ -12,16 +12,17 @@ import (
 )
 
 type Activity struct {
-	AdvancedAt  pgtype.Timestamptz `db:"advanced_at" permit:"read"`
-	CreatedAt   pgtype.Timestamptz `db:"created_at" permit:"read"`
-	Description pgtype.Text        `db:"description" permit:"create/read/update"`
-	ID          mytype.OID         `db:"id" permit:"read"`
-	LessonID    mytype.OID         `db:"lesson_id" permit:"create/read/update"`
-	Name        pgtype.Text        `db:"name" permit:"create/read"`
-	Number      pgtype.Int4        `db:"number" permit:"read/update"`
-	StudyID     mytype.OID         `db:"study_id" permit:"create/read"`
-	UpdatedAt   pgtype.Timestamptz `db:"updated_at" permit:"read"`
-	UserID      mytype.OID         `db:"user_id" permit:"create/read"`
+	AdvancedAt    pgtype.Timestamptz `db:"advanced_at" permit:"read"`
+	BestMatchRank pgtype.Float8      `db:"best_match_rank"`
+	CreatedAt     pgtype.Timestamptz `db:"created_at" permit:"read"`
+	Description   pgtype.Text        `db:"description" permit:"create/read/update"`
+	ID            mytype.OID         `db:"id" permit:"read"`
+	LessonID      mytype.OID         `db:"lesson_id" permit:"create/read/update"`
+	Name          pgtype.Text        `db:"name" permit:"create/read"`
+	Number        pgtype.Int4        `db:"number" permit:"read/update"`
+	StudyID       mytype.OID         `db:"study_id" permit:"create/read"`
+	UpdatedAt     pgtype.Timestamptz `db:"updated_at" permit:"read"`
+	UserID        mytype.OID         `db:"user_id" permit:"create/read"`
 }
 
 func activityDelimeter(r rune) bool {
@@ -30,6 +31,17 @@ func activityDelimeter(r rune) bool {
 
 type ActivityFilterOptions struct {
 	Search *string
+	// Fuzzy also matches rows with a title similar to the search.
+	Fuzzy bool
+	// SearchLanguage is the text search configuration the search is also
+	// stemmed with, that of the study the rows belong to.
+	SearchLanguage *string
+	// CreatedAt are comparisons the creation time of the rows must satisfy.
+	CreatedAt []Comparison
+	// StudyNameWithOwner limits the rows to those in the study, i.e. owner/name.
+	StudyNameWithOwner *string
+	// UserLogin limits the rows to those of the user with the login.
+	UserLogin *string
 }
 
 func (src *ActivityFilterOptions) SQL(from string, args *pgx.QueryArgs) *SQLParts {
@@ -39,13 +51,17 @@ func (src *ActivityFilterOptions) SQL(from string, args *pgx.QueryArgs) *SQLPart
 
 	fromParts := make([]string, 0, 2)
 	whereParts := make([]string, 0, 2)
+	whereParts = append(whereParts, comparisonsSQL(from+".created_at", src.CreatedAt, args)...)
+	if src.StudyNameWithOwner != nil {
+		whereParts = append(whereParts, studyNameWithOwnerSQL(from, *src.StudyNameWithOwner, args))
+	}
+	if src.UserLogin != nil {
+		whereParts = append(whereParts, userLoginSQL(from, *src.UserLogin, args))
+	}
 	if src.Search != nil {
-		query := ToPrefixTsQuery(*src.Search)
-		fromParts = append(fromParts, "to_tsquery('simple',"+args.Append(query)+") AS document_query")
-		whereParts = append(
-			whereParts,
-			"CASE "+args.Append(query)+" WHEN '*' THEN TRUE ELSE "+from+".document @@ document_query END",
-		)
+		fromPart, wherePart := documentSearchSQL(from, *src.Search, src.SearchLanguage, src.titleSearch(), args)
+		fromParts = append(fromParts, fromPart)
+		whereParts = append(whereParts, wherePart)
 	}
 
 	where := ""
@@ -59,6 +75,17 @@ func (src *ActivityFilterOptions) SQL(from string, args *pgx.QueryArgs) *SQLPart
 	}
 }
 
+func (src *ActivityFilterOptions) searchesDocument() bool {
+	return src != nil && src.Search != nil
+}
+
+func (src *ActivityFilterOptions) titleSearch() *titleSearch {
+	if src == nil {
+		return nil
+	}
+	return newTitleSearch(src.Search, src.Fuzzy, "name")
+}
+
 func CountActivityByLesson(
 	db Queryer,
 	lessonID string,
@@ -648,6 +675,7 @@ func SearchActivity(
 
 	selects := []string{
 		"advanced_at",
+		"best_match_rank",
 		"created_at",
 		"description",
 		"id",
@@ -663,7 +691,32 @@ func SearchActivity(
 
 	psName := preparedName("searchActivityIndex", sql)
 
-	if err := getManyActivity(db, psName, sql, &rows, args...); err != nil {
+	dbRows, err := prepareQuery(db, psName, sql, args...)
+	if err != nil {
+		mylog.Log.WithError(err).Error(util.Trace(""))
+		return nil, err
+	}
+	defer dbRows.Close()
+
+	for dbRows.Next() {
+		var row Activity
+		dbRows.Scan(
+			&row.AdvancedAt,
+			&row.BestMatchRank,
+			&row.CreatedAt,
+			&row.Description,
+			&row.ID,
+			&row.LessonID,
+			&row.Name,
+			&row.Number,
+			&row.StudyID,
+			&row.UpdatedAt,
+			&row.UserID,
+		)
+		rows = append(rows, &row)
+	}
+
+	if err := dbRows.Err(); err != nil {
 		mylog.Log.WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
@@ -3,6 +3,7 @@ package data
 import (
 	"mime/multipart"
 	"strings"
+	"time"
 
 	"github.com/jackc/pgx"
 	"github.com/jackc/pgx/pgtype"
@@ -238,3 +239,100 @@ func DeleteAsset(
 	mylog.Log.WithField("id", id).Info(util.Trace("asset deleted"))
 	return nil
 }
+
+// An asset is unreferenced when no user asset is backed by it, and its key
+// appears in no text a user may have embedded it in.
+const getUnreferencedAssetSQL = `
+	SELECT
+		created_at,
+		id,
+		key,
+		name,
+		size,
+		subtype,
+		type,
+		user_id
+	FROM asset a
+	WHERE a.created_at < $1
+		AND NOT EXISTS (SELECT 1 FROM user_asset WHERE asset_id = a.id)
+		AND NOT EXISTS (
+			SELECT 1 FROM lesson
+			WHERE position(a.key IN coalesce(body, '') || coalesce(draft, '')) > 0
+		)
+		AND NOT EXISTS (
+			SELECT 1 FROM lesson_draft_backup
+			WHERE position(a.key IN coalesce(draft, '')) > 0
+		)
+		AND NOT EXISTS (
+			SELECT 1 FROM comment
+			WHERE position(a.key IN coalesce(body, '') || coalesce(draft, '')) > 0
+		)
+		AND NOT EXISTS (
+			SELECT 1 FROM comment_draft_backup
+			WHERE position(a.key IN coalesce(draft, '')) > 0
+		)
+		AND NOT EXISTS (
+			SELECT 1 FROM study
+			WHERE position(a.key IN coalesce(description, '')) > 0
+		)
+		AND NOT EXISTS (
+			SELECT 1 FROM course
+			WHERE position(a.key IN coalesce(description, '')) > 0
+		)
+		AND NOT EXISTS (
+			SELECT 1 FROM activity
+			WHERE position(a.key IN coalesce(description, '')) > 0
+		)
+		AND NOT EXISTS (
+			SELECT 1 FROM user_asset
+			WHERE position(a.key IN coalesce(description, '')) > 0
+		)
+		AND NOT EXISTS (
+			SELECT 1 FROM user_profile
+			WHERE position(a.key IN coalesce(bio, '')) > 0
+		)
+	ORDER BY a.created_at
+`
+
+// GetUnreferencedAssets returns the assets created before createdBefore that
+// are no longer referenced anywhere.
+func GetUnreferencedAssets(
+	db Queryer,
+	createdBefore time.Time,
+) ([]*Asset, error) {
+	var rows []*Asset
+	dbRows, err := prepareQuery(
+		db,
+		"getUnreferencedAsset",
+		getUnreferencedAssetSQL,
+		createdBefore,
+	)
+	if err != nil {
+		mylog.Log.WithError(err).Error(util.Trace(""))
+		return nil, err
+	}
+	defer dbRows.Close()
+
+	for dbRows.Next() {
+		var row Asset
+		dbRows.Scan(
+			&row.CreatedAt,
+			&row.ID,
+			&row.Key,
+			&row.Name,
+			&row.Size,
+			&row.Subtype,
+			&row.Type,
+			&row.UserID,
+		)
+		rows = append(rows, &row)
+	}
+
+	if err := dbRows.Err(); err != nil {
+		mylog.Log.WithError(err).Error(util.Trace(""))
+		return nil, err
+	}
+
+	mylog.Log.WithField("n", len(rows)).Info(util.Trace("unreferenced assets found"))
+	return rows, nil
+}
@@ -13,6 +13,7 @@ import (
 
 // Comment - data type comment
 type Comment struct {
+	BestMatchRank pgtype.Float8      `db:"best_match_rank"`
 	Body          mytype.Markdown    `db:"body" permit:"create/read/update"`
 	CommentableID mytype.OID         `db:"commentable_id" permit:"create/read"`
 	CreatedAt     pgtype.Timestamptz `db:"created_at" permit:"read"`
@@ -30,6 +31,16 @@ type Comment struct {
 type CommentFilterOptions struct {
 	IsPublished *bool
 	Labels      *[]string
+	Search      *string
+	// SearchLanguage is the text search configuration the search is also
+	// stemmed with, that of the study the rows belong to.
+	SearchLanguage *string
+	// CreatedAt are comparisons the creation time of the rows must satisfy.
+	CreatedAt []Comparison
+	// StudyNameWithOwner limits the rows to those in the study, i.e. owner/name.
+	StudyNameWithOwner *string
+	// UserLogin limits the rows to those of the user with the login.
+	UserLogin *string
 }
 
 func (src *CommentFilterOptions) SQL(from string, args *pgx.QueryArgs) *SQLParts {
@@ -54,6 +65,18 @@ func (src *CommentFilterOptions) SQL(from string, args *pgx.QueryArgs) *SQLParts
 			"CASE "+args.Append(query)+" WHEN '*' THEN TRUE ELSE "+from+".labels @@ labels_query END",
 		)
 	}
+	whereParts = append(whereParts, comparisonsSQL(from+".created_at", src.CreatedAt, args)...)
+	if src.StudyNameWithOwner != nil {
+		whereParts = append(whereParts, studyNameWithOwnerSQL(from, *src.StudyNameWithOwner, args))
+	}
+	if src.UserLogin != nil {
+		whereParts = append(whereParts, userLoginSQL(from, *src.UserLogin, args))
+	}
+	if src.Search != nil {
+		fromPart, wherePart := documentSearchSQL(from, *src.Search, src.SearchLanguage, nil, args)
+		fromParts = append(fromParts, fromPart)
+		whereParts = append(whereParts, wherePart)
+	}
 
 	where := ""
 	if len(whereParts) > 0 {
@@ -66,6 +89,10 @@ func (src *CommentFilterOptions) SQL(from string, args *pgx.QueryArgs) *SQLParts
 	}
 }
 
+func (src *CommentFilterOptions) searchesDocument() bool {
+	return src != nil && src.Search != nil
+}
+
 func CountCommentByLabel(
 	db Queryer,
 	labelID string,
@@ -140,6 +167,33 @@ func CountCommentByStudy(
 	return n, err
 }
 
+// CountCommentBySearch counts the comments matching filters, in the studies
+// the viewer may read.
+func CountCommentBySearch(
+	db Queryer,
+	viewerID string,
+	viewerIsAdmin bool,
+	filters *CommentFilterOptions,
+) (int32, error) {
+	args := pgx.QueryArgs(make([]interface{}, 0, 4))
+	where := func(from string) string {
+		return inReadableStudySQL(from, viewerID, viewerIsAdmin, &args)
+	}
+	from := "comment_search_index"
+
+	sql := CountSQL(from, where, filters, &args)
+	psName := preparedName("countCommentBySearch", sql)
+
+	var n int32
+	err := prepareQueryRow(db, psName, sql, args...).Scan(&n)
+	if err != nil {
+		mylog.Log.WithError(err).Error(util.Trace(""))
+	} else {
+		mylog.Log.WithField("n", n).Info(util.Trace("comments found"))
+	}
+	return n, err
+}
+
 // CountCommentByUser - count comments by user id
 func CountCommentByUser(
 	db Queryer,
@@ -527,6 +581,81 @@ func GetCommentByStudy(
 	return rows, nil
 }
 
+// SearchComment returns the comments matching filters, in the studies the
+// viewer may read.
+func SearchComment(
+	db Queryer,
+	viewerID string,
+	viewerIsAdmin bool,
+	po *PageOptions,
+	filters *CommentFilterOptions,
+) ([]*Comment, error) {
+	var rows []*Comment
+	if po != nil && po.Limit() > 0 {
+		limit := po.Limit()
+		if limit > 0 {
+			rows = make([]*Comment, 0, limit)
+		} else {
+			mylog.Log.Info(util.Trace("limit is 0"))
+			return rows, nil
+		}
+	}
+
+	var args pgx.QueryArgs
+	where := func(from string) string {
+		return inReadableStudySQL(from, viewerID, viewerIsAdmin, &args)
+	}
+
+	selects := []string{
+		"best_match_rank",
+		"body",
+		"commentable_id",
+		"created_at",
+		"id",
+		"published_at",
+		"study_id",
+		"type",
+		"updated_at",
+		"user_id",
+	}
+	from := "comment_search_index"
+	sql := SQL3(selects, from, where, filters, &args, po)
+
+	psName := preparedName("searchCommentIndex", sql)
+
+	dbRows, err := prepareQuery(db, psName, sql, args...)
+	if err != nil {
+		mylog.Log.WithError(err).Error(util.Trace(""))
+		return nil, err
+	}
+	defer dbRows.Close()
+
+	for dbRows.Next() {
+		var row Comment
+		dbRows.Scan(
+			&row.BestMatchRank,
+			&row.Body,
+			&row.CommentableID,
+			&row.CreatedAt,
+			&row.ID,
+			&row.PublishedAt,
+			&row.StudyID,
+			&row.Type,
+			&row.UpdatedAt,
+			&row.UserID,
+		)
+		rows = append(rows, &row)
+	}
+
+	if err := dbRows.Err(); err != nil {
+		mylog.Log.WithError(err).Error(util.Trace(""))
+		return nil, err
+	}
+
+	mylog.Log.WithField("n", len(rows)).Info(util.Trace("comments found"))
+	return rows, nil
+}
+
 // GetCommentByUser - get comments by user id
 func GetCommentByUser(
 	db Queryer,
@@ -668,6 +797,60 @@ func DeleteComment(
 	return nil
 }
 
+const reassignCommentSQL = `
+	UPDATE comment
+	SET user_id = $2,
+		draft = body
+	WHERE user_id = $1
+		AND published_at IS NOT NULL
+		AND study_id NOT IN (SELECT id FROM study WHERE user_id = $1)
+`
+
+const deleteReassignedCommentDraftBackupSQL = `
+	DELETE FROM comment_draft_backup
+	WHERE comment_id IN (SELECT id FROM comment WHERE user_id = $1)
+`
+
+// ReassignComment - reassign the published comments of user with passed id on
+// studies of other users to user with passed toUserID, so threads survive the
+// user's deletion. The draft backups of reassigned comments are dropped, and
+// unpublished comments are left to be deleted with the user.
+func ReassignComment(
+	db Queryer,
+	userID,
+	toUserID string,
+) (int32, error) {
+	commandTag, err := prepareExec(
+		db,
+		"reassignComment",
+		reassignCommentSQL,
+		userID,
+		toUserID,
+	)
+	if err != nil {
+		mylog.Log.WithError(err).Error(util.Trace(""))
+		return 0, err
+	}
+	n := int32(commandTag.RowsAffected())
+
+	if _, err := prepareExec(
+		db,
+		"deleteReassignedCommentDraftBackup",
+		deleteReassignedCommentDraftBackupSQL,
+		toUserID,
+	); err != nil {
+		mylog.Log.WithError(err).Error(util.Trace(""))
+		return 0, err
+	}
+
+	mylog.Log.WithFields(logrus.Fields{
+		"user_id":    userID,
+		"to_user_id": toUserID,
+		"n":          n,
+	}).Info(util.Trace("comments reassigned"))
+	return n, nil
+}
+
 // UpdateComment - Update comment
 func UpdateComment(
 	db Queryer,
@@ -0,0 +1,72 @@
+package data_test
+
+import (
+	"testing"
+
+	"github.com/marksauter/markus-ninja-api/pkg/data"
+	"github.com/marksauter/markus-ninja-api/pkg/mydb"
+)
+
+func TestDataSearchCommentInPrivateStudy(t *testing.T) {
+	testDb := mydb.NewTestDB(t)
+
+	owner, err := data.CreateUser(testDb.DB, newUser())
+	if err != nil {
+		t.Fatal(err)
+	}
+	study := &data.Study{}
+	study.Name.Set("private")
+	study.Private.Set(true)
+	study.UserID.Set(&owner.ID)
+	study, err = data.CreateStudy(testDb.DB, study)
+	if err != nil {
+		t.Fatal(err)
+	}
+	lesson := &data.Lesson{}
+	lesson.StudyID.Set(&study.ID)
+	lesson.Title.Set("secret")
+	lesson.UserID.Set(&owner.ID)
+	lesson, err = data.CreateLesson(testDb.DB, lesson)
+	if err != nil {
+		t.Fatal(err)
+	}
+	comment := &data.Comment{}
+	comment.CommentableID.Set(&lesson.ID)
+	comment.Draft.Set("secret")
+	comment.StudyID.Set(&study.ID)
+	comment.UserID.Set(&owner.ID)
+	if _, err := data.CreateComment(testDb.DB, comment); err != nil {
+		t.Fatal(err)
+	}
+
+	studyNameWithOwner := owner.Login.String + "/" + study.Name.String
+	filters := &data.CommentFilterOptions{StudyNameWithOwner: &studyNameWithOwner}
+
+	var tests = []struct {
+		name          string
+		viewerID      string
+		viewerIsAdmin bool
+		expected      int
+	}{
+		{"guest", "", false, 0},
+		{"owner", owner.ID.String, false, 1},
+		{"admin", "", true, 1},
+	}
+
+	for _, tt := range tests {
+		comments, err := data.SearchComment(testDb.DB, tt.viewerID, tt.viewerIsAdmin, nil, filters)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(comments) != tt.expected {
+			t.Errorf("%s: expected %d comments found, got %d", tt.name, tt.expected, len(comments))
+		}
+		n, err := data.CountCommentBySearch(testDb.DB, tt.viewerID, tt.viewerIsAdmin, filters)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if int(n) != tt.expected {
+			t.Errorf("%s: expected %d comments counted, got %d", tt.name, tt.expected, n)
+		}
+	}
+}
@@ -0,0 +1,245 @@
+package data
+
+import (
+	"github.com/jackc/pgx"
+	"github.com/jackc/pgx/pgtype"
+	"github.com/marksauter/markus-ninja-api/pkg/mylog"
+	"github.com/marksauter/markus-ninja-api/pkg/mytype"
+	"github.com/marksauter/markus-ninja-api/pkg/util"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	ContentReportDismissed = "DISMISSED"
+	ContentReportOpen      = "OPEN"
+	ContentReportResolved  = "RESOLVED"
+)
+
+// ContentReport is a user's report of a node that breaks the rules. Reports
+// stay open until an admin acts on their subject.
+type ContentReport struct {
+	CreatedAt  pgtype.Timestamptz `db:"created_at"`
+	ID         mytype.OID         `db:"id"`
+	Note       pgtype.Text        `db:"note"`
+	Reason     pgtype.Varchar     `db:"reason"`
+	ReporterID mytype.OID         `db:"reporter_id"`
+	ResolvedAt pgtype.Timestamptz `db:"resolved_at"`
+	Status     pgtype.Varchar     `db:"status"`
+	SubjectID  mytype.OID         `db:"subject_id"`
+}
+
+func CountContentReportByStatus(
+	db Queryer,
+	status string,
+) (int32, error) {
+	args := pgx.QueryArgs(make([]interface{}, 0, 1))
+	where := func(from string) string {
+		return from + `.status = ` + args.Append(status)
+	}
+	from := "content_report"
+
+	sql := CountSQL(from, where, nil, &args)
+	psName := preparedName("countContentReportByStatus", sql)
+
+	var n int32
+	err := prepareQueryRow(db, psName, sql, args...).Scan(&n)
+	if err != nil {
+		mylog.Log.WithError(err).Error(util.Trace(""))
+	} else {
+		mylog.Log.WithField("n", n).Info(util.Trace("content reports found"))
+	}
+	return n, err
+}
+
+func getContentReport(
+	db Queryer,
+	name string,
+	sql string,
+	args ...interface{},
+) (*ContentReport, error) {
+	var row ContentReport
+	err := prepareQueryRow(db, name, sql, args...).Scan(
+		&row.CreatedAt,
+		&row.ID,
+		&row.Note,
+		&row.Reason,
+		&row.ReporterID,
+		&row.ResolvedAt,
+		&row.Status,
+		&row.SubjectID,
+	)
+	if err == pgx.ErrNoRows {
+		mylog.Log.WithError(err).Debug(util.Trace(""))
+		return nil, ErrNotFound
+	} else if err != nil {
+		mylog.Log.WithError(err).Error(util.Trace(""))
+		return nil, err
+	}
+
+	return &row, nil
+}
+
+func getManyContentReport(
+	db Queryer,
+	name string,
+	sql string,
+	rows *[]*ContentReport,
+	args ...interface{},
+) error {
+	dbRows, err := prepareQuery(db, name, sql, args...)
+	if err != nil {
+		mylog.Log.WithError(err).Error(util.Trace(""))
+		return err
+	}
+	defer dbRows.Close()
+
+	for dbRows.Next() {
+		var row ContentReport
+		dbRows.Scan(
+			&row.CreatedAt,
+			&row.ID,
+			&row.Note,
+			&row.Reason,
+			&row.ReporterID,
+			&row.ResolvedAt,
+			&row.Status,
+			&row.SubjectID,
+		)
+		*rows = append(*rows, &row)
+	}
+
+	if err := dbRows.Err(); err != nil {
+		mylog.Log.WithError(err).Error(util.Trace(""))
+		return err
+	}
+
+	return nil
+}
+
+func GetContentReportByStatus(
+	db Queryer,
+	status string,
+	po *PageOptions,
+) ([]*ContentReport, error) {
+	var rows []*ContentReport
+	if po != nil && po.Limit() > 0 {
+		limit := po.Limit()
+		if limit > 0 {
+			rows = make([]*ContentReport, 0, limit)
+		} else {
+			mylog.Log.Info(util.Trace("limit is 0"))
+			return rows, nil
+		}
+	}
+
+	args := pgx.QueryArgs(make([]interface{}, 0, 4))
+	where := func(from string) string {
+		return from + `.status = ` + args.Append(status)
+	}
+
+	selects := []string{
+		"created_at",
+		"id",
+		"note",
+		"reason",
+		"reporter_id",
+		"resolved_at",
+		"status",
+		"subject_id",
+	}
+	from := "content_report"
+	sql := SQL3(selects, from, where, nil, &args, po)
+
+	psName := preparedName("getContentReportByStatus", sql)
+
+	if err := getManyContentReport(db, psName, sql, &rows, args...); err != nil {
+		mylog.Log.WithError(err).Error(util.Trace(""))
+		return nil, err
+	}
+
+	mylog.Log.WithField("n", len(rows)).Info(util.Trace("content reports found"))
+	return rows, nil
+}
+
+const createContentReportSQL = `
+	INSERT INTO content_report(id, note, reason, reporter_id, subject_id)
+	VALUES ($1, $2, $3, $4, $5)
+	RETURNING
+		created_at,
+		id,
+		note,
+		reason,
+		reporter_id,
+		resolved_at,
+		status,
+		subject_id
+`
+
+func CreateContentReport(
+	db Queryer,
+	row *ContentReport,
+) (*ContentReport, error) {
+	id, err := mytype.NewOID("ContentReport")
+	if err != nil {
+		mylog.Log.WithError(err).Error(util.Trace(""))
+		return nil, err
+	}
+
+	contentReport, err := getContentReport(
+		db,
+		"createContentReport",
+		createContentReportSQL,
+		id,
+		&row.Note,
+		&row.Reason,
+		&row.ReporterID,
+		&row.SubjectID,
+	)
+	if err != nil {
+		if pgErr, ok := err.(pgx.PgError); ok {
+			mylog.Log.WithError(pgErr).Error(util.Trace(""))
+			return nil, handlePSQLError(pgErr)
+		}
+		mylog.Log.WithError(err).Error(util.Trace(""))
+		return nil, err
+	}
+
+	mylog.Log.WithField("subject_id", row.SubjectID.String).Info(util.Trace("content report created"))
+	return contentReport, nil
+}
+
+const closeContentReportBySubjectSQL = `
+	UPDATE content_report
+	SET status = $2,
+		resolved_at = statement_timestamp()
+	WHERE subject_id = $1
+		AND status = 'OPEN'
+`
+
+// CloseContentReportBySubject sets the status of the open reports of the
+// subject, and returns how many were closed.
+func CloseContentReportBySubject(
+	db Queryer,
+	subjectID string,
+	status string,
+) (int32, error) {
+	commandTag, err := prepareExec(
+		db,
+		"closeContentReportBySubject",
+		closeContentReportBySubjectSQL,
+		subjectID,
+		status,
+	)
+	if err != nil {
+		mylog.Log.WithError(err).Error(util.Trace(""))
+		return 0, err
+	}
+	n := int32(commandTag.RowsAffected())
+
+	mylog.Log.WithFields(logrus.Fields{
+		"subject_id": subjectID,
+		"status":     status,
+		"n":          n,
+	}).Info(util.Trace("content reports closed"))
+	return n, nil
+}
@@ -12,20 +12,24 @@ import (
 )
 
 type Course struct {
-	AdvancedAt  pgtype.Timestamptz  `db:"advanced_at" permit:"read"`
-	CompletedAt pgtype.Timestamptz  `db:"completed_at" permit:"read"`
-	AppledAt    pgtype.Timestamptz  `db:"appled_at"`
-	CreatedAt   pgtype.Timestamptz  `db:"created_at" permit:"read"`
-	Description pgtype.Text         `db:"description" permit:"create/read/update"`
-	ID          mytype.OID          `db:"id" permit:"read"`
-	Name        pgtype.Text         `db:"name" permit:"create/read"`
-	Number      pgtype.Int4         `db:"number" permit:"read/update"`
-	PublishedAt pgtype.Timestamptz  `db:"published_at" permit:"read/update"`
-	Status      mytype.CourseStatus `db:"status" permit:"read/update"`
-	StudyID     mytype.OID          `db:"study_id" permit:"create/read"`
-	TopicedAt   pgtype.Timestamptz  `db:"topiced_at"`
-	UpdatedAt   pgtype.Timestamptz  `db:"updated_at" permit:"read"`
-	UserID      mytype.OID          `db:"user_id" permit:"create/read"`
+	AdvancedAt         pgtype.Timestamptz  `db:"advanced_at" permit:"read"`
+	BestMatchRank      pgtype.Float8       `db:"best_match_rank"`
+	CompletedAt        pgtype.Timestamptz  `db:"completed_at" permit:"read"`
+	AppledAt           pgtype.Timestamptz  `db:"appled_at"`
+	CreatedAt          pgtype.Timestamptz  `db:"created_at" permit:"read"`
+	Description        pgtype.Text         `db:"description" permit:"create/read/update"`
+	ID                 mytype.OID          `db:"id" permit:"read"`
+	LessonCount        pgtype.Int8         `db:"lesson_count" permit:"read"`
+	Name               pgtype.Text         `db:"name" permit:"create/read"`
+	Number             pgtype.Int4         `db:"number" permit:"read/update"`
+	PublishedAt        pgtype.Timestamptz  `db:"published_at" permit:"read/update"`
+	ReadingTimeMinutes pgtype.Int8         `db:"reading_time_minutes" permit:"read"`
+	ScheduledPublishAt pgtype.Timestamptz  `db:"scheduled_publish_at" permit:"read/update"`
+	Status             mytype.CourseStatus `db:"status" permit:"read/update"`
+	StudyID            mytype.OID          `db:"study_id" permit:"create/read"`
+	TopicedAt          pgtype.Timestamptz  `db:"topiced_at"`
+	UpdatedAt          pgtype.Timestamptz  `db:"updated_at" permit:"read"`
+	UserID             mytype.OID          `db:"user_id" permit:"create/read"`
 }
 
 func courseDelimeter(r rune) bool {
@@ -36,6 +40,19 @@ type CourseFilterOptions struct {
 	IsPublished *bool
 	Topics      *[]string
 	Search      *string
+	// Fuzzy also matches rows with a title similar to the search.
+	Fuzzy bool
+	// SearchLanguage is the text search configuration the search is also
+	// stemmed with, that of the study the rows belong to.
+	SearchLanguage *string
+	// AppleCount are comparisons the apple count of the rows must satisfy.
+	AppleCount []Comparison
+	// CreatedAt are comparisons the creation time of the rows must satisfy.
+	CreatedAt []Comparison
+	// StudyNameWithOwner limits the rows to those in the study, i.e. owner/name.
+	StudyNameWithOwner *string
+	// UserLogin limits the rows to those of the user with the login.
+	UserLogin *string
 }
 
 func (src *CourseFilterOptions) SQL(from string, args *pgx.QueryArgs) *SQLParts {
@@ -60,13 +77,18 @@ func (src *CourseFilterOptions) SQL(from string, args *pgx.QueryArgs) *SQLParts
 			"CASE "+args.Append(query)+" WHEN '*' THEN TRUE ELSE "+from+".topics @@ topics_query END",
 		)
 	}
+	whereParts = append(whereParts, comparisonsSQL(from+".apple_count", src.AppleCount, args)...)
+	whereParts = append(whereParts, comparisonsSQL(from+".created_at", src.CreatedAt, args)...)
+	if src.StudyNameWithOwner != nil {
+		whereParts = append(whereParts, studyNameWithOwnerSQL(from, *src.StudyNameWithOwner, args))
+	}
+	if src.UserLogin != nil {
+		whereParts = append(whereParts, userLoginSQL(from, *src.UserLogin, args))
+	}
 	if src.Search != nil {
-		query := ToPrefixTsQuery(*src.Search)
-		fromParts = append(fromParts, "to_tsquery('simple',"+args.Append(query)+") AS document_query")
-		whereParts = append(
-			whereParts,
-			"CASE "+args.Append(query)+" WHEN '*' THEN TRUE ELSE "+from+".document @@ document_query END",
-		)
+		fromPart, wherePart := documentSearchSQL(from, *src.Search, src.SearchLanguage, src.titleSearch(), args)
+		fromParts = append(fromParts, fromPart)
+		whereParts = append(whereParts, wherePart)
 	}
 
 	where := ""
@@ -80,6 +102,17 @@ func (src *CourseFilterOptions) SQL(from string, args *pgx.QueryArgs) *SQLParts
 	}
 }
 
+func (src *CourseFilterOptions) searchesDocument() bool {
+	return src != nil && src.Search != nil
+}
+
+func (src *CourseFilterOptions) titleSearch() *titleSearch {
+	if src == nil {
+		return nil
+	}
+	return newTitleSearch(src.Search, src.Fuzzy, "name")
+}
+
 func CountCourseByApplee(
 	db Queryer,
 	appleeID string,
@@ -210,9 +243,12 @@ func getCourse(
 		&row.CreatedAt,
 		&row.Description,
 		&row.ID,
+		&row.LessonCount,
 		&row.Name,
 		&row.Number,
 		&row.PublishedAt,
+		&row.ReadingTimeMinutes,
+		&row.ScheduledPublishAt,
 		&row.Status,
 		&row.StudyID,
 		&row.UpdatedAt,
@@ -251,9 +287,12 @@ func getManyCourse(
 			&row.CreatedAt,
 			&row.Description,
 			&row.ID,
+			&row.LessonCount,
 			&row.Name,
 			&row.Number,
 			&row.PublishedAt,
+			&row.ReadingTimeMinutes,
+			&row.ScheduledPublishAt,
 			&row.Status,
 			&row.StudyID,
 			&row.UpdatedAt,
@@ -277,9 +316,12 @@ const getCourseByIDSQL = `
 		created_at,
 		description,
 		id,
+		lesson_count,
 		name,
 		number,
 		published_at,
+		reading_time_minutes,
+		scheduled_publish_at,
 		status,
 		study_id,
 		updated_at,
@@ -308,9 +350,12 @@ const getCourseByNameSQL = `
 		created_at,
 		description,
 		id,
+		lesson_count,
 		name,
 		number,
 		published_at,
+		reading_time_minutes,
+		scheduled_publish_at,
 		status,
 		study_id,
 		updated_at,
@@ -340,9 +385,12 @@ const getCourseByNumberSQL = `
 		created_at,
 		description,
 		id,
+		lesson_count,
 		name,
 		number,
 		published_at,
+		reading_time_minutes,
+		scheduled_publish_at,
 		status,
 		study_id,
 		updated_at,
@@ -378,9 +426,12 @@ const getCourseByStudyAndNameSQL = `
 		c.created_at,
 		c.description,
 		c.id,
+		c.lesson_count,
 		c.name,
 		c.number,
 		c.published_at,
+		c.reading_time_minutes,
+		c.scheduled_publish_at,
 		c.status,
 		c.study_id,
 		c.updated_at,
@@ -446,9 +497,12 @@ func GetCourseByApplee(
 		"created_at",
 		"description",
 		"id",
+		"lesson_count",
 		"name",
 		"number",
 		"published_at",
+		"reading_time_minutes",
+		"scheduled_publish_at",
 		"status",
 		"study_id",
 		"updated_at",
@@ -475,9 +529,12 @@ func GetCourseByApplee(
 			&row.CreatedAt,
 			&row.Description,
 			&row.ID,
+			&row.LessonCount,
 			&row.Name,
 			&row.Number,
 			&row.PublishedAt,
+			&row.ReadingTimeMinutes,
+			&row.ScheduledPublishAt,
 			&row.Status,
 			&row.StudyID,
 			&row.UpdatedAt,
@@ -524,9 +581,12 @@ func GetCourseByTopic(
 		"created_at",
 		"description",
 		"id",
+		"lesson_count",
 		"name",
 		"number",
 		"published_at",
+		"reading_time_minutes",
+		"scheduled_publish_at",
 		"status",
 		"study_id",
 		"topiced_at",
@@ -553,9 +613,12 @@ func GetCourseByTopic(
 			&row.CreatedAt,
 			&row.Description,
 			&row.ID,
+			&row.LessonCount,
 			&row.Name,
 			&row.Number,
 			&row.PublishedAt,
+			&row.ReadingTimeMinutes,
+			&row.ScheduledPublishAt,
 			&row.Status,
 			&row.StudyID,
 			&row.TopicedAt,
@@ -603,9 +666,12 @@ func GetCourseByStudy(
 		"created_at",
 		"description",
 		"id",
+		"lesson_count",
 		"name",
 		"number",
 		"published_at",
+		"reading_time_minutes",
+		"scheduled_publish_at",
 		"status",
 		"study_id",
 		"updated_at",
@@ -654,9 +720,12 @@ func GetCourseByUser(
 		"created_at",
 		"description",
 		"id",
+		"lesson_count",
 		"name",
 		"number",
 		"published_at",
+		"reading_time_minutes",
+		"scheduled_publish_at",
 		"status",
 		"study_id",
 		"updated_at",
@@ -842,13 +911,17 @@ func SearchCourse(
 
 	selects := []string{
 		"advanced_at",
+		"best_match_rank",
 		"completed_at",
 		"created_at",
 		"description",
 		"id",
+		"lesson_count",
 		"name",
 		"number",
 		"published_at",
+		"reading_time_minutes",
+		"scheduled_publish_at",
 		"status",
 		"study_id",
 		"updated_at",
@@ -859,7 +932,37 @@ func SearchCourse(
 
 	psName := preparedName("searchCourseIndex", sql)
 
-	if err := getManyCourse(db, psName, sql, &rows, args...); err != nil {
+	dbRows, err := prepareQuery(db, psName, sql, args...)
+	if err != nil {
+		mylog.Log.WithError(err).Error(util.Trace(""))
+		return nil, err
+	}
+	defer dbRows.Close()
+
+	for dbRows.Next() {
+		var row Course
+		dbRows.Scan(
+			&row.AdvancedAt,
+			&row.BestMatchRank,
+			&row.CompletedAt,
+			&row.CreatedAt,
+			&row.Description,
+			&row.ID,
+			&row.LessonCount,
+			&row.Name,
+			&row.Number,
+			&row.PublishedAt,
+			&row.ReadingTimeMinutes,
+			&row.ScheduledPublishAt,
+			&row.Status,
+			&row.StudyID,
+			&row.UpdatedAt,
+			&row.UserID,
+		)
+		rows = append(rows, &row)
+	}
+
+	if err := dbRows.Err(); err != nil {
 		mylog.Log.WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
@@ -886,8 +989,8 @@ func UpdateCourse(
 		return nil, err
 	}
 
-	sets := make([]string, 0, 4)
-	args := pgx.QueryArgs(make([]interface{}, 0, 5))
+	sets := make([]string, 0, 5)
+	args := pgx.QueryArgs(make([]interface{}, 0, 6))
 
 	if row.Description.Status != pgtype.Undefined {
 		sets = append(sets, `description`+"="+args.Append(&row.Description))
@@ -901,6 +1004,9 @@ func UpdateCourse(
 	if row.PublishedAt.Status != pgtype.Undefined {
 		sets = append(sets, `published_at`+"="+args.Append(&row.PublishedAt))
 	}
+	if row.ScheduledPublishAt.Status != pgtype.Undefined {
+		sets = append(sets, `scheduled_publish_at`+"="+args.Append(&row.ScheduledPublishAt))
+	}
 	if row.Status.Status != pgtype.Undefined {
 		sets = append(sets, `status`+"="+args.Append(&row.Status))
 	}
@@ -968,3 +1074,35 @@ func UpdateCourse(
 	mylog.Log.WithField("id", row.ID.String).Info(util.Trace("course updated"))
 	return course, nil
 }
+
+const claimScheduledCourseSQL = `
+	SELECT
+		id,
+		user_id
+	FROM course
+	WHERE scheduled_publish_at <= statement_timestamp()
+		AND NOT (id = ANY($1::text[]))
+	ORDER BY scheduled_publish_at
+	LIMIT 1
+	FOR UPDATE SKIP LOCKED
+`
+
+// ClaimScheduledCourse returns the ID and owner of a course due to be
+// published, and locks it until the end of the transaction db, like
+// ClaimScheduledLesson.
+func ClaimScheduledCourse(db Queryer, skip []string) (*Course, error) {
+	var row Course
+	err := prepareQueryRow(db, "claimScheduledCourse", claimScheduledCourseSQL, skip).Scan(
+		&row.ID,
+		&row.UserID,
+	)
+	if err == pgx.ErrNoRows {
+		return nil, ErrNotFound
+	} else if err != nil {
+		mylog.Log.WithError(err).Error(util.Trace(""))
+		return nil, err
+	}
+
+	mylog.Log.WithField("id", row.ID.String).Info(util.Trace("scheduled course claimed"))
+	return &row, nil
+}
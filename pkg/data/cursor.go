@@ -2,51 +2,118 @@ package data
 
 import (
 	"encoding/base64"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"reflect"
 	"strings"
 
-	"github.com/jackc/pgx"
+	"github.com/jackc/pgx/pgtype"
 )
 
+var ErrInvalidCursor = errors.New("invalid cursor")
+
+// Cursor points to a row in a connection by its id and the value of the field
+// the connection is ordered by.
 type Cursor struct {
-	String string
-	Value  string
+	// HasValue is false if the row did not carry the order field when the cursor
+	// was encoded, in which case the value is looked up by id.
+	HasValue bool
+	ID       string
+	String   string
+	// Value is the text of the order field of the row, or nil if it was NULL.
+	Value *string
 }
 
 func NewCursor(cursor string) (*Cursor, error) {
-	v, err := DecodeCursor(cursor)
+	bs, err := base64.StdEncoding.DecodeString(cursor)
 	if err != nil {
 		return nil, err
 	}
+	keys := []*string{}
+	err = json.Unmarshal([]byte(strings.TrimPrefix(string(bs), "cursor:")), &keys)
+	if err != nil {
+		return nil, ErrInvalidCursor
+	}
+	if len(keys) < 1 || len(keys) > 2 || keys[0] == nil {
+		return nil, ErrInvalidCursor
+	}
 	c := &Cursor{
+		ID:     *keys[0],
 		String: cursor,
-		Value:  v,
+	}
+	if len(keys) == 2 {
+		c.HasValue = true
+		c.Value = keys[1]
 	}
 	return c, nil
 }
 
-func (c *Cursor) SQL(field string, args *pgx.QueryArgs) string {
-	return field + " = " + args.Append(c.Value)
-}
+var cursorConnInfo = pgtype.NewConnInfo()
 
-func DecodeCursor(cursor string) (string, error) {
-	bs, err := base64.StdEncoding.DecodeString(cursor)
+// EncodeCursor encodes the id of row, which must be a pointer to a struct with
+// db tags, along with the text of its field tagged field. The value is left
+// out if field is empty, row has no such field, or the field was not scanned.
+func EncodeCursor(row interface{}, field string) (string, error) {
+	v := reflect.ValueOf(row)
+	if v.Kind() != reflect.Ptr || v.Elem().Kind() != reflect.Struct {
+		return "", fmt.Errorf("invalid type %T for row", row)
+	}
+	if field == BestMatch {
+		field = bestMatchRankColumn
+	}
+
+	id, ok, err := encodeCursorField(v.Elem(), "id")
+	if err != nil {
+		return "", err
+	} else if !ok || id == nil {
+		return "", fmt.Errorf("row %T has no id", row)
+	}
+	keys := []*string{id}
+	if field != "" {
+		value, ok, err := encodeCursorField(v.Elem(), field)
+		if err != nil {
+			return "", err
+		} else if ok {
+			keys = append(keys, value)
+		}
+	}
+
+	bs, err := json.Marshal(keys)
 	if err != nil {
 		return "", err
 	}
-	decodedValue := strings.TrimPrefix(string(bs), "cursor:")
-	return decodedValue, nil
+	return base64.StdEncoding.EncodeToString(append([]byte("cursor:"), bs...)), nil
 }
 
-func EncodeCursor(value interface{}) (string, error) {
-	cursor := ""
-	switch v := value.(type) {
-	case int32:
-		cursor = fmt.Sprintf("cursor:%d", v)
-	case string:
-		cursor = fmt.Sprintf("cursor:%s", v)
-	default:
-		return "", fmt.Errorf("invalid type %T for value", v)
-	}
-	return base64.StdEncoding.EncodeToString([]byte(cursor)), nil
+// encodeCursorField returns the text of the field of v tagged db, or nil if it
+// is NULL. ok is false if v has no such field or it is undefined.
+func encodeCursorField(v reflect.Value, db string) (text *string, ok bool, err error) {
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		if t.Field(i).Tag.Get("db") != db {
+			continue
+		}
+		f := v.Field(i)
+		if f.Kind() == reflect.Struct {
+			status := f.FieldByName("Status")
+			if status.IsValid() && status.Interface() == pgtype.Undefined {
+				return nil, false, nil
+			}
+		}
+		encoder, isEncoder := f.Addr().Interface().(pgtype.TextEncoder)
+		if !isEncoder {
+			return nil, false, fmt.Errorf("invalid type %s for cursor field %s", f.Type(), db)
+		}
+		buf, err := encoder.EncodeText(cursorConnInfo, nil)
+		if err != nil {
+			return nil, false, err
+		}
+		if buf == nil {
+			return nil, true, nil
+		}
+		s := string(buf)
+		return &s, true, nil
+	}
+	return nil, false, nil
 }
@@ -0,0 +1,117 @@
+package data_test
+
+import (
+	"testing"
+
+	"github.com/jackc/pgx/pgtype"
+	"github.com/marksauter/markus-ninja-api/pkg/data"
+	"github.com/marksauter/markus-ninja-api/pkg/mytype"
+)
+
+type cursorRow struct {
+	BestMatchRank pgtype.Float8 `db:"best_match_rank"`
+	ID            mytype.OID    `db:"id"`
+	Name          pgtype.Text   `db:"name"`
+}
+
+func newCursorRow(id string, rank pgtype.Float8, name pgtype.Text) *cursorRow {
+	return &cursorRow{
+		BestMatchRank: rank,
+		ID:            mytype.OID{String: id, Status: pgtype.Present},
+		Name:          name,
+	}
+}
+
+func strptr(s string) *string {
+	return &s
+}
+
+var cursorTests = []struct {
+	row      *cursorRow
+	field    string
+	hasValue bool
+	value    *string
+}{
+	{
+		newCursorRow(
+			"MDA1U3R1ZHliZnNzbmg0NTU1N2VuMGc0YjZlMA==",
+			pgtype.Float8{Float: 0.6180339887498949, Status: pgtype.Present},
+			pgtype.Text{},
+		),
+		data.BestMatch,
+		true,
+		strptr("0.6180339887498949"),
+	},
+	{
+		newCursorRow(
+			"MDA2TGVzc29uYmZzc25oNDU1NTdlbjBnNGI2ZWc=",
+			pgtype.Float8{},
+			pgtype.Text{String: "a:b", Status: pgtype.Present},
+		),
+		"name",
+		true,
+		strptr("a:b"),
+	},
+	{
+		newCursorRow(
+			"MDA1VG9waWNiZnNzbmg0NTU1N2VuMGc0YjZmMA==",
+			pgtype.Float8{},
+			pgtype.Text{Status: pgtype.Null},
+		),
+		"name",
+		true,
+		nil,
+	},
+	{
+		newCursorRow(
+			"MDA1VG9waWNiZnNzbmg0NTU1N2VuMGc0YjZmMA==",
+			pgtype.Float8{},
+			pgtype.Text{},
+		),
+		"name",
+		false,
+		nil,
+	},
+	{
+		newCursorRow(
+			"MDA1VG9waWNiZnNzbmg0NTU1N2VuMGc0YjZmMA==",
+			pgtype.Float8{},
+			pgtype.Text{},
+		),
+		"apple_count",
+		false,
+		nil,
+	},
+}
+
+func TestCursor(t *testing.T) {
+	for _, tt := range cursorTests {
+		s, err := data.EncodeCursor(tt.row, tt.field)
+		if err != nil {
+			t.Fatal(err)
+		}
+		c, err := data.NewCursor(s)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if c.ID != tt.row.ID.String ||
+			c.HasValue != tt.hasValue ||
+			(c.Value == nil) != (tt.value == nil) ||
+			(c.Value != nil && *c.Value != *tt.value) {
+			t.Errorf(
+				"NewCursor(EncodeCursor(%+v, %s)) actual %+v",
+				tt.row,
+				tt.field,
+				c,
+			)
+		}
+	}
+}
+
+func TestInvalidCursor(t *testing.T) {
+	for _, s := range []string{"", "Y3Vyc29yOg==", "Y3Vyc29yOltd", "Y3Vyc29yOltudWxsXQ=="} {
+		if _, err := data.NewCursor(s); err == nil {
+			t.Errorf("NewCursor(%q) expected error", s)
+		}
+	}
+}
@@ -0,0 +1,290 @@
+package data
+
+import (
+	"strings"
+
+	"github.com/jackc/pgx"
+	"github.com/jackc/pgx/pgtype"
+	"github.com/marksauter/markus-ninja-api/pkg/mylog"
+	"github.com/marksauter/markus-ninja-api/pkg/mytype"
+	"github.com/marksauter/markus-ninja-api/pkg/util"
+)
+
+const (
+	DataExportPending   = "pending"
+	DataExportRunning   = "running"
+	DataExportSucceeded = "succeeded"
+	DataExportFailed    = "failed"
+)
+
+// DataExport is a request of a user for an archive of their data. Exports are
+// built in the background, and a user may only have one pending or running
+// export at a time.
+type DataExport struct {
+	CreatedAt  pgtype.Timestamptz `db:"created_at"`
+	EndedAt    pgtype.Timestamptz `db:"ended_at"`
+	ExpiresAt  pgtype.Timestamptz `db:"expires_at"`
+	ID         mytype.OID         `db:"id"`
+	ObjectPath pgtype.Text        `db:"object_path"`
+	StartedAt  pgtype.Timestamptz `db:"started_at"`
+	Status     pgtype.Varchar     `db:"status"`
+	UserID     mytype.OID         `db:"user_id"`
+}
+
+func getDataExport(
+	db Queryer,
+	name string,
+	sql string,
+	args ...interface{},
+) (*DataExport, error) {
+	var row DataExport
+	err := prepareQueryRow(db, name, sql, args...).Scan(
+		&row.CreatedAt,
+		&row.EndedAt,
+		&row.ExpiresAt,
+		&row.ID,
+		&row.ObjectPath,
+		&row.StartedAt,
+		&row.Status,
+		&row.UserID,
+	)
+	if err == pgx.ErrNoRows {
+		mylog.Log.WithError(err).Debug(util.Trace(""))
+		return nil, ErrNotFound
+	} else if err != nil {
+		mylog.Log.WithError(err).Error(util.Trace(""))
+		return nil, err
+	}
+
+	return &row, nil
+}
+
+func getManyDataExport(
+	db Queryer,
+	name string,
+	sql string,
+	rows *[]*DataExport,
+	args ...interface{},
+) error {
+	dbRows, err := prepareQuery(db, name, sql, args...)
+	if err != nil {
+		mylog.Log.WithError(err).Debug(util.Trace(""))
+		return err
+	}
+	defer dbRows.Close()
+
+	for dbRows.Next() {
+		var row DataExport
+		dbRows.Scan(
+			&row.CreatedAt,
+			&row.EndedAt,
+			&row.ExpiresAt,
+			&row.ID,
+			&row.ObjectPath,
+			&row.StartedAt,
+			&row.Status,
+			&row.UserID,
+		)
+		*rows = append(*rows, &row)
+	}
+
+	if err := dbRows.Err(); err != nil {
+		mylog.Log.WithError(err).Debug(util.Trace(""))
+		return err
+	}
+
+	return nil
+}
+
+const getDataExportSQL = `
+	SELECT
+		created_at,
+		ended_at,
+		expires_at,
+		id,
+		object_path,
+		started_at,
+		status,
+		user_id
+	FROM data_export
+	WHERE id = $1
+`
+
+func GetDataExport(
+	db Queryer,
+	id string,
+) (*DataExport, error) {
+	dataExport, err := getDataExport(db, "getDataExport", getDataExportSQL, id)
+	if err != nil {
+		mylog.Log.WithField("id", id).WithError(err).Error(util.Trace(""))
+	} else {
+		mylog.Log.WithField("id", id).Info(util.Trace("data export found"))
+	}
+	return dataExport, err
+}
+
+const getExpiredDataExportSQL = `
+	SELECT
+		created_at,
+		ended_at,
+		expires_at,
+		id,
+		object_path,
+		started_at,
+		status,
+		user_id
+	FROM data_export
+	WHERE status = 'succeeded'
+		AND object_path IS NOT NULL
+		AND expires_at < statement_timestamp()
+	ORDER BY expires_at ASC
+`
+
+// GetExpiredDataExport returns the succeeded exports whose download links have
+// expired, and whose archives are still in storage.
+func GetExpiredDataExport(
+	db Queryer,
+) ([]*DataExport, error) {
+	var rows []*DataExport
+	err := getManyDataExport(db, "getExpiredDataExport", getExpiredDataExportSQL, &rows)
+	if err != nil {
+		mylog.Log.WithError(err).Error(util.Trace(""))
+		return nil, err
+	}
+
+	mylog.Log.WithField("n", len(rows)).Info(util.Trace("expired data exports found"))
+	return rows, nil
+}
+
+// An export that has been running for longer than this is assumed to have
+// been abandoned, e.g. by an instance that shut down, and may be claimed again.
+const claimDataExportSQL = `
+	UPDATE data_export
+	SET status = 'running',
+		started_at = statement_timestamp()
+	WHERE id = (
+		SELECT id
+		FROM data_export
+		WHERE status = 'pending'
+			OR (status = 'running' AND started_at < statement_timestamp() - interval '1 hour')
+		ORDER BY created_at ASC
+		LIMIT 1
+		FOR UPDATE SKIP LOCKED
+	)
+	RETURNING
+		created_at,
+		ended_at,
+		expires_at,
+		id,
+		object_path,
+		started_at,
+		status,
+		user_id
+`
+
+// ClaimDataExport marks the oldest pending export as running, and returns it.
+// It returns ErrNotFound when there is no export to claim.
+func ClaimDataExport(
+	db Queryer,
+) (*DataExport, error) {
+	dataExport, err := getDataExport(db, "claimDataExport", claimDataExportSQL)
+	if err != nil {
+		if err != ErrNotFound {
+			mylog.Log.WithError(err).Error(util.Trace(""))
+		}
+		return nil, err
+	}
+
+	mylog.Log.WithField("id", dataExport.ID.String).Info(util.Trace("data export claimed"))
+	return dataExport, nil
+}
+
+const createDataExportSQL = `
+	INSERT INTO data_export(id, user_id)
+	VALUES ($1, $2)
+	RETURNING
+		created_at,
+		ended_at,
+		expires_at,
+		id,
+		object_path,
+		started_at,
+		status,
+		user_id
+`
+
+func CreateDataExport(
+	db Queryer,
+	userID string,
+) (*DataExport, error) {
+	id, err := mytype.NewOID("DataExport")
+	if err != nil {
+		mylog.Log.WithError(err).Error(util.Trace(""))
+		return nil, err
+	}
+
+	dataExport, err := getDataExport(db, "createDataExport", createDataExportSQL, id, userID)
+	if err != nil {
+		if pgErr, ok := err.(pgx.PgError); ok {
+			mylog.Log.WithError(pgErr).Error(util.Trace(""))
+			return nil, handlePSQLError(pgErr)
+		}
+		mylog.Log.WithError(err).Error(util.Trace(""))
+		return nil, err
+	}
+
+	mylog.Log.WithField("user_id", userID).Info(util.Trace("data export created"))
+	return dataExport, nil
+}
+
+func UpdateDataExport(
+	db Queryer,
+	row *DataExport,
+) (*DataExport, error) {
+	sets := make([]string, 0, 4)
+	args := pgx.QueryArgs(make([]interface{}, 0, 5))
+
+	if row.EndedAt.Status != pgtype.Undefined {
+		sets = append(sets, `ended_at`+"="+args.Append(&row.EndedAt))
+	}
+	if row.ExpiresAt.Status != pgtype.Undefined {
+		sets = append(sets, `expires_at`+"="+args.Append(&row.ExpiresAt))
+	}
+	if row.ObjectPath.Status != pgtype.Undefined {
+		sets = append(sets, `object_path`+"="+args.Append(&row.ObjectPath))
+	}
+	if row.Status.Status != pgtype.Undefined {
+		sets = append(sets, `status`+"="+args.Append(&row.Status))
+	}
+
+	if len(sets) == 0 {
+		mylog.Log.Info(util.Trace("no updates"))
+		return GetDataExport(db, row.ID.String)
+	}
+
+	sql := `
+		UPDATE data_export
+		SET ` + strings.Join(sets, ", ") + `
+		WHERE id = ` + args.Append(row.ID.String) + `
+		RETURNING
+			created_at,
+			ended_at,
+			expires_at,
+			id,
+			object_path,
+			started_at,
+			status,
+			user_id
+	`
+
+	psName := preparedName("updateDataExport", sql)
+
+	dataExport, err := getDataExport(db, psName, sql, args...)
+	if err != nil {
+		mylog.Log.WithError(err).Error(util.Trace(""))
+		return nil, err
+	}
+
+	mylog.Log.WithField("id", row.ID.String).Info(util.Trace("data export updated"))
+	return dataExport, nil
+}
@@ -1,6 +1,7 @@
 package data
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"hash/fnv"
@@ -10,6 +11,7 @@ import (
 
 	"github.com/jackc/pgx"
 	"github.com/marksauter/markus-ninja-api/pkg/mylog"
+	"github.com/marksauter/markus-ninja-api/pkg/mytrace"
 	"github.com/marksauter/markus-ninja-api/pkg/util"
 	"github.com/rs/xid"
 )
@@ -53,7 +55,54 @@ type preparer interface {
 	Prepare(name, sql string) (*pgx.PreparedStatement, error)
 }
 
+// tracedQueryer binds a Queryer to a context, so that the statements run on it
+// by the prepare* functions are traced under the current span of ctx.
+type tracedQueryer struct {
+	Queryer
+	ctx context.Context
+}
+
+// WithContext returns db bound to ctx.
+func WithContext(ctx context.Context, db Queryer) Queryer {
+	return &tracedQueryer{Queryer: unwrapQueryer(db), ctx: ctx}
+}
+
+func unwrapQueryer(db Queryer) Queryer {
+	if t, ok := db.(*tracedQueryer); ok {
+		return t.Queryer
+	}
+	return db
+}
+
+func startStatementSpan(db Queryer, name, sql string) *mytrace.Span {
+	t, ok := db.(*tracedQueryer)
+	if !ok {
+		return nil
+	}
+	_, span := mytrace.StartSpan(t.ctx, "sql "+name)
+	span.Kind = mytrace.ClientSpanKind
+	span.SetAttribute("db.system", "postgresql")
+	span.SetAttribute("db.prepared_name", name)
+	span.SetAttribute("db.statement", sql)
+	return span
+}
+
+func finishStatementSpan(span *mytrace.Span, err error) {
+	if span == nil {
+		return
+	}
+	span.SetError(err)
+	span.Finish()
+}
+
 func BeginTransaction(db Queryer) (Queryer, error, bool) {
+	if t, ok := db.(*tracedQueryer); ok {
+		tx, err, newTx := BeginTransaction(t.Queryer)
+		if err != nil {
+			return nil, err, false
+		}
+		return &tracedQueryer{Queryer: tx, ctx: t.ctx}, nil, newTx
+	}
 	if transactor, ok := db.(transactor); ok {
 		tx, err := transactor.Begin()
 		if err != nil {
@@ -66,21 +115,21 @@ func BeginTransaction(db Queryer) (Queryer, error, bool) {
 }
 
 func CommitTransaction(db Queryer) error {
-	if committer, ok := db.(committer); ok {
+	if committer, ok := unwrapQueryer(db).(committer); ok {
 		return committer.Commit()
 	}
 	return nil
 }
 
 func RollbackTransaction(db Queryer) error {
-	if committer, ok := db.(committer); ok {
+	if committer, ok := unwrapQueryer(db).(committer); ok {
 		return committer.Rollback()
 	}
 	return nil
 }
 
 func prepare(db Queryer, name, sql string) (*pgx.PreparedStatement, error) {
-	if preparer, ok := db.(preparer); ok {
+	if preparer, ok := unwrapQueryer(db).(preparer); ok {
 		ps, err := preparer.Prepare(name, sql)
 		if err != nil {
 			mylog.Log.WithError(err).Error(util.Trace(""))
@@ -99,7 +148,10 @@ func prepareQuery(db Queryer, name, sql string, args ...interface{}) (*pgx.Rows,
 	//   sql = name
 	// }
 
-	return db.Query(sql, args...)
+	span := startStatementSpan(db, name, sql)
+	rows, err := db.Query(sql, args...)
+	finishStatementSpan(span, err)
+	return rows, err
 }
 
 func prepareQueryRow(db Queryer, name, sql string, args ...interface{}) *pgx.Row {
@@ -112,7 +164,10 @@ func prepareQueryRow(db Queryer, name, sql string, args ...interface{}) *pgx.Row
 	//   }
 	// }
 
-	return db.QueryRow(sql, args...)
+	span := startStatementSpan(db, name, sql)
+	row := db.QueryRow(sql, args...)
+	finishStatementSpan(span, nil)
+	return row
 }
 
 func prepareExec(db Queryer, name, sql string, args ...interface{}) (pgx.CommandTag, error) {
@@ -123,7 +178,10 @@ func prepareExec(db Queryer, name, sql string, args ...interface{}) (pgx.Command
 	//   sql = name
 	// }
 
-	return db.Exec(sql, args...)
+	span := startStatementSpan(db, name, sql)
+	commandTag, err := db.Exec(sql, args...)
+	finishStatementSpan(span, err)
+	return commandTag, err
 }
 
 func preparedName(baseName, sql string) string {
@@ -217,9 +275,27 @@ func NewPageOptions(after, before *string, first, last *int32, o Order) (*PageOp
 		}
 		pageOptions.Before = b
 	}
+	if pageOptions.isBestMatch() {
+		for _, c := range []*Cursor{pageOptions.After, pageOptions.Before} {
+			if c != nil && !c.HasValue {
+				err := fmt.Errorf("Cursor %q was not returned by a search ordered by best match.", c.String)
+				mylog.Log.WithError(err).Error(util.Trace(""))
+				return nil, err
+			}
+		}
+	}
 	return pageOptions, nil
 }
 
+// OrderField returns the field the connection is ordered by, or "" if there are
+// no page options, as for the edges returned by mutations.
+func (p *PageOptions) OrderField() string {
+	if p == nil || p.Order == nil {
+		return ""
+	}
+	return p.Order.Field()
+}
+
 // If the query is asking for the last elements in a list, then we need two
 // queries to get the items more efficiently and in the right order.
 // First, we query the reverse direction of that requested, so that only
@@ -232,6 +308,9 @@ func (p *PageOptions) QueryDirection() string {
 	return direction.String()
 }
 
+// The rows at the cursors are included in the page, so that the connection
+// knows if there are rows on the other side of them, and one more row is
+// included to know if there are rows past the end of the page.
 func (p *PageOptions) Limit() int32 {
 	// Assuming one of these is 0, so the sum will be the non-zero field + 1
 	limit := p.First + p.Last
@@ -239,67 +318,71 @@ func (p *PageOptions) Limit() int32 {
 		return 0
 	}
 	limit += 1
-	if (p.After != nil && p.First > 0) ||
-		(p.Before != nil && p.Last > 0) {
-		limit = limit + int32(1)
+	if p.After != nil {
+		limit += 1
+	}
+	if p.Before != nil {
+		limit += 1
 	}
 	return limit
 }
 
-func (p *PageOptions) joins(from, as string, args *pgx.QueryArgs) string {
-	joins := make([]string, 0, 2)
-	if p.After != nil {
-		joins = append(joins, fmt.Sprintf(
-			"JOIN %[1]s AS %[2]s2 ON %[2]s2."+p.After.SQL("id", args),
-			from,
-			as,
-		))
+// orderSQL returns the expression the rows of from, aliased as as, are ordered
+// by.
+func (p *PageOptions) orderSQL(as, rank string) string {
+	if p.isBestMatch() {
+		return rank
 	}
-	if p.Before != nil {
-		joins = append(joins, fmt.Sprintf(
-			"JOIN %[1]s AS %[2]s3 ON %[2]s3."+p.Before.SQL("id", args),
-			from,
-			as,
-		))
+	return as + "." + p.Order.Field()
+}
+
+// cursorValue returns the order value of the row at the cursor. The value is
+// looked up by the id of the row if the cursor did not carry it.
+func (p *PageOptions) cursorValue(c *Cursor, from string, args *pgx.QueryArgs) string {
+	if c.HasValue {
+		return args.Append(*c.Value)
 	}
-	return strings.Join(joins, " ")
+	return "(SELECT " + p.Order.Field() + " FROM " + from +
+		" WHERE id = " + args.Append(c.ID) + " LIMIT 1)"
 }
 
-func (p *PageOptions) where(from string) string {
-	where := make([]string, 0, 2)
-	field := p.Order.Field()
-	if field == "best_match" {
-		field = "created_at"
+// cursorWhere returns the condition matching the rows at the cursor and after
+// it, or before it, in the requested order. Rows are ordered by the order
+// value, with nulls last, and then by id to break ties.
+func (p *PageOptions) cursorWhere(
+	c *Cursor,
+	after bool,
+	from,
+	as,
+	order string,
+	args *pgx.QueryArgs,
+) string {
+	relation := ">="
+	if after == (p.Order.Direction() == DESC) {
+		relation = "<="
+	}
+	id := args.Append(c.ID)
+	if c.HasValue && c.Value == nil {
+		if after {
+			return "(" + order + " IS NULL AND " + as + ".id " + relation + " " + id + ")"
+		}
+		return "(" + order + " IS NOT NULL OR " + as + ".id " + relation + " " + id + ")"
+	}
+	value := p.cursorValue(c, from, args)
+	where := "(" + order + ", " + as + ".id) " + relation + " (" + value + ", " + id + ")"
+	if after {
+		return "(" + where + " OR " + order + " IS NULL)"
 	}
+	return where
+}
+
+func (p *PageOptions) where(from, as, order string, args *pgx.QueryArgs) string {
+	where := make([]string, 0, 2)
 	if p.After != nil {
-		relation := ""
-		switch p.Order.Direction() {
-		case ASC:
-			relation = ">="
-		case DESC:
-			relation = "<="
-		}
-		where = append(where, fmt.Sprintf(
-			"%[1]s.%[2]s %[3]s %[1]s2.%[2]s",
-			from,
-			field,
-			relation,
-		))
+		where = append(where, p.cursorWhere(p.After, true, from, as, order, args))
 	}
 	if p.Before != nil {
-		relation := ""
-		switch p.Order.Direction() {
-		case ASC:
-			relation = "<="
-		case DESC:
-			relation = ">="
-		}
-		where = append(where, fmt.Sprintf(
-			"%[1]s.%[2]s %[3]s %[1]s3.%[2]s",
-			from,
-			field,
-			relation,
-		))
+		where = append(where, p.cursorWhere(p.Before, false, from, as, order, args))
 	}
 
 	if len(where) == 0 {
@@ -309,16 +392,37 @@ func (p *PageOptions) where(from string) string {
 	return "(" + strings.Join(where, " AND ") + ")"
 }
 
-func (p *PageOptions) orderBy(from string) string {
-	field := p.Order.Field()
-	if field == "best_match" {
-		field = "created_at"
-	}
-	return fmt.Sprintf("ORDER BY %s.%s %s", from, field, p.QueryDirection())
+func (p *PageOptions) orderBy(as, order string) string {
+	direction := p.QueryDirection()
+	nulls := "LAST"
+	if direction != p.Order.Direction().String() {
+		nulls = "FIRST"
+	}
+	return fmt.Sprintf(
+		"ORDER BY %[1]s %[2]s NULLS %[3]s, %[4]s.id %[2]s",
+		order,
+		direction,
+		nulls,
+		as,
+	)
 }
 
 type WhereFrom = func(string) string
 
+// ranksBestMatch returns whether the query selects or orders by the best match
+// rank, so that the arguments of the rank are only appended when it is used.
+func ranksBestMatch(selects []string, po *PageOptions) bool {
+	if po != nil && po.isBestMatch() {
+		return true
+	}
+	for _, s := range selects {
+		if s == bestMatchRankColumn {
+			return true
+		}
+	}
+	return false
+}
+
 func SQL3(
 	selects []string,
 	from string,
@@ -328,21 +432,31 @@ func SQL3(
 	po *PageOptions,
 ) string {
 	fromAlias := xid.New().String()
+	rank := ""
+	if ranksBestMatch(selects, po) {
+		rank = bestMatchRankSQL(from, fromAlias, filtersSearchDocument(filters), filtersSearchTitles(filters), args)
+	}
 	selectSQL := make([]string, len(selects))
 	for i, s := range selects {
-		selectSQL[i] = fromAlias + "." + s
+		if s == bestMatchRankColumn {
+			selectSQL[i] = rank + " AS " + s
+		} else {
+			selectSQL[i] = fromAlias + "." + s
+		}
 	}
 	fromSQL := []string{from + " AS " + fromAlias}
-	joinSQL := []string{}
 	whereSQL := []string{where(fromAlias)}
 
 	var limit, orderBy string
 
 	if po != nil {
-		joinSQL = append(joinSQL, po.joins(from, fromAlias, args))
-		whereSQL = append(whereSQL, po.where(fromAlias))
+		order := po.orderSQL(fromAlias, rank)
+		whereSQL = append(whereSQL, po.where(from, fromAlias, order, args))
+		orderBy = po.orderBy(fromAlias, order)
 		limit = "LIMIT " + args.Append(po.Limit())
-		orderBy = po.orderBy(fromAlias)
+		if po.Last != 0 {
+			selectSQL = append(selectSQL, order+" AS "+reorderColumn)
+		}
 	}
 
 	if filters != nil {
@@ -360,12 +474,11 @@ func SQL3(
 		SELECT 
 		` + strings.Join(selectSQL, ",") + `
 		FROM ` + strings.Join(fromSQL, ", ") + `
-		` + strings.Join(joinSQL, " ") + `
 		WHERE ` + strings.Join(whereSQL, " AND ") + `
 		` + orderBy + `
 		` + limit
 
-	return ReorderQuery(po, sql)
+	return ReorderQuery(po, selects, sql)
 }
 
 func CountSQL(
@@ -403,15 +516,22 @@ func SearchSQL2(
 	po *PageOptions,
 ) string {
 	fromAlias := xid.New().String()
+	rank := ""
+	if ranksBestMatch(selects, po) {
+		rank = bestMatchRankSQL(from, fromAlias, true, nil, args)
+	}
 	selectSQL := make([]string, len(selects))
 	for i, s := range selects {
-		selectSQL[i] = fromAlias + "." + s
+		if s == bestMatchRankColumn {
+			selectSQL[i] = rank + " AS " + s
+		} else {
+			selectSQL[i] = fromAlias + "." + s
+		}
 	}
 	fromSQL := []string{
 		from + " AS " + fromAlias,
 		"to_tsquery('simple', " + args.Append(query) + ") AS document_query",
 	}
-	joinSQL := []string{}
 	whereSQL := []string{
 		"CASE " + args.Append(query) + " WHEN '*' THEN TRUE ELSE " + fromAlias + ".document @@ document_query END",
 	}
@@ -419,10 +539,13 @@ func SearchSQL2(
 	var limit, orderBy string
 
 	if po != nil {
-		joinSQL = append(joinSQL, po.joins(from, fromAlias, args))
-		whereSQL = append(whereSQL, po.where(fromAlias))
+		order := po.orderSQL(fromAlias, rank)
+		whereSQL = append(whereSQL, po.where(from, fromAlias, order, args))
+		orderBy = po.orderBy(fromAlias, order)
 		limit = "LIMIT " + args.Append(po.Limit())
-		orderBy = po.orderBy(fromAlias)
+		if po.Last != 0 {
+			selectSQL = append(selectSQL, order+" AS "+reorderColumn)
+		}
 	}
 
 	fromSQL = util.RemoveEmptyStrings(fromSQL)
@@ -432,26 +555,27 @@ func SearchSQL2(
 		SELECT 
 		` + strings.Join(selectSQL, ",") + `
 		FROM ` + strings.Join(fromSQL, ", ") + `
-		` + strings.Join(joinSQL, " ") + `
 		WHERE ` + strings.Join(whereSQL, " AND ") + `
 		` + orderBy + `
 		` + limit
 
-	return ReorderQuery(po, sql)
+	return ReorderQuery(po, selects, sql)
 }
 
+// reorderColumn is the column the order value of the rows is selected into
+// when they are queried in reverse.
+const reorderColumn = "reorder_value"
+
 // Then, we can reorder the items to the originally requested direction.
-func ReorderQuery(po *PageOptions, query string) string {
+func ReorderQuery(po *PageOptions, selects []string, query string) string {
 	if po != nil && po.Last != 0 {
-		field := po.Order.Field()
-		if field == "best_match" {
-			field = "created_at"
-		}
+		direction := po.Order.Direction()
 		return fmt.Sprintf(
-			`SELECT * FROM (%s) reorder_last_query ORDER BY %s %s`,
+			`SELECT %[1]s FROM (%[2]s) reorder_last_query ORDER BY %[3]s %[4]s NULLS LAST, id %[4]s`,
+			strings.Join(selects, ","),
 			query,
-			field,
-			po.Order.Direction(),
+			reorderColumn,
+			direction,
 		)
 	}
 	return query
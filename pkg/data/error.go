@@ -91,6 +91,18 @@ var uniqueLessonNotificationUserIDLessonID = "lesson_notification_user_id_lesson
 
 var uniqueUserAssetSearchIndexStudyIDName = "user_asset_search_index_study_id_name_idx"
 
+var uniqueLessonPrerequisite = "lesson_prerequisite_pkey"
+var ErrLessonPrerequisiteExists = DataEndUserError{UniqueViolation, "lesson already has that prerequisite"}
+
+var checkLessonPrerequisiteAcyclic = "lesson_prerequisite_acyclic"
+var ErrLessonPrerequisiteCycle = DataEndUserError{CheckViolation, "lesson prerequisites may not form a cycle"}
+
+var uniqueDataExportActiveUserID = "data_export_unique_active_user_id_idx"
+var ErrDataExportInProgress = DataEndUserError{UniqueViolation, "a data export is already in progress"}
+
+var uniqueContentReportOpenReporterIDSubjectID = "content_report_unique_open_reporter_id_subject_id_idx"
+var ErrContentReportExists = DataEndUserError{UniqueViolation, "you have already reported this"}
+
 func handleUniqueViolation(constraintName string) error {
 	switch constraintName {
 	case uniqueUserLogin:
@@ -107,11 +119,26 @@ func handleUniqueViolation(constraintName string) error {
 		return ErrStudyLabelNameUnavailable
 	case uniqueUserAssetStudyIDName:
 		return ErrStudyUserAssetNameUnavailable
+	case uniqueLessonPrerequisite:
+		return ErrLessonPrerequisiteExists
+	case uniqueDataExportActiveUserID:
+		return ErrDataExportInProgress
+	case uniqueContentReportOpenReporterIDSubjectID:
+		return ErrContentReportExists
 	default:
 		return myerr.SomethingWentWrongError
 	}
 }
 
+func handleCheckViolation(pgErr pgx.PgError) error {
+	switch pgErr.ConstraintName {
+	case checkLessonPrerequisiteAcyclic:
+		return ErrLessonPrerequisiteCycle
+	default:
+		return pgErr
+	}
+}
+
 func handlePSQLError(pgErr pgx.PgError) error {
 	code := PSQLError(pgErr.Code)
 	switch code {
@@ -119,6 +146,8 @@ func handlePSQLError(pgErr pgx.PgError) error {
 		return DataEndUserError{code, fmt.Sprintf("field '%s' required", pgErr.ColumnName)}
 	case UniqueViolation:
 		return handleUniqueViolation(pgErr.ConstraintName)
+	case CheckViolation:
+		return handleCheckViolation(pgErr)
 	default:
 		return pgErr
 	}
@@ -452,6 +452,56 @@ func GetEventByLesson(
 	return rows, nil
 }
 
+func GetEventByTopic(
+	db Queryer,
+	topicID string,
+	po *PageOptions,
+	filters *EventFilterOptions,
+) ([]*Event, error) {
+	mylog.Log.WithField("topic_id", topicID).Info("GetEventByTopic(topic_id)")
+	var rows []*Event
+	if po != nil && po.Limit() > 0 {
+		limit := po.Limit()
+		if limit > 0 {
+			rows = make([]*Event, 0, limit)
+		} else {
+			mylog.Log.Info(util.Trace("limit is 0"))
+			return rows, nil
+		}
+	}
+
+	args := pgx.QueryArgs(make([]interface{}, 0, 4))
+	where := func(from string) string {
+		return from + `.study_id IN (
+			SELECT topicable_id
+			FROM topiced
+			WHERE topic_id = ` + args.Append(topicID) + `
+		)`
+	}
+
+	selects := []string{
+		"created_at",
+		"id",
+		"payload",
+		"public",
+		"study_id",
+		"type",
+		"user_id",
+	}
+	from := "event"
+	sql := SQL3(selects, from, where, filters, &args, po)
+
+	psName := preparedName("getEventsByTopic", sql)
+
+	if err := getManyEvent(db, psName, sql, &rows, args...); err != nil {
+		mylog.Log.WithError(err).Error(util.Trace(""))
+		return nil, err
+	}
+
+	mylog.Log.WithField("n", len(rows)).Info(util.Trace("events found"))
+	return rows, nil
+}
+
 func GetEventByUser(
 	db Queryer,
 	userID string,
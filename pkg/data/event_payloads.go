@@ -123,13 +123,14 @@ type RenamePayload struct {
 }
 
 type LessonEventPayload struct {
-	Action    string        `json:"action,omitempty"`
-	CommentID mytype.OID    `json:"comment_id,omitempty"`
-	CourseID  mytype.OID    `json:"course_id,omitempty"`
-	LabelID   mytype.OID    `json:"label_id,omitempty"`
-	LessonID  mytype.OID    `json:"lesson_id,omitempty"`
-	Rename    RenamePayload `json:"rename,omitempty"`
-	SourceID  mytype.OID    `json:"source_id,omitempty"`
+	Action      string        `json:"action,omitempty"`
+	CommentID   mytype.OID    `json:"comment_id,omitempty"`
+	CourseID    mytype.OID    `json:"course_id,omitempty"`
+	LabelID     mytype.OID    `json:"label_id,omitempty"`
+	LessonID    mytype.OID    `json:"lesson_id,omitempty"`
+	MentionerID mytype.OID    `json:"mentioner_id,omitempty"`
+	Rename      RenamePayload `json:"rename,omitempty"`
+	SourceID    mytype.OID    `json:"source_id,omitempty"`
 }
 
 func NewLessonAddedToCoursePayload(lessonID, courseID *mytype.OID) (*LessonEventPayload, error) {
@@ -179,15 +180,19 @@ func NewLessonCreatedPayload(lessonID *mytype.OID) (*LessonEventPayload, error)
 	return payload, nil
 }
 
-func NewLessonMentionedPayload(lessonID *mytype.OID) (*LessonEventPayload, error) {
-	if lessonID == nil {
-		return nil, errors.New("lessonID must not be nil")
+func NewLessonMentionedPayload(lessonID, mentionerID *mytype.OID) (*LessonEventPayload, error) {
+	if lessonID == nil || mentionerID == nil {
+		return nil, errors.New("lessonID and mentionerID must not be nil")
 	}
 	payload := &LessonEventPayload{Action: LessonMentioned}
 	if err := payload.LessonID.Set(lessonID); err != nil {
 		mylog.Log.WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
+	if err := payload.MentionerID.Set(mentionerID); err != nil {
+		mylog.Log.WithError(err).Error(util.Trace(""))
+		return nil, err
+	}
 
 	return payload, nil
 }
@@ -337,13 +342,14 @@ func NewStudyUnappledPayload(studyID *mytype.OID) (*StudyEventPayload, error) {
 }
 
 type UserAssetEventPayload struct {
-	Action     string        `json:"action,omitempty"`
-	ActivityID mytype.OID    `json:"activity_id,omitempty"`
-	AssetID    mytype.OID    `json:"asset_id,omitempty"`
-	CommentID  mytype.OID    `json:"comment_id,omitempty"`
-	LabelID    mytype.OID    `json:"label_id,omitempty"`
-	Rename     RenamePayload `json:"rename,omitempty"`
-	SourceID   mytype.OID    `json:"source_id,omitempty"`
+	Action      string        `json:"action,omitempty"`
+	ActivityID  mytype.OID    `json:"activity_id,omitempty"`
+	AssetID     mytype.OID    `json:"asset_id,omitempty"`
+	CommentID   mytype.OID    `json:"comment_id,omitempty"`
+	LabelID     mytype.OID    `json:"label_id,omitempty"`
+	MentionerID mytype.OID    `json:"mentioner_id,omitempty"`
+	Rename      RenamePayload `json:"rename,omitempty"`
+	SourceID    mytype.OID    `json:"source_id,omitempty"`
 }
 
 func NewUserAssetAddedToActivityPayload(assetID, activityID *mytype.OID) (*UserAssetEventPayload, error) {
@@ -393,15 +399,19 @@ func NewUserAssetCreatedPayload(assetID *mytype.OID) (*UserAssetEventPayload, er
 	return payload, nil
 }
 
-func NewUserAssetMentionedPayload(assetID *mytype.OID) (*UserAssetEventPayload, error) {
-	if assetID == nil {
-		return nil, errors.New("assetID must not be nil")
+func NewUserAssetMentionedPayload(assetID, mentionerID *mytype.OID) (*UserAssetEventPayload, error) {
+	if assetID == nil || mentionerID == nil {
+		return nil, errors.New("assetID and mentionerID must not be nil")
 	}
 	payload := &UserAssetEventPayload{Action: UserAssetMentioned}
 	if err := payload.AssetID.Set(assetID); err != nil {
 		mylog.Log.WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
+	if err := payload.MentionerID.Set(mentionerID); err != nil {
+		mylog.Log.WithError(err).Error(util.Trace(""))
+		return nil, err
+	}
 
 	return payload, nil
 }
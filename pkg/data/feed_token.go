@@ -0,0 +1,126 @@
+package data
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+
+	"github.com/jackc/pgx"
+	"github.com/jackc/pgx/pgtype"
+	"github.com/marksauter/markus-ninja-api/pkg/mylog"
+	"github.com/marksauter/markus-ninja-api/pkg/mytype"
+	"github.com/marksauter/markus-ninja-api/pkg/util"
+)
+
+// FeedToken is a user's secret token for reading the feeds of private studies
+// the user can admin. A user has at most one token.
+type FeedToken struct {
+	CreatedAt pgtype.Timestamptz `db:"created_at"`
+	Token     pgtype.Varchar     `db:"token"`
+	UserID    mytype.OID         `db:"user_id"`
+}
+
+func getFeedToken(
+	db Queryer,
+	name string,
+	sql string,
+	args ...interface{},
+) (*FeedToken, error) {
+	var row FeedToken
+	err := prepareQueryRow(db, name, sql, args...).Scan(
+		&row.CreatedAt,
+		&row.Token,
+		&row.UserID,
+	)
+	if err == pgx.ErrNoRows {
+		mylog.Log.WithError(err).Debug(util.Trace(""))
+		return nil, ErrNotFound
+	} else if err != nil {
+		mylog.Log.WithError(err).Error(util.Trace(""))
+		return nil, err
+	}
+
+	return &row, nil
+}
+
+const getFeedTokenSQL = `
+	SELECT
+		created_at,
+		token,
+		user_id
+	FROM feed_token
+	WHERE token = $1
+`
+
+func GetFeedToken(
+	db Queryer,
+	token string,
+) (*FeedToken, error) {
+	feedToken, err := getFeedToken(db, "getFeedToken", getFeedTokenSQL, token)
+	if err != nil {
+		mylog.Log.WithError(err).Error(util.Trace(""))
+	} else {
+		mylog.Log.WithField("user_id", feedToken.UserID.String).Info(util.Trace("feed token found"))
+	}
+	return feedToken, err
+}
+
+const getFeedTokenByUserSQL = `
+	SELECT
+		created_at,
+		token,
+		user_id
+	FROM feed_token
+	WHERE user_id = $1
+`
+
+func GetFeedTokenByUser(
+	db Queryer,
+	userID string,
+) (*FeedToken, error) {
+	feedToken, err := getFeedToken(db, "getFeedTokenByUser", getFeedTokenByUserSQL, userID)
+	if err != nil {
+		mylog.Log.WithField("user_id", userID).WithError(err).Error(util.Trace(""))
+	} else {
+		mylog.Log.WithField("user_id", userID).Info(util.Trace("feed token found"))
+	}
+	return feedToken, err
+}
+
+const resetFeedTokenSQL = `
+	INSERT INTO feed_token(token, user_id)
+	VALUES ($1, $2)
+	ON CONFLICT (user_id) DO UPDATE
+	SET token = EXCLUDED.token,
+		created_at = statement_timestamp()
+	RETURNING
+		created_at,
+		token,
+		user_id
+`
+
+// ResetFeedToken replaces the user's feed token with a new one, so feeds
+// subscribed with the old token stop working.
+func ResetFeedToken(
+	db Queryer,
+	userID string,
+) (*FeedToken, error) {
+	b := make([]byte, 20)
+	if _, err := rand.Read(b); err != nil {
+		mylog.Log.WithError(err).Error(util.Trace(""))
+		return nil, err
+	}
+	token := hex.EncodeToString(b)
+
+	feedToken, err := getFeedToken(db, "resetFeedToken", resetFeedTokenSQL, token, userID)
+	if err != nil {
+		if pgErr, ok := err.(pgx.PgError); ok {
+			mylog.Log.WithError(pgErr).Error(util.Trace(""))
+			return nil, handlePSQLError(pgErr)
+		}
+		mylog.Log.WithError(err).Error(util.Trace(""))
+		return nil, err
+	}
+
+	mylog.Log.WithField("user_id", userID).Info(util.Trace("feed token reset"))
+	return feedToken, nil
+}
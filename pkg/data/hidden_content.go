@@ -0,0 +1,78 @@
+package data
+
+import (
+	"github.com/marksauter/markus-ninja-api/pkg/mylog"
+	"github.com/marksauter/markus-ninja-api/pkg/util"
+)
+
+const batchGetHiddenContentSQL = `
+	SELECT subject_id
+	FROM hidden_content
+	WHERE subject_id = ANY($1)
+`
+
+// BatchGetHiddenContent returns the IDs of the subjects in subjectIDs that
+// have been hidden by an admin.
+func BatchGetHiddenContent(
+	db Queryer,
+	subjectIDs []string,
+) ([]string, error) {
+	dbRows, err := prepareQuery(db, "batchGetHiddenContent", batchGetHiddenContentSQL, subjectIDs)
+	if err != nil {
+		mylog.Log.WithError(err).Error(util.Trace(""))
+		return nil, err
+	}
+	defer dbRows.Close()
+
+	hidden := make([]string, 0, len(subjectIDs))
+	for dbRows.Next() {
+		var subjectID string
+		dbRows.Scan(&subjectID)
+		hidden = append(hidden, subjectID)
+	}
+
+	if err := dbRows.Err(); err != nil {
+		mylog.Log.WithError(err).Error(util.Trace(""))
+		return nil, err
+	}
+
+	mylog.Log.WithField("n", len(hidden)).Info(util.Trace("hidden content found"))
+	return hidden, nil
+}
+
+const hideContentSQL = `
+	INSERT INTO hidden_content(subject_id)
+	VALUES ($1)
+	ON CONFLICT (subject_id) DO NOTHING
+`
+
+func HideContent(
+	db Queryer,
+	subjectID string,
+) error {
+	if _, err := prepareExec(db, "hideContent", hideContentSQL, subjectID); err != nil {
+		mylog.Log.WithError(err).Error(util.Trace(""))
+		return err
+	}
+
+	mylog.Log.WithField("subject_id", subjectID).Info(util.Trace("content hidden"))
+	return nil
+}
+
+const restoreContentSQL = `
+	DELETE FROM hidden_content
+	WHERE subject_id = $1
+`
+
+func RestoreContent(
+	db Queryer,
+	subjectID string,
+) error {
+	if _, err := prepareExec(db, "restoreContent", restoreContentSQL, subjectID); err != nil {
+		mylog.Log.WithError(err).Error(util.Trace(""))
+		return err
+	}
+
+	mylog.Log.WithField("subject_id", subjectID).Info(util.Trace("content restored"))
+	return nil
+}
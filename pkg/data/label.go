@@ -12,16 +12,17 @@ import (
 )
 
 type Label struct {
-	Color       mytype.Color       `db:"color" permit:"create/read/update"`
-	CreatedAt   pgtype.Timestamptz `db:"created_at" permit:"read"`
-	Description pgtype.Text        `db:"description" permit:"create/read/update"`
-	ID          mytype.OID         `db:"id" permit:"read"`
-	IsDefault   pgtype.Bool        `db:"is_default" permit:"read"`
-	LabelableID mytype.OID         `db:"labelable_id"`
-	LabeledAt   pgtype.Timestamptz `db:"labeled_at"`
-	Name        mytype.WordsName   `db:"name" permit:"create/read"`
-	StudyID     mytype.OID         `db:"study_id" permit:"create/read"`
-	UpdatedAt   pgtype.Timestamptz `db:"updated_at" permit:"read"`
+	BestMatchRank pgtype.Float8      `db:"best_match_rank"`
+	Color         mytype.Color       `db:"color" permit:"create/read/update"`
+	CreatedAt     pgtype.Timestamptz `db:"created_at" permit:"read"`
+	Description   pgtype.Text        `db:"description" permit:"create/read/update"`
+	ID            mytype.OID         `db:"id" permit:"read"`
+	IsDefault     pgtype.Bool        `db:"is_default" permit:"read"`
+	LabelableID   mytype.OID         `db:"labelable_id"`
+	LabeledAt     pgtype.Timestamptz `db:"labeled_at"`
+	Name          mytype.WordsName   `db:"name" permit:"create/read"`
+	StudyID       mytype.OID         `db:"study_id" permit:"create/read"`
+	UpdatedAt     pgtype.Timestamptz `db:"updated_at" permit:"read"`
 }
 
 func labelDelimeter(r rune) bool {
@@ -31,6 +32,12 @@ func labelDelimeter(r rune) bool {
 type LabelFilterOptions struct {
 	IsDefault *bool
 	Search    *string
+	// Fuzzy also matches rows with a title similar to the search.
+	Fuzzy bool
+	// CreatedAt are comparisons the creation time of the rows must satisfy.
+	CreatedAt []Comparison
+	// StudyNameWithOwner limits the rows to those in the study, i.e. owner/name.
+	StudyNameWithOwner *string
 }
 
 func (src *LabelFilterOptions) SQL(from string, args *pgx.QueryArgs) *SQLParts {
@@ -47,13 +54,14 @@ func (src *LabelFilterOptions) SQL(from string, args *pgx.QueryArgs) *SQLParts {
 			whereParts = append(whereParts, from+".is_default = false")
 		}
 	}
+	whereParts = append(whereParts, comparisonsSQL(from+".created_at", src.CreatedAt, args)...)
+	if src.StudyNameWithOwner != nil {
+		whereParts = append(whereParts, studyNameWithOwnerSQL(from, *src.StudyNameWithOwner, args))
+	}
 	if src.Search != nil {
-		query := ToPrefixTsQuery(*src.Search)
-		fromParts = append(fromParts, "to_tsquery('simple',"+args.Append(query)+") AS document_query")
-		whereParts = append(
-			whereParts,
-			"CASE "+args.Append(query)+" WHEN '*' THEN TRUE ELSE "+from+".document @@ document_query END",
-		)
+		fromPart, wherePart := documentSearchSQL(from, *src.Search, nil, src.titleSearch(), args)
+		fromParts = append(fromParts, fromPart)
+		whereParts = append(whereParts, wherePart)
 	}
 
 	where := ""
@@ -67,6 +75,17 @@ func (src *LabelFilterOptions) SQL(from string, args *pgx.QueryArgs) *SQLParts {
 	}
 }
 
+func (src *LabelFilterOptions) searchesDocument() bool {
+	return src != nil && src.Search != nil
+}
+
+func (src *LabelFilterOptions) titleSearch() *titleSearch {
+	if src == nil {
+		return nil
+	}
+	return newTitleSearch(src.Search, src.Fuzzy, "name")
+}
+
 func CountLabelByLabelable(
 	db Queryer,
 	labelableID string,
@@ -503,6 +522,7 @@ func SearchLabel(
 	where := func(string) string { return "" }
 
 	selects := []string{
+		"best_match_rank",
 		"color",
 		"created_at",
 		"description",
@@ -517,7 +537,30 @@ func SearchLabel(
 
 	psName := preparedName("searchLabelIndex", sql)
 
-	if err := getManyLabel(db, psName, sql, &rows, args...); err != nil {
+	dbRows, err := prepareQuery(db, psName, sql, args...)
+	if err != nil {
+		mylog.Log.WithError(err).Error(util.Trace(""))
+		return nil, err
+	}
+	defer dbRows.Close()
+
+	for dbRows.Next() {
+		var row Label
+		dbRows.Scan(
+			&row.BestMatchRank,
+			&row.Color,
+			&row.CreatedAt,
+			&row.Description,
+			&row.ID,
+			&row.IsDefault,
+			&row.Name,
+			&row.StudyID,
+			&row.UpdatedAt,
+		)
+		rows = append(rows, &row)
+	}
+
+	if err := dbRows.Err(); err != nil {
 		mylog.Log.WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
@@ -1,6 +1,7 @@
 package data
 
 import (
+	"reflect"
 	"strings"
 
 	"github.com/jackc/pgx"
@@ -12,33 +13,70 @@ import (
 )
 
 type Lesson struct {
-	Body         mytype.Markdown    `db:"body" permit:"create/read/update"`
-	CourseID     mytype.OID         `db:"course_id" permit:"read"`
-	CourseNumber pgtype.Int4        `db:"course_number" permit:"read"`
-	CreatedAt    pgtype.Timestamptz `db:"created_at" permit:"read"`
-	Draft        pgtype.Text        `db:"draft" permit:"read/update"`
-	EnrolledAt   pgtype.Timestamptz `db:"enrolled_at"`
-	ID           mytype.OID         `db:"id" permit:"read"`
-	LabeledAt    pgtype.Timestamptz `db:"labeled_at"`
-	LastEditedAt pgtype.Timestamptz `db:"last_edited_at" permit:"read"`
-	Number       pgtype.Int4        `db:"number" permit:"read"`
-	PublishedAt  pgtype.Timestamptz `db:"published_at" permit:"read/update"`
-	StudyID      mytype.OID         `db:"study_id" permit:"create/read"`
-	Title        pgtype.Text        `db:"title" permit:"create/read/update"`
-	UpdatedAt    pgtype.Timestamptz `db:"updated_at" permit:"read"`
-	UserID       mytype.OID         `db:"user_id" permit:"create/read"`
+	BestMatchRank      pgtype.Float8      `db:"best_match_rank"`
+	Body               mytype.Markdown    `db:"body" permit:"create/read/update"`
+	CourseID           mytype.OID         `db:"course_id" permit:"read"`
+	CourseNumber       pgtype.Int4        `db:"course_number" permit:"read"`
+	CreatedAt          pgtype.Timestamptz `db:"created_at" permit:"read"`
+	Draft              pgtype.Text        `db:"draft" permit:"read/update"`
+	EnrolledAt         pgtype.Timestamptz `db:"enrolled_at"`
+	ID                 mytype.OID         `db:"id" permit:"read"`
+	LabeledAt          pgtype.Timestamptz `db:"labeled_at"`
+	LastEditedAt       pgtype.Timestamptz `db:"last_edited_at" permit:"read"`
+	Number             pgtype.Int4        `db:"number" permit:"read"`
+	PublishedAt        pgtype.Timestamptz `db:"published_at" permit:"read/update"`
+	ReadingTimeMinutes pgtype.Int4        `db:"reading_time_minutes" permit:"read"`
+	ScheduledPublishAt pgtype.Timestamptz `db:"scheduled_publish_at" permit:"read/update"`
+	StudyID            mytype.OID         `db:"study_id" permit:"create/read"`
+	Title              pgtype.Text        `db:"title" permit:"create/read/update"`
+	TOC                pgtype.JSONB       `db:"toc" permit:"read"`
+	UpdatedAt          pgtype.Timestamptz `db:"updated_at" permit:"read"`
+	UserID             mytype.OID         `db:"user_id" permit:"create/read"`
+	WordCount          pgtype.Int4        `db:"word_count" permit:"read"`
 }
 
 func lessonDelimeter(r rune) bool {
 	return r == ' ' || r == '-' || r == '_'
 }
 
+// lessonBodyStats returns the reading time, table of contents and word count
+// of the lesson body, which are stored alongside it.
+func lessonBodyStats(body *mytype.Markdown) (
+	readingTimeMinutes *pgtype.Int4,
+	toc *pgtype.JSONB,
+	wordCount *pgtype.Int4,
+	err error,
+) {
+	readingTimeMinutes = &pgtype.Int4{}
+	if err = readingTimeMinutes.Set(body.ReadingTimeMinutes()); err != nil {
+		return
+	}
+	toc = &pgtype.JSONB{}
+	if err = toc.Set(body.Headings()); err != nil {
+		return
+	}
+	wordCount = &pgtype.Int4{}
+	err = wordCount.Set(body.WordCount())
+	return
+}
+
 type LessonFilterOptions struct {
 	IsCourseLesson   *bool
 	IsPublished      *bool
 	Labels           *[]string
 	CourseNotEqualTo *string
 	Search           *string
+	// Fuzzy also matches rows with a title similar to the search.
+	Fuzzy bool
+	// SearchLanguage is the text search configuration the search is also
+	// stemmed with, that of the study the rows belong to.
+	SearchLanguage *string
+	// CreatedAt are comparisons the creation time of the rows must satisfy.
+	CreatedAt []Comparison
+	// StudyNameWithOwner limits the rows to those in the study, i.e. owner/name.
+	StudyNameWithOwner *string
+	// UserLogin limits the rows to those of the user with the login.
+	UserLogin *string
 }
 
 func (src *LessonFilterOptions) SQL(from string, args *pgx.QueryArgs) *SQLParts {
@@ -77,13 +115,17 @@ func (src *LessonFilterOptions) SQL(from string, args *pgx.QueryArgs) *SQLParts
 			mylog.Log.WithError(err).Error("invalid course_id for lesson filter CourseNotEqualTo")
 		}
 	}
+	whereParts = append(whereParts, comparisonsSQL(from+".created_at", src.CreatedAt, args)...)
+	if src.StudyNameWithOwner != nil {
+		whereParts = append(whereParts, studyNameWithOwnerSQL(from, *src.StudyNameWithOwner, args))
+	}
+	if src.UserLogin != nil {
+		whereParts = append(whereParts, userLoginSQL(from, *src.UserLogin, args))
+	}
 	if src.Search != nil {
-		query := ToPrefixTsQuery(*src.Search)
-		fromParts = append(fromParts, "to_tsquery('simple',"+args.Append(query)+") AS document_query")
-		whereParts = append(
-			whereParts,
-			"CASE "+args.Append(query)+" WHEN '*' THEN TRUE ELSE "+from+".document @@ document_query END",
-		)
+		fromPart, wherePart := documentSearchSQL(from, *src.Search, src.SearchLanguage, src.titleSearch(), args)
+		fromParts = append(fromParts, fromPart)
+		whereParts = append(whereParts, wherePart)
 	}
 
 	where := ""
@@ -97,6 +139,17 @@ func (src *LessonFilterOptions) SQL(from string, args *pgx.QueryArgs) *SQLParts
 	}
 }
 
+func (src *LessonFilterOptions) searchesDocument() bool {
+	return src != nil && src.Search != nil
+}
+
+func (src *LessonFilterOptions) titleSearch() *titleSearch {
+	if src == nil {
+		return nil
+	}
+	return newTitleSearch(src.Search, src.Fuzzy, "title")
+}
+
 func CountLessonByEnrollee(
 	db Queryer,
 	enrolleeID string,
@@ -145,6 +198,57 @@ func CountLessonByLabel(
 	return n, err
 }
 
+// CountLessonByDependent counts the prerequisites of the dependent lesson.
+func CountLessonByDependent(
+	db Queryer,
+	dependentID string,
+	filters *LessonFilterOptions,
+) (int32, error) {
+	args := pgx.QueryArgs(make([]interface{}, 0, 4))
+	where := func(from string) string {
+		return from + `.dependent_id = ` + args.Append(dependentID)
+	}
+	from := "prerequisite_lesson"
+
+	sql := CountSQL(from, where, filters, &args)
+	psName := preparedName("countLessonByDependent", sql)
+
+	var n int32
+	err := prepareQueryRow(db, psName, sql, args...).Scan(&n)
+	if err != nil {
+		mylog.Log.WithError(err).Error(util.Trace(""))
+	} else {
+		mylog.Log.WithField("n", n).Info(util.Trace("lessons found"))
+	}
+	return n, err
+}
+
+// CountLessonByPrerequisite counts the lessons that depend on the
+// prerequisite.
+func CountLessonByPrerequisite(
+	db Queryer,
+	prerequisiteID string,
+	filters *LessonFilterOptions,
+) (int32, error) {
+	args := pgx.QueryArgs(make([]interface{}, 0, 4))
+	where := func(from string) string {
+		return from + `.prerequisite_id = ` + args.Append(prerequisiteID)
+	}
+	from := "dependent_lesson"
+
+	sql := CountSQL(from, where, filters, &args)
+	psName := preparedName("countLessonByPrerequisite", sql)
+
+	var n int32
+	err := prepareQueryRow(db, psName, sql, args...).Scan(&n)
+	if err != nil {
+		mylog.Log.WithError(err).Error(util.Trace(""))
+	} else {
+		mylog.Log.WithField("n", n).Info(util.Trace("lessons found"))
+	}
+	return n, err
+}
+
 func CountLessonBySearch(
 	db Queryer,
 	filters *LessonFilterOptions,
@@ -367,10 +471,14 @@ func getLesson(
 		&row.LastEditedAt,
 		&row.Number,
 		&row.PublishedAt,
+		&row.ReadingTimeMinutes,
+		&row.ScheduledPublishAt,
 		&row.StudyID,
 		&row.Title,
+		&row.TOC,
 		&row.UpdatedAt,
 		&row.UserID,
+		&row.WordCount,
 	)
 	if err == pgx.ErrNoRows {
 		mylog.Log.WithError(err).Debug(util.Trace(""))
@@ -409,10 +517,14 @@ func getManyLesson(
 			&row.LastEditedAt,
 			&row.Number,
 			&row.PublishedAt,
+			&row.ReadingTimeMinutes,
+			&row.ScheduledPublishAt,
 			&row.StudyID,
 			&row.Title,
+			&row.TOC,
 			&row.UpdatedAt,
 			&row.UserID,
+			&row.WordCount,
 		)
 		*rows = append(*rows, &row)
 	}
@@ -436,10 +548,14 @@ const getLessonByIDSQL = `
 		last_edited_at,
 		number,
 		published_at,
+		reading_time_minutes,
+		scheduled_publish_at,
 		study_id,
 		title,
+		toc,
 		updated_at,
-		user_id
+		user_id,
+		word_count
 	FROM lesson_search_index
 	WHERE id = $1
 `
@@ -468,10 +584,14 @@ const getLessonByOwnerStudyAndNumberSQL = `
 		l.last_edited_at,
 		l.number,
 		l.published_at,
+		l.reading_time_minutes,
+		l.scheduled_publish_at,
 		l.study_id,
 		l.title,
+		l.toc,
 		l.updated_at,
-		l.user_id
+		l.user_id,
+		l.word_count
 	FROM lesson_search_index l
 	JOIN account ON lower(account.login) = lower($1)
 	JOIN study ON lower(study.name) = lower($2)
@@ -541,10 +661,14 @@ func GetLessonByEnrollee(
 		"last_edited_at",
 		"number",
 		"published_at",
+		"reading_time_minutes",
+		"scheduled_publish_at",
 		"study_id",
 		"title",
+		"toc",
 		"updated_at",
 		"user_id",
+		"word_count",
 	}
 	from := "enrolled_lesson"
 	sql := SQL3(selects, from, where, filters, &args, po)
@@ -571,10 +695,14 @@ func GetLessonByEnrollee(
 			&row.LastEditedAt,
 			&row.Number,
 			&row.PublishedAt,
+			&row.ReadingTimeMinutes,
+			&row.ScheduledPublishAt,
 			&row.StudyID,
 			&row.Title,
+			&row.TOC,
 			&row.UpdatedAt,
 			&row.UserID,
+			&row.WordCount,
 		)
 		rows = append(rows, &row)
 	}
@@ -621,10 +749,14 @@ func GetLessonByLabel(
 		"last_edited_at",
 		"number",
 		"published_at",
+		"reading_time_minutes",
+		"scheduled_publish_at",
 		"study_id",
 		"title",
+		"toc",
 		"updated_at",
 		"user_id",
+		"word_count",
 	}
 	from := "labeled_lesson"
 	sql := SQL3(selects, from, where, filters, &args, po)
@@ -651,10 +783,188 @@ func GetLessonByLabel(
 			&row.LastEditedAt,
 			&row.Number,
 			&row.PublishedAt,
+			&row.ReadingTimeMinutes,
+			&row.ScheduledPublishAt,
+			&row.StudyID,
+			&row.Title,
+			&row.TOC,
+			&row.UpdatedAt,
+			&row.UserID,
+			&row.WordCount,
+		)
+		rows = append(rows, &row)
+	}
+
+	if err := dbRows.Err(); err != nil {
+		mylog.Log.WithError(err).Error(util.Trace(""))
+		return nil, err
+	}
+
+	mylog.Log.WithField("n", len(rows)).Info(util.Trace("lessons found"))
+	return rows, nil
+}
+
+// GetLessonByDependent returns the prerequisites of the dependent lesson.
+func GetLessonByDependent(
+	db Queryer,
+	dependentID string,
+	po *PageOptions,
+	filters *LessonFilterOptions,
+) ([]*Lesson, error) {
+	var rows []*Lesson
+	if po != nil && po.Limit() > 0 {
+		limit := po.Limit()
+		if limit > 0 {
+			rows = make([]*Lesson, 0, limit)
+		} else {
+			mylog.Log.Info(util.Trace("limit is 0"))
+			return rows, nil
+		}
+	}
+
+	args := pgx.QueryArgs(make([]interface{}, 0, 4))
+	where := func(from string) string {
+		return from + `.dependent_id = ` + args.Append(dependentID)
+	}
+
+	selects := []string{
+		"body",
+		"course_id",
+		"course_number",
+		"created_at",
+		"draft",
+		"id",
+		"last_edited_at",
+		"number",
+		"published_at",
+		"reading_time_minutes",
+		"scheduled_publish_at",
+		"study_id",
+		"title",
+		"toc",
+		"updated_at",
+		"user_id",
+		"word_count",
+	}
+	from := "prerequisite_lesson"
+	sql := SQL3(selects, from, where, filters, &args, po)
+
+	psName := preparedName("getLessonsByDependent", sql)
+
+	dbRows, err := prepareQuery(db, psName, sql, args...)
+	if err != nil {
+		mylog.Log.WithError(err).Error(util.Trace(""))
+		return nil, err
+	}
+	defer dbRows.Close()
+
+	for dbRows.Next() {
+		var row Lesson
+		dbRows.Scan(
+			&row.Body,
+			&row.CourseID,
+			&row.CourseNumber,
+			&row.CreatedAt,
+			&row.Draft,
+			&row.ID,
+			&row.LastEditedAt,
+			&row.Number,
+			&row.PublishedAt,
+			&row.ReadingTimeMinutes,
+			&row.ScheduledPublishAt,
+			&row.StudyID,
+			&row.Title,
+			&row.TOC,
+			&row.UpdatedAt,
+			&row.UserID,
+			&row.WordCount,
+		)
+		rows = append(rows, &row)
+	}
+
+	if err := dbRows.Err(); err != nil {
+		mylog.Log.WithError(err).Error(util.Trace(""))
+		return nil, err
+	}
+
+	mylog.Log.WithField("n", len(rows)).Info(util.Trace("lessons found"))
+	return rows, nil
+}
+
+// GetLessonByPrerequisite returns the lessons that depend on the prerequisite.
+func GetLessonByPrerequisite(
+	db Queryer,
+	prerequisiteID string,
+	po *PageOptions,
+	filters *LessonFilterOptions,
+) ([]*Lesson, error) {
+	var rows []*Lesson
+	if po != nil && po.Limit() > 0 {
+		limit := po.Limit()
+		if limit > 0 {
+			rows = make([]*Lesson, 0, limit)
+		} else {
+			mylog.Log.Info(util.Trace("limit is 0"))
+			return rows, nil
+		}
+	}
+
+	args := pgx.QueryArgs(make([]interface{}, 0, 4))
+	where := func(from string) string {
+		return from + `.prerequisite_id = ` + args.Append(prerequisiteID)
+	}
+
+	selects := []string{
+		"body",
+		"course_id",
+		"course_number",
+		"created_at",
+		"draft",
+		"id",
+		"last_edited_at",
+		"number",
+		"published_at",
+		"reading_time_minutes",
+		"scheduled_publish_at",
+		"study_id",
+		"title",
+		"toc",
+		"updated_at",
+		"user_id",
+		"word_count",
+	}
+	from := "dependent_lesson"
+	sql := SQL3(selects, from, where, filters, &args, po)
+
+	psName := preparedName("getLessonsByPrerequisite", sql)
+
+	dbRows, err := prepareQuery(db, psName, sql, args...)
+	if err != nil {
+		mylog.Log.WithError(err).Error(util.Trace(""))
+		return nil, err
+	}
+	defer dbRows.Close()
+
+	for dbRows.Next() {
+		var row Lesson
+		dbRows.Scan(
+			&row.Body,
+			&row.CourseID,
+			&row.CourseNumber,
+			&row.CreatedAt,
+			&row.Draft,
+			&row.ID,
+			&row.LastEditedAt,
+			&row.Number,
+			&row.PublishedAt,
+			&row.ReadingTimeMinutes,
+			&row.ScheduledPublishAt,
 			&row.StudyID,
 			&row.Title,
+			&row.TOC,
 			&row.UpdatedAt,
 			&row.UserID,
+			&row.WordCount,
 		)
 		rows = append(rows, &row)
 	}
@@ -700,10 +1010,14 @@ func GetLessonByUser(
 		"last_edited_at",
 		"number",
 		"published_at",
+		"reading_time_minutes",
+		"scheduled_publish_at",
 		"study_id",
 		"title",
+		"toc",
 		"updated_at",
 		"user_id",
+		"word_count",
 	}
 	from := "lesson_search_index"
 	sql := SQL3(selects, from, where, filters, &args, po)
@@ -751,10 +1065,14 @@ func GetLessonByCourse(
 		"last_edited_at",
 		"number",
 		"published_at",
+		"reading_time_minutes",
+		"scheduled_publish_at",
 		"study_id",
 		"title",
+		"toc",
 		"updated_at",
 		"user_id",
+		"word_count",
 	}
 	from := "lesson_search_index"
 	sql := SQL3(selects, from, where, filters, &args, po)
@@ -802,10 +1120,14 @@ func GetLessonByStudy(
 		"last_edited_at",
 		"number",
 		"published_at",
+		"reading_time_minutes",
+		"scheduled_publish_at",
 		"study_id",
 		"title",
+		"toc",
 		"updated_at",
 		"user_id",
+		"word_count",
 	}
 	from := "lesson_search_index"
 	sql := SQL3(selects, from, where, filters, &args, po)
@@ -832,10 +1154,14 @@ const getLessonByNumberSQL = `
 		last_edited_at,
 		number,
 		published_at,
+		reading_time_minutes,
+		scheduled_publish_at,
 		study_id,
 		title,
+		toc,
 		updated_at,
-		user_id
+		user_id,
+		word_count
 	FROM lesson_search_index
 	WHERE study_id = $1 AND number = $2
 `
@@ -877,10 +1203,14 @@ const getLessonByCourseNumberSQL = `
 		last_edited_at,
 		number,
 		published_at,
+		reading_time_minutes,
+		scheduled_publish_at,
 		study_id,
 		title,
+		toc,
 		updated_at,
-		user_id
+		user_id,
+		word_count
 	FROM lesson_search_index
 	WHERE course_id = $1 AND course_number = $2
 `
@@ -922,10 +1252,14 @@ const batchGetLessonByNumberSQL = `
 		last_edited_at,
 		number,
 		published_at,
+		reading_time_minutes,
+		scheduled_publish_at,
 		study_id,
 		title,
+		toc,
 		updated_at,
-		user_id
+		user_id,
+		word_count
 	FROM lesson_search_index
 	WHERE study_id = $1 AND number = ANY($2)
 `
@@ -1093,6 +1427,7 @@ func SearchLesson(
 	where := func(string) string { return "" }
 
 	selects := []string{
+		"best_match_rank",
 		"body",
 		"course_id",
 		"course_number",
@@ -1102,17 +1437,53 @@ func SearchLesson(
 		"last_edited_at",
 		"number",
 		"published_at",
+		"reading_time_minutes",
+		"scheduled_publish_at",
 		"study_id",
 		"title",
+		"toc",
 		"updated_at",
 		"user_id",
+		"word_count",
 	}
 	from := "lesson_search_index"
 	sql := SQL3(selects, from, where, filters, &args, po)
 
 	psName := preparedName("searchLessonIndex", sql)
 
-	if err := getManyLesson(db, psName, sql, &rows, args...); err != nil {
+	dbRows, err := prepareQuery(db, psName, sql, args...)
+	if err != nil {
+		mylog.Log.WithError(err).Error(util.Trace(""))
+		return nil, err
+	}
+	defer dbRows.Close()
+
+	for dbRows.Next() {
+		var row Lesson
+		dbRows.Scan(
+			&row.BestMatchRank,
+			&row.Body,
+			&row.CourseID,
+			&row.CourseNumber,
+			&row.CreatedAt,
+			&row.Draft,
+			&row.ID,
+			&row.LastEditedAt,
+			&row.Number,
+			&row.PublishedAt,
+			&row.ReadingTimeMinutes,
+			&row.ScheduledPublishAt,
+			&row.StudyID,
+			&row.Title,
+			&row.TOC,
+			&row.UpdatedAt,
+			&row.UserID,
+			&row.WordCount,
+		)
+		rows = append(rows, &row)
+	}
+
+	if err := dbRows.Err(); err != nil {
 		mylog.Log.WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
@@ -1139,11 +1510,19 @@ func UpdateLesson(
 		return nil, err
 	}
 
-	sets := make([]string, 0, 5)
-	args := pgx.QueryArgs(make([]interface{}, 0, 7))
+	sets := make([]string, 0, 9)
+	args := pgx.QueryArgs(make([]interface{}, 0, 11))
 
 	if row.Body.Status != pgtype.Undefined {
 		sets = append(sets, `body`+"="+args.Append(&row.Body))
+		readingTimeMinutes, toc, wordCount, err := lessonBodyStats(&row.Body)
+		if err != nil {
+			mylog.Log.WithError(err).Error(util.Trace(""))
+			return nil, err
+		}
+		sets = append(sets, `reading_time_minutes`+"="+args.Append(readingTimeMinutes))
+		sets = append(sets, `toc`+"="+args.Append(toc))
+		sets = append(sets, `word_count`+"="+args.Append(wordCount))
 	}
 	if row.Draft.Status != pgtype.Undefined {
 		sets = append(sets, `draft`+"="+args.Append(&row.Draft))
@@ -1151,6 +1530,9 @@ func UpdateLesson(
 	if row.PublishedAt.Status != pgtype.Undefined {
 		sets = append(sets, `published_at`+"="+args.Append(&row.PublishedAt))
 	}
+	if row.ScheduledPublishAt.Status != pgtype.Undefined {
+		sets = append(sets, `scheduled_publish_at`+"="+args.Append(&row.ScheduledPublishAt))
+	}
 	if row.Title.Status != pgtype.Undefined {
 		sets = append(sets, `title`+"="+args.Append(&row.Title))
 		titleTokens := &pgtype.Text{}
@@ -1239,3 +1621,123 @@ func UpdateLesson(
 	mylog.Log.WithField("id", row.ID.String).Info(util.Trace("lesson updated"))
 	return lesson, nil
 }
+
+const claimScheduledLessonSQL = `
+	SELECT
+		id,
+		user_id
+	FROM lesson
+	WHERE scheduled_publish_at <= statement_timestamp()
+		AND NOT (id = ANY($1::text[]))
+	ORDER BY scheduled_publish_at
+	LIMIT 1
+	FOR UPDATE SKIP LOCKED
+`
+
+// ClaimScheduledLesson returns the ID and owner of a lesson due to be
+// published, and locks it until the end of the transaction db. Lessons locked
+// by other transactions are skipped, so that concurrent callers never claim the
+// same lesson, as are lessons whose IDs are in skip. It returns ErrNotFound if
+// no lesson is due.
+func ClaimScheduledLesson(db Queryer, skip []string) (*Lesson, error) {
+	var row Lesson
+	err := prepareQueryRow(db, "claimScheduledLesson", claimScheduledLessonSQL, skip).Scan(
+		&row.ID,
+		&row.UserID,
+	)
+	if err == pgx.ErrNoRows {
+		return nil, ErrNotFound
+	} else if err != nil {
+		mylog.Log.WithError(err).Error(util.Trace(""))
+		return nil, err
+	}
+
+	mylog.Log.WithField("id", row.ID.String).Info(util.Trace("scheduled lesson claimed"))
+	return &row, nil
+}
+
+const getLessonBodiesSQL = `
+	SELECT
+		body,
+		id,
+		reading_time_minutes,
+		toc,
+		word_count
+	FROM lesson
+`
+
+// RefreshLessonBodyStats recomputes the reading time, table of contents and
+// word count of every lesson whose body was written before they were stored,
+// or whose stats are out of date, and returns the number of lessons updated.
+func RefreshLessonBodyStats(db Queryer) (int64, error) {
+	tx, err, newTx := BeginTransaction(db)
+	if err != nil {
+		mylog.Log.WithError(err).Error(util.Trace(""))
+		return 0, err
+	}
+	if newTx {
+		defer RollbackTransaction(tx)
+	}
+
+	dbRows, err := prepareQuery(tx, "getLessonBodies", getLessonBodiesSQL)
+	if err != nil {
+		mylog.Log.WithError(err).Error(util.Trace(""))
+		return 0, err
+	}
+	var stale []*Lesson
+	for dbRows.Next() {
+		var row Lesson
+		dbRows.Scan(
+			&row.Body,
+			&row.ID,
+			&row.ReadingTimeMinutes,
+			&row.TOC,
+			&row.WordCount,
+		)
+		var toc []util.MarkdownHeading
+		if err := row.TOC.AssignTo(&toc); err != nil {
+			toc = nil
+		}
+		if row.ReadingTimeMinutes.Int != row.Body.ReadingTimeMinutes() ||
+			row.WordCount.Int != row.Body.WordCount() ||
+			!reflect.DeepEqual(toc, row.Body.Headings()) {
+			stale = append(stale, &row)
+		}
+	}
+	dbRows.Close()
+	if err := dbRows.Err(); err != nil {
+		mylog.Log.WithError(err).Error(util.Trace(""))
+		return 0, err
+	}
+
+	sql := `
+		UPDATE lesson
+		SET reading_time_minutes = $1, toc = $2, word_count = $3
+		WHERE id = $4
+	`
+	psName := preparedName("refreshLessonBodyStats", sql)
+	for _, row := range stale {
+		readingTimeMinutes, toc, wordCount, err := lessonBodyStats(&row.Body)
+		if err != nil {
+			mylog.Log.WithError(err).Error(util.Trace(""))
+			return 0, err
+		}
+		_, err = prepareExec(tx, psName, sql, readingTimeMinutes, toc, wordCount, row.ID.String)
+		if err != nil {
+			mylog.Log.WithError(err).Error(util.Trace(""))
+			return 0, err
+		}
+	}
+
+	if newTx {
+		err = CommitTransaction(tx)
+		if err != nil {
+			mylog.Log.WithError(err).Error(util.Trace(""))
+			return 0, err
+		}
+	}
+
+	n := int64(len(stale))
+	mylog.Log.WithField("n", n).Info(util.Trace("lesson body stats refreshed"))
+	return n, nil
+}
@@ -0,0 +1,284 @@
+package data
+
+import (
+	"strings"
+
+	"github.com/jackc/pgx"
+	"github.com/jackc/pgx/pgtype"
+	"github.com/marksauter/markus-ninja-api/pkg/mylog"
+	"github.com/marksauter/markus-ninja-api/pkg/mytype"
+	"github.com/marksauter/markus-ninja-api/pkg/util"
+	"github.com/sirupsen/logrus"
+)
+
+// LessonPrerequisite is an edge of the lesson dependency graph, stating that
+// the prerequisite should be read before the lesson.
+type LessonPrerequisite struct {
+	CreatedAt      pgtype.Timestamptz `db:"created_at" permit:"read"`
+	LessonID       mytype.OID         `db:"lesson_id" permit:"read"`
+	PrerequisiteID mytype.OID         `db:"prerequisite_id" permit:"read"`
+}
+
+func getLessonPrerequisite(
+	db Queryer,
+	name string,
+	sql string,
+	args ...interface{},
+) (*LessonPrerequisite, error) {
+	var row LessonPrerequisite
+	err := prepareQueryRow(db, name, sql, args...).Scan(
+		&row.CreatedAt,
+		&row.LessonID,
+		&row.PrerequisiteID,
+	)
+	if err == pgx.ErrNoRows {
+		mylog.Log.WithError(err).Debug(util.Trace(""))
+		return nil, ErrNotFound
+	} else if err != nil {
+		mylog.Log.WithError(err).Debug(util.Trace(""))
+		return nil, err
+	}
+
+	return &row, nil
+}
+
+func getManyLessonPrerequisite(
+	db Queryer,
+	name string,
+	sql string,
+	rows *[]*LessonPrerequisite,
+	args ...interface{},
+) error {
+	dbRows, err := prepareQuery(db, name, sql, args...)
+	if err != nil {
+		mylog.Log.WithError(err).Debug(util.Trace(""))
+		return err
+	}
+	defer dbRows.Close()
+
+	for dbRows.Next() {
+		var row LessonPrerequisite
+		dbRows.Scan(
+			&row.CreatedAt,
+			&row.LessonID,
+			&row.PrerequisiteID,
+		)
+		*rows = append(*rows, &row)
+	}
+
+	if err := dbRows.Err(); err != nil {
+		mylog.Log.WithError(err).Debug(util.Trace(""))
+		return err
+	}
+
+	return nil
+}
+
+const getLessonPrerequisiteSQL = `
+	SELECT
+		created_at,
+		lesson_id,
+		prerequisite_id
+	FROM lesson_prerequisite
+	WHERE lesson_id = $1 AND prerequisite_id = $2
+`
+
+func GetLessonPrerequisite(
+	db Queryer,
+	lessonID,
+	prerequisiteID string,
+) (*LessonPrerequisite, error) {
+	lessonPrerequisite, err := getLessonPrerequisite(
+		db,
+		"getLessonPrerequisite",
+		getLessonPrerequisiteSQL,
+		lessonID,
+		prerequisiteID,
+	)
+	if err != nil {
+		mylog.Log.WithFields(logrus.Fields{
+			"lesson_id":       lessonID,
+			"prerequisite_id": prerequisiteID,
+		}).WithError(err).Error(util.Trace(""))
+	} else {
+		mylog.Log.WithFields(logrus.Fields{
+			"lesson_id":       lessonID,
+			"prerequisite_id": prerequisiteID,
+		}).Info(util.Trace("lesson prerequisite found"))
+	}
+	return lessonPrerequisite, err
+}
+
+const getLessonPrerequisiteAncestrySQL = `
+	WITH RECURSIVE ancestry AS (
+		SELECT
+			created_at,
+			lesson_id,
+			prerequisite_id
+		FROM lesson_prerequisite
+		WHERE lesson_id = $1
+		UNION
+		SELECT
+			lesson_prerequisite.created_at,
+			lesson_prerequisite.lesson_id,
+			lesson_prerequisite.prerequisite_id
+		FROM lesson_prerequisite
+		JOIN ancestry ON ancestry.prerequisite_id = lesson_prerequisite.lesson_id
+	)
+	SELECT
+		created_at,
+		lesson_id,
+		prerequisite_id
+	FROM ancestry
+`
+
+// GetLessonPrerequisiteAncestry returns the edges between the lesson and all
+// of its direct and indirect prerequisites.
+func GetLessonPrerequisiteAncestry(
+	db Queryer,
+	lessonID string,
+) ([]*LessonPrerequisite, error) {
+	var rows []*LessonPrerequisite
+	err := getManyLessonPrerequisite(
+		db,
+		"getLessonPrerequisiteAncestry",
+		getLessonPrerequisiteAncestrySQL,
+		&rows,
+		lessonID,
+	)
+	if err != nil {
+		mylog.Log.WithField("lesson_id", lessonID).WithError(err).Error(util.Trace(""))
+		return nil, err
+	}
+
+	mylog.Log.WithField("n", len(rows)).Info(util.Trace("lesson prerequisites found"))
+	return rows, nil
+}
+
+const getLessonPrerequisiteByStudySQL = `
+	SELECT
+		lesson_prerequisite.created_at,
+		lesson_prerequisite.lesson_id,
+		lesson_prerequisite.prerequisite_id
+	FROM lesson_prerequisite
+	JOIN lesson ON lesson.id = lesson_prerequisite.lesson_id
+	WHERE lesson.study_id = $1
+	UNION
+	SELECT
+		lesson_prerequisite.created_at,
+		lesson_prerequisite.lesson_id,
+		lesson_prerequisite.prerequisite_id
+	FROM lesson_prerequisite
+	JOIN lesson ON lesson.id = lesson_prerequisite.prerequisite_id
+	WHERE lesson.study_id = $1
+`
+
+// GetLessonPrerequisiteByStudy returns the edges with a lesson of the study at
+// either end, including those from or to lessons of other studies.
+func GetLessonPrerequisiteByStudy(
+	db Queryer,
+	studyID string,
+) ([]*LessonPrerequisite, error) {
+	var rows []*LessonPrerequisite
+	err := getManyLessonPrerequisite(
+		db,
+		"getLessonPrerequisiteByStudy",
+		getLessonPrerequisiteByStudySQL,
+		&rows,
+		studyID,
+	)
+	if err != nil {
+		mylog.Log.WithField("study_id", studyID).WithError(err).Error(util.Trace(""))
+		return nil, err
+	}
+
+	mylog.Log.WithField("n", len(rows)).Info(util.Trace("lesson prerequisites found"))
+	return rows, nil
+}
+
+// CreateLessonPrerequisite returns ErrLessonPrerequisiteCycle if the
+// prerequisite is the lesson itself, or already depends on the lesson.
+func CreateLessonPrerequisite(
+	db Queryer,
+	row LessonPrerequisite,
+) (*LessonPrerequisite, error) {
+	args := pgx.QueryArgs(make([]interface{}, 0, 2))
+
+	var columns, values []string
+
+	if row.LessonID.Status != pgtype.Undefined {
+		columns = append(columns, "lesson_id")
+		values = append(values, args.Append(&row.LessonID))
+	}
+	if row.PrerequisiteID.Status != pgtype.Undefined {
+		columns = append(columns, "prerequisite_id")
+		values = append(values, args.Append(&row.PrerequisiteID))
+	}
+
+	sql := `
+		INSERT INTO lesson_prerequisite(` + strings.Join(columns, ",") + `)
+		VALUES(` + strings.Join(values, ",") + `)
+	`
+
+	psName := preparedName("createLessonPrerequisite", sql)
+
+	_, err := prepareExec(db, psName, sql, args...)
+	if err != nil && err != pgx.ErrNoRows {
+		if pgErr, ok := err.(pgx.PgError); ok {
+			mylog.Log.WithError(pgErr).Error(util.Trace(""))
+			return nil, handlePSQLError(pgErr)
+		}
+		mylog.Log.WithError(err).Error(util.Trace(""))
+		return nil, err
+	}
+
+	lessonPrerequisite, err := GetLessonPrerequisite(
+		db,
+		row.LessonID.String,
+		row.PrerequisiteID.String,
+	)
+	if err != nil {
+		mylog.Log.WithError(err).Error(util.Trace(""))
+		return nil, err
+	}
+
+	mylog.Log.Info(util.Trace("lesson prerequisite created"))
+	return lessonPrerequisite, nil
+}
+
+const deleteLessonPrerequisiteSQL = `
+	DELETE FROM lesson_prerequisite
+	WHERE lesson_id = $1 AND prerequisite_id = $2
+`
+
+func DeleteLessonPrerequisite(
+	db Queryer,
+	lessonID,
+	prerequisiteID string,
+) error {
+	commandTag, err := prepareExec(
+		db,
+		"deleteLessonPrerequisite",
+		deleteLessonPrerequisiteSQL,
+		lessonID,
+		prerequisiteID,
+	)
+	if err != nil {
+		mylog.Log.WithError(err).Error(util.Trace(""))
+		return err
+	}
+	if commandTag.RowsAffected() != 1 {
+		err := ErrNotFound
+		mylog.Log.WithFields(logrus.Fields{
+			"lesson_id":       lessonID,
+			"prerequisite_id": prerequisiteID,
+		}).WithError(err).Error(util.Trace(""))
+		return err
+	}
+
+	mylog.Log.WithFields(logrus.Fields{
+		"lesson_id":       lessonID,
+		"prerequisite_id": prerequisiteID,
+	}).Info(util.Trace("lesson prerequisite deleted"))
+	return nil
+}
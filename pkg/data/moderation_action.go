@@ -0,0 +1,242 @@
+package data
+
+import (
+	"strings"
+
+	"github.com/jackc/pgx"
+	"github.com/jackc/pgx/pgtype"
+	"github.com/marksauter/markus-ninja-api/pkg/mylog"
+	"github.com/marksauter/markus-ninja-api/pkg/mytype"
+	"github.com/marksauter/markus-ninja-api/pkg/util"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	ModerationActionDelete  = "DELETE"
+	ModerationActionDismiss = "DISMISS"
+	ModerationActionHide    = "HIDE"
+	ModerationActionRestore = "RESTORE"
+	ModerationActionSuspend = "SUSPEND"
+	ModerationActionWarn    = "WARN"
+)
+
+// ModerationAction is the audit record of an action an admin took on a
+// subject, and on the user responsible for it.
+type ModerationAction struct {
+	Action    pgtype.Varchar     `db:"action"`
+	ActorID   mytype.OID         `db:"actor_id"`
+	CreatedAt pgtype.Timestamptz `db:"created_at"`
+	ID        mytype.OID         `db:"id"`
+	Note      pgtype.Text        `db:"note"`
+	SubjectID mytype.OID         `db:"subject_id"`
+	UserID    mytype.OID         `db:"user_id"`
+}
+
+type ModerationActionFilterOptions struct {
+	SubjectID *string
+	UserID    *string
+}
+
+func (src *ModerationActionFilterOptions) SQL(from string, args *pgx.QueryArgs) *SQLParts {
+	if src == nil {
+		return nil
+	}
+
+	whereParts := make([]string, 0, 2)
+	if src.SubjectID != nil {
+		whereParts = append(whereParts, from+".subject_id = "+args.Append(*src.SubjectID))
+	}
+	if src.UserID != nil {
+		whereParts = append(whereParts, from+".user_id = "+args.Append(*src.UserID))
+	}
+
+	where := ""
+	if len(whereParts) > 0 {
+		where = "(" + strings.Join(whereParts, " AND ") + ")"
+	}
+
+	return &SQLParts{
+		Where: where,
+	}
+}
+
+func CountModerationAction(
+	db Queryer,
+	filters *ModerationActionFilterOptions,
+) (int32, error) {
+	args := pgx.QueryArgs(make([]interface{}, 0, 2))
+	where := func(from string) string {
+		return "TRUE"
+	}
+	from := "moderation_action"
+
+	sql := CountSQL(from, where, filters, &args)
+	psName := preparedName("countModerationAction", sql)
+
+	var n int32
+	err := prepareQueryRow(db, psName, sql, args...).Scan(&n)
+	if err != nil {
+		mylog.Log.WithError(err).Error(util.Trace(""))
+	} else {
+		mylog.Log.WithField("n", n).Info(util.Trace("moderation actions found"))
+	}
+	return n, err
+}
+
+func getModerationAction(
+	db Queryer,
+	name string,
+	sql string,
+	args ...interface{},
+) (*ModerationAction, error) {
+	var row ModerationAction
+	err := prepareQueryRow(db, name, sql, args...).Scan(
+		&row.Action,
+		&row.ActorID,
+		&row.CreatedAt,
+		&row.ID,
+		&row.Note,
+		&row.SubjectID,
+		&row.UserID,
+	)
+	if err == pgx.ErrNoRows {
+		mylog.Log.WithError(err).Debug(util.Trace(""))
+		return nil, ErrNotFound
+	} else if err != nil {
+		mylog.Log.WithError(err).Error(util.Trace(""))
+		return nil, err
+	}
+
+	return &row, nil
+}
+
+func getManyModerationAction(
+	db Queryer,
+	name string,
+	sql string,
+	rows *[]*ModerationAction,
+	args ...interface{},
+) error {
+	dbRows, err := prepareQuery(db, name, sql, args...)
+	if err != nil {
+		mylog.Log.WithError(err).Error(util.Trace(""))
+		return err
+	}
+	defer dbRows.Close()
+
+	for dbRows.Next() {
+		var row ModerationAction
+		dbRows.Scan(
+			&row.Action,
+			&row.ActorID,
+			&row.CreatedAt,
+			&row.ID,
+			&row.Note,
+			&row.SubjectID,
+			&row.UserID,
+		)
+		*rows = append(*rows, &row)
+	}
+
+	if err := dbRows.Err(); err != nil {
+		mylog.Log.WithError(err).Error(util.Trace(""))
+		return err
+	}
+
+	return nil
+}
+
+func GetModerationAction(
+	db Queryer,
+	po *PageOptions,
+	filters *ModerationActionFilterOptions,
+) ([]*ModerationAction, error) {
+	var rows []*ModerationAction
+	if po != nil && po.Limit() > 0 {
+		limit := po.Limit()
+		if limit > 0 {
+			rows = make([]*ModerationAction, 0, limit)
+		} else {
+			mylog.Log.Info(util.Trace("limit is 0"))
+			return rows, nil
+		}
+	}
+
+	args := pgx.QueryArgs(make([]interface{}, 0, 4))
+	where := func(from string) string {
+		return "TRUE"
+	}
+
+	selects := []string{
+		"action",
+		"actor_id",
+		"created_at",
+		"id",
+		"note",
+		"subject_id",
+		"user_id",
+	}
+	from := "moderation_action"
+	sql := SQL3(selects, from, where, filters, &args, po)
+
+	psName := preparedName("getModerationAction", sql)
+
+	if err := getManyModerationAction(db, psName, sql, &rows, args...); err != nil {
+		mylog.Log.WithError(err).Error(util.Trace(""))
+		return nil, err
+	}
+
+	mylog.Log.WithField("n", len(rows)).Info(util.Trace("moderation actions found"))
+	return rows, nil
+}
+
+const createModerationActionSQL = `
+	INSERT INTO moderation_action(action, actor_id, id, note, subject_id, user_id)
+	VALUES ($1, $2, $3, $4, $5, $6)
+	RETURNING
+		action,
+		actor_id,
+		created_at,
+		id,
+		note,
+		subject_id,
+		user_id
+`
+
+func CreateModerationAction(
+	db Queryer,
+	row *ModerationAction,
+) (*ModerationAction, error) {
+	id, err := mytype.NewOID("ModerationAction")
+	if err != nil {
+		mylog.Log.WithError(err).Error(util.Trace(""))
+		return nil, err
+	}
+
+	moderationAction, err := getModerationAction(
+		db,
+		"createModerationAction",
+		createModerationActionSQL,
+		&row.Action,
+		&row.ActorID,
+		id,
+		&row.Note,
+		&row.SubjectID,
+		&row.UserID,
+	)
+	if err != nil {
+		if pgErr, ok := err.(pgx.PgError); ok {
+			mylog.Log.WithError(pgErr).Error(util.Trace(""))
+			return nil, handlePSQLError(pgErr)
+		}
+		mylog.Log.WithError(err).Error(util.Trace(""))
+		return nil, err
+	}
+
+	mylog.Log.WithFields(logrus.Fields{
+		"action":     row.Action.String,
+		"actor_id":   row.ActorID.String,
+		"subject_id": row.SubjectID.String,
+	}).Info(util.Trace("moderation action created"))
+	return moderationAction, nil
+}
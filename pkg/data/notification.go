@@ -445,6 +445,14 @@ func CreateNotificationsFromEvent(
 				return err
 			}
 		case LessonMentioned:
+			blocked, err := mentionerIsBlocked(tx, &payload.MentionerID, &event.UserID)
+			if err != nil {
+				mylog.Log.WithError(err).Error(util.Trace(""))
+				return err
+			} else if blocked {
+				mylog.Log.Debug("will not notify users of mentions by users they blocked")
+				return nil
+			}
 			if err := row.ReasonName.Set(MentionReason); err != nil {
 				mylog.Log.WithError(err).Error(util.Trace(""))
 				return err
@@ -454,7 +462,7 @@ func CreateNotificationsFromEvent(
 				return err
 			}
 
-			_, err := CreateNotification(tx, row)
+			_, err = CreateNotification(tx, row)
 			mylog.Log.WithError(err).Error(util.Trace(""))
 			return err
 		default:
@@ -477,6 +485,14 @@ func CreateNotificationsFromEvent(
 
 		switch payload.Action {
 		case UserAssetMentioned:
+			blocked, err := mentionerIsBlocked(tx, &payload.MentionerID, &event.UserID)
+			if err != nil {
+				mylog.Log.WithError(err).Error(util.Trace(""))
+				return err
+			} else if blocked {
+				mylog.Log.Debug("will not notify users of mentions by users they blocked")
+				return nil
+			}
 			if err := row.ReasonName.Set(MentionReason); err != nil {
 				mylog.Log.WithError(err).Error(util.Trace(""))
 				return err
@@ -486,7 +502,7 @@ func CreateNotificationsFromEvent(
 				return err
 			}
 
-			_, err := CreateNotification(tx, row)
+			_, err = CreateNotification(tx, row)
 			mylog.Log.WithError(err).Error(util.Trace(""))
 			return err
 		default:
@@ -504,9 +520,21 @@ func CreateNotificationsFromEvent(
 		return nil
 	}
 
+	// Users who blocked the user that caused the event are not notified.
+	blockerIDs, err := GetUserBlockerIDs(tx, event.UserID.String)
+	if err != nil {
+		mylog.Log.WithError(err).Error(util.Trace(""))
+		return err
+	}
+	blockers := make(map[string]bool, len(blockerIDs))
+	for _, id := range blockerIDs {
+		blockers[id] = true
+	}
+
 	notifiedEnrolleds := make([]*Enrolled, 0, len(enrolleds))
 	for _, enrolled := range enrolleds {
-		if event.UserID.String != enrolled.UserID.String {
+		if event.UserID.String != enrolled.UserID.String &&
+			!blockers[enrolled.UserID.String] {
 			notifiedEnrolleds = append(notifiedEnrolleds, enrolled)
 		}
 	}
@@ -527,6 +555,20 @@ func CreateNotificationsFromEvent(
 	return nil
 }
 
+// mentionerIsBlocked returns whether the mentioned user has blocked the user
+// that mentioned them. Mentions recorded before mentioners were tracked are
+// never blocked.
+func mentionerIsBlocked(
+	db Queryer,
+	mentionerID,
+	mentionedID *mytype.OID,
+) (bool, error) {
+	if mentionerID.Status != pgtype.Present {
+		return false, nil
+	}
+	return ExistsUserBlock(db, mentionedID.String, mentionerID.String)
+}
+
 const deleteNotificationSQl = `
 	DELETE FROM notification
 	WHERE id = $1
@@ -149,3 +149,34 @@ func GrantUserRoles(
 	}).Info(util.Trace("roles granted"))
 	return nil
 }
+
+const revokeUserRolesSQL = `
+	DELETE FROM user_role
+	WHERE user_id = $1 AND role = ANY($2)
+`
+
+func RevokeUserRoles(
+	db Queryer,
+	userID string,
+	roles ...string,
+) error {
+	if len(roles) > 0 {
+		_, err := prepareExec(
+			db,
+			"revokeUserRoles",
+			revokeUserRolesSQL,
+			userID,
+			roles,
+		)
+		if err != nil {
+			mylog.Log.WithError(err).Error(util.Trace(""))
+			return err
+		}
+	}
+
+	mylog.Log.WithFields(logrus.Fields{
+		"user_id": userID,
+		"roles":   roles,
+	}).Info(util.Trace("roles revoked"))
+	return nil
+}
@@ -0,0 +1,32 @@
+package data
+
+import (
+	"github.com/jackc/pgx/pgtype"
+	"github.com/marksauter/markus-ninja-api/pkg/mylog"
+	"github.com/marksauter/markus-ninja-api/pkg/util"
+)
+
+// SchemaVersion is the version of the database schema expected by this build.
+// Whenever the schema changes, bump it and insert the new version into the
+// schema_version table at the end of data/init_database.sql.
+const SchemaVersion = 17
+
+const getSchemaVersionSQL = `
+	SELECT max(version)
+	FROM schema_version
+`
+
+func GetSchemaVersion(db Queryer) (int32, error) {
+	var version pgtype.Int4
+	err := prepareQueryRow(
+		db,
+		"getSchemaVersion",
+		getSchemaVersionSQL,
+	).Scan(&version)
+	if err != nil {
+		mylog.Log.WithError(err).Error(util.Trace(""))
+		return 0, err
+	}
+
+	return version.Int, nil
+}
@@ -0,0 +1,241 @@
+package data
+
+import (
+	"strings"
+
+	"github.com/jackc/pgx"
+	"github.com/marksauter/markus-ninja-api/pkg/mylog"
+	"github.com/marksauter/markus-ninja-api/pkg/util"
+)
+
+// SearchLanguages are the text search configurations a study may stem the
+// descriptions and bodies of its content with.
+var SearchLanguages = []string{
+	"danish",
+	"dutch",
+	"english",
+	"finnish",
+	"french",
+	"german",
+	"hungarian",
+	"italian",
+	"norwegian",
+	"portuguese",
+	"romanian",
+	"russian",
+	"simple",
+	"spanish",
+	"swedish",
+	"turkish",
+}
+
+func IsSearchLanguage(language string) bool {
+	for _, l := range SearchLanguages {
+		if l == language {
+			return true
+		}
+	}
+	return false
+}
+
+// titleSearch is a fuzzy search of the titles of rows, matching those with a
+// word similar to the search.
+type titleSearch struct {
+	search string
+	titles []string
+}
+
+// titleSearcher is implemented by the filter options that may match rows by the
+// similarity of their titles to the search, as well as by their document.
+type titleSearcher interface {
+	titleSearch() *titleSearch
+}
+
+func filtersSearchTitles(filters FilterOptions) *titleSearch {
+	if s, ok := filters.(titleSearcher); ok {
+		return s.titleSearch()
+	}
+	return nil
+}
+
+func newTitleSearch(search *string, fuzzy bool, titles ...string) *titleSearch {
+	if search == nil || !fuzzy {
+		return nil
+	}
+	// Titles are only compared to the words and phrases the rows must match.
+	tokens, _ := tokenizeSearchQuery(*search)
+	words := make([]string, 0, len(tokens))
+	for _, t := range tokens {
+		if !t.negated && t.text != "*" {
+			words = append(words, t.text)
+		}
+	}
+	s := strings.TrimSpace(strings.Join(words, " "))
+	if s == "" {
+		return nil
+	}
+	return &titleSearch{search: s, titles: titles}
+}
+
+// whereSQL returns the condition matching the rows of from with a title
+// similar to the search.
+func (s *titleSearch) whereSQL(from string, args *pgx.QueryArgs) string {
+	search := args.Append(s.search)
+	where := make([]string, len(s.titles))
+	for i, t := range s.titles {
+		where[i] = search + " <% " + from + "." + t
+	}
+	return strings.Join(where, " OR ")
+}
+
+// rankSQL returns the similarity of the closest title of the rows of from to
+// the search, in the range [0, 1].
+func (s *titleSearch) rankSQL(from string, args *pgx.QueryArgs) string {
+	search := args.Append(s.search)
+	ranks := make([]string, len(s.titles))
+	for i, t := range s.titles {
+		ranks[i] = "coalesce(word_similarity(" + search + ", " + from + "." + t + "), 0)"
+	}
+	return "greatest(" + strings.Join(ranks, ", ") + ")"
+}
+
+// documentSearchSQL returns the from and where parts of filter options matching
+// the document of the rows of from against search. Documents are searched with
+// the 'simple' configuration, in which titles are indexed, and also with
+// language, in which descriptions and bodies may be stemmed. Rows with a title
+// similar to the search also match if titles is not nil.
+func documentSearchSQL(
+	from,
+	search string,
+	language *string,
+	titles *titleSearch,
+	args *pgx.QueryArgs,
+) (string, string) {
+	query := ToPrefixTsQuery(search)
+	documentQuery := "to_tsquery('simple'," + args.Append(query) + ")"
+	if language != nil && *language != "simple" && IsSearchLanguage(*language) {
+		documentQuery = "(" + documentQuery + " || to_tsquery(" +
+			args.Append(*language) + "::regconfig," + args.Append(query) + "))"
+	}
+	match := from + ".document @@ document_query"
+	if titles != nil {
+		match = "(" + match + " OR " + titles.whereSQL(from, args) + ")"
+	}
+	return documentQuery + " AS document_query",
+		"CASE " + args.Append(query) + " WHEN '*' THEN TRUE ELSE " + match + " END"
+}
+
+// searchTitleColumns are the columns of the search indexes holding the titles
+// of their rows.
+var searchTitleColumns = map[string]string{
+	"activity_search_index":      "name",
+	"course_search_index":        "name",
+	"label_search_index":         "name",
+	"lesson_search_index":        "title",
+	"study_content_search_index": "title",
+	"study_search_index":         "name",
+	"topic_search_index":         "name",
+	"user_asset_search_index":    "name",
+	"user_search_index":          "login",
+}
+
+const notInPrivateStudyWhere = "study_id NOT IN (SELECT id FROM study WHERE private)"
+
+// notHiddenWhere returns the condition excluding the rows of from that admins
+// have hidden.
+func notHiddenWhere(from string) string {
+	return "NOT EXISTS (SELECT 1 FROM hidden_content WHERE hidden_content.subject_id = " + from + ".id)"
+}
+
+// searchVisibleWhere limits the titles suggested from each search index to those
+// anyone may read, i.e. neither in private studies nor hidden by admins.
+var searchVisibleWhere = map[string]string{
+	"activity_search_index":   notInPrivateStudyWhere + " AND " + notHiddenWhere("activity_search_index"),
+	"course_search_index":     notInPrivateStudyWhere + " AND published_at IS NOT NULL AND " + notHiddenWhere("course_search_index"),
+	"label_search_index":      notInPrivateStudyWhere + " AND " + notHiddenWhere("label_search_index"),
+	"lesson_search_index":     notInPrivateStudyWhere + " AND published_at IS NOT NULL AND " + notHiddenWhere("lesson_search_index"),
+	"study_search_index":      "private IS NOT TRUE AND " + notHiddenWhere("study_search_index"),
+	"topic_search_index":      notHiddenWhere("topic_search_index"),
+	"user_asset_search_index": notInPrivateStudyWhere + " AND " + notHiddenWhere("user_asset_search_index"),
+	"user_search_index":       notHiddenWhere("user_search_index"),
+}
+
+// GetSearchSuggestions returns up to n titles in the search index from with a
+// word similar to search, most similar first, to suggest in place of a search
+// that matched few rows.
+func GetSearchSuggestions(
+	db Queryer,
+	from,
+	search string,
+	n int32,
+) ([]string, error) {
+	where := func(*pgx.QueryArgs) string { return searchVisibleWhere[from] }
+	return getSearchSuggestions(db, from, search, where, n)
+}
+
+// GetStudySearchSuggestions returns up to n titles of the content of the study
+// with a word similar to search, most similar first.
+func GetStudySearchSuggestions(
+	db Queryer,
+	studyID,
+	search string,
+	n int32,
+) ([]string, error) {
+	where := func(args *pgx.QueryArgs) string {
+		return "study_id = " + args.Append(studyID) + " AND " + notHiddenWhere("study_content_search_index")
+	}
+	return getSearchSuggestions(db, "study_content_search_index", search, where, n)
+}
+
+func getSearchSuggestions(
+	db Queryer,
+	from,
+	search string,
+	where func(*pgx.QueryArgs) string,
+	n int32,
+) ([]string, error) {
+	column, ok := searchTitleColumns[from]
+	search = strings.TrimSpace(search)
+	if !ok || search == "" || search == "*" {
+		return []string{}, nil
+	}
+
+	var args pgx.QueryArgs
+	searchArg := args.Append(search)
+	whereSQL := []string{searchArg + " <% " + column, "lower(" + column + ") != lower(" + searchArg + ")"}
+	if where := where(&args); where != "" {
+		whereSQL = append(whereSQL, where)
+	}
+
+	sql := `
+		SELECT ` + column + `
+		FROM ` + from + `
+		WHERE ` + strings.Join(whereSQL, " AND ") + `
+		GROUP BY ` + column + `
+		ORDER BY word_similarity(` + searchArg + `, ` + column + `) DESC, ` + column + `
+		LIMIT ` + args.Append(n)
+
+	psName := preparedName("getSearchSuggestions", sql)
+
+	dbRows, err := prepareQuery(db, psName, sql, args...)
+	if err != nil {
+		mylog.Log.WithError(err).Error(util.Trace(""))
+		return nil, err
+	}
+	defer dbRows.Close()
+
+	suggestions := make([]string, 0, n)
+	for dbRows.Next() {
+		var suggestion string
+		dbRows.Scan(&suggestion)
+		suggestions = append(suggestions, suggestion)
+	}
+
+	if err := dbRows.Err(); err != nil {
+		mylog.Log.WithError(err).Error(util.Trace(""))
+		return nil, err
+	}
+
+	mylog.Log.WithField("n", len(suggestions)).Info(util.Trace("search suggestions found"))
+	return suggestions, nil
+}
@@ -0,0 +1,63 @@
+package data
+
+import (
+	"fmt"
+
+	"github.com/jackc/pgx/pgtype"
+	"github.com/marksauter/markus-ninja-api/pkg/mylog"
+	"github.com/marksauter/markus-ninja-api/pkg/util"
+	"github.com/sirupsen/logrus"
+)
+
+// SearchIndexes lists the *_search_index tables, by the name of the model
+// they index.
+var SearchIndexes = []string{
+	"activity",
+	"comment",
+	"course",
+	"lesson",
+	"study",
+	"topic",
+	"user",
+	"user_asset",
+}
+
+// ReindexSearchIndex recomputes the documents and derived columns of every row
+// in the search index of model, and returns the number of rows reindexed.
+func ReindexSearchIndex(db Queryer, model string) (int64, error) {
+	valid := false
+	for _, m := range SearchIndexes {
+		if m == model {
+			valid = true
+			break
+		}
+	}
+	if !valid {
+		err := fmt.Errorf("invalid search index: %q", model)
+		mylog.Log.WithError(err).Error(util.Trace(""))
+		return 0, err
+	}
+
+	// The stats of lesson bodies are computed here rather than in SQL, so they
+	// are brought up to date before the index copies them.
+	if model == "lesson" {
+		if _, err := RefreshLessonBodyStats(db); err != nil {
+			mylog.Log.WithError(err).Error(util.Trace(""))
+			return 0, err
+		}
+	}
+
+	var n pgtype.Int8
+	sql := `SELECT reindex_` + model + `_search_index()`
+	psName := preparedName("reindexSearchIndex", sql)
+	if err := prepareQueryRow(db, psName, sql).Scan(&n); err != nil {
+		mylog.Log.WithError(err).Error(util.Trace(""))
+		return 0, err
+	}
+
+	mylog.Log.WithFields(logrus.Fields{
+		"index": model,
+		"n":     n.Int,
+	}).Info(util.Trace("search index rebuilt"))
+	return n.Int, nil
+}
@@ -0,0 +1,528 @@
+package data
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/jackc/pgx"
+)
+
+// SearchQueryError is returned for search queries with qualifiers that are
+// invalid, or do not apply to the type of results searched.
+type SearchQueryError struct {
+	Qualifier string
+	Message   string
+}
+
+func (e SearchQueryError) Error() string {
+	if e.Qualifier == "" {
+		return "invalid search query: " + e.Message
+	}
+	return fmt.Sprintf("invalid search qualifier %q: %s", e.Qualifier, e.Message)
+}
+
+// searchToken is a word, a quoted phrase, or a qualifier of a search query.
+type searchToken struct {
+	negated bool
+	// quoted is true if the token started with a quote, i.e. is a phrase.
+	quoted bool
+	text   string
+}
+
+// tokenizeSearchQuery splits query into tokens at whitespace outside of quotes.
+// A leading '-' negates a token. unterminated is true if the last quote was not
+// closed, in which case the quote runs to the end of query.
+func tokenizeSearchQuery(query string) (tokens []searchToken, unterminated bool) {
+	var token *searchToken
+	var text strings.Builder
+	inQuote := false
+	for _, r := range query {
+		switch {
+		case token == nil && unicode.IsSpace(r):
+			continue
+		case token == nil:
+			token = &searchToken{}
+			if r == '-' {
+				token.negated = true
+				continue
+			}
+		}
+		switch {
+		case r == '"':
+			if text.Len() == 0 && !inQuote {
+				token.quoted = true
+			}
+			inQuote = !inQuote
+		case unicode.IsSpace(r) && !inQuote:
+			token.text = text.String()
+			tokens = append(tokens, *token)
+			token = nil
+			text.Reset()
+		default:
+			text.WriteRune(r)
+		}
+	}
+	if token != nil {
+		token.text = text.String()
+		tokens = append(tokens, *token)
+	}
+	return tokens, inQuote
+}
+
+// Comparison compares a column to a value with one of the operators <, <=, =,
+// >= and >.
+type Comparison struct {
+	Operator string
+	Value    interface{}
+}
+
+func comparisonsSQL(column string, comparisons []Comparison, args *pgx.QueryArgs) []string {
+	where := make([]string, len(comparisons))
+	for i, c := range comparisons {
+		where[i] = column + " " + c.Operator + " " + args.Append(c.Value)
+	}
+	return where
+}
+
+// SearchQualifier limits the results of a search, e.g. `user:marksauter`.
+type SearchQualifier struct {
+	Name    string
+	Negated bool
+	Value   string
+
+	comparisons []Comparison
+}
+
+func (q *SearchQualifier) String() string {
+	value := q.Value
+	if strings.IndexFunc(value, unicode.IsSpace) != -1 {
+		value = `"` + value + `"`
+	}
+	s := q.Name + ":" + value
+	if q.Negated {
+		s = "-" + s
+	}
+	return s
+}
+
+func (q *SearchQualifier) error(message string) error {
+	return SearchQueryError{Qualifier: q.String(), Message: message}
+}
+
+func (q *SearchQualifier) unsupported(results string) error {
+	return q.error("not supported when searching " + results)
+}
+
+func (q *SearchQualifier) bool() *bool {
+	b := !q.Negated
+	return &b
+}
+
+func (q *SearchQualifier) values(values *[]string) *[]string {
+	if values == nil {
+		return &[]string{q.Value}
+	}
+	vs := append(*values, q.Value)
+	return &vs
+}
+
+// Names of the qualifiers of search queries.
+const (
+	applesQualifier  = "apples"
+	createdQualifier = "created"
+	isQualifier      = "is"
+	labelQualifier   = "label"
+	studyQualifier   = "study"
+	topicQualifier   = "topic"
+	typeQualifier    = "type"
+	userQualifier    = "user"
+)
+
+var searchQualifierPattern = regexp.MustCompile(`^([a-z]+):(.+)$`)
+
+// SearchQuery is a search query parsed into the text matched against the
+// documents of results, and the qualifiers limiting them.
+type SearchQuery struct {
+	// Terms are the words and phrases results must match.
+	Terms []string
+	// ExcludedTerms are the negated words and phrases results must not match.
+	ExcludedTerms []string
+	Qualifiers    []*SearchQualifier
+	// Text is the query without its qualifiers.
+	Text string
+}
+
+// ParseSearchQuery parses the GitHub style qualifiers out of query, e.g.
+// `user:marksauter`, `label:"needs review"`, `created:>2018-01-01` or
+// `apples:10..20`. Words and quoted phrases may be negated with a leading '-'.
+func ParseSearchQuery(query string) (*SearchQuery, error) {
+	tokens, unterminated := tokenizeSearchQuery(query)
+	if unterminated {
+		return nil, SearchQueryError{Message: "unterminated quote"}
+	}
+
+	q := &SearchQuery{
+		ExcludedTerms: []string{},
+		Qualifiers:    []*SearchQualifier{},
+		Terms:         []string{},
+	}
+	text := make([]string, 0, len(tokens))
+	for _, t := range tokens {
+		if m := searchQualifierPattern.FindStringSubmatch(t.text); m != nil && !t.quoted {
+			qualifier := &SearchQualifier{Name: m[1], Negated: t.negated, Value: m[2]}
+			known, err := qualifier.parse()
+			if err != nil {
+				return nil, err
+			} else if known {
+				q.Qualifiers = append(q.Qualifiers, qualifier)
+				continue
+			}
+		}
+		if strings.TrimSpace(t.text) == "" {
+			continue
+		}
+		term := t.text
+		if t.quoted {
+			term = `"` + term + `"`
+		}
+		if t.negated {
+			q.ExcludedTerms = append(q.ExcludedTerms, t.text)
+			term = "-" + term
+		} else {
+			q.Terms = append(q.Terms, t.text)
+		}
+		text = append(text, term)
+	}
+	q.Text = strings.Join(text, " ")
+	return q, nil
+}
+
+// parse validates the value of the qualifier. known is false if the qualifier
+// is not one of the known qualifiers, in which case it is searched as a word.
+func (q *SearchQualifier) parse() (known bool, err error) {
+	switch q.Name {
+	case applesQualifier:
+		q.comparisons, err = parseIntComparisons(q.Value)
+		if err != nil {
+			return true, q.error("expected a number, e.g. >10 or 10..20")
+		}
+	case createdQualifier:
+		q.comparisons, err = parseDateComparisons(q.Value)
+		if err != nil {
+			return true, q.error("expected a date, e.g. >2018-01-01 or 2018-01-01..2018-02-01")
+		}
+	case isQualifier:
+		switch q.Value {
+		case "activity-asset", "course-lesson", "default", "published":
+		default:
+			return true, q.error("expected one of activity-asset, course-lesson, default, or published")
+		}
+		return true, nil
+	case labelQualifier, topicQualifier, typeQualifier, userQualifier:
+	case studyQualifier:
+		if i := strings.Index(q.Value, "/"); i <= 0 || i == len(q.Value)-1 {
+			return true, q.error("expected the name with owner of a study, e.g. owner/name")
+		}
+	default:
+		return false, nil
+	}
+	if q.Negated {
+		return true, q.error("only is qualifiers may be negated")
+	}
+	return true, nil
+}
+
+var comparisonOperators = []string{">=", "<=", ">", "<"}
+
+// parseComparisons parses a comparison to a value, e.g. >10, or a range of
+// values, e.g. 10..20, in which either bound may be *. The values are parsed by
+// parse into the first value the compared column may equal, and the first it
+// may not following it.
+func parseComparisons(
+	s string,
+	parse func(string) (from, to interface{}, err error),
+) ([]Comparison, error) {
+	if i := strings.Index(s, ".."); i != -1 {
+		comparisons := make([]Comparison, 0, 2)
+		if min := s[:i]; min != "*" {
+			from, _, err := parse(min)
+			if err != nil {
+				return nil, err
+			}
+			comparisons = append(comparisons, Comparison{Operator: ">=", Value: from})
+		}
+		if max := s[i+2:]; max != "*" {
+			_, to, err := parse(max)
+			if err != nil {
+				return nil, err
+			}
+			comparisons = append(comparisons, Comparison{Operator: "<", Value: to})
+		}
+		return comparisons, nil
+	}
+
+	operator := "="
+	for _, op := range comparisonOperators {
+		if strings.HasPrefix(s, op) {
+			operator = op
+			s = s[len(op):]
+			break
+		}
+	}
+	from, to, err := parse(s)
+	if err != nil {
+		return nil, err
+	}
+	switch operator {
+	case ">":
+		return []Comparison{{Operator: ">=", Value: to}}, nil
+	case ">=":
+		return []Comparison{{Operator: ">=", Value: from}}, nil
+	case "<":
+		return []Comparison{{Operator: "<", Value: from}}, nil
+	case "<=":
+		return []Comparison{{Operator: "<", Value: to}}, nil
+	default:
+		return []Comparison{
+			{Operator: ">=", Value: from},
+			{Operator: "<", Value: to},
+		}, nil
+	}
+}
+
+func parseIntComparisons(s string) ([]Comparison, error) {
+	return parseComparisons(s, func(s string) (interface{}, interface{}, error) {
+		n, err := strconv.ParseInt(s, 10, 64)
+		if err != nil {
+			return nil, nil, err
+		}
+		return n, n + 1, nil
+	})
+}
+
+// parseDateComparisons parses comparisons to dates, which span the whole day
+// in UTC.
+func parseDateComparisons(s string) ([]Comparison, error) {
+	return parseComparisons(s, func(s string) (interface{}, interface{}, error) {
+		day, err := time.Parse("2006-01-02", s)
+		if err != nil {
+			return nil, nil, err
+		}
+		return day, day.AddDate(0, 0, 1), nil
+	})
+}
+
+func (q *SearchQuery) search() *string {
+	return &q.Text
+}
+
+// userLoginSQL returns the condition matching the rows of from belonging to
+// the user with login.
+func userLoginSQL(from, login string, args *pgx.QueryArgs) string {
+	return from + ".user_id = (SELECT id FROM account WHERE lower(login) = lower(" +
+		args.Append(login) + "))"
+}
+
+// studyNameWithOwnerSQL returns the condition matching the rows of from in the
+// study with the name with owner, i.e. owner/name.
+func studyNameWithOwnerSQL(from, nameWithOwner string, args *pgx.QueryArgs) string {
+	owner, name := nameWithOwner, ""
+	if i := strings.Index(nameWithOwner, "/"); i != -1 {
+		owner, name = nameWithOwner[:i], nameWithOwner[i+1:]
+	}
+	return from + `.study_id = (
+		SELECT study.id
+		FROM study
+		JOIN account ON account.id = study.user_id
+		WHERE lower(account.login) = lower(` + args.Append(owner) + `)
+			AND lower(study.name) = lower(` + args.Append(name) + `)
+	)`
+}
+
+func (q *SearchQuery) ActivityFilterOptions() (*ActivityFilterOptions, error) {
+	filters := &ActivityFilterOptions{Search: q.search()}
+	for _, qualifier := range q.Qualifiers {
+		switch qualifier.Name {
+		case createdQualifier:
+			filters.CreatedAt = append(filters.CreatedAt, qualifier.comparisons...)
+		case studyQualifier:
+			filters.StudyNameWithOwner = &qualifier.Value
+		case userQualifier:
+			filters.UserLogin = &qualifier.Value
+		default:
+			return nil, qualifier.unsupported("activities")
+		}
+	}
+	return filters, nil
+}
+
+func (q *SearchQuery) CommentFilterOptions() (*CommentFilterOptions, error) {
+	filters := &CommentFilterOptions{Search: q.search()}
+	for _, qualifier := range q.Qualifiers {
+		switch {
+		case qualifier.Name == createdQualifier:
+			filters.CreatedAt = append(filters.CreatedAt, qualifier.comparisons...)
+		case qualifier.Name == isQualifier && qualifier.Value == "published":
+			filters.IsPublished = qualifier.bool()
+		case qualifier.Name == studyQualifier:
+			filters.StudyNameWithOwner = &qualifier.Value
+		case qualifier.Name == userQualifier:
+			filters.UserLogin = &qualifier.Value
+		default:
+			return nil, qualifier.unsupported("comments")
+		}
+	}
+	return filters, nil
+}
+
+func (q *SearchQuery) CourseFilterOptions() (*CourseFilterOptions, error) {
+	filters := &CourseFilterOptions{Search: q.search()}
+	for _, qualifier := range q.Qualifiers {
+		switch {
+		case qualifier.Name == applesQualifier:
+			filters.AppleCount = append(filters.AppleCount, qualifier.comparisons...)
+		case qualifier.Name == createdQualifier:
+			filters.CreatedAt = append(filters.CreatedAt, qualifier.comparisons...)
+		case qualifier.Name == isQualifier && qualifier.Value == "published":
+			filters.IsPublished = qualifier.bool()
+		case qualifier.Name == studyQualifier:
+			filters.StudyNameWithOwner = &qualifier.Value
+		case qualifier.Name == topicQualifier:
+			filters.Topics = qualifier.values(filters.Topics)
+		case qualifier.Name == userQualifier:
+			filters.UserLogin = &qualifier.Value
+		default:
+			return nil, qualifier.unsupported("courses")
+		}
+	}
+	return filters, nil
+}
+
+func (q *SearchQuery) LabelFilterOptions() (*LabelFilterOptions, error) {
+	filters := &LabelFilterOptions{Search: q.search()}
+	for _, qualifier := range q.Qualifiers {
+		switch {
+		case qualifier.Name == createdQualifier:
+			filters.CreatedAt = append(filters.CreatedAt, qualifier.comparisons...)
+		case qualifier.Name == isQualifier && qualifier.Value == "default":
+			filters.IsDefault = qualifier.bool()
+		case qualifier.Name == studyQualifier:
+			filters.StudyNameWithOwner = &qualifier.Value
+		default:
+			return nil, qualifier.unsupported("labels")
+		}
+	}
+	return filters, nil
+}
+
+func (q *SearchQuery) LessonFilterOptions() (*LessonFilterOptions, error) {
+	filters := &LessonFilterOptions{Search: q.search()}
+	for _, qualifier := range q.Qualifiers {
+		switch {
+		case qualifier.Name == createdQualifier:
+			filters.CreatedAt = append(filters.CreatedAt, qualifier.comparisons...)
+		case qualifier.Name == isQualifier && qualifier.Value == "course-lesson":
+			filters.IsCourseLesson = qualifier.bool()
+		case qualifier.Name == isQualifier && qualifier.Value == "published":
+			filters.IsPublished = qualifier.bool()
+		case qualifier.Name == labelQualifier:
+			filters.Labels = qualifier.values(filters.Labels)
+		case qualifier.Name == studyQualifier:
+			filters.StudyNameWithOwner = &qualifier.Value
+		case qualifier.Name == userQualifier:
+			filters.UserLogin = &qualifier.Value
+		default:
+			return nil, qualifier.unsupported("lessons")
+		}
+	}
+	return filters, nil
+}
+
+func (q *SearchQuery) StudyFilterOptions() (*StudyFilterOptions, error) {
+	filters := &StudyFilterOptions{Search: q.search()}
+	for _, qualifier := range q.Qualifiers {
+		switch qualifier.Name {
+		case applesQualifier:
+			filters.AppleCount = append(filters.AppleCount, qualifier.comparisons...)
+		case createdQualifier:
+			filters.CreatedAt = append(filters.CreatedAt, qualifier.comparisons...)
+		case topicQualifier:
+			filters.Topics = qualifier.values(filters.Topics)
+		case userQualifier:
+			filters.UserLogin = &qualifier.Value
+		default:
+			return nil, qualifier.unsupported("studies")
+		}
+	}
+	return filters, nil
+}
+
+// StudyContentFilterOptions returns the filter options of a search of the
+// content of a study, which is already limited to the study.
+func (q *SearchQuery) StudyContentFilterOptions() (*StudyContentFilterOptions, error) {
+	filters := &StudyContentFilterOptions{Search: q.search()}
+	for _, qualifier := range q.Qualifiers {
+		switch qualifier.Name {
+		case createdQualifier:
+			filters.CreatedAt = append(filters.CreatedAt, qualifier.comparisons...)
+		case userQualifier:
+			filters.UserLogin = &qualifier.Value
+		default:
+			return nil, qualifier.unsupported("the content of a study")
+		}
+	}
+	return filters, nil
+}
+
+func (q *SearchQuery) TopicFilterOptions() (*TopicFilterOptions, error) {
+	filters := &TopicFilterOptions{Search: q.search()}
+	for _, qualifier := range q.Qualifiers {
+		switch qualifier.Name {
+		case createdQualifier:
+			filters.CreatedAt = append(filters.CreatedAt, qualifier.comparisons...)
+		default:
+			return nil, qualifier.unsupported("topics")
+		}
+	}
+	return filters, nil
+}
+
+func (q *SearchQuery) UserFilterOptions() (*UserFilterOptions, error) {
+	filters := &UserFilterOptions{Search: q.search()}
+	for _, qualifier := range q.Qualifiers {
+		switch qualifier.Name {
+		case createdQualifier:
+			filters.CreatedAt = append(filters.CreatedAt, qualifier.comparisons...)
+		default:
+			return nil, qualifier.unsupported("users")
+		}
+	}
+	return filters, nil
+}
+
+func (q *SearchQuery) UserAssetFilterOptions() (*UserAssetFilterOptions, error) {
+	filters := &UserAssetFilterOptions{Search: q.search()}
+	for _, qualifier := range q.Qualifiers {
+		switch {
+		case qualifier.Name == createdQualifier:
+			filters.CreatedAt = append(filters.CreatedAt, qualifier.comparisons...)
+		case qualifier.Name == isQualifier && qualifier.Value == "activity-asset":
+			filters.IsActivityAsset = qualifier.bool()
+		case qualifier.Name == labelQualifier:
+			filters.Labels = qualifier.values(filters.Labels)
+		case qualifier.Name == studyQualifier:
+			filters.StudyNameWithOwner = &qualifier.Value
+		case qualifier.Name == typeQualifier:
+			filters.Type = &qualifier.Value
+		case qualifier.Name == userQualifier:
+			filters.UserLogin = &qualifier.Value
+		default:
+			return nil, qualifier.unsupported("user assets")
+		}
+	}
+	return filters, nil
+}
@@ -0,0 +1,87 @@
+package data_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/marksauter/markus-ninja-api/pkg/data"
+)
+
+var parseSearchQueryTests = []struct {
+	query         string
+	text          string
+	terms         []string
+	excludedTerms []string
+	qualifiers    []string
+}{
+	{
+		"foo bar",
+		"foo bar",
+		[]string{"foo", "bar"},
+		[]string{},
+		[]string{},
+	},
+	{
+		`"binary search" -tree user:marksauter`,
+		`"binary search" -tree`,
+		[]string{"binary search"},
+		[]string{"tree"},
+		[]string{"user:marksauter"},
+	},
+	{
+		`label:"needs review" -is:published study:marksauter/go`,
+		"",
+		[]string{},
+		[]string{},
+		[]string{`label:"needs review"`, "-is:published", "study:marksauter/go"},
+	},
+	{
+		"created:>2018-01-01 apples:10..* note:foo",
+		"note:foo",
+		[]string{"note:foo"},
+		[]string{},
+		[]string{"created:>2018-01-01", "apples:10..*"},
+	},
+}
+
+func TestParseSearchQuery(t *testing.T) {
+	for _, tt := range parseSearchQueryTests {
+		q, err := data.ParseSearchQuery(tt.query)
+		if err != nil {
+			t.Errorf("ParseSearchQuery(%q) unexpected error %s", tt.query, err)
+			continue
+		}
+		qualifiers := make([]string, len(q.Qualifiers))
+		for i, qualifier := range q.Qualifiers {
+			qualifiers[i] = qualifier.String()
+		}
+		if q.Text != tt.text ||
+			!reflect.DeepEqual(q.Terms, tt.terms) ||
+			!reflect.DeepEqual(q.ExcludedTerms, tt.excludedTerms) ||
+			!reflect.DeepEqual(qualifiers, tt.qualifiers) {
+			t.Errorf(
+				"ParseSearchQuery(%q) actual %q %v %v %v",
+				tt.query,
+				q.Text,
+				q.Terms,
+				q.ExcludedTerms,
+				qualifiers,
+			)
+		}
+	}
+}
+
+func TestInvalidSearchQuery(t *testing.T) {
+	for _, query := range []string{
+		`"foo`,
+		"apples:>ten",
+		"created:2018-13-01",
+		"is:fun",
+		"-user:marksauter",
+		"study:marksauter",
+	} {
+		if _, err := data.ParseSearchQuery(query); err == nil {
+			t.Errorf("ParseSearchQuery(%q) expected error", query)
+		}
+	}
+}
@@ -0,0 +1,108 @@
+package data
+
+import (
+	"strings"
+
+	"github.com/jackc/pgx"
+)
+
+// BestMatch is the order field of searches ordered by relevance.
+const BestMatch = "best_match"
+
+// bestMatchRankColumn is the column SQL3 computes the best match rank of a
+// row into when it is selected.
+const bestMatchRankColumn = "best_match_rank"
+
+// Weights of the D, C, B and A labels of the documents in the search indexes.
+// Titles and names are labelled A, and bodies and descriptions B.
+const documentRankWeights = "'{0.05, 0.1, 0.3, 1.0}'"
+
+// SearchRankWeights are the weights blended into the rank of a row when
+// ordering searches by best match.
+type SearchRankWeights struct {
+	// Text weighs how well the document of the row matches the search, which
+	// is normalized to the range [0, 1).
+	Text float64
+	// Popularity weighs the natural logarithm of each popularity counter of the
+	// search indexes, by column name.
+	Popularity map[string]float64
+}
+
+func DefaultSearchRankWeights() SearchRankWeights {
+	return SearchRankWeights{
+		Text: 1.0,
+		Popularity: map[string]float64{
+			"apple_count":   0.05,
+			"comment_count": 0.02,
+			"lesson_count":  0.02,
+			"topiced_count": 0.05,
+		},
+	}
+}
+
+var searchRankWeights = DefaultSearchRankWeights()
+
+// SetSearchRankWeights sets the weights used to rank best matches. It should
+// be called before serving any requests.
+func SetSearchRankWeights(weights SearchRankWeights) {
+	searchRankWeights = weights
+}
+
+// searchPopularityCounters are the popularity counters kept in each search
+// index.
+var searchPopularityCounters = map[string][]string{
+	"course_search_index":        {"apple_count", "lesson_count"},
+	"lesson_search_index":        {"comment_count"},
+	"study_content_search_index": {"apple_count", "comment_count"},
+	"study_search_index":         {"apple_count", "lesson_count"},
+	"topic_search_index":         {"topiced_count"},
+	"user_asset_search_index":    {"comment_count"},
+}
+
+// documentSearcher is implemented by the filter options that add a
+// document_query to match against the document of the rows.
+type documentSearcher interface {
+	searchesDocument() bool
+}
+
+func filtersSearchDocument(filters FilterOptions) bool {
+	s, ok := filters.(documentSearcher)
+	return ok && s.searchesDocument()
+}
+
+// bestMatchRankSQL returns the best match rank of the rows of from aliased as
+// as. The rank blends the text rank of the document against document_query, if
+// it is searched, or the similarity of the titles to a fuzzy search, whichever
+// is greater, with the popularity counters of from.
+func bestMatchRankSQL(
+	from,
+	as string,
+	searchesDocument bool,
+	titles *titleSearch,
+	args *pgx.QueryArgs,
+) string {
+	terms := make([]string, 0, 3)
+	if searchesDocument && searchRankWeights.Text != 0 {
+		textRank := "ts_rank_cd(" + documentRankWeights + ", " + as + ".document, document_query, 32)"
+		if titles != nil {
+			textRank = "greatest(" + textRank + ", " + titles.rankSQL(as, args) + ")"
+		}
+		terms = append(terms, args.Append(searchRankWeights.Text)+"::float8 * "+textRank)
+	}
+	for _, column := range searchPopularityCounters[from] {
+		weight := searchRankWeights.Popularity[column]
+		if weight == 0 {
+			continue
+		}
+		terms = append(terms, args.Append(weight)+
+			"::float8 * ln(1 + "+as+"."+column+"::float8)")
+	}
+	if len(terms) == 0 {
+		return "0::float8"
+	}
+	return "(" + strings.Join(terms, " + ") + ")::float8"
+}
+
+func (p *PageOptions) isBestMatch() bool {
+	return p.Order != nil && p.Order.Field() == BestMatch
+}
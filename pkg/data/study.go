@@ -12,17 +12,25 @@ import (
 )
 
 type Study struct {
-	AdvancedAt  pgtype.Timestamptz `db:"advanced_at" permit:"read"`
-	AppledAt    pgtype.Timestamptz `db:"appled_at"`
-	CreatedAt   pgtype.Timestamptz `db:"created_at" permit:"read"`
-	Description pgtype.Text        `db:"description" permit:"create/read/update"`
-	EnrolledAt  pgtype.Timestamptz `db:"enrolled_at"`
-	ID          mytype.OID         `db:"id" permit:"read"`
-	Name        mytype.WordsName   `db:"name" permit:"create/read"`
-	Private     pgtype.Bool        `db:"private" permit:"create/read/update"`
-	TopicedAt   pgtype.Timestamptz `db:"topiced_at"`
-	UpdatedAt   pgtype.Timestamptz `db:"updated_at" permit:"read"`
-	UserID      mytype.OID         `db:"user_id" permit:"create/read"`
+	AdvancedAt    pgtype.Timestamptz `db:"advanced_at" permit:"read"`
+	AppledAt      pgtype.Timestamptz `db:"appled_at"`
+	BestMatchRank pgtype.Float8      `db:"best_match_rank"`
+	CreatedAt     pgtype.Timestamptz `db:"created_at" permit:"read"`
+	Description   pgtype.Text        `db:"description" permit:"create/read/update"`
+	EnrolledAt    pgtype.Timestamptz `db:"enrolled_at"`
+	ID            mytype.OID         `db:"id" permit:"read"`
+	Name          mytype.WordsName   `db:"name" permit:"create/read"`
+	Private       pgtype.Bool        `db:"private" permit:"create/read/update"`
+	// RecommendationScore is how strongly the study is recommended to a user.
+	RecommendationScore pgtype.Float8 `db:"recommendation_score"`
+	// SearchLanguage is the text search configuration the descriptions and
+	// bodies of the study's content are stemmed with.
+	SearchLanguage pgtype.Varchar     `db:"search_language" permit:"create/read/update"`
+	TopicedAt      pgtype.Timestamptz `db:"topiced_at"`
+	// TrendingScore is how much the study is trending during a period.
+	TrendingScore pgtype.Float8      `db:"trending_score"`
+	UpdatedAt     pgtype.Timestamptz `db:"updated_at" permit:"read"`
+	UserID        mytype.OID         `db:"user_id" permit:"create/read"`
 }
 
 func studyDelimeter(r rune) bool {
@@ -32,6 +40,14 @@ func studyDelimeter(r rune) bool {
 type StudyFilterOptions struct {
 	Topics *[]string
 	Search *string
+	// Fuzzy also matches rows with a title similar to the search.
+	Fuzzy bool
+	// AppleCount are comparisons the apple count of the rows must satisfy.
+	AppleCount []Comparison
+	// CreatedAt are comparisons the creation time of the rows must satisfy.
+	CreatedAt []Comparison
+	// UserLogin limits the rows to those of the user with the login.
+	UserLogin *string
 }
 
 func (src *StudyFilterOptions) SQL(from string, args *pgx.QueryArgs) *SQLParts {
@@ -49,13 +65,15 @@ func (src *StudyFilterOptions) SQL(from string, args *pgx.QueryArgs) *SQLParts {
 			"CASE "+args.Append(query)+" WHEN '*' THEN TRUE ELSE "+from+".topics @@ topics_query END",
 		)
 	}
+	whereParts = append(whereParts, comparisonsSQL(from+".apple_count", src.AppleCount, args)...)
+	whereParts = append(whereParts, comparisonsSQL(from+".created_at", src.CreatedAt, args)...)
+	if src.UserLogin != nil {
+		whereParts = append(whereParts, userLoginSQL(from, *src.UserLogin, args))
+	}
 	if src.Search != nil {
-		query := ToPrefixTsQuery(*src.Search)
-		fromParts = append(fromParts, "to_tsquery('simple',"+args.Append(query)+") AS document_query")
-		whereParts = append(
-			whereParts,
-			"CASE "+args.Append(query)+" WHEN '*' THEN TRUE ELSE "+from+".document @@ document_query END",
-		)
+		fromPart, wherePart := documentSearchSQL(from, *src.Search, nil, src.titleSearch(), args)
+		fromParts = append(fromParts, fromPart)
+		whereParts = append(whereParts, wherePart)
 	}
 
 	where := ""
@@ -69,6 +87,17 @@ func (src *StudyFilterOptions) SQL(from string, args *pgx.QueryArgs) *SQLParts {
 	}
 }
 
+func (src *StudyFilterOptions) searchesDocument() bool {
+	return src != nil && src.Search != nil
+}
+
+func (src *StudyFilterOptions) titleSearch() *titleSearch {
+	if src == nil {
+		return nil
+	}
+	return newTitleSearch(src.Search, src.Fuzzy, "name")
+}
+
 func CountStudyByApplee(
 	db Queryer,
 	appleeID string,
@@ -200,6 +229,7 @@ func getStudy(
 		&row.ID,
 		&row.Name,
 		&row.Private,
+		&row.SearchLanguage,
 		&row.UpdatedAt,
 		&row.UserID,
 	)
@@ -237,6 +267,7 @@ func getManyStudy(
 			&row.ID,
 			&row.Name,
 			&row.Private,
+			&row.SearchLanguage,
 			&row.UpdatedAt,
 			&row.UserID,
 		)
@@ -259,6 +290,7 @@ const getStudyByIDSQL = `
 		id,
 		name,
 		private,
+		search_language,
 		updated_at,
 		user_id
 	FROM study_search_index
@@ -308,6 +340,7 @@ func GetStudyByApplee(
 		"id",
 		"name",
 		"private",
+		"search_language",
 		"updated_at",
 		"user_id",
 	}
@@ -333,6 +366,7 @@ func GetStudyByApplee(
 			&row.ID,
 			&row.Name,
 			&row.Private,
+			&row.SearchLanguage,
 			&row.UpdatedAt,
 			&row.UserID,
 		)
@@ -378,6 +412,7 @@ func GetStudyByEnrollee(
 		"id",
 		"name",
 		"private",
+		"search_language",
 		"updated_at",
 		"user_id",
 	}
@@ -403,6 +438,7 @@ func GetStudyByEnrollee(
 			&row.ID,
 			&row.Name,
 			&row.Private,
+			&row.SearchLanguage,
 			&row.UpdatedAt,
 			&row.UserID,
 		)
@@ -447,6 +483,7 @@ func GetStudyByTopic(
 		"id",
 		"name",
 		"private",
+		"search_language",
 		"topiced_at",
 		"updated_at",
 		"user_id",
@@ -472,6 +509,7 @@ func GetStudyByTopic(
 			&row.ID,
 			&row.Name,
 			&row.Private,
+			&row.SearchLanguage,
 			&row.TopicedAt,
 			&row.UpdatedAt,
 			&row.UserID,
@@ -517,6 +555,7 @@ func GetStudyByUser(
 		"id",
 		"name",
 		"private",
+		"search_language",
 		"updated_at",
 		"user_id",
 	}
@@ -541,6 +580,7 @@ const getStudyByNameSQL = `
 		id,
 		name,
 		private,
+		search_language,
 		updated_at,
 		user_id
 	FROM study_search_index
@@ -569,6 +609,7 @@ const getStudyByUserAndNameSQL = `
 		s.id,
 		s.name,
 		s.private,
+		s.search_language,
 		s.updated_at,
 		s.user_id
 	FROM study_search_index s
@@ -624,6 +665,10 @@ func CreateStudy(
 		columns = append(columns, "private")
 		values = append(values, args.Append(&row.Private))
 	}
+	if row.SearchLanguage.Status != pgtype.Undefined {
+		columns = append(columns, "search_language")
+		values = append(values, args.Append(&row.SearchLanguage))
+	}
 	if row.UserID.Status != pgtype.Undefined {
 		columns = append(columns, "user_id")
 		values = append(values, args.Append(&row.UserID))
@@ -733,11 +778,13 @@ func SearchStudy(
 
 	selects := []string{
 		"advanced_at",
+		"best_match_rank",
 		"created_at",
 		"description",
 		"id",
 		"name",
 		"private",
+		"search_language",
 		"updated_at",
 		"user_id",
 	}
@@ -746,7 +793,31 @@ func SearchStudy(
 
 	psName := preparedName("searchStudyIndex", sql)
 
-	if err := getManyStudy(db, psName, sql, &rows, args...); err != nil {
+	dbRows, err := prepareQuery(db, psName, sql, args...)
+	if err != nil {
+		mylog.Log.WithError(err).Error(util.Trace(""))
+		return nil, err
+	}
+	defer dbRows.Close()
+
+	for dbRows.Next() {
+		var row Study
+		dbRows.Scan(
+			&row.AdvancedAt,
+			&row.BestMatchRank,
+			&row.CreatedAt,
+			&row.Description,
+			&row.ID,
+			&row.Name,
+			&row.Private,
+			&row.SearchLanguage,
+			&row.UpdatedAt,
+			&row.UserID,
+		)
+		rows = append(rows, &row)
+	}
+
+	if err := dbRows.Err(); err != nil {
 		mylog.Log.WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
@@ -774,6 +845,9 @@ func UpdateStudy(
 	if row.Private.Status != pgtype.Undefined {
 		sets = append(sets, `private`+"="+args.Append(&row.Private))
 	}
+	if row.SearchLanguage.Status != pgtype.Undefined {
+		sets = append(sets, `search_language`+"="+args.Append(&row.SearchLanguage))
+	}
 
 	if len(sets) == 0 {
 		mylog.Log.Info(util.Trace("no updates"))
@@ -0,0 +1,180 @@
+package data
+
+import (
+	"github.com/jackc/pgx"
+	"github.com/jackc/pgx/pgtype"
+	"github.com/marksauter/markus-ninja-api/pkg/mylog"
+	"github.com/marksauter/markus-ninja-api/pkg/mytype"
+	"github.com/marksauter/markus-ninja-api/pkg/util"
+	"github.com/sirupsen/logrus"
+)
+
+// StudyAccess is a user's grant to read, or to read and enroll in, a private
+// study, made when they accept an invitation to it.
+type StudyAccess struct {
+	Access       pgtype.Varchar     `db:"access"`
+	CreatedAt    pgtype.Timestamptz `db:"created_at"`
+	InvitationID mytype.OID         `db:"invitation_id"`
+	StudyID      mytype.OID         `db:"study_id"`
+	UserID       mytype.OID         `db:"user_id"`
+}
+
+const batchGetStudyAccessByUserSQL = `
+	SELECT
+		access,
+		created_at,
+		invitation_id,
+		study_id,
+		user_id
+	FROM study_access
+	WHERE user_id = ANY($1)
+`
+
+// BatchGetStudyAccessByUser returns the grants of the users in userIDs.
+func BatchGetStudyAccessByUser(
+	db Queryer,
+	userIDs []string,
+) ([]*StudyAccess, error) {
+	dbRows, err := prepareQuery(db, "batchGetStudyAccessByUser", batchGetStudyAccessByUserSQL, userIDs)
+	if err != nil {
+		mylog.Log.WithError(err).Error(util.Trace(""))
+		return nil, err
+	}
+	defer dbRows.Close()
+
+	rows := make([]*StudyAccess, 0, len(userIDs))
+	for dbRows.Next() {
+		var row StudyAccess
+		dbRows.Scan(
+			&row.Access,
+			&row.CreatedAt,
+			&row.InvitationID,
+			&row.StudyID,
+			&row.UserID,
+		)
+		rows = append(rows, &row)
+	}
+
+	if err := dbRows.Err(); err != nil {
+		mylog.Log.WithError(err).Error(util.Trace(""))
+		return nil, err
+	}
+
+	mylog.Log.WithField("n", len(rows)).Info(util.Trace("study accesses found"))
+	return rows, nil
+}
+
+// inReadableStudySQL returns the condition limiting the rows of from to those
+// in studies the viewer may read, i.e. public studies, and private studies the
+// viewer owns or has been granted access to. Site admins may read every study.
+func inReadableStudySQL(
+	from,
+	viewerID string,
+	viewerIsAdmin bool,
+	args *pgx.QueryArgs,
+) string {
+	if viewerIsAdmin {
+		return ""
+	}
+	viewer := args.Append(viewerID)
+	return from + `.study_id NOT IN (
+		SELECT study.id FROM study
+		WHERE study.private
+			AND study.user_id != ` + viewer + `
+			AND NOT EXISTS (
+				SELECT 1 FROM study_access
+				WHERE study_access.study_id = study.id AND study_access.user_id = ` + viewer + `
+			)
+	)`
+}
+
+// Enroll access includes read access, so a grant is never downgraded.
+const grantStudyAccessSQL = `
+	INSERT INTO study_access(access, invitation_id, study_id, user_id)
+	VALUES ($1, $2, $3, $4)
+	ON CONFLICT (user_id, study_id) DO UPDATE
+	SET access = CASE
+			WHEN study_access.access = 'ENROLL' THEN study_access.access
+			ELSE EXCLUDED.access
+		END,
+		invitation_id = CASE
+			WHEN study_access.access = 'ENROLL' THEN study_access.invitation_id
+			ELSE EXCLUDED.invitation_id
+		END
+	RETURNING
+		access,
+		created_at,
+		invitation_id,
+		study_id,
+		user_id
+`
+
+func GrantStudyAccess(
+	db Queryer,
+	row *StudyAccess,
+) (*StudyAccess, error) {
+	var studyAccess StudyAccess
+	err := prepareQueryRow(
+		db,
+		"grantStudyAccess",
+		grantStudyAccessSQL,
+		&row.Access,
+		&row.InvitationID,
+		&row.StudyID,
+		&row.UserID,
+	).Scan(
+		&studyAccess.Access,
+		&studyAccess.CreatedAt,
+		&studyAccess.InvitationID,
+		&studyAccess.StudyID,
+		&studyAccess.UserID,
+	)
+	if err != nil {
+		if pgErr, ok := err.(pgx.PgError); ok {
+			mylog.Log.WithError(pgErr).Error(util.Trace(""))
+			return nil, handlePSQLError(pgErr)
+		}
+		mylog.Log.WithError(err).Error(util.Trace(""))
+		return nil, err
+	}
+
+	mylog.Log.WithFields(logrus.Fields{
+		"access":   studyAccess.Access.String,
+		"study_id": row.StudyID.String,
+		"user_id":  row.UserID.String,
+	}).Info(util.Trace("study access granted"))
+	return &studyAccess, nil
+}
+
+const revokeStudyAccessSQL = `
+	DELETE FROM study_access
+	WHERE study_id = $1 AND user_id = $2
+`
+
+// RevokeStudyAccess removes the user's grant to the study, whichever
+// invitation it was made by.
+func RevokeStudyAccess(
+	db Queryer,
+	studyID,
+	userID string,
+) error {
+	commandTag, err := prepareExec(db, "revokeStudyAccess", revokeStudyAccessSQL, studyID, userID)
+	if err != nil {
+		mylog.Log.WithError(err).Error(util.Trace(""))
+		return err
+	}
+	if commandTag.RowsAffected() != 1 {
+		err := ErrNotFound
+		mylog.Log.WithFields(logrus.Fields{
+			"study_id": studyID,
+			"user_id":  userID,
+		}).WithError(err).Error(util.Trace(""))
+		return err
+	}
+
+	mylog.Log.WithFields(logrus.Fields{
+		"study_id": studyID,
+		"user_id":  userID,
+	}).Info(util.Trace("study access revoked"))
+	return nil
+}
@@ -0,0 +1,179 @@
+package data
+
+import (
+	"strings"
+
+	"github.com/jackc/pgx"
+	"github.com/jackc/pgx/pgtype"
+	"github.com/marksauter/markus-ninja-api/pkg/mylog"
+	"github.com/marksauter/markus-ninja-api/pkg/mytype"
+	"github.com/marksauter/markus-ninja-api/pkg/util"
+)
+
+// The types of the published content of studies.
+const (
+	StudyContentTypeActivity  = "Activity"
+	StudyContentTypeComment   = "Comment"
+	StudyContentTypeCourse    = "Course"
+	StudyContentTypeLesson    = "Lesson"
+	StudyContentTypeUserAsset = "UserAsset"
+)
+
+// StudyContent is an activity, comment, course, lesson or user asset of a
+// study, as it is searched together with the rest of the study's content.
+type StudyContent struct {
+	BestMatchRank pgtype.Float8      `db:"best_match_rank"`
+	CreatedAt     pgtype.Timestamptz `db:"created_at"`
+	ID            mytype.OID         `db:"id"`
+	Type          pgtype.Text        `db:"type"`
+	UpdatedAt     pgtype.Timestamptz `db:"updated_at"`
+}
+
+type StudyContentFilterOptions struct {
+	Search *string
+	// Fuzzy also matches rows with a title similar to the search.
+	Fuzzy bool
+	// SearchLanguage is the text search configuration the search is also
+	// stemmed with, that of the study the rows belong to.
+	SearchLanguage *string
+	// CreatedAt are comparisons the creation time of the rows must satisfy.
+	CreatedAt []Comparison
+	// Types limits the rows to those of the types, or all of them if empty.
+	Types []string
+	// UserLogin limits the rows to those of the user with the login.
+	UserLogin *string
+}
+
+func (src *StudyContentFilterOptions) SQL(from string, args *pgx.QueryArgs) *SQLParts {
+	if src == nil {
+		return nil
+	}
+
+	fromParts := make([]string, 0, 1)
+	whereParts := make([]string, 0, 4)
+	if len(src.Types) > 0 {
+		types := make([]string, len(src.Types))
+		for i, t := range src.Types {
+			types[i] = args.Append(t)
+		}
+		whereParts = append(whereParts, from+".type IN ("+strings.Join(types, ", ")+")")
+	}
+	whereParts = append(whereParts, comparisonsSQL(from+".created_at", src.CreatedAt, args)...)
+	if src.UserLogin != nil {
+		whereParts = append(whereParts, userLoginSQL(from, *src.UserLogin, args))
+	}
+	if src.Search != nil {
+		fromPart, wherePart := documentSearchSQL(from, *src.Search, src.SearchLanguage, src.titleSearch(), args)
+		fromParts = append(fromParts, fromPart)
+		whereParts = append(whereParts, wherePart)
+	}
+
+	where := ""
+	if len(whereParts) > 0 {
+		where = "(" + strings.Join(whereParts, " AND ") + ")"
+	}
+
+	return &SQLParts{
+		From:  strings.Join(fromParts, ", "),
+		Where: where,
+	}
+}
+
+func (src *StudyContentFilterOptions) searchesDocument() bool {
+	return src != nil && src.Search != nil
+}
+
+func (src *StudyContentFilterOptions) titleSearch() *titleSearch {
+	if src == nil {
+		return nil
+	}
+	return newTitleSearch(src.Search, src.Fuzzy, "title")
+}
+
+func CountStudyContentBySearch(
+	db Queryer,
+	studyID string,
+	filters *StudyContentFilterOptions,
+) (int32, error) {
+	args := pgx.QueryArgs(make([]interface{}, 0, 4))
+	where := func(from string) string {
+		return from + `.study_id = ` + args.Append(studyID)
+	}
+	from := "study_content_search_index"
+
+	sql := CountSQL(from, where, filters, &args)
+	psName := preparedName("countStudyContentBySearch", sql)
+
+	var n int32
+	err := prepareQueryRow(db, psName, sql, args...).Scan(&n)
+	if err != nil {
+		mylog.Log.WithError(err).Error(util.Trace(""))
+	} else {
+		mylog.Log.WithField("n", n).Info(util.Trace("study content found"))
+	}
+	return n, err
+}
+
+// SearchStudyContent searches the activities, comments, courses, lessons and
+// user assets of the study together, ranking them against one another.
+func SearchStudyContent(
+	db Queryer,
+	studyID string,
+	po *PageOptions,
+	filters *StudyContentFilterOptions,
+) ([]*StudyContent, error) {
+	var rows []*StudyContent
+	if po != nil && po.Limit() > 0 {
+		limit := po.Limit()
+		if limit > 0 {
+			rows = make([]*StudyContent, 0, limit)
+		} else {
+			mylog.Log.Info(util.Trace("limit is 0"))
+			return rows, nil
+		}
+	}
+
+	var args pgx.QueryArgs
+	where := func(from string) string {
+		return from + `.study_id = ` + args.Append(studyID)
+	}
+
+	selects := []string{
+		"best_match_rank",
+		"created_at",
+		"id",
+		"type",
+		"updated_at",
+	}
+	from := "study_content_search_index"
+	sql := SQL3(selects, from, where, filters, &args, po)
+
+	psName := preparedName("searchStudyContentIndex", sql)
+
+	dbRows, err := prepareQuery(db, psName, sql, args...)
+	if err != nil {
+		mylog.Log.WithError(err).Error(util.Trace(""))
+		return nil, err
+	}
+	defer dbRows.Close()
+
+	for dbRows.Next() {
+		var row StudyContent
+		dbRows.Scan(
+			&row.BestMatchRank,
+			&row.CreatedAt,
+			&row.ID,
+			&row.Type,
+			&row.UpdatedAt,
+		)
+		rows = append(rows, &row)
+	}
+
+	if err := dbRows.Err(); err != nil {
+		mylog.Log.WithError(err).Error(util.Trace(""))
+		return nil, err
+	}
+
+	mylog.Log.WithField("n", len(rows)).Info(util.Trace("study content found"))
+	return rows, nil
+}
@@ -0,0 +1,54 @@
+package data_test
+
+import (
+	"testing"
+
+	"github.com/jackc/pgx"
+	"github.com/marksauter/markus-ninja-api/pkg/data"
+)
+
+var studyContentFilterOptionsSQLTests = []struct {
+	types    []string
+	expected string
+	nArgs    int
+}{
+	{
+		nil,
+		"",
+		0,
+	},
+	{
+		[]string{data.StudyContentTypeLesson},
+		"(c.type IN ($1))",
+		1,
+	},
+	{
+		[]string{data.StudyContentTypeCourse, data.StudyContentTypeLesson},
+		"(c.type IN ($1, $2))",
+		2,
+	},
+}
+
+func TestStudyContentFilterOptionsSQL(t *testing.T) {
+	for _, tt := range studyContentFilterOptionsSQLTests {
+		args := pgx.QueryArgs(make([]interface{}, 0, len(tt.types)))
+		filters := &data.StudyContentFilterOptions{Types: tt.types}
+		actual := filters.SQL("c", &args)
+		if actual.Where != tt.expected {
+			t.Errorf(
+				"StudyContentFilterOptions{Types: %v}.SQL() expected %s actual %s",
+				tt.types,
+				tt.expected,
+				actual.Where,
+			)
+		}
+		if len(args) != tt.nArgs {
+			t.Errorf(
+				"StudyContentFilterOptions{Types: %v}.SQL() expected %d args actual %d",
+				tt.types,
+				tt.nArgs,
+				len(args),
+			)
+		}
+	}
+}
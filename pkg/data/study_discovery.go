@@ -0,0 +1,274 @@
+package data
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx"
+	"github.com/jackc/pgx/pgtype"
+	"github.com/marksauter/markus-ninja-api/pkg/mylog"
+	"github.com/marksauter/markus-ninja-api/pkg/util"
+	"github.com/sirupsen/logrus"
+)
+
+// The periods studies are trending during.
+const (
+	TrendingPeriodDay   = "DAY"
+	TrendingPeriodWeek  = "WEEK"
+	TrendingPeriodMonth = "MONTH"
+)
+
+func isTrendingPeriod(period string) bool {
+	switch period {
+	case TrendingPeriodDay, TrendingPeriodWeek, TrendingPeriodMonth:
+		return true
+	default:
+		return false
+	}
+}
+
+func CountTrendingStudy(
+	db Queryer,
+	period string,
+	filters *StudyFilterOptions,
+) (int32, error) {
+	if !isTrendingPeriod(period) {
+		err := fmt.Errorf("invalid trending period: %q", period)
+		mylog.Log.WithError(err).Error(util.Trace(""))
+		return 0, err
+	}
+
+	args := pgx.QueryArgs(make([]interface{}, 0, 4))
+	where := func(from string) string {
+		return from + `.period = ` + args.Append(period)
+	}
+	from := "trending_study"
+
+	sql := CountSQL(from, where, filters, &args)
+	psName := preparedName("countTrendingStudy", sql)
+
+	var n int32
+	err := prepareQueryRow(db, psName, sql, args...).Scan(&n)
+	if err != nil {
+		mylog.Log.WithError(err).Error(util.Trace(""))
+	} else {
+		mylog.Log.WithField("n", n).Info(util.Trace("studies found"))
+	}
+	return n, err
+}
+
+func CountRecommendedStudy(
+	db Queryer,
+	recommendeeID string,
+	filters *StudyFilterOptions,
+) (int32, error) {
+	args := pgx.QueryArgs(make([]interface{}, 0, 4))
+	where := func(from string) string {
+		return from + `.recommendee_id = ` + args.Append(recommendeeID)
+	}
+	from := "recommended_study"
+
+	sql := CountSQL(from, where, filters, &args)
+	psName := preparedName("countRecommendedStudy", sql)
+
+	var n int32
+	err := prepareQueryRow(db, psName, sql, args...).Scan(&n)
+	if err != nil {
+		mylog.Log.WithError(err).Error(util.Trace(""))
+	} else {
+		mylog.Log.WithField("n", n).Info(util.Trace("studies found"))
+	}
+	return n, err
+}
+
+// GetTrendingStudy returns the public studies trending during the period, as of
+// the last refresh of the trends.
+func GetTrendingStudy(
+	db Queryer,
+	period string,
+	po *PageOptions,
+	filters *StudyFilterOptions,
+) ([]*Study, error) {
+	if !isTrendingPeriod(period) {
+		err := fmt.Errorf("invalid trending period: %q", period)
+		mylog.Log.WithError(err).Error(util.Trace(""))
+		return nil, err
+	}
+
+	var rows []*Study
+	if po != nil && po.Limit() > 0 {
+		limit := po.Limit()
+		if limit > 0 {
+			rows = make([]*Study, 0, limit)
+		} else {
+			mylog.Log.Info(util.Trace("limit is 0"))
+			return rows, nil
+		}
+	}
+
+	args := pgx.QueryArgs(make([]interface{}, 0, 4))
+	where := func(from string) string {
+		return from + `.period = ` + args.Append(period)
+	}
+
+	selects := []string{
+		"advanced_at",
+		"created_at",
+		"description",
+		"id",
+		"name",
+		"private",
+		"search_language",
+		"trending_score",
+		"updated_at",
+		"user_id",
+	}
+	from := "trending_study"
+	sql := SQL3(selects, from, where, filters, &args, po)
+
+	psName := preparedName("getTrendingStudies", sql)
+
+	dbRows, err := prepareQuery(db, psName, sql, args...)
+	if err != nil {
+		mylog.Log.WithError(err).Error(util.Trace(""))
+		return nil, err
+	}
+	defer dbRows.Close()
+
+	for dbRows.Next() {
+		var row Study
+		dbRows.Scan(
+			&row.AdvancedAt,
+			&row.CreatedAt,
+			&row.Description,
+			&row.ID,
+			&row.Name,
+			&row.Private,
+			&row.SearchLanguage,
+			&row.TrendingScore,
+			&row.UpdatedAt,
+			&row.UserID,
+		)
+		rows = append(rows, &row)
+	}
+
+	if err := dbRows.Err(); err != nil {
+		mylog.Log.WithError(err).Error(util.Trace(""))
+		return nil, err
+	}
+
+	mylog.Log.WithField("n", len(rows)).Info(util.Trace("studies found"))
+	return rows, nil
+}
+
+// GetRecommendedStudy returns the public studies recommended to the user, as of
+// the last refresh of the recommendations.
+func GetRecommendedStudy(
+	db Queryer,
+	recommendeeID string,
+	po *PageOptions,
+	filters *StudyFilterOptions,
+) ([]*Study, error) {
+	var rows []*Study
+	if po != nil && po.Limit() > 0 {
+		limit := po.Limit()
+		if limit > 0 {
+			rows = make([]*Study, 0, limit)
+		} else {
+			mylog.Log.Info(util.Trace("limit is 0"))
+			return rows, nil
+		}
+	}
+
+	args := pgx.QueryArgs(make([]interface{}, 0, 4))
+	where := func(from string) string {
+		return from + `.recommendee_id = ` + args.Append(recommendeeID)
+	}
+
+	selects := []string{
+		"advanced_at",
+		"created_at",
+		"description",
+		"id",
+		"name",
+		"private",
+		"recommendation_score",
+		"search_language",
+		"updated_at",
+		"user_id",
+	}
+	from := "recommended_study"
+	sql := SQL3(selects, from, where, filters, &args, po)
+
+	psName := preparedName("getRecommendedStudies", sql)
+
+	dbRows, err := prepareQuery(db, psName, sql, args...)
+	if err != nil {
+		mylog.Log.WithError(err).Error(util.Trace(""))
+		return nil, err
+	}
+	defer dbRows.Close()
+
+	for dbRows.Next() {
+		var row Study
+		dbRows.Scan(
+			&row.AdvancedAt,
+			&row.CreatedAt,
+			&row.Description,
+			&row.ID,
+			&row.Name,
+			&row.Private,
+			&row.RecommendationScore,
+			&row.SearchLanguage,
+			&row.UpdatedAt,
+			&row.UserID,
+		)
+		rows = append(rows, &row)
+	}
+
+	if err := dbRows.Err(); err != nil {
+		mylog.Log.WithError(err).Error(util.Trace(""))
+		return nil, err
+	}
+
+	mylog.Log.WithField("n", len(rows)).Info(util.Trace("studies found"))
+	return rows, nil
+}
+
+const refreshStudyDiscoverySQL = `
+	SELECT refresh_study_discovery($1, $2)
+`
+
+// RefreshStudyDiscovery recomputes the studies trending during each period, and
+// up to limit recommended studies for each user, unless another refresh
+// happened within interval. It returns whether they were recomputed.
+func RefreshStudyDiscovery(
+	db Queryer,
+	limit int32,
+	interval time.Duration,
+) (bool, error) {
+	var refreshInterval pgtype.Interval
+	if err := refreshInterval.Set(interval); err != nil {
+		mylog.Log.WithError(err).Error(util.Trace(""))
+		return false, err
+	}
+
+	var refreshed pgtype.Bool
+	err := prepareQueryRow(
+		db,
+		"refreshStudyDiscovery",
+		refreshStudyDiscoverySQL,
+		limit,
+		&refreshInterval,
+	).Scan(&refreshed)
+	if err != nil {
+		mylog.Log.WithError(err).Error(util.Trace(""))
+		return false, err
+	}
+
+	mylog.Log.WithFields(logrus.Fields{
+		"limit":     limit,
+		"refreshed": refreshed.Bool,
+	}).Info(util.Trace("study discovery refreshed"))
+	return refreshed.Bool, nil
+}
@@ -0,0 +1,25 @@
+package data_test
+
+import (
+	"testing"
+
+	"github.com/marksauter/markus-ninja-api/pkg/data"
+)
+
+var invalidTrendingPeriodTests = []string{
+	"",
+	"day",
+	"YEAR",
+	"DAY; DROP TABLE study",
+}
+
+func TestInvalidTrendingPeriod(t *testing.T) {
+	for _, period := range invalidTrendingPeriodTests {
+		if _, err := data.CountTrendingStudy(nil, period, nil); err == nil {
+			t.Errorf("CountTrendingStudy(%q) expected an error", period)
+		}
+		if _, err := data.GetTrendingStudy(nil, period, nil, nil); err == nil {
+			t.Errorf("GetTrendingStudy(%q) expected an error", period)
+		}
+	}
+}
@@ -0,0 +1,327 @@
+package data
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+
+	"github.com/jackc/pgx"
+	"github.com/jackc/pgx/pgtype"
+	"github.com/marksauter/markus-ninja-api/pkg/mylog"
+	"github.com/marksauter/markus-ninja-api/pkg/mytype"
+	"github.com/marksauter/markus-ninja-api/pkg/util"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	StudyAccessEnroll = "ENROLL"
+	StudyAccessRead   = "READ"
+)
+
+// StudyInvitation is a token, shared as a link or sent by email, that grants
+// access to a private study to the users that accept it.
+type StudyInvitation struct {
+	Access    pgtype.Varchar     `db:"access"`
+	CreatedAt pgtype.Timestamptz `db:"created_at"`
+	Email     pgtype.Varchar     `db:"email"`
+	ExpiresAt pgtype.Timestamptz `db:"expires_at"`
+	ID        mytype.OID         `db:"id"`
+	InviterID mytype.OID         `db:"inviter_id"`
+	MaxUses   pgtype.Int4        `db:"max_uses"`
+	RevokedAt pgtype.Timestamptz `db:"revoked_at"`
+	StudyID   mytype.OID         `db:"study_id"`
+	Token     pgtype.Varchar     `db:"token"`
+	UseCount  pgtype.Int4        `db:"use_count"`
+}
+
+func getStudyInvitation(
+	db Queryer,
+	name string,
+	sql string,
+	args ...interface{},
+) (*StudyInvitation, error) {
+	var row StudyInvitation
+	err := prepareQueryRow(db, name, sql, args...).Scan(
+		&row.Access,
+		&row.CreatedAt,
+		&row.Email,
+		&row.ExpiresAt,
+		&row.ID,
+		&row.InviterID,
+		&row.MaxUses,
+		&row.RevokedAt,
+		&row.StudyID,
+		&row.Token,
+		&row.UseCount,
+	)
+	if err == pgx.ErrNoRows {
+		mylog.Log.WithError(err).Debug(util.Trace(""))
+		return nil, ErrNotFound
+	} else if err != nil {
+		mylog.Log.WithError(err).Error(util.Trace(""))
+		return nil, err
+	}
+
+	return &row, nil
+}
+
+func getManyStudyInvitation(
+	db Queryer,
+	name string,
+	sql string,
+	rows *[]*StudyInvitation,
+	args ...interface{},
+) error {
+	dbRows, err := prepareQuery(db, name, sql, args...)
+	if err != nil {
+		mylog.Log.WithError(err).Error(util.Trace(""))
+		return err
+	}
+	defer dbRows.Close()
+
+	for dbRows.Next() {
+		var row StudyInvitation
+		dbRows.Scan(
+			&row.Access,
+			&row.CreatedAt,
+			&row.Email,
+			&row.ExpiresAt,
+			&row.ID,
+			&row.InviterID,
+			&row.MaxUses,
+			&row.RevokedAt,
+			&row.StudyID,
+			&row.Token,
+			&row.UseCount,
+		)
+		*rows = append(*rows, &row)
+	}
+
+	if err := dbRows.Err(); err != nil {
+		mylog.Log.WithError(err).Error(util.Trace(""))
+		return err
+	}
+
+	return nil
+}
+
+const getStudyInvitationSQL = `
+	SELECT
+		access,
+		created_at,
+		email,
+		expires_at,
+		id,
+		inviter_id,
+		max_uses,
+		revoked_at,
+		study_id,
+		token,
+		use_count
+	FROM study_invitation
+	WHERE id = $1
+`
+
+func GetStudyInvitation(
+	db Queryer,
+	id string,
+) (*StudyInvitation, error) {
+	studyInvitation, err := getStudyInvitation(db, "getStudyInvitation", getStudyInvitationSQL, id)
+	if err != nil {
+		mylog.Log.WithField("id", id).WithError(err).Error(util.Trace(""))
+	} else {
+		mylog.Log.WithField("id", id).Info(util.Trace("study invitation found"))
+	}
+	return studyInvitation, err
+}
+
+const getStudyInvitationByStudySQL = `
+	SELECT
+		access,
+		created_at,
+		email,
+		expires_at,
+		id,
+		inviter_id,
+		max_uses,
+		revoked_at,
+		study_id,
+		token,
+		use_count
+	FROM study_invitation
+	WHERE study_id = $1
+	ORDER BY created_at DESC
+`
+
+func GetStudyInvitationByStudy(
+	db Queryer,
+	studyID string,
+) ([]*StudyInvitation, error) {
+	var rows []*StudyInvitation
+	err := getManyStudyInvitation(
+		db,
+		"getStudyInvitationByStudy",
+		getStudyInvitationByStudySQL,
+		&rows,
+		studyID,
+	)
+	if err != nil {
+		mylog.Log.WithError(err).Error(util.Trace(""))
+		return nil, err
+	}
+
+	mylog.Log.WithField("n", len(rows)).Info(util.Trace("study invitations found"))
+	return rows, nil
+}
+
+const createStudyInvitationSQL = `
+	INSERT INTO study_invitation(
+		access,
+		email,
+		expires_at,
+		id,
+		inviter_id,
+		max_uses,
+		study_id,
+		token
+	)
+	VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+	RETURNING
+		access,
+		created_at,
+		email,
+		expires_at,
+		id,
+		inviter_id,
+		max_uses,
+		revoked_at,
+		study_id,
+		token,
+		use_count
+`
+
+func CreateStudyInvitation(
+	db Queryer,
+	row *StudyInvitation,
+) (*StudyInvitation, error) {
+	id, err := mytype.NewOID("StudyInvitation")
+	if err != nil {
+		mylog.Log.WithError(err).Error(util.Trace(""))
+		return nil, err
+	}
+	b := make([]byte, 20)
+	if _, err := rand.Read(b); err != nil {
+		mylog.Log.WithError(err).Error(util.Trace(""))
+		return nil, err
+	}
+	token := hex.EncodeToString(b)
+
+	studyInvitation, err := getStudyInvitation(
+		db,
+		"createStudyInvitation",
+		createStudyInvitationSQL,
+		&row.Access,
+		&row.Email,
+		&row.ExpiresAt,
+		id,
+		&row.InviterID,
+		&row.MaxUses,
+		&row.StudyID,
+		token,
+	)
+	if err != nil {
+		if pgErr, ok := err.(pgx.PgError); ok {
+			mylog.Log.WithError(pgErr).Error(util.Trace(""))
+			return nil, handlePSQLError(pgErr)
+		}
+		mylog.Log.WithError(err).Error(util.Trace(""))
+		return nil, err
+	}
+
+	mylog.Log.WithField("study_id", row.StudyID.String).Info(util.Trace("study invitation created"))
+	return studyInvitation, nil
+}
+
+const revokeStudyInvitationSQL = `
+	UPDATE study_invitation
+	SET revoked_at = statement_timestamp()
+	WHERE id = $1 AND revoked_at IS NULL
+	RETURNING
+		access,
+		created_at,
+		email,
+		expires_at,
+		id,
+		inviter_id,
+		max_uses,
+		revoked_at,
+		study_id,
+		token,
+		use_count
+`
+
+// RevokeStudyInvitation stops the invitation from being accepted. Access
+// already granted by it is kept.
+func RevokeStudyInvitation(
+	db Queryer,
+	id string,
+) (*StudyInvitation, error) {
+	studyInvitation, err := getStudyInvitation(
+		db,
+		"revokeStudyInvitation",
+		revokeStudyInvitationSQL,
+		id,
+	)
+	if err != nil {
+		mylog.Log.WithField("id", id).WithError(err).Error(util.Trace(""))
+		return nil, err
+	}
+
+	mylog.Log.WithField("id", id).Info(util.Trace("study invitation revoked"))
+	return studyInvitation, nil
+}
+
+const useStudyInvitationSQL = `
+	UPDATE study_invitation
+	SET use_count = use_count + 1
+	WHERE token = $1
+		AND revoked_at IS NULL
+		AND (expires_at IS NULL OR expires_at > statement_timestamp())
+		AND (max_uses IS NULL OR use_count < max_uses)
+	RETURNING
+		access,
+		created_at,
+		email,
+		expires_at,
+		id,
+		inviter_id,
+		max_uses,
+		revoked_at,
+		study_id,
+		token,
+		use_count
+`
+
+// UseStudyInvitation counts a use of the invitation with the token, and
+// returns ErrNotFound if there is no such invitation, or if it has been
+// revoked, has expired, or has been used up.
+func UseStudyInvitation(
+	db Queryer,
+	token string,
+) (*StudyInvitation, error) {
+	studyInvitation, err := getStudyInvitation(
+		db,
+		"useStudyInvitation",
+		useStudyInvitationSQL,
+		token,
+	)
+	if err != nil {
+		mylog.Log.WithError(err).Error(util.Trace(""))
+		return nil, err
+	}
+
+	mylog.Log.WithFields(logrus.Fields{
+		"id":        studyInvitation.ID.String,
+		"use_count": studyInvitation.UseCount.Int,
+	}).Info(util.Trace("study invitation used"))
+	return studyInvitation, nil
+}
@@ -0,0 +1,242 @@
+package data
+
+import (
+	"strings"
+
+	"github.com/jackc/pgx"
+	"github.com/jackc/pgx/pgtype"
+	"github.com/marksauter/markus-ninja-api/pkg/mylog"
+	"github.com/marksauter/markus-ninja-api/pkg/mytype"
+	"github.com/marksauter/markus-ninja-api/pkg/util"
+)
+
+// The types of nodes suggested to complete a search query.
+const (
+	SuggestionTypeLabel  = "Label"
+	SuggestionTypeLesson = "Lesson"
+	SuggestionTypeStudy  = "Study"
+	SuggestionTypeTopic  = "Topic"
+	SuggestionTypeUser   = "User"
+)
+
+// Suggestion is a node with a title starting with a search query, i.e. the
+// login of a user, the name with owner of a study, the name of a topic or
+// label, or the title of a lesson.
+type Suggestion struct {
+	ID   mytype.OID    `db:"id"`
+	Rank pgtype.Float8 `db:"rank"`
+	Text pgtype.Text   `db:"text"`
+	Type pgtype.Text   `db:"type"`
+}
+
+type SuggestionOptions struct {
+	// StudyID is the study lessons and labels are suggested from. They are
+	// not suggested without it.
+	StudyID *string
+	// Types are the types of nodes to suggest, or all of them if empty.
+	Types []string
+	// ViewerID is the id of the user the suggestions are for, or nil if they
+	// are for a guest.
+	ViewerID      *string
+	ViewerIsAdmin bool
+}
+
+func (o *SuggestionOptions) suggests(suggestionType string) bool {
+	if len(o.Types) == 0 {
+		return true
+	}
+	for _, t := range o.Types {
+		if t == suggestionType {
+			return true
+		}
+	}
+	return false
+}
+
+// studyVisibleSQL returns the condition limiting the studies aliased as as to
+// those the viewer may read, i.e. public studies, and private studies the
+// viewer owns or has been granted access to.
+func (o *SuggestionOptions) studyVisibleSQL(as string, args *pgx.QueryArgs) string {
+	if o.ViewerIsAdmin {
+		return "TRUE"
+	}
+	public := as + ".private IS NOT TRUE"
+	if o.ViewerID == nil {
+		return public
+	}
+	viewerID := args.Append(*o.ViewerID)
+	return "(" + public + " OR " + as + ".user_id = " + viewerID + ` OR EXISTS (
+		SELECT 1 FROM study_access
+		WHERE study_access.study_id = ` + as + `.id AND study_access.user_id = ` + viewerID + `
+	))`
+}
+
+// notHiddenSQL returns the condition excluding the node with the id idColumn if
+// admins have hidden it. Hidden nodes are only suggested to site admins.
+func (o *SuggestionOptions) notHiddenSQL(idColumn string) string {
+	if o.ViewerIsAdmin {
+		return "TRUE"
+	}
+	return `NOT EXISTS (
+		SELECT 1 FROM hidden_content WHERE hidden_content.subject_id = ` + idColumn + `
+	)`
+}
+
+// notBlockedSQL returns the condition excluding the user with the id
+// userIDColumn if the viewer has blocked them.
+func (o *SuggestionOptions) notBlockedSQL(userIDColumn string, args *pgx.QueryArgs) string {
+	if o.ViewerID == nil {
+		return "TRUE"
+	}
+	return `NOT EXISTS (
+		SELECT 1 FROM user_block
+		WHERE user_block.blocker_id = ` + args.Append(*o.ViewerID) + `
+			AND user_block.blocked_id = ` + userIDColumn + `
+	)`
+}
+
+// likePrefixPattern returns the pattern matching lowercase text starting with
+// prefix.
+func likePrefixPattern(prefix string) string {
+	prefix = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`).Replace(prefix)
+	return strings.ToLower(prefix) + "%"
+}
+
+// GetSuggestions returns up to n nodes with a title starting with query, most
+// popular first.
+func GetSuggestions(
+	db Queryer,
+	query string,
+	n int32,
+	opts *SuggestionOptions,
+) ([]*Suggestion, error) {
+	rows := make([]*Suggestion, 0, n)
+	query = strings.TrimSpace(query)
+	if query == "" || n <= 0 {
+		return rows, nil
+	}
+
+	args := pgx.QueryArgs(make([]interface{}, 0, 8))
+	limit := args.Append(n)
+	// The prefix is only appended to the args once it is used, as unused args
+	// fail to prepare.
+	prefixArg := ""
+	prefix := func() string {
+		if prefixArg == "" {
+			prefixArg = args.Append(likePrefixPattern(query))
+		}
+		return prefixArg
+	}
+
+	suggestions := make([]string, 0, 5)
+	if opts.suggests(SuggestionTypeUser) {
+		suggestions = append(suggestions, `
+			SELECT id, ln(1 + enrollee_count::float8) AS rank, login AS text, 'User' AS type
+			FROM user_search_index
+			WHERE lower(login) LIKE `+prefix()+`
+				AND `+opts.notBlockedSQL("id", &args)+`
+			ORDER BY rank DESC, lower(login)
+			LIMIT `+limit)
+	}
+	if opts.suggests(SuggestionTypeStudy) {
+		var match string
+		// Queries with a slash complete the name of a study of the owner
+		// before it.
+		if i := strings.Index(query, "/"); i > 0 {
+			match = "lower(u.login) = " + args.Append(strings.ToLower(query[:i])) +
+				" AND lower(s.name) LIKE " + args.Append(likePrefixPattern(query[i+1:]))
+		} else {
+			match = "lower(s.name) LIKE " + prefix()
+		}
+		suggestions = append(suggestions, `
+			SELECT s.id, ln(1 + s.apple_count::float8) AS rank, u.login || '/' || s.name AS text, 'Study' AS type
+			FROM study_search_index s
+			JOIN user_search_index u ON u.id = s.user_id
+			WHERE `+match+`
+				AND `+opts.studyVisibleSQL("s", &args)+`
+				AND `+opts.notHiddenSQL("s.id")+`
+				AND `+opts.notBlockedSQL("s.user_id", &args)+`
+			ORDER BY rank DESC, lower(s.name)
+			LIMIT `+limit)
+	}
+	if opts.suggests(SuggestionTypeTopic) {
+		suggestions = append(suggestions, `
+			SELECT id, ln(1 + topiced_count::float8) AS rank, name AS text, 'Topic' AS type
+			FROM topic_search_index
+			WHERE lower(name) LIKE `+prefix()+`
+			ORDER BY rank DESC, lower(name)
+			LIMIT `+limit)
+	}
+	if opts.StudyID != nil &&
+		(opts.suggests(SuggestionTypeLesson) || opts.suggests(SuggestionTypeLabel)) {
+		studyID := args.Append(*opts.StudyID)
+		studyVisible := `EXISTS (
+			SELECT 1 FROM study s
+			WHERE s.id = ` + studyID + `
+				AND ` + opts.studyVisibleSQL("s", &args) + `
+				AND ` + opts.notHiddenSQL("s.id") + `
+				AND ` + opts.notBlockedSQL("s.user_id", &args) + `
+		)`
+		if opts.suggests(SuggestionTypeLesson) {
+			suggestions = append(suggestions, `
+				SELECT id, ln(1 + comment_count::float8) AS rank, title AS text, 'Lesson' AS type
+				FROM lesson_search_index
+				WHERE study_id = `+studyID+`
+					AND published_at IS NOT NULL
+					AND lower(title) LIKE `+prefix()+`
+					AND `+opts.notHiddenSQL("id")+`
+					AND `+studyVisible+`
+				ORDER BY rank DESC, lower(title)
+				LIMIT `+limit)
+		}
+		if opts.suggests(SuggestionTypeLabel) {
+			suggestions = append(suggestions, `
+				SELECT id, ln(1 + (
+					SELECT count(*) FROM labeled WHERE labeled.label_id = label.id
+				)::float8) AS rank, name AS text, 'Label' AS type
+				FROM label
+				WHERE study_id = `+studyID+`
+					AND lower(name) LIKE `+prefix()+`
+					AND `+studyVisible+`
+				ORDER BY rank DESC, lower(name)
+				LIMIT `+limit)
+		}
+	}
+	if len(suggestions) == 0 {
+		return rows, nil
+	}
+
+	sql := `
+		SELECT id, rank, text, type
+		FROM ((` + strings.Join(suggestions, `) UNION ALL (`) + `)) AS suggestion
+		ORDER BY rank DESC, lower(text)
+		LIMIT ` + limit
+
+	psName := preparedName("getSuggestions", sql)
+
+	dbRows, err := prepareQuery(db, psName, sql, args...)
+	if err != nil {
+		mylog.Log.WithError(err).Error(util.Trace(""))
+		return nil, err
+	}
+	defer dbRows.Close()
+
+	for dbRows.Next() {
+		var row Suggestion
+		dbRows.Scan(
+			&row.ID,
+			&row.Rank,
+			&row.Text,
+			&row.Type,
+		)
+		rows = append(rows, &row)
+	}
+
+	if err := dbRows.Err(); err != nil {
+		mylog.Log.WithError(err).Error(util.Trace(""))
+		return nil, err
+	}
+
+	mylog.Log.WithField("n", len(rows)).Info(util.Trace("suggestions found"))
+	return rows, nil
+}
@@ -8,12 +8,19 @@ import (
 	"github.com/marksauter/markus-ninja-api/pkg/mylog"
 	"github.com/marksauter/markus-ninja-api/pkg/mytype"
 	"github.com/marksauter/markus-ninja-api/pkg/util"
+	"github.com/sirupsen/logrus"
 )
 
 type Topic struct {
-	CreatedAt   pgtype.Timestamptz `db:"created_at" permit:"read"`
-	Description pgtype.Text        `db:"description" permit:"create/read/update"`
+	BestMatchRank pgtype.Float8      `db:"best_match_rank"`
+	CreatedAt     pgtype.Timestamptz `db:"created_at" permit:"read"`
+	Description   pgtype.Text        `db:"description" permit:"create/read/update"`
+	// DisplayName is the name the topic is shown with, e.g. 'Go' for 'go'.
+	DisplayName pgtype.Text        `db:"display_name" permit:"read/update"`
+	FeaturedAt  pgtype.Timestamptz `db:"featured_at" permit:"read/update"`
 	ID          mytype.OID         `db:"id" permit:"read"`
+	// LogoID is the ID of the user asset shown as the logo of the topic.
+	LogoID      mytype.OID         `db:"logo_id" permit:"read/update"`
 	Name        mytype.WordName    `db:"name" permit:"create/read"`
 	TopicableID mytype.OID         `db:"topicable_id"`
 	TopicedAt   pgtype.Timestamptz `db:"topiced_at"`
@@ -26,6 +33,10 @@ func topicDelimeter(r rune) bool {
 
 type TopicFilterOptions struct {
 	Search *string
+	// Fuzzy also matches rows with a title similar to the search.
+	Fuzzy bool
+	// CreatedAt are comparisons the creation time of the rows must satisfy.
+	CreatedAt []Comparison
 }
 
 func (src *TopicFilterOptions) SQL(from string, args *pgx.QueryArgs) *SQLParts {
@@ -35,13 +46,11 @@ func (src *TopicFilterOptions) SQL(from string, args *pgx.QueryArgs) *SQLParts {
 
 	fromParts := make([]string, 0, 2)
 	whereParts := make([]string, 0, 3)
+	whereParts = append(whereParts, comparisonsSQL(from+".created_at", src.CreatedAt, args)...)
 	if src.Search != nil {
-		query := ToPrefixTsQuery(*src.Search)
-		fromParts = append(fromParts, "to_tsquery('simple',"+args.Append(query)+") AS document_query")
-		whereParts = append(
-			whereParts,
-			"CASE "+args.Append(query)+" WHEN '*' THEN TRUE ELSE "+from+".document @@ document_query END",
-		)
+		fromPart, wherePart := documentSearchSQL(from, *src.Search, nil, src.titleSearch(), args)
+		fromParts = append(fromParts, fromPart)
+		whereParts = append(whereParts, wherePart)
 	}
 
 	where := ""
@@ -55,6 +64,17 @@ func (src *TopicFilterOptions) SQL(from string, args *pgx.QueryArgs) *SQLParts {
 	}
 }
 
+func (src *TopicFilterOptions) searchesDocument() bool {
+	return src != nil && src.Search != nil
+}
+
+func (src *TopicFilterOptions) titleSearch() *titleSearch {
+	if src == nil {
+		return nil
+	}
+	return newTitleSearch(src.Search, src.Fuzzy, "name")
+}
+
 func CountTopicByTopicable(
 	db Queryer,
 	topicableID string,
@@ -100,6 +120,26 @@ func CountTopicBySearch(
 	return n, err
 }
 
+func CountFeaturedTopic(db Queryer) (int32, error) {
+	args := pgx.QueryArgs(make([]interface{}, 0, 1))
+	where := func(from string) string {
+		return from + `.featured_at IS NOT NULL`
+	}
+	from := "topic_search_index"
+
+	sql := CountSQL(from, where, nil, &args)
+	psName := preparedName("countFeaturedTopic", sql)
+
+	var n int32
+	err := prepareQueryRow(db, psName, sql, args...).Scan(&n)
+	if err != nil {
+		mylog.Log.WithError(err).Error(util.Trace(""))
+	} else {
+		mylog.Log.WithField("n", n).Info(util.Trace("topics found"))
+	}
+	return n, err
+}
+
 func getTopic(
 	db Queryer,
 	name string,
@@ -110,7 +150,10 @@ func getTopic(
 	err := prepareQueryRow(db, name, sql, args...).Scan(
 		&row.CreatedAt,
 		&row.Description,
+		&row.DisplayName,
+		&row.FeaturedAt,
 		&row.ID,
+		&row.LogoID,
 		&row.Name,
 		&row.UpdatedAt,
 	)
@@ -144,7 +187,10 @@ func getManyTopic(
 		dbRows.Scan(
 			&row.CreatedAt,
 			&row.Description,
+			&row.DisplayName,
+			&row.FeaturedAt,
 			&row.ID,
+			&row.LogoID,
 			&row.Name,
 			&row.UpdatedAt,
 		)
@@ -163,7 +209,10 @@ const getTopicByIDSQL = `
 	SELECT
 		created_at,
 		description,
+		display_name,
+		featured_at,
 		id,
+		logo_id,
 		name,
 		updated_at
 	FROM topic_search_index
@@ -187,13 +236,18 @@ const getTopicByNameSQL = `
 	SELECT
 		created_at,
 		description,
+		display_name,
+		featured_at,
 		id,
+		logo_id,
 		name,
 		updated_at
 	FROM topic_search_index
 	WHERE LOWER(name) = LOWER($1)
+		OR id = (SELECT topic_id FROM topic_alias WHERE name = LOWER($1))
 `
 
+// GetTopicByName returns the topic with the name, or with the name as an alias.
 func GetTopicByName(
 	db Queryer,
 	name string,
@@ -207,6 +261,65 @@ func GetTopicByName(
 	return topic, err
 }
 
+// GetFeaturedTopic returns the topics featured by site admins.
+func GetFeaturedTopic(
+	db Queryer,
+	po *PageOptions,
+) ([]*Topic, error) {
+	var rows []*Topic
+	if po != nil && po.Limit() > 0 {
+		limit := po.Limit()
+		if limit > 0 {
+			rows = make([]*Topic, 0, limit)
+		} else {
+			mylog.Log.Info(util.Trace("limit is 0"))
+			return rows, nil
+		}
+	}
+
+	var args pgx.QueryArgs
+	where := func(from string) string {
+		return from + `.featured_at IS NOT NULL`
+	}
+
+	selects := []string{
+		"created_at",
+		"description",
+		"display_name",
+		"featured_at",
+		"id",
+		"logo_id",
+		"name",
+		"updated_at",
+	}
+	from := "topic_search_index"
+	sql := SQL3(selects, from, where, nil, &args, po)
+
+	psName := preparedName("getFeaturedTopics", sql)
+
+	if err := getManyTopic(db, psName, sql, &rows, args...); err != nil {
+		mylog.Log.WithError(err).Error(util.Trace(""))
+		return nil, err
+	}
+
+	mylog.Log.WithField("n", len(rows)).Info(util.Trace("topics found"))
+	return rows, nil
+}
+
+const getTopicByAliasSQL = `
+	SELECT
+		created_at,
+		description,
+		display_name,
+		featured_at,
+		id,
+		logo_id,
+		name,
+		updated_at
+	FROM topic_search_index
+	WHERE id = (SELECT topic_id FROM topic_alias WHERE name = LOWER($1))
+`
+
 const getTopicNamesByTopicableSQL = `
 	SELECT
 		array_agg(name) topic_names
@@ -270,7 +383,10 @@ func GetTopicByTopicable(
 	selects := []string{
 		"created_at",
 		"description",
+		"display_name",
+		"featured_at",
 		"id",
+		"logo_id",
 		"name",
 		"topicable_id",
 		"topiced_at",
@@ -293,7 +409,10 @@ func GetTopicByTopicable(
 		dbRows.Scan(
 			&row.CreatedAt,
 			&row.Description,
+			&row.DisplayName,
+			&row.FeaturedAt,
 			&row.ID,
+			&row.LogoID,
 			&row.Name,
 			&row.TopicableID,
 			&row.TopicedAt,
@@ -349,16 +468,26 @@ func CreateTopic(
 
 	psName := preparedName("createTopic", sql)
 
-	err = prepareQueryRow(tx, psName, sql, args...).Scan(
-		&row.ID,
-	)
-	if err != nil {
-		if pgErr, ok := err.(pgx.PgError); ok {
-			mylog.Log.WithError(pgErr).Error(util.Trace(""))
-			return nil, handlePSQLError(pgErr)
-		}
+	// Topics are not created with the aliases of other topics, which are
+	// returned in their place.
+	aliased, err := getTopic(tx, "getTopicByAlias", getTopicByAliasSQL, row.Name.String)
+	if err == nil {
+		row.ID = aliased.ID
+	} else if err != ErrNotFound {
 		mylog.Log.WithError(err).Error(util.Trace(""))
 		return nil, err
+	} else {
+		err = prepareQueryRow(tx, psName, sql, args...).Scan(
+			&row.ID,
+		)
+		if err != nil {
+			if pgErr, ok := err.(pgx.PgError); ok {
+				mylog.Log.WithError(pgErr).Error(util.Trace(""))
+				return nil, handlePSQLError(pgErr)
+			}
+			mylog.Log.WithError(err).Error(util.Trace(""))
+			return nil, err
+		}
 	}
 
 	if row.TopicableID.Status != pgtype.Undefined {
@@ -410,9 +539,13 @@ func SearchTopic(
 	where := func(string) string { return "" }
 
 	selects := []string{
+		"best_match_rank",
 		"created_at",
 		"description",
+		"display_name",
+		"featured_at",
 		"id",
+		"logo_id",
 		"name",
 		"updated_at",
 	}
@@ -421,7 +554,30 @@ func SearchTopic(
 
 	psName := preparedName("searchTopicIndex", sql)
 
-	if err := getManyTopic(db, psName, sql, &rows, args...); err != nil {
+	dbRows, err := prepareQuery(db, psName, sql, args...)
+	if err != nil {
+		mylog.Log.WithError(err).Error(util.Trace(""))
+		return nil, err
+	}
+	defer dbRows.Close()
+
+	for dbRows.Next() {
+		var row Topic
+		dbRows.Scan(
+			&row.BestMatchRank,
+			&row.CreatedAt,
+			&row.Description,
+			&row.DisplayName,
+			&row.FeaturedAt,
+			&row.ID,
+			&row.LogoID,
+			&row.Name,
+			&row.UpdatedAt,
+		)
+		rows = append(rows, &row)
+	}
+
+	if err := dbRows.Err(); err != nil {
 		mylog.Log.WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
@@ -434,12 +590,21 @@ func UpdateTopic(
 	db Queryer,
 	row *Topic,
 ) (*Topic, error) {
-	sets := make([]string, 0, 1)
-	args := pgx.QueryArgs(make([]interface{}, 0, 2))
+	sets := make([]string, 0, 4)
+	args := pgx.QueryArgs(make([]interface{}, 0, 5))
 
 	if row.Description.Status != pgtype.Undefined {
 		sets = append(sets, `description`+"="+args.Append(&row.Description))
 	}
+	if row.DisplayName.Status != pgtype.Undefined {
+		sets = append(sets, `display_name`+"="+args.Append(&row.DisplayName))
+	}
+	if row.FeaturedAt.Status != pgtype.Undefined {
+		sets = append(sets, `featured_at`+"="+args.Append(&row.FeaturedAt))
+	}
+	if row.LogoID.Status != pgtype.Undefined {
+		sets = append(sets, `logo_id`+"="+args.Append(&row.LogoID))
+	}
 
 	if len(sets) == 0 {
 		mylog.Log.Info(util.Trace("no updates"))
@@ -491,3 +656,40 @@ func UpdateTopic(
 	mylog.Log.WithField("id", row.ID.String).Info(util.Trace("topic updated"))
 	return topic, nil
 }
+
+const mergeTopicsSQL = `
+	SELECT merge_topics($1, $2)
+`
+
+// MergeTopics merges the source topic into the target, leaving the name of the
+// source as an alias of the target, and returns the number of topicables moved
+// to the target.
+func MergeTopics(
+	db Queryer,
+	sourceID,
+	targetID string,
+) (int64, error) {
+	var n pgtype.Int8
+	err := prepareQueryRow(
+		db,
+		"mergeTopics",
+		mergeTopicsSQL,
+		sourceID,
+		targetID,
+	).Scan(&n)
+	if err != nil {
+		if pgErr, ok := err.(pgx.PgError); ok {
+			mylog.Log.WithError(pgErr).Error(util.Trace(""))
+			return 0, handlePSQLError(pgErr)
+		}
+		mylog.Log.WithError(err).Error(util.Trace(""))
+		return 0, err
+	}
+
+	mylog.Log.WithFields(logrus.Fields{
+		"source_id": sourceID,
+		"target_id": targetID,
+		"n":         n.Int,
+	}).Info(util.Trace("topics merged"))
+	return n.Int, nil
+}
@@ -0,0 +1,97 @@
+package data
+
+import (
+	"github.com/jackc/pgx"
+	"github.com/jackc/pgx/pgtype"
+	"github.com/marksauter/markus-ninja-api/pkg/mylog"
+	"github.com/marksauter/markus-ninja-api/pkg/util"
+	"github.com/sirupsen/logrus"
+)
+
+const getTopicAliasNamesByTopicSQL = `
+	SELECT coalesce(array_agg(name ORDER BY name), '{}')
+	FROM topic_alias
+	WHERE topic_id = $1
+`
+
+// GetTopicAliasNames returns the aliases of the topic, in order.
+func GetTopicAliasNames(
+	db Queryer,
+	topicID string,
+) ([]string, error) {
+	names := []string{}
+	aliasNames := pgtype.TextArray{}
+	err := prepareQueryRow(
+		db,
+		"getTopicAliasNamesByTopic",
+		getTopicAliasNamesByTopicSQL,
+		topicID,
+	).Scan(&aliasNames)
+	if err != nil {
+		mylog.Log.WithError(err).Error(util.Trace(""))
+		return nil, err
+	}
+
+	if err := aliasNames.AssignTo(&names); err != nil {
+		mylog.Log.WithError(err).Error(util.Trace(""))
+		return nil, err
+	}
+
+	mylog.Log.WithField("n", len(names)).Info(util.Trace("topic aliases found"))
+	return names, nil
+}
+
+const createTopicAliasSQL = `
+	INSERT INTO topic_alias(name, topic_id)
+	VALUES (lower($1), $2)
+`
+
+// CreateTopicAlias makes name an alias of the topic. Names of topics, and
+// aliases of other topics, cannot be aliases.
+func CreateTopicAlias(
+	db Queryer,
+	name,
+	topicID string,
+) error {
+	_, err := prepareExec(db, "createTopicAlias", createTopicAliasSQL, name, topicID)
+	if err != nil {
+		if pgErr, ok := err.(pgx.PgError); ok {
+			mylog.Log.WithError(pgErr).Error(util.Trace(""))
+			return handlePSQLError(pgErr)
+		}
+		mylog.Log.WithError(err).Error(util.Trace(""))
+		return err
+	}
+
+	mylog.Log.WithFields(logrus.Fields{
+		"name":     name,
+		"topic_id": topicID,
+	}).Info(util.Trace("topic alias created"))
+	return nil
+}
+
+const deleteTopicAliasSQL = `
+	DELETE FROM topic_alias
+	WHERE name = lower($1)
+	RETURNING topic_id
+`
+
+// DeleteTopicAlias deletes the alias, and returns the ID of the topic it was an
+// alias of.
+func DeleteTopicAlias(
+	db Queryer,
+	name string,
+) (string, error) {
+	var topicID pgtype.Varchar
+	err := prepareQueryRow(db, "deleteTopicAlias", deleteTopicAliasSQL, name).Scan(&topicID)
+	if err == pgx.ErrNoRows {
+		mylog.Log.WithField("name", name).WithError(err).Error(util.Trace(""))
+		return "", ErrNotFound
+	} else if err != nil {
+		mylog.Log.WithError(err).Error(util.Trace(""))
+		return "", err
+	}
+
+	mylog.Log.WithField("name", name).Info(util.Trace("topic alias deleted"))
+	return topicID.String, nil
+}
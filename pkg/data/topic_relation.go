@@ -0,0 +1,98 @@
+package data
+
+import (
+	"github.com/jackc/pgx"
+	"github.com/marksauter/markus-ninja-api/pkg/mylog"
+	"github.com/marksauter/markus-ninja-api/pkg/util"
+	"github.com/sirupsen/logrus"
+)
+
+const getRelatedTopicSQL = `
+	SELECT
+		created_at,
+		description,
+		display_name,
+		featured_at,
+		id,
+		logo_id,
+		name,
+		updated_at
+	FROM related_topic
+	WHERE related_to_id = $1
+	ORDER BY name
+`
+
+// GetRelatedTopic returns the topics related to the topic, by name.
+func GetRelatedTopic(
+	db Queryer,
+	topicID string,
+) ([]*Topic, error) {
+	var rows []*Topic
+	err := getManyTopic(db, "getRelatedTopic", getRelatedTopicSQL, &rows, topicID)
+	if err != nil {
+		mylog.Log.WithError(err).Error(util.Trace(""))
+		return nil, err
+	}
+
+	mylog.Log.WithField("n", len(rows)).Info(util.Trace("topics found"))
+	return rows, nil
+}
+
+const deleteTopicRelationByTopicSQL = `
+	DELETE FROM topic_relation
+	WHERE topic_id = $1
+`
+
+const createTopicRelationSQL = `
+	INSERT INTO topic_relation(related_id, topic_id)
+	VALUES ($1, $2)
+	ON CONFLICT DO NOTHING
+`
+
+// ReplaceTopicRelations replaces the topics related to the topic with those of
+// relatedIDs.
+func ReplaceTopicRelations(
+	db Queryer,
+	topicID string,
+	relatedIDs []string,
+) error {
+	tx, err, newTx := BeginTransaction(db)
+	if err != nil {
+		mylog.Log.WithError(err).Error(util.Trace(""))
+		return err
+	}
+	if newTx {
+		defer RollbackTransaction(tx)
+	}
+
+	_, err = prepareExec(tx, "deleteTopicRelationByTopic", deleteTopicRelationByTopicSQL, topicID)
+	if err != nil {
+		mylog.Log.WithError(err).Error(util.Trace(""))
+		return err
+	}
+	for _, relatedID := range relatedIDs {
+		_, err := prepareExec(tx, "createTopicRelation", createTopicRelationSQL, relatedID, topicID)
+		if err != nil {
+			if pgErr, ok := err.(pgx.PgError); ok {
+				mylog.Log.WithError(pgErr).Error(util.Trace(""))
+				return handlePSQLError(pgErr)
+			}
+			mylog.Log.WithError(err).Error(util.Trace(""))
+			return err
+		}
+	}
+
+	if newTx {
+		err = CommitTransaction(tx)
+		if err != nil {
+			mylog.Log.WithError(err).Error(util.Trace(""))
+			return err
+		}
+	}
+
+	mylog.Log.WithFields(logrus.Fields{
+		"n":        len(relatedIDs),
+		"topic_id": topicID,
+	}).Info(util.Trace("topic relations replaced"))
+	return nil
+}
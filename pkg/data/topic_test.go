@@ -0,0 +1,93 @@
+package data_test
+
+import (
+	"testing"
+
+	"github.com/marksauter/markus-ninja-api/pkg/data"
+	"github.com/marksauter/markus-ninja-api/pkg/mydb"
+)
+
+func createTopic(t *testing.T, testDb *mydb.TestDB, name string) *data.Topic {
+	topic := &data.Topic{}
+	topic.Name.Set(name)
+	topic, err := data.CreateTopic(testDb.DB, topic)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return topic
+}
+
+// deleteTopics deletes the topics, as TestDB.Empty leaves them, along with
+// their aliases.
+func deleteTopics(t *testing.T, testDb *mydb.TestDB, names ...string) {
+	if _, err := testDb.DB.Exec(
+		"DELETE FROM topic WHERE name = ANY($1)",
+		names,
+	); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestDataTopicAliasLifeCycle(t *testing.T) {
+	testDb := mydb.NewTestDB(t)
+	defer deleteTopics(t, testDb, "golang")
+
+	topic := createTopic(t, testDb, "golang")
+
+	if err := data.CreateTopicAlias(testDb.DB, "Go", topic.ID.String); err != nil {
+		t.Fatal(err)
+	}
+	if err := data.CreateTopicAlias(testDb.DB, "golang", topic.ID.String); err == nil {
+		t.Errorf("Expected an error making the topic's name an alias")
+	}
+
+	names, err := data.GetTopicAliasNames(testDb.DB, topic.ID.String)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(names) != 1 || names[0] != "go" {
+		t.Errorf("Expected %v, got %v", []string{"go"}, names)
+	}
+
+	aliased, err := data.GetTopicByName(testDb.DB, "GO")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if aliased.ID.String != topic.ID.String {
+		t.Errorf("Expected %v, got %v", topic.ID.String, aliased.ID.String)
+	}
+
+	topicID, err := data.DeleteTopicAlias(testDb.DB, "go")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if topicID != topic.ID.String {
+		t.Errorf("Expected %v, got %v", topic.ID.String, topicID)
+	}
+	if _, err := data.GetTopicByName(testDb.DB, "go"); err != data.ErrNotFound {
+		t.Errorf("Expected %v, got %v", data.ErrNotFound, err)
+	}
+}
+
+func TestDataMergeTopics(t *testing.T) {
+	testDb := mydb.NewTestDB(t)
+	defer deleteTopics(t, testDb, "js", "javascript")
+
+	source := createTopic(t, testDb, "js")
+	target := createTopic(t, testDb, "javascript")
+
+	if _, err := data.MergeTopics(testDb.DB, source.ID.String, target.ID.String); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := data.GetTopic(testDb.DB, source.ID.String); err != data.ErrNotFound {
+		t.Errorf("Expected %v, got %v", data.ErrNotFound, err)
+	}
+	merged, err := data.GetTopicByName(testDb.DB, "js")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if merged.ID.String != target.ID.String {
+		t.Errorf("Expected %v, got %v", target.ID.String, merged.ID.String)
+	}
+}
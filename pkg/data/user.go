@@ -13,7 +13,9 @@ import (
 type User struct {
 	AccountUpdatedAt pgtype.Timestamptz `db:"account_updated_at" permit:"read"`
 	AppledAt         pgtype.Timestamptz `db:"appled_at"`
+	BestMatchRank    pgtype.Float8      `db:"best_match_rank"`
 	Bio              pgtype.Text        `db:"bio" permit:"read/update"`
+	BlockedAt        pgtype.Timestamptz `db:"blocked_at"`
 	CreatedAt        pgtype.Timestamptz `db:"created_at" permit:"read"`
 	EnrolledAt       pgtype.Timestamptz `db:"enrolled_at"`
 	ID               mytype.OID         `db:"id" permit:"read"`
@@ -33,6 +35,10 @@ func userDelimeter(r rune) bool {
 
 type UserFilterOptions struct {
 	Search *string
+	// Fuzzy also matches rows with a title similar to the search.
+	Fuzzy bool
+	// CreatedAt are comparisons the creation time of the rows must satisfy.
+	CreatedAt []Comparison
 }
 
 func (src *UserFilterOptions) SQL(from string, args *pgx.QueryArgs) *SQLParts {
@@ -42,13 +48,11 @@ func (src *UserFilterOptions) SQL(from string, args *pgx.QueryArgs) *SQLParts {
 
 	fromParts := make([]string, 0, 2)
 	whereParts := make([]string, 0, 2)
+	whereParts = append(whereParts, comparisonsSQL(from+".created_at", src.CreatedAt, args)...)
 	if src.Search != nil {
-		query := ToPrefixTsQuery(*src.Search)
-		fromParts = append(fromParts, "to_tsquery('simple',"+args.Append(query)+") AS document_query")
-		whereParts = append(
-			whereParts,
-			"CASE "+args.Append(query)+" WHEN '*' THEN TRUE ELSE "+from+".document @@ document_query END",
-		)
+		fromPart, wherePart := documentSearchSQL(from, *src.Search, nil, src.titleSearch(), args)
+		fromParts = append(fromParts, fromPart)
+		whereParts = append(whereParts, wherePart)
 	}
 
 	where := ""
@@ -62,6 +66,17 @@ func (src *UserFilterOptions) SQL(from string, args *pgx.QueryArgs) *SQLParts {
 	}
 }
 
+func (src *UserFilterOptions) searchesDocument() bool {
+	return src != nil && src.Search != nil
+}
+
+func (src *UserFilterOptions) titleSearch() *titleSearch {
+	if src == nil {
+		return nil
+	}
+	return newTitleSearch(src.Search, src.Fuzzy, "login", "name")
+}
+
 func CountUserByAppleable(
 	db Queryer,
 	appleableID string,
@@ -86,6 +101,30 @@ func CountUserByAppleable(
 	return n, err
 }
 
+func CountUserByBlocker(
+	db Queryer,
+	blockerID string,
+	filters *UserFilterOptions,
+) (int32, error) {
+	args := pgx.QueryArgs(make([]interface{}, 0, 4))
+	where := func(from string) string {
+		return from + `.blocker_id = ` + args.Append(blockerID)
+	}
+	from := "blocked_user"
+
+	sql := CountSQL(from, where, filters, &args)
+	psName := preparedName("countUserByBlocker", sql)
+
+	var n int32
+	err := prepareQueryRow(db, psName, sql, args...).Scan(&n)
+	if err != nil {
+		mylog.Log.WithError(err).Error(util.Trace(""))
+	} else {
+		mylog.Log.WithField("n", n).Info(util.Trace("users found"))
+	}
+	return n, err
+}
+
 func CountUserByEnrollable(
 	db Queryer,
 	enrollableID string,
@@ -498,6 +537,80 @@ func GetUserByAppleable(
 	return rows, nil
 }
 
+func GetUserByBlocker(
+	db Queryer,
+	blockerID string,
+	po *PageOptions,
+	filters *UserFilterOptions,
+) ([]*User, error) {
+	var rows []*User
+	if po != nil && po.Limit() > 0 {
+		limit := po.Limit()
+		if limit > 0 {
+			rows = make([]*User, 0, limit)
+		} else {
+			mylog.Log.Info(util.Trace("limit is 0"))
+			return rows, nil
+		}
+	}
+
+	args := pgx.QueryArgs(make([]interface{}, 0, 4))
+	where := func(from string) string {
+		return from + `.blocker_id = ` + args.Append(blockerID)
+	}
+
+	selects := []string{
+		"account_updated_at",
+		"bio",
+		"blocked_at",
+		"created_at",
+		"id",
+		"login",
+		"name",
+		"profile_email_id",
+		"profile_updated_at",
+		"roles",
+		"verified",
+	}
+	from := "blocked_user"
+	sql := SQL3(selects, from, where, filters, &args, po)
+
+	psName := preparedName("getUsersByBlocker", sql)
+
+	dbRows, err := prepareQuery(db, psName, sql, args...)
+	if err != nil {
+		mylog.Log.WithError(err).Error(util.Trace(""))
+		return nil, err
+	}
+	defer dbRows.Close()
+
+	for dbRows.Next() {
+		var row User
+		dbRows.Scan(
+			&row.AccountUpdatedAt,
+			&row.Bio,
+			&row.BlockedAt,
+			&row.CreatedAt,
+			&row.ID,
+			&row.Login,
+			&row.Name,
+			&row.ProfileEmailID,
+			&row.ProfileUpdatedAt,
+			&row.Roles,
+			&row.Verified,
+		)
+		rows = append(rows, &row)
+	}
+
+	if err := dbRows.Err(); err != nil {
+		mylog.Log.WithError(err).Error(util.Trace(""))
+		return nil, err
+	}
+
+	mylog.Log.WithField("n", len(rows)).Info(util.Trace("users found"))
+	return rows, nil
+}
+
 func GetUserByEnrollee(
 	db Queryer,
 	enrolleeID string,
@@ -880,6 +993,7 @@ func SearchUser(
 
 	selects := []string{
 		"account_updated_at",
+		"best_match_rank",
 		"bio",
 		"created_at",
 		"id",
@@ -895,7 +1009,32 @@ func SearchUser(
 
 	psName := preparedName("searchUserIndex", sql)
 
-	if err := getManyUser(db, psName, sql, &rows, args...); err != nil {
+	dbRows, err := prepareQuery(db, psName, sql, args...)
+	if err != nil {
+		mylog.Log.WithError(err).Error(util.Trace(""))
+		return nil, err
+	}
+	defer dbRows.Close()
+
+	for dbRows.Next() {
+		var row User
+		dbRows.Scan(
+			&row.AccountUpdatedAt,
+			&row.BestMatchRank,
+			&row.Bio,
+			&row.CreatedAt,
+			&row.ID,
+			&row.Login,
+			&row.Name,
+			&row.ProfileEmailID,
+			&row.ProfileUpdatedAt,
+			&row.Roles,
+			&row.Verified,
+		)
+		rows = append(rows, &row)
+	}
+
+	if err := dbRows.Err(); err != nil {
 		mylog.Log.WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
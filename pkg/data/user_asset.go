@@ -15,6 +15,7 @@ type UserAsset struct {
 	ActivityID     mytype.OID         `db:"activity_id" permit:"read"`
 	ActivityNumber pgtype.Int4        `db:"activity_number" permit:"read"`
 	AssetID        pgtype.Int8        `db:"asset_id" permit:"create/read"`
+	BestMatchRank  pgtype.Float8      `db:"best_match_rank"`
 	CreatedAt      pgtype.Timestamptz `db:"created_at" permit:"read"`
 	Description    pgtype.Text        `db:"description" permit:"create/read/update"`
 	ID             mytype.OID         `db:"id" permit:"read"`
@@ -66,6 +67,19 @@ type UserAssetFilterOptions struct {
 	Labels             *[]string
 	ActivityNotEqualTo *string
 	Search             *string
+	// Fuzzy also matches rows with a title similar to the search.
+	Fuzzy bool
+	// SearchLanguage is the text search configuration the search is also
+	// stemmed with, that of the study the rows belong to.
+	SearchLanguage *string
+	// CreatedAt are comparisons the creation time of the rows must satisfy.
+	CreatedAt []Comparison
+	// StudyNameWithOwner limits the rows to those in the study, i.e. owner/name.
+	StudyNameWithOwner *string
+	// Type limits the assets to those of the type, e.g. image.
+	Type *string
+	// UserLogin limits the rows to those of the user with the login.
+	UserLogin *string
 }
 
 func (src *UserAssetFilterOptions) SQL(from string, args *pgx.QueryArgs) *SQLParts {
@@ -97,13 +111,20 @@ func (src *UserAssetFilterOptions) SQL(from string, args *pgx.QueryArgs) *SQLPar
 			mylog.Log.WithError(err).Error("invalid activity_id for user asset filter ActivityNotEqualTo")
 		}
 	}
+	whereParts = append(whereParts, comparisonsSQL(from+".created_at", src.CreatedAt, args)...)
+	if src.StudyNameWithOwner != nil {
+		whereParts = append(whereParts, studyNameWithOwnerSQL(from, *src.StudyNameWithOwner, args))
+	}
+	if src.Type != nil {
+		whereParts = append(whereParts, from+".type = "+args.Append(*src.Type))
+	}
+	if src.UserLogin != nil {
+		whereParts = append(whereParts, userLoginSQL(from, *src.UserLogin, args))
+	}
 	if src.Search != nil {
-		query := ToPrefixTsQuery(*src.Search)
-		fromParts = append(fromParts, "to_tsquery('simple',"+args.Append(query)+") AS document_query")
-		whereParts = append(
-			whereParts,
-			"CASE "+args.Append(query)+" WHEN '*' THEN TRUE ELSE "+from+".document @@ document_query END",
-		)
+		fromPart, wherePart := documentSearchSQL(from, *src.Search, src.SearchLanguage, src.titleSearch(), args)
+		fromParts = append(fromParts, fromPart)
+		whereParts = append(whereParts, wherePart)
 	}
 
 	where := ""
@@ -117,6 +138,17 @@ func (src *UserAssetFilterOptions) SQL(from string, args *pgx.QueryArgs) *SQLPar
 	}
 }
 
+func (src *UserAssetFilterOptions) searchesDocument() bool {
+	return src != nil && src.Search != nil
+}
+
+func (src *UserAssetFilterOptions) titleSearch() *titleSearch {
+	if src == nil {
+		return nil
+	}
+	return newTitleSearch(src.Search, src.Fuzzy, "name")
+}
+
 func CountUserAssetByActivity(
 	db Queryer,
 	activityID string,
@@ -972,6 +1004,7 @@ func SearchUserAsset(
 		"activity_id",
 		"activity_number",
 		"asset_id",
+		"best_match_rank",
 		"created_at",
 		"description",
 		"id",
@@ -990,7 +1023,37 @@ func SearchUserAsset(
 
 	psName := preparedName("searchUserAssetIndex", sql)
 
-	if err := getManyUserAsset(db, psName, sql, &rows, args...); err != nil {
+	dbRows, err := prepareQuery(db, psName, sql, args...)
+	if err != nil {
+		mylog.Log.WithError(err).Error(util.Trace(""))
+		return nil, err
+	}
+	defer dbRows.Close()
+
+	for dbRows.Next() {
+		var row UserAsset
+		dbRows.Scan(
+			&row.ActivityID,
+			&row.ActivityNumber,
+			&row.AssetID,
+			&row.BestMatchRank,
+			&row.CreatedAt,
+			&row.Description,
+			&row.ID,
+			&row.Key,
+			&row.Name,
+			&row.OriginalName,
+			&row.Size,
+			&row.StudyID,
+			&row.Subtype,
+			&row.Type,
+			&row.UpdatedAt,
+			&row.UserID,
+		)
+		rows = append(rows, &row)
+	}
+
+	if err := dbRows.Err(); err != nil {
 		mylog.Log.WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
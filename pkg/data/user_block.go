@@ -0,0 +1,237 @@
+package data
+
+import (
+	"github.com/jackc/pgx"
+	"github.com/jackc/pgx/pgtype"
+	"github.com/marksauter/markus-ninja-api/pkg/mylog"
+	"github.com/marksauter/markus-ninja-api/pkg/mytype"
+	"github.com/marksauter/markus-ninja-api/pkg/util"
+	"github.com/sirupsen/logrus"
+)
+
+// UserBlock is a user's block of another user. Blocked users may not enroll
+// in, apple, or comment on the blocker's content, and their mentions of the
+// blocker do not notify.
+type UserBlock struct {
+	BlockedID mytype.OID         `db:"blocked_id"`
+	BlockerID mytype.OID         `db:"blocker_id"`
+	CreatedAt pgtype.Timestamptz `db:"created_at"`
+}
+
+const batchGetUserBlockSQL = `
+	SELECT
+		blocked_id,
+		blocker_id,
+		created_at
+	FROM user_block
+	WHERE blocker_id = ANY($1)
+`
+
+// BatchGetUserBlock returns the blocks made by the users in blockerIDs.
+func BatchGetUserBlock(
+	db Queryer,
+	blockerIDs []string,
+) ([]*UserBlock, error) {
+	dbRows, err := prepareQuery(db, "batchGetUserBlock", batchGetUserBlockSQL, blockerIDs)
+	if err != nil {
+		mylog.Log.WithError(err).Error(util.Trace(""))
+		return nil, err
+	}
+	defer dbRows.Close()
+
+	rows := make([]*UserBlock, 0, len(blockerIDs))
+	for dbRows.Next() {
+		var row UserBlock
+		dbRows.Scan(
+			&row.BlockedID,
+			&row.BlockerID,
+			&row.CreatedAt,
+		)
+		rows = append(rows, &row)
+	}
+
+	if err := dbRows.Err(); err != nil {
+		mylog.Log.WithError(err).Error(util.Trace(""))
+		return nil, err
+	}
+
+	mylog.Log.WithField("n", len(rows)).Info(util.Trace("user blocks found"))
+	return rows, nil
+}
+
+const getUserBlockerIDsSQL = `
+	SELECT blocker_id
+	FROM user_block
+	WHERE blocked_id = $1
+`
+
+// GetUserBlockerIDs returns the IDs of the users that have blocked the user.
+func GetUserBlockerIDs(
+	db Queryer,
+	blockedID string,
+) ([]string, error) {
+	dbRows, err := prepareQuery(db, "getUserBlockerIDs", getUserBlockerIDsSQL, blockedID)
+	if err != nil {
+		mylog.Log.WithError(err).Error(util.Trace(""))
+		return nil, err
+	}
+	defer dbRows.Close()
+
+	blockerIDs := []string{}
+	for dbRows.Next() {
+		var blockerID string
+		dbRows.Scan(&blockerID)
+		blockerIDs = append(blockerIDs, blockerID)
+	}
+
+	if err := dbRows.Err(); err != nil {
+		mylog.Log.WithError(err).Error(util.Trace(""))
+		return nil, err
+	}
+
+	mylog.Log.WithField("n", len(blockerIDs)).Info(util.Trace("user blockers found"))
+	return blockerIDs, nil
+}
+
+const existsUserBlockSQL = `
+	SELECT exists(
+		SELECT 1
+		FROM user_block
+		WHERE blocker_id = $1 AND blocked_id = $2
+	)
+`
+
+func ExistsUserBlock(
+	db Queryer,
+	blockerID,
+	blockedID string,
+) (bool, error) {
+	var exists bool
+	err := prepareQueryRow(
+		db,
+		"existsUserBlock",
+		existsUserBlockSQL,
+		blockerID,
+		blockedID,
+	).Scan(&exists)
+	if err != nil {
+		mylog.Log.WithError(err).Error(util.Trace(""))
+		return false, err
+	}
+
+	return exists, nil
+}
+
+const createUserBlockSQL = `
+	INSERT INTO user_block(blocked_id, blocker_id)
+	VALUES ($1, $2)
+	ON CONFLICT (blocker_id, blocked_id) DO NOTHING
+`
+
+// The blocked user's enrollments in the blocker, and in the blocker's studies
+// and lessons.
+const deleteBlockedEnrolledSQL = `
+	DELETE FROM enrolled
+	WHERE user_id = $1
+		AND (
+			enrollable_id = $2
+			OR enrollable_id IN (SELECT id FROM study WHERE user_id = $2)
+			OR enrollable_id IN (SELECT id FROM lesson WHERE user_id = $2)
+		)
+`
+
+// BlockUser creates the block of blockedID by blockerID, and removes the
+// blocked user's existing enrollments in the blocker's content.
+func BlockUser(
+	db Queryer,
+	blockerID,
+	blockedID string,
+) error {
+	tx, err, newTx := BeginTransaction(db)
+	if err != nil {
+		mylog.Log.WithError(err).Error(util.Trace(""))
+		return err
+	}
+	if newTx {
+		defer RollbackTransaction(tx)
+	}
+
+	if _, err := prepareExec(
+		tx,
+		"createUserBlock",
+		createUserBlockSQL,
+		blockedID,
+		blockerID,
+	); err != nil {
+		if pgErr, ok := err.(pgx.PgError); ok {
+			mylog.Log.WithError(pgErr).Error(util.Trace(""))
+			return handlePSQLError(pgErr)
+		}
+		mylog.Log.WithError(err).Error(util.Trace(""))
+		return err
+	}
+
+	commandTag, err := prepareExec(
+		tx,
+		"deleteBlockedEnrolled",
+		deleteBlockedEnrolledSQL,
+		blockedID,
+		blockerID,
+	)
+	if err != nil {
+		mylog.Log.WithError(err).Error(util.Trace(""))
+		return err
+	}
+
+	if newTx {
+		err = CommitTransaction(tx)
+		if err != nil {
+			mylog.Log.WithError(err).Error(util.Trace(""))
+			return err
+		}
+	}
+
+	mylog.Log.WithFields(logrus.Fields{
+		"blocker_id":        blockerID,
+		"blocked_id":        blockedID,
+		"enrolleds_deleted": commandTag.RowsAffected(),
+	}).Info(util.Trace("user blocked"))
+	return nil
+}
+
+const deleteUserBlockSQL = `
+	DELETE FROM user_block
+	WHERE blocker_id = $1 AND blocked_id = $2
+`
+
+func UnblockUser(
+	db Queryer,
+	blockerID,
+	blockedID string,
+) error {
+	commandTag, err := prepareExec(
+		db,
+		"deleteUserBlock",
+		deleteUserBlockSQL,
+		blockerID,
+		blockedID,
+	)
+	if err != nil {
+		mylog.Log.WithError(err).Error(util.Trace(""))
+		return err
+	}
+	if commandTag.RowsAffected() != 1 {
+		err := ErrNotFound
+		mylog.Log.WithFields(logrus.Fields{
+			"blocker_id": blockerID,
+			"blocked_id": blockedID,
+		}).WithError(err).Error(util.Trace(""))
+		return err
+	}
+
+	mylog.Log.WithFields(logrus.Fields{
+		"blocker_id": blockerID,
+		"blocked_id": blockedID,
+	}).Info(util.Trace("user unblocked"))
+	return nil
+}
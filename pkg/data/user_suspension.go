@@ -0,0 +1,78 @@
+package data
+
+import (
+	"github.com/marksauter/markus-ninja-api/pkg/mylog"
+	"github.com/marksauter/markus-ninja-api/pkg/util"
+)
+
+const batchGetUserSuspensionSQL = `
+	SELECT user_id
+	FROM user_suspension
+	WHERE user_id = ANY($1)
+`
+
+// BatchGetUserSuspension returns the IDs of the users in userIDs that have
+// been suspended by an admin.
+func BatchGetUserSuspension(
+	db Queryer,
+	userIDs []string,
+) ([]string, error) {
+	dbRows, err := prepareQuery(db, "batchGetUserSuspension", batchGetUserSuspensionSQL, userIDs)
+	if err != nil {
+		mylog.Log.WithError(err).Error(util.Trace(""))
+		return nil, err
+	}
+	defer dbRows.Close()
+
+	suspended := make([]string, 0, len(userIDs))
+	for dbRows.Next() {
+		var userID string
+		dbRows.Scan(&userID)
+		suspended = append(suspended, userID)
+	}
+
+	if err := dbRows.Err(); err != nil {
+		mylog.Log.WithError(err).Error(util.Trace(""))
+		return nil, err
+	}
+
+	mylog.Log.WithField("n", len(suspended)).Info(util.Trace("user suspensions found"))
+	return suspended, nil
+}
+
+const suspendUserSQL = `
+	INSERT INTO user_suspension(user_id)
+	VALUES ($1)
+	ON CONFLICT (user_id) DO NOTHING
+`
+
+func SuspendUser(
+	db Queryer,
+	userID string,
+) error {
+	if _, err := prepareExec(db, "suspendUser", suspendUserSQL, userID); err != nil {
+		mylog.Log.WithError(err).Error(util.Trace(""))
+		return err
+	}
+
+	mylog.Log.WithField("user_id", userID).Info(util.Trace("user suspended"))
+	return nil
+}
+
+const unsuspendUserSQL = `
+	DELETE FROM user_suspension
+	WHERE user_id = $1
+`
+
+func UnsuspendUser(
+	db Queryer,
+	userID string,
+) error {
+	if _, err := prepareExec(db, "unsuspendUser", unsuspendUserSQL, userID); err != nil {
+		mylog.Log.WithError(err).Error(util.Trace(""))
+		return err
+	}
+
+	mylog.Log.WithField("user_id", userID).Info(util.Trace("user unsuspended"))
+	return nil
+}
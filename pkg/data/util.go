@@ -19,16 +19,43 @@ func ToTsQuery(query string) string {
 	return strings.Join(words, " & ")
 }
 
+// ToPrefixTsQuery returns the tsquery matching the words of query, or words
+// they prefix. Quoted phrases match words that follow each other, and words and
+// phrases negated with a leading '-' must not match.
 func ToPrefixTsQuery(query string) string {
 	query = strings.TrimSpace(query)
 	if query == "" || query == "*" {
 		return "*"
 	}
-	words := strings.FieldsFunc(query, queryDelimiter)
-	for i, v := range words {
-		words[i] = v + ":*"
+	tokens, _ := tokenizeSearchQuery(query)
+	terms := make([]string, 0, len(tokens))
+	for _, t := range tokens {
+		words := strings.FieldsFunc(t.text, queryDelimiter)
+		if len(words) == 0 {
+			continue
+		}
+		for i, v := range words {
+			words[i] = v + ":*"
+		}
+		operator := " & "
+		if t.quoted {
+			operator = " <-> "
+		}
+		term := strings.Join(words, operator)
+		if t.negated {
+			if len(words) > 1 {
+				term = "(" + term + ")"
+			}
+			term = "!" + term
+		} else if t.quoted && len(words) > 1 {
+			term = "(" + term + ")"
+		}
+		terms = append(terms, term)
 	}
-	return strings.Join(words, " & ")
+	if len(terms) == 0 {
+		return "*"
+	}
+	return strings.Join(terms, " & ")
 }
 
 func ToLikeAnyPatternQuery(query string) *pgtype.TextArray {
@@ -44,5 +71,10 @@ func ToLikeAnyPatternQuery(query string) *pgtype.TextArray {
 func queryDelimiter(r rune) bool {
 	return r == ' ' ||
 		r == '_' ||
-		r == '-'
+		r == '-' ||
+		strings.ContainsRune(tsQueryOperators, r)
 }
+
+// tsQueryOperators are the characters with special meaning in a tsquery, which
+// are dropped from the words of a query.
+const tsQueryOperators = `!&|():*<>'"\`
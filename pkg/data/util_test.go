@@ -26,6 +26,22 @@ var toTsQueryTests = []struct {
 		"fooBar baz-qux",
 		"fooBar:* & baz:* & qux:*",
 	},
+	{
+		`"foo bar" baz`,
+		"(foo:* <-> bar:*) & baz:*",
+	},
+	{
+		`foo -bar -"baz qux"`,
+		"foo:* & !bar:* & !(baz:* <-> qux:*)",
+	},
+	{
+		"foo:bar (baz)",
+		"foo:* & bar:* & baz:*",
+	},
+	{
+		"- ()",
+		"*",
+	},
 }
 
 func TestToTsQuery(t *testing.T) {
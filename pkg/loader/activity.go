@@ -17,6 +17,7 @@ import (
 func NewActivityLoader() *ActivityLoader {
 	return &ActivityLoader{
 		batchGet: createLoader(
+			"activity.get",
 			func(ctx context.Context, keys dataloader.Keys) []*dataloader.Result {
 				var (
 					n       = len(keys)
@@ -45,6 +46,7 @@ func NewActivityLoader() *ActivityLoader {
 			},
 		),
 		batchGetByName: createLoader(
+			"activity.get_by_name",
 			func(ctx context.Context, keys dataloader.Keys) []*dataloader.Result {
 				var (
 					n       = len(keys)
@@ -74,6 +76,7 @@ func NewActivityLoader() *ActivityLoader {
 			},
 		),
 		batchGetByNumber: createLoader(
+			"activity.get_by_number",
 			func(ctx context.Context, keys dataloader.Keys) []*dataloader.Result {
 				var (
 					n       = len(keys)
@@ -108,6 +111,7 @@ func NewActivityLoader() *ActivityLoader {
 			},
 		),
 		batchGetByStudyAndName: createLoader(
+			"activity.get_by_study_and_name",
 			func(ctx context.Context, keys dataloader.Keys) []*dataloader.Result {
 				var (
 					n       = len(keys)
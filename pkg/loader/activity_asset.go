@@ -17,6 +17,7 @@ import (
 func NewActivityAssetLoader() *ActivityAssetLoader {
 	return &ActivityAssetLoader{
 		batchGet: createLoader(
+			"activity_asset.get",
 			func(ctx context.Context, keys dataloader.Keys) []*dataloader.Result {
 				var (
 					n       = len(keys)
@@ -45,6 +46,7 @@ func NewActivityAssetLoader() *ActivityAssetLoader {
 			},
 		),
 		batchGetByActivityAndNumber: createLoader(
+			"activity_asset.get_by_activity_and_number",
 			func(ctx context.Context, keys dataloader.Keys) []*dataloader.Result {
 				var (
 					n       = len(keys)
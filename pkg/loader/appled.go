@@ -15,6 +15,7 @@ import (
 func NewAppledLoader() *AppledLoader {
 	return &AppledLoader{
 		batchGet: createLoader(
+			"appled.get",
 			func(ctx context.Context, keys dataloader.Keys) []*dataloader.Result {
 				var (
 					n       = len(keys)
@@ -48,6 +49,7 @@ func NewAppledLoader() *AppledLoader {
 			},
 		),
 		batchGetByAppleableAndUser: createLoader(
+			"appled.get_by_appleable_and_user",
 			func(ctx context.Context, keys dataloader.Keys) []*dataloader.Result {
 				var (
 					n       = len(keys)
@@ -15,6 +15,7 @@ import (
 func NewAssetLoader() *AssetLoader {
 	return &AssetLoader{
 		batchGet: createLoader(
+			"asset.get",
 			func(ctx context.Context, keys dataloader.Keys) []*dataloader.Result {
 				var (
 					n       = len(keys)
@@ -48,6 +49,7 @@ func NewAssetLoader() *AssetLoader {
 			},
 		),
 		batchGetByKey: createLoader(
+			"asset.get_by_key",
 			func(ctx context.Context, keys dataloader.Keys) []*dataloader.Result {
 				var (
 					n       = len(keys)
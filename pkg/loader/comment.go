@@ -14,6 +14,7 @@ import (
 func NewCommentLoader() *CommentLoader {
 	return &CommentLoader{
 		batchGet: createLoader(
+			"comment.get",
 			func(ctx context.Context, keys dataloader.Keys) []*dataloader.Result {
 				var (
 					n       = len(keys)
@@ -16,6 +16,7 @@ import (
 func NewCommentDraftBackupLoader() *CommentDraftBackupLoader {
 	return &CommentDraftBackupLoader{
 		batchGet: createLoader(
+			"comment_draft_backup.get",
 			func(ctx context.Context, keys dataloader.Keys) []*dataloader.Result {
 				var (
 					n       = len(keys)
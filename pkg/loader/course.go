@@ -17,6 +17,7 @@ import (
 func NewCourseLoader() *CourseLoader {
 	return &CourseLoader{
 		batchGet: createLoader(
+			"course.get",
 			func(ctx context.Context, keys dataloader.Keys) []*dataloader.Result {
 				var (
 					n       = len(keys)
@@ -45,6 +46,7 @@ func NewCourseLoader() *CourseLoader {
 			},
 		),
 		batchGetByName: createLoader(
+			"course.get_by_name",
 			func(ctx context.Context, keys dataloader.Keys) []*dataloader.Result {
 				var (
 					n       = len(keys)
@@ -74,6 +76,7 @@ func NewCourseLoader() *CourseLoader {
 			},
 		),
 		batchGetByNumber: createLoader(
+			"course.get_by_number",
 			func(ctx context.Context, keys dataloader.Keys) []*dataloader.Result {
 				var (
 					n       = len(keys)
@@ -108,6 +111,7 @@ func NewCourseLoader() *CourseLoader {
 			},
 		),
 		batchGetByStudyAndName: createLoader(
+			"course.get_by_study_and_name",
 			func(ctx context.Context, keys dataloader.Keys) []*dataloader.Result {
 				var (
 					n       = len(keys)
@@ -17,6 +17,7 @@ import (
 func NewCourseLessonLoader() *CourseLessonLoader {
 	return &CourseLessonLoader{
 		batchGet: createLoader(
+			"course_lesson.get",
 			func(ctx context.Context, keys dataloader.Keys) []*dataloader.Result {
 				var (
 					n       = len(keys)
@@ -45,6 +46,7 @@ func NewCourseLessonLoader() *CourseLessonLoader {
 			},
 		),
 		batchGetByCourseAndNumber: createLoader(
+			"course_lesson.get_by_course_and_number",
 			func(ctx context.Context, keys dataloader.Keys) []*dataloader.Result {
 				var (
 					n       = len(keys)
@@ -14,6 +14,7 @@ import (
 func NewEmailLoader() *EmailLoader {
 	return &EmailLoader{
 		batchGet: createLoader(
+			"email.get",
 			func(ctx context.Context, keys dataloader.Keys) []*dataloader.Result {
 				var (
 					n       = len(keys)
@@ -42,6 +43,7 @@ func NewEmailLoader() *EmailLoader {
 			},
 		),
 		batchGetByValue: createLoader(
+			"email.get_by_value",
 			func(ctx context.Context, keys dataloader.Keys) []*dataloader.Result {
 				var (
 					n       = len(keys)
@@ -14,6 +14,7 @@ import (
 func NewEVTLoader() *EVTLoader {
 	return &EVTLoader{
 		batchGet: createLoader(
+			"email_verification_token.get",
 			func(ctx context.Context, keys dataloader.Keys) []*dataloader.Result {
 				var (
 					n       = len(keys)
@@ -15,6 +15,7 @@ import (
 func NewEnrolledLoader() *EnrolledLoader {
 	return &EnrolledLoader{
 		batchGet: createLoader(
+			"enrolled.get",
 			func(ctx context.Context, keys dataloader.Keys) []*dataloader.Result {
 				var (
 					n       = len(keys)
@@ -48,6 +49,7 @@ func NewEnrolledLoader() *EnrolledLoader {
 			},
 		),
 		batchGetByEnrollableAndUser: createLoader(
+			"enrolled.get_by_enrollable_and_user",
 			func(ctx context.Context, keys dataloader.Keys) []*dataloader.Result {
 				var (
 					n       = len(keys)
@@ -14,6 +14,7 @@ import (
 func NewEventLoader() *EventLoader {
 	return &EventLoader{
 		batchGet: createLoader(
+			"event.get",
 			func(ctx context.Context, keys dataloader.Keys) []*dataloader.Result {
 				var (
 					n       = len(keys)
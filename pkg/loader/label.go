@@ -14,6 +14,7 @@ import (
 func NewLabelLoader() *LabelLoader {
 	return &LabelLoader{
 		batchGet: createLoader(
+			"label.get",
 			func(ctx context.Context, keys dataloader.Keys) []*dataloader.Result {
 				var (
 					n       = len(keys)
@@ -42,6 +43,7 @@ func NewLabelLoader() *LabelLoader {
 			},
 		),
 		batchGetByName: createLoader(
+			"label.get_by_name",
 			func(ctx context.Context, keys dataloader.Keys) []*dataloader.Result {
 				var (
 					n       = len(keys)
@@ -15,6 +15,7 @@ import (
 func NewLabeledLoader() *LabeledLoader {
 	return &LabeledLoader{
 		batchGet: createLoader(
+			"labeled.get",
 			func(ctx context.Context, keys dataloader.Keys) []*dataloader.Result {
 				var (
 					n       = len(keys)
@@ -48,6 +49,7 @@ func NewLabeledLoader() *LabeledLoader {
 			},
 		),
 		batchGetByLabelableAndLabel: createLoader(
+			"labeled.get_by_labelable_and_label",
 			func(ctx context.Context, keys dataloader.Keys) []*dataloader.Result {
 				var (
 					n       = len(keys)
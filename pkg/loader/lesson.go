@@ -16,6 +16,7 @@ import (
 func NewLessonLoader() *LessonLoader {
 	return &LessonLoader{
 		batchExists: createLoader(
+			"lesson.exists",
 			func(ctx context.Context, keys dataloader.Keys) []*dataloader.Result {
 				var (
 					n       = len(keys)
@@ -44,6 +45,7 @@ func NewLessonLoader() *LessonLoader {
 			},
 		),
 		batchExistsByNumber: createLoader(
+			"lesson.exists_by_number",
 			func(ctx context.Context, keys dataloader.Keys) []*dataloader.Result {
 				var (
 					n       = len(keys)
@@ -78,6 +80,7 @@ func NewLessonLoader() *LessonLoader {
 			},
 		),
 		batchExistsByOwnerStudyAndNumber: createLoader(
+			"lesson.exists_by_owner_study_and_number",
 			func(ctx context.Context, keys dataloader.Keys) []*dataloader.Result {
 				var (
 					n       = len(keys)
@@ -112,6 +115,7 @@ func NewLessonLoader() *LessonLoader {
 			},
 		),
 		batchGet: createLoader(
+			"lesson.get",
 			func(ctx context.Context, keys dataloader.Keys) []*dataloader.Result {
 				var (
 					n       = len(keys)
@@ -140,6 +144,7 @@ func NewLessonLoader() *LessonLoader {
 			},
 		),
 		batchGetByNumber: createLoader(
+			"lesson.get_by_number",
 			func(ctx context.Context, keys dataloader.Keys) []*dataloader.Result {
 				var (
 					n       = len(keys)
@@ -174,6 +179,7 @@ func NewLessonLoader() *LessonLoader {
 			},
 		),
 		batchGetByOwnerStudyAndNumber: createLoader(
+			"lesson.get_by_owner_study_and_number",
 			func(ctx context.Context, keys dataloader.Keys) []*dataloader.Result {
 				var (
 					n       = len(keys)
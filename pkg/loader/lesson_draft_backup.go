@@ -16,6 +16,7 @@ import (
 func NewLessonDraftBackupLoader() *LessonDraftBackupLoader {
 	return &LessonDraftBackupLoader{
 		batchGet: createLoader(
+			"lesson_draft_backup.get",
 			func(ctx context.Context, keys dataloader.Keys) []*dataloader.Result {
 				var (
 					n       = len(keys)
@@ -0,0 +1,81 @@
+package loader
+
+import (
+	"context"
+	"sync"
+
+	"github.com/graph-gophers/dataloader"
+	"github.com/marksauter/markus-ninja-api/pkg/data"
+	"github.com/marksauter/markus-ninja-api/pkg/myctx"
+	"github.com/marksauter/markus-ninja-api/pkg/mylog"
+	"github.com/marksauter/markus-ninja-api/pkg/util"
+)
+
+func NewLessonPrerequisiteLoader() *LessonPrerequisiteLoader {
+	return &LessonPrerequisiteLoader{
+		batchGet: createLoader(
+			"lesson_prerequisite.get",
+			func(ctx context.Context, keys dataloader.Keys) []*dataloader.Result {
+				var (
+					n       = len(keys)
+					results = make([]*dataloader.Result, n)
+					wg      sync.WaitGroup
+				)
+
+				wg.Add(n)
+
+				for i, key := range keys {
+					go func(i int, key dataloader.Key) {
+						defer wg.Done()
+						ks := splitCompositeKey(key)
+						db, ok := myctx.QueryerFromContext(ctx)
+						if !ok {
+							results[i] = &dataloader.Result{Error: &myctx.ErrNotFound{Name: "queryer"}}
+							return
+						}
+						lessonPrerequisite, err := data.GetLessonPrerequisite(db, ks[0], ks[1])
+						results[i] = &dataloader.Result{Data: lessonPrerequisite, Error: err}
+					}(i, key)
+				}
+
+				wg.Wait()
+
+				return results
+			},
+		),
+	}
+}
+
+type LessonPrerequisiteLoader struct {
+	batchGet *dataloader.Loader
+}
+
+func (r *LessonPrerequisiteLoader) Clear(lessonID, prerequisiteID string) {
+	ctx := context.Background()
+	r.batchGet.Clear(ctx, newCompositeKey(lessonID, prerequisiteID))
+}
+
+func (r *LessonPrerequisiteLoader) ClearAll() {
+	r.batchGet.ClearAll()
+}
+
+func (r *LessonPrerequisiteLoader) Get(
+	ctx context.Context,
+	lessonID,
+	prerequisiteID string,
+) (*data.LessonPrerequisite, error) {
+	compositeKey := newCompositeKey(lessonID, prerequisiteID)
+	lessonPrerequisiteData, err := r.batchGet.Load(ctx, compositeKey)()
+	if err != nil {
+		mylog.Log.WithError(err).Error(util.Trace(""))
+		return nil, err
+	}
+	lessonPrerequisite, ok := lessonPrerequisiteData.(*data.LessonPrerequisite)
+	if !ok {
+		err := ErrWrongType
+		mylog.Log.WithError(err).Error(util.Trace(""))
+		return nil, err
+	}
+
+	return lessonPrerequisite, nil
+}
@@ -1,10 +1,13 @@
 package loader
 
 import (
+	"context"
 	"errors"
 	"strings"
 
 	"github.com/graph-gophers/dataloader"
+	"github.com/marksauter/markus-ninja-api/pkg/mymetrics"
+	"github.com/marksauter/markus-ninja-api/pkg/mytrace"
 )
 
 var ErrWrongType = errors.New("wrong type")
@@ -14,8 +17,38 @@ type Loader interface {
 	ClearAll()
 }
 
-func createLoader(batchFn dataloader.BatchFunc) *dataloader.Loader {
-	return dataloader.NewBatchedLoader(batchFn)
+// createLoader returns a batched loader, which reports its batch sizes and cache
+// hit rate under the label name.
+func createLoader(name string, batchFn dataloader.BatchFunc) *dataloader.Loader {
+	return dataloader.NewBatchedLoader(
+		func(ctx context.Context, keys dataloader.Keys) []*dataloader.Result {
+			mymetrics.LoaderBatchSize.WithLabelValues(name).Observe(float64(len(keys)))
+			ctx, span := mytrace.StartSpan(ctx, "loader "+name)
+			defer span.Finish()
+			span.SetAttribute("loader.batch_size", len(keys))
+			return batchFn(ctx, keys)
+		},
+		dataloader.WithCache(&metricsCache{
+			Cache: dataloader.NewCache(),
+			name:  name,
+		}),
+	)
+}
+
+// metricsCache counts the hits and misses of the wrapped cache.
+type metricsCache struct {
+	dataloader.Cache
+	name string
+}
+
+func (c *metricsCache) Get(ctx context.Context, key dataloader.Key) (dataloader.Thunk, bool) {
+	v, ok := c.Cache.Get(ctx, key)
+	if ok {
+		mymetrics.LoaderCacheRequestsTotal.WithLabelValues(c.name, "hit").Inc()
+	} else {
+		mymetrics.LoaderCacheRequestsTotal.WithLabelValues(c.name, "miss").Inc()
+	}
+	return v, ok
 }
 
 func newCompositeKey(strs ...string) dataloader.Key {
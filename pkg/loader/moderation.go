@@ -0,0 +1,113 @@
+package loader
+
+import (
+	"context"
+
+	"github.com/graph-gophers/dataloader"
+	"github.com/marksauter/markus-ninja-api/pkg/data"
+	"github.com/marksauter/markus-ninja-api/pkg/myctx"
+	"github.com/marksauter/markus-ninja-api/pkg/mylog"
+	"github.com/marksauter/markus-ninja-api/pkg/util"
+)
+
+// NewModerationLoader returns a loader of whether subjects are hidden, and
+// users suspended, by admins.
+func NewModerationLoader() *ModerationLoader {
+	return &ModerationLoader{
+		batchIsHidden: createLoader(
+			"moderation.is_hidden",
+			batchContains(data.BatchGetHiddenContent),
+		),
+		batchIsSuspended: createLoader(
+			"moderation.is_suspended",
+			batchContains(data.BatchGetUserSuspension),
+		),
+	}
+}
+
+// batchContains returns a batch function, which loads whether each key is
+// among the IDs returned by get for all the keys.
+func batchContains(
+	get func(db data.Queryer, ids []string) ([]string, error),
+) dataloader.BatchFunc {
+	return func(ctx context.Context, keys dataloader.Keys) []*dataloader.Result {
+		results := make([]*dataloader.Result, len(keys))
+
+		db, ok := myctx.QueryerFromContext(ctx)
+		if !ok {
+			for i := range results {
+				results[i] = &dataloader.Result{Error: &myctx.ErrNotFound{Name: "queryer"}}
+			}
+			return results
+		}
+
+		ids, err := get(db, keys.Keys())
+		if err != nil {
+			for i := range results {
+				results[i] = &dataloader.Result{Error: err}
+			}
+			return results
+		}
+		found := make(map[string]bool, len(ids))
+		for _, id := range ids {
+			found[id] = true
+		}
+		for i, key := range keys {
+			results[i] = &dataloader.Result{Data: found[key.String()]}
+		}
+		return results
+	}
+}
+
+type ModerationLoader struct {
+	batchIsHidden    *dataloader.Loader
+	batchIsSuspended *dataloader.Loader
+}
+
+func (r *ModerationLoader) ClearHidden(subjectID string) {
+	ctx := context.Background()
+	r.batchIsHidden.Clear(ctx, dataloader.StringKey(subjectID))
+}
+
+func (r *ModerationLoader) ClearSuspended(userID string) {
+	ctx := context.Background()
+	r.batchIsSuspended.Clear(ctx, dataloader.StringKey(userID))
+}
+
+func (r *ModerationLoader) ClearAll() {
+	r.batchIsHidden.ClearAll()
+	r.batchIsSuspended.ClearAll()
+}
+
+func (r *ModerationLoader) IsHidden(
+	ctx context.Context,
+	subjectID string,
+) (bool, error) {
+	return r.load(ctx, r.batchIsHidden, subjectID)
+}
+
+func (r *ModerationLoader) IsSuspended(
+	ctx context.Context,
+	userID string,
+) (bool, error) {
+	return r.load(ctx, r.batchIsSuspended, userID)
+}
+
+func (r *ModerationLoader) load(
+	ctx context.Context,
+	l *dataloader.Loader,
+	id string,
+) (bool, error) {
+	v, err := l.Load(ctx, dataloader.StringKey(id))()
+	if err != nil {
+		mylog.Log.WithError(err).Error(util.Trace(""))
+		return false, err
+	}
+	ok, isBool := v.(bool)
+	if !isBool {
+		err := ErrWrongType
+		mylog.Log.WithError(err).Error(util.Trace(""))
+		return false, err
+	}
+	return ok, nil
+}
@@ -14,6 +14,7 @@ import (
 func NewNotificationLoader() *NotificationLoader {
 	return &NotificationLoader{
 		batchGet: createLoader(
+			"notification.get",
 			func(ctx context.Context, keys dataloader.Keys) []*dataloader.Result {
 				var (
 					n       = len(keys)
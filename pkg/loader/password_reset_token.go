@@ -14,6 +14,7 @@ import (
 func NewPRTLoader() *PRTLoader {
 	return &PRTLoader{
 		batchGet: createLoader(
+			"password_reset_token.get",
 			func(ctx context.Context, keys dataloader.Keys) []*dataloader.Result {
 				var (
 					n       = len(keys)
@@ -15,6 +15,7 @@ import (
 func NewQueryPermLoader() *QueryPermLoader {
 	return &QueryPermLoader{
 		batchGet: createLoader(
+			"permission.get",
 			func(ctx context.Context, keys dataloader.Keys) []*dataloader.Result {
 				var (
 					n       = len(keys)
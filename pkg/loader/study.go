@@ -14,6 +14,7 @@ import (
 func NewStudyLoader() *StudyLoader {
 	return &StudyLoader{
 		batchGet: createLoader(
+			"study.get",
 			func(ctx context.Context, keys dataloader.Keys) []*dataloader.Result {
 				var (
 					n       = len(keys)
@@ -42,6 +43,7 @@ func NewStudyLoader() *StudyLoader {
 			},
 		),
 		batchGetByName: createLoader(
+			"study.get_by_name",
 			func(ctx context.Context, keys dataloader.Keys) []*dataloader.Result {
 				var (
 					n       = len(keys)
@@ -71,6 +73,7 @@ func NewStudyLoader() *StudyLoader {
 			},
 		),
 		batchGetByUserAndName: createLoader(
+			"study.get_by_user_and_name",
 			func(ctx context.Context, keys dataloader.Keys) []*dataloader.Result {
 				var (
 					n       = len(keys)
@@ -0,0 +1,89 @@
+package loader
+
+import (
+	"context"
+
+	"github.com/graph-gophers/dataloader"
+	"github.com/marksauter/markus-ninja-api/pkg/data"
+	"github.com/marksauter/markus-ninja-api/pkg/myctx"
+	"github.com/marksauter/markus-ninja-api/pkg/mylog"
+	"github.com/marksauter/markus-ninja-api/pkg/util"
+)
+
+// NewStudyAccessLoader returns a loader of the access each user has been
+// granted to private studies.
+func NewStudyAccessLoader() *StudyAccessLoader {
+	return &StudyAccessLoader{
+		batchGetByUser: createLoader(
+			"study_access.by_user",
+			func(ctx context.Context, keys dataloader.Keys) []*dataloader.Result {
+				results := make([]*dataloader.Result, len(keys))
+
+				db, ok := myctx.QueryerFromContext(ctx)
+				if !ok {
+					for i := range results {
+						results[i] = &dataloader.Result{Error: &myctx.ErrNotFound{Name: "queryer"}}
+					}
+					return results
+				}
+
+				accesses, err := data.BatchGetStudyAccessByUser(db, keys.Keys())
+				if err != nil {
+					for i := range results {
+						results[i] = &dataloader.Result{Error: err}
+					}
+					return results
+				}
+				byUser := make(map[string]map[string]string, len(keys))
+				for _, a := range accesses {
+					if byUser[a.UserID.String] == nil {
+						byUser[a.UserID.String] = make(map[string]string)
+					}
+					byUser[a.UserID.String][a.StudyID.String] = a.Access.String
+				}
+				for i, key := range keys {
+					byStudy := byUser[key.String()]
+					if byStudy == nil {
+						byStudy = map[string]string{}
+					}
+					results[i] = &dataloader.Result{Data: byStudy}
+				}
+				return results
+			},
+		),
+	}
+}
+
+type StudyAccessLoader struct {
+	batchGetByUser *dataloader.Loader
+}
+
+func (r *StudyAccessLoader) Clear(userID string) {
+	ctx := context.Background()
+	r.batchGetByUser.Clear(ctx, dataloader.StringKey(userID))
+}
+
+func (r *StudyAccessLoader) ClearAll() {
+	r.batchGetByUser.ClearAll()
+}
+
+// Get returns the access the user has been granted to the study, or "" if
+// none.
+func (r *StudyAccessLoader) Get(
+	ctx context.Context,
+	userID,
+	studyID string,
+) (string, error) {
+	v, err := r.batchGetByUser.Load(ctx, dataloader.StringKey(userID))()
+	if err != nil {
+		mylog.Log.WithError(err).Error(util.Trace(""))
+		return "", err
+	}
+	byStudy, ok := v.(map[string]string)
+	if !ok {
+		err := ErrWrongType
+		mylog.Log.WithError(err).Error(util.Trace(""))
+		return "", err
+	}
+	return byStudy[studyID], nil
+}
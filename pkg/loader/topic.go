@@ -14,6 +14,7 @@ import (
 func NewTopicLoader() *TopicLoader {
 	return &TopicLoader{
 		batchGet: createLoader(
+			"topic.get",
 			func(ctx context.Context, keys dataloader.Keys) []*dataloader.Result {
 				var (
 					n       = len(keys)
@@ -42,6 +43,7 @@ func NewTopicLoader() *TopicLoader {
 			},
 		),
 		batchGetByName: createLoader(
+			"topic.get_by_name",
 			func(ctx context.Context, keys dataloader.Keys) []*dataloader.Result {
 				var (
 					n       = len(keys)
@@ -15,6 +15,7 @@ import (
 func NewTopicedLoader() *TopicedLoader {
 	return &TopicedLoader{
 		batchGet: createLoader(
+			"topiced.get",
 			func(ctx context.Context, keys dataloader.Keys) []*dataloader.Result {
 				var (
 					n       = len(keys)
@@ -48,6 +49,7 @@ func NewTopicedLoader() *TopicedLoader {
 			},
 		),
 		batchGetByTopicableAndTopic: createLoader(
+			"topiced.get_by_topicable_and_topic",
 			func(ctx context.Context, keys dataloader.Keys) []*dataloader.Result {
 				var (
 					n       = len(keys)
@@ -14,6 +14,7 @@ import (
 func NewUserLoader() *UserLoader {
 	return &UserLoader{
 		batchExists: createLoader(
+			"user.exists",
 			func(ctx context.Context, keys dataloader.Keys) []*dataloader.Result {
 				var (
 					n       = len(keys)
@@ -42,6 +43,7 @@ func NewUserLoader() *UserLoader {
 			},
 		),
 		batchExistsByLogin: createLoader(
+			"user.exists_by_login",
 			func(ctx context.Context, keys dataloader.Keys) []*dataloader.Result {
 				var (
 					n       = len(keys)
@@ -70,6 +72,7 @@ func NewUserLoader() *UserLoader {
 			},
 		),
 		batchGet: createLoader(
+			"user.get",
 			func(ctx context.Context, keys dataloader.Keys) []*dataloader.Result {
 				var (
 					n       = len(keys)
@@ -98,6 +101,7 @@ func NewUserLoader() *UserLoader {
 			},
 		),
 		batchGetByLogin: createLoader(
+			"user.get_by_login",
 			func(ctx context.Context, keys dataloader.Keys) []*dataloader.Result {
 				var (
 					n       = len(keys)
@@ -14,6 +14,7 @@ import (
 func NewUserAssetLoader() *UserAssetLoader {
 	return &UserAssetLoader{
 		batchGet: createLoader(
+			"user_asset.get",
 			func(ctx context.Context, keys dataloader.Keys) []*dataloader.Result {
 				var (
 					n       = len(keys)
@@ -42,6 +43,7 @@ func NewUserAssetLoader() *UserAssetLoader {
 			},
 		),
 		batchGetByName: createLoader(
+			"user_asset.get_by_name",
 			func(ctx context.Context, keys dataloader.Keys) []*dataloader.Result {
 				var (
 					n       = len(keys)
@@ -71,6 +73,7 @@ func NewUserAssetLoader() *UserAssetLoader {
 			},
 		),
 		batchGetByUserStudyAndName: createLoader(
+			"user_asset.get_by_user_study_and_name",
 			func(ctx context.Context, keys dataloader.Keys) []*dataloader.Result {
 				var (
 					n       = len(keys)
@@ -0,0 +1,88 @@
+package loader
+
+import (
+	"context"
+
+	"github.com/graph-gophers/dataloader"
+	"github.com/marksauter/markus-ninja-api/pkg/data"
+	"github.com/marksauter/markus-ninja-api/pkg/myctx"
+	"github.com/marksauter/markus-ninja-api/pkg/mylog"
+	"github.com/marksauter/markus-ninja-api/pkg/util"
+)
+
+// NewUserBlockLoader returns a loader of the IDs of the users each user has
+// blocked.
+func NewUserBlockLoader() *UserBlockLoader {
+	return &UserBlockLoader{
+		batchGetBlockedIDs: createLoader(
+			"user_block.blocked_ids",
+			func(ctx context.Context, keys dataloader.Keys) []*dataloader.Result {
+				results := make([]*dataloader.Result, len(keys))
+
+				db, ok := myctx.QueryerFromContext(ctx)
+				if !ok {
+					for i := range results {
+						results[i] = &dataloader.Result{Error: &myctx.ErrNotFound{Name: "queryer"}}
+					}
+					return results
+				}
+
+				blocks, err := data.BatchGetUserBlock(db, keys.Keys())
+				if err != nil {
+					for i := range results {
+						results[i] = &dataloader.Result{Error: err}
+					}
+					return results
+				}
+				blockedIDs := make(map[string]map[string]bool, len(keys))
+				for _, b := range blocks {
+					if blockedIDs[b.BlockerID.String] == nil {
+						blockedIDs[b.BlockerID.String] = make(map[string]bool)
+					}
+					blockedIDs[b.BlockerID.String][b.BlockedID.String] = true
+				}
+				for i, key := range keys {
+					ids := blockedIDs[key.String()]
+					if ids == nil {
+						ids = map[string]bool{}
+					}
+					results[i] = &dataloader.Result{Data: ids}
+				}
+				return results
+			},
+		),
+	}
+}
+
+type UserBlockLoader struct {
+	batchGetBlockedIDs *dataloader.Loader
+}
+
+func (r *UserBlockLoader) Clear(blockerID string) {
+	ctx := context.Background()
+	r.batchGetBlockedIDs.Clear(ctx, dataloader.StringKey(blockerID))
+}
+
+func (r *UserBlockLoader) ClearAll() {
+	r.batchGetBlockedIDs.ClearAll()
+}
+
+// IsBlocked returns whether the blocker has blocked the blocked user.
+func (r *UserBlockLoader) IsBlocked(
+	ctx context.Context,
+	blockerID,
+	blockedID string,
+) (bool, error) {
+	v, err := r.batchGetBlockedIDs.Load(ctx, dataloader.StringKey(blockerID))()
+	if err != nil {
+		mylog.Log.WithError(err).Error(util.Trace(""))
+		return false, err
+	}
+	blockedIDs, ok := v.(map[string]bool)
+	if !ok {
+		err := ErrWrongType
+		mylog.Log.WithError(err).Error(util.Trace(""))
+		return false, err
+	}
+	return blockedIDs[blockedID], nil
+}
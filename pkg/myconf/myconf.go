@@ -2,6 +2,7 @@ package myconf
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/spf13/viper"
 )
@@ -12,11 +13,15 @@ type Config struct {
 	ClientURL string
 	ImagesURL string
 
+	AccountDeletionGracePeriod time.Duration
+
 	AuthKeyId string
 
 	AWSRegion       string
 	AWSUploadBucket string
 
+	DataExportLinkExpiry time.Duration
+
 	DBHost         string
 	DBPort         uint16
 	DBRootUser     string
@@ -25,9 +30,39 @@ type Config struct {
 	DBPassword     string
 	DBName         string
 
+	DiscoveryRecommendationLimit int32
+	DiscoveryRefreshInterval     time.Duration
+
+	GraphQLMaskInternalErrors bool
+	GraphQLMaxBatchSize       int
+
+	LogFormat string
+	LogLevel  string
+
 	MailCharSet string
 	MailSender  string
 	MailRootURL string
+
+	SchedulerInterval time.Duration
+
+	SearchFuzzyThreshold   int32
+	SearchRankWeights      map[string]float64
+	SearchSuggestCacheSize int
+	SearchSuggestCacheTTL  time.Duration
+
+	ServerHandlerTimeout  time.Duration
+	ServerIdleTimeout     time.Duration
+	ServerReadTimeout     time.Duration
+	ServerRouteTimeouts   map[string]time.Duration
+	ServerShutdownTimeout time.Duration
+	ServerWriteTimeout    time.Duration
+
+	MetricsAllowedIPs []string
+	MetricsToken      string
+
+	TracingExporter     string
+	TracingFilePath     string
+	TracingOTLPEndpoint string
 }
 
 func Load(name string) *Config {
@@ -76,5 +111,107 @@ func Load(name string) *Config {
 		conf.DBPassword = dbPassword.(string)
 	}
 
+	conf.AccountDeletionGracePeriod = 14 * 24 * time.Hour
+	if config.IsSet("account_deletion.grace_period") {
+		conf.AccountDeletionGracePeriod = config.GetDuration("account_deletion.grace_period")
+	}
+	conf.DataExportLinkExpiry = 7 * 24 * time.Hour
+	if config.IsSet("data_export.link_expiry") {
+		conf.DataExportLinkExpiry = config.GetDuration("data_export.link_expiry")
+	}
+	conf.DiscoveryRecommendationLimit = 50
+	if config.IsSet("discovery.recommendation_limit") {
+		conf.DiscoveryRecommendationLimit = config.GetInt32("discovery.recommendation_limit")
+	}
+	conf.DiscoveryRefreshInterval = time.Hour
+	if config.IsSet("discovery.refresh_interval") {
+		conf.DiscoveryRefreshInterval = config.GetDuration("discovery.refresh_interval")
+	}
+	conf.GraphQLMaskInternalErrors = true
+	graphQLMaskInternalErrors := config.Get("graphql.mask_internal_errors")
+	if graphQLMaskInternalErrors != nil {
+		conf.GraphQLMaskInternalErrors = graphQLMaskInternalErrors.(bool)
+	}
+	conf.GraphQLMaxBatchSize = 10
+	graphQLMaxBatchSize := config.Get("graphql.max_batch_size")
+	if graphQLMaxBatchSize != nil {
+		conf.GraphQLMaxBatchSize = int(graphQLMaxBatchSize.(int64))
+	}
+	conf.LogFormat = "json"
+	logFormat := config.Get("log.format")
+	if logFormat != nil {
+		conf.LogFormat = logFormat.(string)
+	}
+	logLevel := config.Get("log.level")
+	if logLevel != nil {
+		conf.LogLevel = logLevel.(string)
+	}
+	conf.SchedulerInterval = time.Minute
+	if config.IsSet("scheduler.interval") {
+		conf.SchedulerInterval = config.GetDuration("scheduler.interval")
+	}
+	conf.SearchFuzzyThreshold = 5
+	if config.IsSet("search.fuzzy_threshold") {
+		conf.SearchFuzzyThreshold = config.GetInt32("search.fuzzy_threshold")
+	}
+	conf.SearchRankWeights = make(map[string]float64)
+	for name := range config.GetStringMap("search.rank_weights") {
+		conf.SearchRankWeights[name] = config.GetFloat64("search.rank_weights." + name)
+	}
+	conf.SearchSuggestCacheSize = 1000
+	if config.IsSet("search.suggest_cache_size") {
+		conf.SearchSuggestCacheSize = config.GetInt("search.suggest_cache_size")
+	}
+	conf.SearchSuggestCacheTTL = time.Minute
+	if config.IsSet("search.suggest_cache_ttl") {
+		conf.SearchSuggestCacheTTL = config.GetDuration("search.suggest_cache_ttl")
+	}
+	conf.ServerHandlerTimeout = 5 * time.Second
+	if config.IsSet("server.handler_timeout") {
+		conf.ServerHandlerTimeout = config.GetDuration("server.handler_timeout")
+	}
+	conf.ServerIdleTimeout = 120 * time.Second
+	if config.IsSet("server.idle_timeout") {
+		conf.ServerIdleTimeout = config.GetDuration("server.idle_timeout")
+	}
+	conf.ServerReadTimeout = 10 * time.Second
+	if config.IsSet("server.read_timeout") {
+		conf.ServerReadTimeout = config.GetDuration("server.read_timeout")
+	}
+	conf.ServerShutdownTimeout = 30 * time.Second
+	if config.IsSet("server.shutdown_timeout") {
+		conf.ServerShutdownTimeout = config.GetDuration("server.shutdown_timeout")
+	}
+	conf.ServerWriteTimeout = 10 * time.Second
+	if config.IsSet("server.write_timeout") {
+		conf.ServerWriteTimeout = config.GetDuration("server.write_timeout")
+	}
+	conf.ServerRouteTimeouts = make(map[string]time.Duration)
+	for route, timeout := range config.GetStringMapString("server.route_timeouts") {
+		d, err := time.ParseDuration(timeout)
+		if err != nil {
+			panic(fmt.Errorf("Fatal error config file: invalid timeout for route %s: %s \n", route, err))
+		}
+		conf.ServerRouteTimeouts[route] = d
+	}
+
+	conf.MetricsAllowedIPs = config.GetStringSlice("metrics.allowed_ips")
+	metricsToken := config.Get("metrics.token")
+	if metricsToken != nil {
+		conf.MetricsToken = metricsToken.(string)
+	}
+	tracingExporter := config.Get("tracing.exporter")
+	if tracingExporter != nil {
+		conf.TracingExporter = tracingExporter.(string)
+	}
+	tracingFilePath := config.Get("tracing.file_path")
+	if tracingFilePath != nil {
+		conf.TracingFilePath = tracingFilePath.(string)
+	}
+	tracingOTLPEndpoint := config.Get("tracing.otlp_endpoint")
+	if tracingOTLPEndpoint != nil {
+		conf.TracingOTLPEndpoint = tracingOTLPEndpoint.(string)
+	}
+
 	return conf
 }
@@ -24,9 +24,14 @@ func NewQueryerContext(ctx context.Context, v data.Queryer) context.Context {
 	return context.WithValue(ctx, queryerContextKey, v)
 }
 
+// QueryerFromContext returns the queryer of ctx, bound to ctx so that its
+// statements are traced under the current span.
 func QueryerFromContext(ctx context.Context) (data.Queryer, bool) {
 	v, ok := ctx.Value(queryerContextKey).(data.Queryer)
-	return v, ok
+	if !ok {
+		return v, ok
+	}
+	return data.WithContext(ctx, v), ok
 }
 
 func TransactionFromContext(ctx context.Context) (q data.Queryer, err error, newTx bool) {
@@ -36,7 +41,7 @@ func TransactionFromContext(ctx context.Context) (q data.Queryer, err error, new
 		err = &ErrNotFound{"queryer"}
 		return
 	}
-	q, err, newTx = data.BeginTransaction(q)
+	q, err, newTx = data.BeginTransaction(data.WithContext(ctx, q))
 	return
 }
 
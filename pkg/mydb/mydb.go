@@ -8,6 +8,7 @@ import (
 	"github.com/jackc/pgx"
 	"github.com/marksauter/markus-ninja-api/pkg/myconf"
 	"github.com/marksauter/markus-ninja-api/pkg/mylog"
+	"github.com/marksauter/markus-ninja-api/pkg/mymetrics"
 )
 
 type DB struct {
@@ -57,6 +58,35 @@ func OpenRoot(config pgx.ConnConfig) (*DB, error) {
 	return &DB{conn}, nil
 }
 
+// recordWait counts a pool wait if every connection in the pool is currently
+// acquired, because the caller will have to wait for one to be released.
+func (db *DB) recordWait() {
+	stat := db.Stat()
+	if stat.AvailableConnections == 0 && stat.CurrentConnections >= stat.MaxConnections {
+		mymetrics.DBPoolWaitsTotal.Inc()
+	}
+}
+
+func (db *DB) Begin() (*pgx.Tx, error) {
+	db.recordWait()
+	return db.ConnPool.Begin()
+}
+
+func (db *DB) Exec(sql string, arguments ...interface{}) (pgx.CommandTag, error) {
+	db.recordWait()
+	return db.ConnPool.Exec(sql, arguments...)
+}
+
+func (db *DB) Query(sql string, args ...interface{}) (*pgx.Rows, error) {
+	db.recordWait()
+	return db.ConnPool.Query(sql, args...)
+}
+
+func (db *DB) QueryRow(sql string, args ...interface{}) *pgx.Row {
+	db.recordWait()
+	return db.ConnPool.QueryRow(sql, args...)
+}
+
 var SharedTestDB *TestDB
 
 type TestDB struct {
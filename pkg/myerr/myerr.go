@@ -14,3 +14,47 @@ type UnexpectedError struct {
 func (e UnexpectedError) Error() string {
 	return fmt.Sprintf("UNEXPECTED: %s", e.Message)
 }
+
+// ErrorCode is a machine-readable code reported to clients alongside an error,
+// so that they need not match on error messages.
+type ErrorCode string
+
+const (
+	BadRequestCode      ErrorCode = "BAD_REQUEST"
+	ForbiddenCode       ErrorCode = "FORBIDDEN"
+	InternalCode        ErrorCode = "INTERNAL"
+	NotFoundCode        ErrorCode = "NOT_FOUND"
+	UnauthenticatedCode ErrorCode = "UNAUTHENTICATED"
+	UniqueViolationCode ErrorCode = "UNIQUE_VIOLATION"
+	ValidationCode      ErrorCode = "VALIDATION"
+)
+
+// Errors of this type may be seen by the end user. They are reported when the
+// credentials given by the user do not identify them.
+type AuthenticationError struct {
+	Message string
+}
+
+func (e AuthenticationError) Error() string {
+	return e.Message
+}
+
+// Errors of this type may be seen by the end user.
+type NotFoundError struct {
+	Resource string
+}
+
+func (e NotFoundError) Error() string {
+	return fmt.Sprintf("%s not found", e.Resource)
+}
+
+// Errors of this type may be seen by the end user. Field, when set, names the
+// input field that failed validation.
+type ValidationError struct {
+	Field   string
+	Message string
+}
+
+func (e ValidationError) Error() string {
+	return e.Message
+}
@@ -22,6 +22,7 @@ const (
 	Unauthorized
 	UserExists
 	UsernameExists
+	NotFound
 )
 
 func (e *ErrorCode) UnmarshalJSON(b []byte) error {
@@ -44,6 +45,8 @@ func (e *ErrorCode) UnmarshalJSON(b []byte) error {
 		*e = InvalidPassword
 	case "method_not_allowed":
 		*e = MethodNotAllowed
+	case "not_found":
+		*e = NotFound
 	case "password_strength_error":
 		*e = PasswordStrengthError
 	case "too_many_attempts":
@@ -76,6 +79,8 @@ func (e ErrorCode) MarshalJSON() ([]byte, error) {
 		s = "invalid_password"
 	case MethodNotAllowed:
 		s = "method_not_allowed"
+	case NotFound:
+		s = "not_found"
 	case PasswordStrengthError:
 		s = "password_strength_error"
 	case TooManyAttempts:
@@ -113,6 +118,8 @@ func (r *ErrorResponse) StatusHTTP() int {
 		return http.StatusInternalServerError
 	case MethodNotAllowed:
 		return http.StatusMethodNotAllowed
+	case NotFound:
+		return http.StatusNotFound
 	case PasswordStrengthError:
 		return http.StatusUnprocessableEntity
 	case TooManyAttempts:
@@ -165,6 +172,13 @@ func MethodNotAllowedResponse(method string) *ErrorResponse {
 	}
 }
 
+func NotFoundErrorResponse(resource string) *ErrorResponse {
+	return &ErrorResponse{
+		Error:            NotFound,
+		ErrorDescription: fmt.Sprintf("%v not found", resource),
+	}
+}
+
 func PasswordStrengthErrorResponse(err string) *ErrorResponse {
 	return &ErrorResponse{
 		Error:            PasswordStrengthError,
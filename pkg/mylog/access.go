@@ -0,0 +1,99 @@
+package mylog
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/marksauter/markus-ninja-api/pkg/util"
+	"github.com/rs/xid"
+	"github.com/sirupsen/logrus"
+)
+
+type key string
+
+var requestIDContextKey key = "request_id"
+
+func NewRequestIDContext(ctx context.Context, v string) context.Context {
+	return context.WithValue(ctx, requestIDContextKey, v)
+}
+
+func RequestIDFromContext(ctx context.Context) (string, bool) {
+	v, ok := ctx.Value(requestIDContextKey).(string)
+	return v, ok
+}
+
+var accessContextKey key = "access"
+
+// access holds the details of a request that are only known to handlers
+// further down the chain.
+type access struct {
+	mu       sync.Mutex
+	viewerID string
+}
+
+// SetViewerID records the viewer of the request of ctx for the access log.
+func SetViewerID(ctx context.Context, viewerID string) {
+	if a, ok := ctx.Value(accessContextKey).(*access); ok {
+		a.mu.Lock()
+		a.viewerID = viewerID
+		a.mu.Unlock()
+	}
+}
+
+type responseRecorder struct {
+	http.ResponseWriter
+	status int
+	bytes  int
+}
+
+func (r *responseRecorder) WriteHeader(status int) {
+	r.status = status
+	r.ResponseWriter.WriteHeader(status)
+}
+
+func (r *responseRecorder) Write(b []byte) (int, error) {
+	n, err := r.ResponseWriter.Write(b)
+	r.bytes += n
+	return n, err
+}
+
+const maxRequestIDLength = 128
+
+// AccessMiddleware attaches a request ID to the request context, propagating
+// the X-Request-ID header if the client sent one, and logs each request once
+// it has been handled.
+func (l *Logger) AccessMiddleware(h http.Handler) http.Handler {
+	return http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		start := time.Now()
+
+		requestID := req.Header.Get("X-Request-ID")
+		if requestID == "" || len(requestID) > maxRequestIDLength {
+			requestID = xid.New().String()
+		}
+		rw.Header().Set("X-Request-ID", requestID)
+
+		a := &access{}
+		ctx := NewRequestIDContext(req.Context(), requestID)
+		ctx = context.WithValue(ctx, accessContextKey, a)
+		recorder := &responseRecorder{ResponseWriter: rw, status: http.StatusOK}
+
+		h.ServeHTTP(recorder, req.WithContext(ctx))
+
+		a.mu.Lock()
+		viewerID := a.viewerID
+		a.mu.Unlock()
+		l.WithContext(ctx).WithFields(logrus.Fields{
+			"remote_addr": req.RemoteAddr,
+			"method":      req.Method,
+			"url":         util.RedactURL(req.URL),
+			"proto":       req.Proto,
+			"user_agent":  req.UserAgent(),
+			"status":      recorder.status,
+			"bytes":       recorder.bytes,
+			"duration_ms": float64(time.Since(start)) / float64(time.Millisecond),
+			"viewer_id":   viewerID,
+		}).Info("Request Info")
+	})
+}
@@ -1,10 +1,12 @@
 package mylog
 
 import (
-	"net/http"
+	"context"
+	"fmt"
 	"os"
 
 	"github.com/jackc/pgx"
+	"github.com/marksauter/markus-ninja-api/pkg/mytrace"
 	"github.com/marksauter/markus-ninja-api/pkg/util"
 	"github.com/sirupsen/logrus"
 )
@@ -18,18 +20,38 @@ type Logger struct {
 func New() *Logger {
 	log := logrus.New()
 	branch := util.GetRequiredEnv("BRANCH")
-	forceColors := false
-	if branch == "development.local" {
-		forceColors = true
-	}
-	log.Formatter = &logrus.TextFormatter{ForceColors: forceColors}
+	log.Formatter = &logrus.JSONFormatter{}
 	log.Out = os.Stdout
 	if branch == "development.local" {
+		log.Formatter = &logrus.TextFormatter{ForceColors: true}
 		log.SetLevel(logrus.DebugLevel)
 	}
+	log.AddHook(redactHook{})
 	return &Logger{log}
 }
 
+// Configure sets the level, e.g. "debug" or "info", and the format, "json" or
+// "text", of the logger. Empty values keep the current setting.
+func (l *Logger) Configure(level, format string) error {
+	if level != "" {
+		lvl, err := logrus.ParseLevel(level)
+		if err != nil {
+			return err
+		}
+		l.SetLevel(lvl)
+	}
+	switch format {
+	case "":
+	case "json":
+		l.Formatter = &logrus.JSONFormatter{}
+	case "text":
+		l.Formatter = &logrus.TextFormatter{}
+	default:
+		return fmt.Errorf("invalid log format: %q", format)
+	}
+	return nil
+}
+
 func (l *Logger) Log(level pgx.LogLevel, msg string, data map[string]interface{}) {
 	lvl, err := logrus.ParseLevel(level.String())
 	if err != nil {
@@ -38,24 +60,19 @@ func (l *Logger) Log(level pgx.LogLevel, msg string, data map[string]interface{}
 	l.WithFields(logrus.Fields(data)).WriterLevel(lvl).Write([]byte(msg))
 }
 
-func (l *Logger) AccessMiddleware(h http.Handler) http.Handler {
-	return http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
-		l.WithFields(logrus.Fields{
-			"remote_addr": req.RemoteAddr,
-			"method":      req.Method,
-			"url":         req.URL,
-			"proto":       req.Proto,
-		}).Info("Request Info")
-		l.WithField("user_agent", req.UserAgent()).Info("")
-		// if l.Level >= logrus.DebugLevel {
-		//   body, err := ioutil.ReadAll(req.Body)
-		//   if err != nil {
-		//     l.WithField("error", err).Error("Error reading request body")
-		//   }
-		//   reqStr := ioutil.NopCloser(bytes.NewBuffer(body))
-		//   l.WithField("body", string(body)).Debug("")
-		//   req.Body = reqStr
-		// }
-		h.ServeHTTP(rw, req)
-	})
+// WithContext returns an entry with the request ID of ctx, and the trace and
+// span IDs of its current span, if there are any.
+func (l *Logger) WithContext(ctx context.Context) *logrus.Entry {
+	fields := logrus.Fields{}
+	if ctx == nil {
+		return l.WithFields(fields)
+	}
+	if requestID, ok := RequestIDFromContext(ctx); ok {
+		fields["request_id"] = requestID
+	}
+	if span := mytrace.SpanFromContext(ctx); span != nil {
+		fields["trace_id"] = span.TraceID.String()
+		fields["span_id"] = span.SpanID.String()
+	}
+	return l.WithFields(fields)
 }
@@ -0,0 +1,36 @@
+package mylog
+
+import (
+	"regexp"
+
+	"github.com/marksauter/markus-ninja-api/pkg/util"
+	"github.com/sirupsen/logrus"
+)
+
+const redacted = util.Redacted
+
+var emailRegexp = regexp.MustCompile(`[a-zA-Z0-9._%+\-]+@[a-zA-Z0-9.\-]+\.[a-zA-Z]{2,}`)
+
+// redactHook scrubs passwords, tokens and email addresses from log entries.
+type redactHook struct{}
+
+func (redactHook) Levels() []logrus.Level {
+	return logrus.AllLevels
+}
+
+func (redactHook) Fire(entry *logrus.Entry) error {
+	for k, v := range entry.Data {
+		if util.IsSensitiveKey(k) {
+			entry.Data[k] = redacted
+			continue
+		}
+		switch v := v.(type) {
+		case string:
+			entry.Data[k] = emailRegexp.ReplaceAllString(v, redacted)
+		case error:
+			entry.Data[k] = emailRegexp.ReplaceAllString(v.Error(), redacted)
+		}
+	}
+	entry.Message = emailRegexp.ReplaceAllString(entry.Message, redacted)
+	return nil
+}
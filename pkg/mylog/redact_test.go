@@ -0,0 +1,42 @@
+package mylog
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/sirupsen/logrus"
+)
+
+func TestRedactHook(t *testing.T) {
+	entry := &logrus.Entry{
+		Data: logrus.Fields{
+			"password":      "hunter2",
+			"access_token":  "abc",
+			"primary_email": "a@b.com",
+			"login":         "markus",
+			"url":           "/verify?email=jane.doe@example.com",
+			"error":         errors.New(`duplicate key value (email)=(jane.doe@example.com)`),
+		},
+		Message: "sent mail to jane.doe@example.com",
+	}
+	if err := (redactHook{}).Fire(entry); err != nil {
+		t.Fatal(err)
+	}
+
+	expected := logrus.Fields{
+		"password":      redacted,
+		"access_token":  redacted,
+		"primary_email": redacted,
+		"login":         "markus",
+		"url":           "/verify?email=" + redacted,
+		"error":         "duplicate key value (email)=(" + redacted + ")",
+	}
+	for k, v := range expected {
+		if entry.Data[k] != v {
+			t.Errorf("Data[%q]: expected %v, got %v", k, v, entry.Data[k])
+		}
+	}
+	if entry.Message != "sent mail to "+redacted {
+		t.Errorf("Message: expected redacted email, got %q", entry.Message)
+	}
+}
@@ -0,0 +1,266 @@
+package mymetrics
+
+import (
+	"net/http"
+	"regexp"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/jackc/pgx"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+const namespace = "markus_ninja"
+
+var (
+	HTTPRequestsTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: "http",
+			Name:      "requests_total",
+			Help:      "Number of HTTP requests by route, method and status.",
+		},
+		[]string{"route", "method", "status"},
+	)
+	HTTPRequestDuration = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: namespace,
+			Subsystem: "http",
+			Name:      "request_duration_seconds",
+			Help:      "Latency of HTTP requests by route, method and status.",
+			Buckets:   prometheus.DefBuckets,
+		},
+		[]string{"route", "method", "status"},
+	)
+
+	GraphQLOperationsTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: "graphql",
+			Name:      "operations_total",
+			Help:      "Number of GraphQL operations by operation name.",
+		},
+		[]string{"operation"},
+	)
+	GraphQLOperationDuration = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: namespace,
+			Subsystem: "graphql",
+			Name:      "operation_duration_seconds",
+			Help:      "Latency of GraphQL operations by operation name.",
+			Buckets:   prometheus.DefBuckets,
+		},
+		[]string{"operation"},
+	)
+	GraphQLErrorsTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: "graphql",
+			Name:      "errors_total",
+			Help:      "Number of GraphQL errors by operation name and error code.",
+		},
+		[]string{"operation", "code"},
+	)
+
+	DBPoolWaitsTotal = prometheus.NewCounter(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: "db_pool",
+			Name:      "waits_total",
+			Help:      "Number of queries started while every pooled connection was in use.",
+		},
+	)
+
+	LoaderBatchSize = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: namespace,
+			Subsystem: "loader",
+			Name:      "batch_size",
+			Help:      "Number of keys per dataloader batch by loader.",
+			Buckets:   prometheus.ExponentialBuckets(1, 2, 8),
+		},
+		[]string{"loader"},
+	)
+	LoaderCacheRequestsTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: "loader",
+			Name:      "cache_requests_total",
+			Help:      "Number of dataloader cache lookups by loader and result (hit or miss).",
+		},
+		[]string{"loader", "result"},
+	)
+
+	KMSRequestDuration = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: namespace,
+			Subsystem: "kms",
+			Name:      "request_duration_seconds",
+			Help:      "Latency of KMS calls by method.",
+			Buckets:   prometheus.DefBuckets,
+		},
+		[]string{"method"},
+	)
+	StorageRequestDuration = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: namespace,
+			Subsystem: "storage",
+			Name:      "request_duration_seconds",
+			Help:      "Latency of storage calls by method.",
+			Buckets:   prometheus.DefBuckets,
+		},
+		[]string{"method"},
+	)
+
+	PermissionDenialsTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: "permitter",
+			Name:      "denials_total",
+			Help:      "Number of permission checks denied by operation.",
+		},
+		[]string{"operation"},
+	)
+)
+
+func init() {
+	prometheus.MustRegister(
+		HTTPRequestsTotal,
+		HTTPRequestDuration,
+		GraphQLOperationsTotal,
+		GraphQLOperationDuration,
+		GraphQLErrorsTotal,
+		DBPoolWaitsTotal,
+		LoaderBatchSize,
+		LoaderCacheRequestsTotal,
+		KMSRequestDuration,
+		StorageRequestDuration,
+		PermissionDenialsTotal,
+	)
+}
+
+// Handler returns the http.Handler that serves the registered metrics.
+func Handler() http.Handler {
+	return promhttp.Handler()
+}
+
+// ObserveSince records the time elapsed since start on o.
+func ObserveSince(o prometheus.Observer, start time.Time) {
+	o.Observe(time.Since(start).Seconds())
+}
+
+// maxOperationLabels bounds the number of distinct operation names recorded,
+// since clients choose them.
+const maxOperationLabels = 256
+
+var (
+	validOperationName = regexp.MustCompile(`^[_A-Za-z][_0-9A-Za-z]{0,63}$`)
+
+	operationLabelsMu sync.Mutex
+	operationLabels   = make(map[string]struct{}, maxOperationLabels)
+)
+
+// OperationLabel returns the label to record the GraphQL operation under. Names
+// that are not valid GraphQL names, or that arrive after maxOperationLabels
+// names have been seen, are recorded as "other".
+func OperationLabel(name string) string {
+	if name == "" {
+		return "anonymous"
+	}
+	if !validOperationName.MatchString(name) {
+		return "other"
+	}
+	operationLabelsMu.Lock()
+	defer operationLabelsMu.Unlock()
+	if _, ok := operationLabels[name]; !ok {
+		if len(operationLabels) >= maxOperationLabels {
+			return "other"
+		}
+		operationLabels[name] = struct{}{}
+	}
+	return name
+}
+
+type statusRecorder struct {
+	http.ResponseWriter
+	status int
+}
+
+func (r *statusRecorder) WriteHeader(status int) {
+	r.status = status
+	r.ResponseWriter.WriteHeader(status)
+}
+
+// Middleware returns a middleware that records the count and latency of
+// requests handled under the name route.
+func Middleware(route string) func(http.Handler) http.Handler {
+	return func(h http.Handler) http.Handler {
+		return http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+			start := time.Now()
+			recorder := &statusRecorder{ResponseWriter: rw, status: http.StatusOK}
+
+			h.ServeHTTP(recorder, req)
+
+			status := strconv.Itoa(recorder.status)
+			HTTPRequestsTotal.WithLabelValues(route, req.Method, status).Inc()
+			ObserveSince(
+				HTTPRequestDuration.WithLabelValues(route, req.Method, status),
+				start,
+			)
+		})
+	}
+}
+
+// RegisterDBPool registers a collector exposing the stats of pool.
+func RegisterDBPool(pool *pgx.ConnPool) error {
+	return prometheus.Register(&dbPoolCollector{pool: pool})
+}
+
+var (
+	dbPoolMaxDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(namespace, "db_pool", "max_connections"),
+		"Maximum number of connections in the pool.",
+		nil, nil,
+	)
+	dbPoolAcquiredDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(namespace, "db_pool", "acquired_connections"),
+		"Number of connections currently acquired from the pool.",
+		nil, nil,
+	)
+	dbPoolAvailableDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(namespace, "db_pool", "available_connections"),
+		"Number of live connections available in the pool.",
+		nil, nil,
+	)
+)
+
+type dbPoolCollector struct {
+	pool *pgx.ConnPool
+}
+
+func (c *dbPoolCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- dbPoolMaxDesc
+	ch <- dbPoolAcquiredDesc
+	ch <- dbPoolAvailableDesc
+}
+
+func (c *dbPoolCollector) Collect(ch chan<- prometheus.Metric) {
+	stat := c.pool.Stat()
+	ch <- prometheus.MustNewConstMetric(
+		dbPoolMaxDesc,
+		prometheus.GaugeValue,
+		float64(stat.MaxConnections),
+	)
+	ch <- prometheus.MustNewConstMetric(
+		dbPoolAcquiredDesc,
+		prometheus.GaugeValue,
+		float64(stat.CurrentConnections-stat.AvailableConnections),
+	)
+	ch <- prometheus.MustNewConstMetric(
+		dbPoolAvailableDesc,
+		prometheus.GaugeValue,
+		float64(stat.AvailableConnections),
+	)
+}
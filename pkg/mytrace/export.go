@@ -0,0 +1,254 @@
+package mytrace
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+type spanJSON struct {
+	TraceID      string                 `json:"traceId"`
+	SpanID       string                 `json:"spanId"`
+	ParentSpanID string                 `json:"parentSpanId,omitempty"`
+	Name         string                 `json:"name"`
+	Kind         SpanKind               `json:"kind"`
+	Start        time.Time              `json:"start"`
+	End          time.Time              `json:"end"`
+	DurationMS   float64                `json:"durationMs"`
+	Attributes   map[string]interface{} `json:"attributes,omitempty"`
+	Error        string                 `json:"error,omitempty"`
+}
+
+// WriterExporter writes each finished span to w as a line of JSON. It is meant
+// for local debugging.
+type WriterExporter struct {
+	mu sync.Mutex
+	w  io.Writer
+}
+
+func NewWriterExporter(w io.Writer) *WriterExporter {
+	return &WriterExporter{w: w}
+}
+
+// NewStdoutExporter returns a WriterExporter writing to stdout.
+func NewStdoutExporter() *WriterExporter {
+	return NewWriterExporter(os.Stdout)
+}
+
+// NewFileExporter returns a WriterExporter appending to the file at path.
+func NewFileExporter(path string) (*WriterExporter, error) {
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+	if err != nil {
+		return nil, err
+	}
+	return NewWriterExporter(f), nil
+}
+
+func (e *WriterExporter) ExportSpan(s *Span) {
+	span := spanJSON{
+		TraceID:    s.TraceID.String(),
+		SpanID:     s.SpanID.String(),
+		Name:       s.Name,
+		Kind:       s.Kind,
+		Start:      s.Start,
+		End:        s.End,
+		DurationMS: float64(s.End.Sub(s.Start)) / float64(time.Millisecond),
+		Attributes: s.Attributes(),
+		Error:      s.Error(),
+	}
+	if s.ParentSpanID.IsValid() {
+		span.ParentSpanID = s.ParentSpanID.String()
+	}
+	b, err := json.Marshal(span)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "mytrace: failed to marshal span: %s\n", err)
+		return
+	}
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.w.Write(append(b, '\n'))
+}
+
+func (e *WriterExporter) Close() error {
+	if c, ok := e.w.(io.Closer); ok && e.w != os.Stdout {
+		return c.Close()
+	}
+	return nil
+}
+
+const (
+	otlpBatchSize     = 512
+	otlpQueueSize     = 2048
+	otlpFlushInterval = 5 * time.Second
+)
+
+// OTLPExporter sends finished spans in batches to an OpenTelemetry collector,
+// using the JSON encoding of OTLP over HTTP. Spans are dropped if the queue is
+// full.
+type OTLPExporter struct {
+	client      *http.Client
+	endpoint    string
+	serviceName string
+
+	queue chan *Span
+	done  chan struct{}
+	wg    sync.WaitGroup
+}
+
+// NewOTLPExporter returns an OTLPExporter that posts to the collector at
+// endpoint, e.g. `http://localhost:4318`.
+func NewOTLPExporter(endpoint, serviceName string) *OTLPExporter {
+	e := &OTLPExporter{
+		client:      &http.Client{Timeout: 10 * time.Second},
+		endpoint:    strings.TrimRight(endpoint, "/") + "/v1/traces",
+		serviceName: serviceName,
+		queue:       make(chan *Span, otlpQueueSize),
+		done:        make(chan struct{}),
+	}
+	e.wg.Add(1)
+	go e.run()
+	return e
+}
+
+func (e *OTLPExporter) ExportSpan(s *Span) {
+	select {
+	case e.queue <- s:
+	default:
+	}
+}
+
+// Close flushes the queued spans, and stops the exporter.
+func (e *OTLPExporter) Close() error {
+	close(e.done)
+	e.wg.Wait()
+	return nil
+}
+
+func (e *OTLPExporter) run() {
+	defer e.wg.Done()
+	ticker := time.NewTicker(otlpFlushInterval)
+	defer ticker.Stop()
+
+	batch := make([]*Span, 0, otlpBatchSize)
+	flush := func() {
+		if len(batch) == 0 {
+			return
+		}
+		if err := e.send(batch); err != nil {
+			fmt.Fprintf(os.Stderr, "mytrace: failed to export spans: %s\n", err)
+		}
+		batch = batch[:0]
+	}
+
+	for {
+		select {
+		case s := <-e.queue:
+			batch = append(batch, s)
+			if len(batch) >= otlpBatchSize {
+				flush()
+			}
+		case <-ticker.C:
+			flush()
+		case <-e.done:
+			for {
+				select {
+				case s := <-e.queue:
+					batch = append(batch, s)
+				default:
+					flush()
+					return
+				}
+			}
+		}
+	}
+}
+
+type otlpKeyValue struct {
+	Key   string                 `json:"key"`
+	Value map[string]interface{} `json:"value"`
+}
+
+func otlpAttributes(attributes map[string]interface{}) []otlpKeyValue {
+	kvs := make([]otlpKeyValue, 0, len(attributes))
+	for k, v := range attributes {
+		var value map[string]interface{}
+		switch v := v.(type) {
+		case bool:
+			value = map[string]interface{}{"boolValue": v}
+		case int:
+			value = map[string]interface{}{"intValue": strconv.FormatInt(int64(v), 10)}
+		case int32:
+			value = map[string]interface{}{"intValue": strconv.FormatInt(int64(v), 10)}
+		case int64:
+			value = map[string]interface{}{"intValue": strconv.FormatInt(v, 10)}
+		case float64:
+			value = map[string]interface{}{"doubleValue": v}
+		case string:
+			value = map[string]interface{}{"stringValue": v}
+		default:
+			value = map[string]interface{}{"stringValue": fmt.Sprint(v)}
+		}
+		kvs = append(kvs, otlpKeyValue{Key: k, Value: value})
+	}
+	return kvs
+}
+
+func (e *OTLPExporter) send(batch []*Span) error {
+	spans := make([]map[string]interface{}, len(batch))
+	for i, s := range batch {
+		span := map[string]interface{}{
+			"traceId":           s.TraceID.String(),
+			"spanId":            s.SpanID.String(),
+			"name":              s.Name,
+			"kind":              s.Kind,
+			"startTimeUnixNano": strconv.FormatInt(s.Start.UnixNano(), 10),
+			"endTimeUnixNano":   strconv.FormatInt(s.End.UnixNano(), 10),
+			"attributes":        otlpAttributes(s.Attributes()),
+		}
+		if s.ParentSpanID.IsValid() {
+			span["parentSpanId"] = s.ParentSpanID.String()
+		}
+		if msg := s.Error(); msg != "" {
+			span["status"] = map[string]interface{}{"code": 2, "message": msg}
+		}
+		spans[i] = span
+	}
+	body, err := json.Marshal(map[string]interface{}{
+		"resourceSpans": []interface{}{
+			map[string]interface{}{
+				"resource": map[string]interface{}{
+					"attributes": otlpAttributes(map[string]interface{}{
+						"service.name": e.serviceName,
+					}),
+				},
+				"scopeSpans": []interface{}{
+					map[string]interface{}{
+						"scope": map[string]interface{}{"name": "mytrace"},
+						"spans": spans,
+					},
+				},
+			},
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	res, err := e.client.Post(e.endpoint, "application/json", bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+	if res.StatusCode >= 300 {
+		return fmt.Errorf("unexpected status from collector: %s", res.Status)
+	}
+	return nil
+}
@@ -0,0 +1,61 @@
+package mytrace
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/marksauter/graphql-go/errors"
+	"github.com/marksauter/graphql-go/introspection"
+	"github.com/marksauter/graphql-go/trace"
+)
+
+// GraphQLTracer implements the graphql-go trace.Tracer, and starts a span for
+// each query and each non-trivial field resolver. The query text is not
+// recorded, as it may hold literal passwords and tokens.
+type GraphQLTracer struct{}
+
+func (GraphQLTracer) TraceQuery(
+	ctx context.Context,
+	queryString string,
+	operationName string,
+	variables map[string]interface{},
+	varTypes map[string]*introspection.Type,
+) (context.Context, trace.TraceQueryFinishFunc) {
+	ctx, span := StartSpan(ctx, "graphql "+operationName)
+	span.SetAttribute("graphql.operation_name", operationName)
+
+	return ctx, func(errs []*errors.QueryError) {
+		if len(errs) > 0 {
+			msg := errs[0].Error()
+			if len(errs) > 1 {
+				msg += fmt.Sprintf(" (and %d more errors)", len(errs)-1)
+			}
+			span.SetError(fmt.Errorf("%s", msg))
+		}
+		span.Finish()
+	}
+}
+
+func (GraphQLTracer) TraceField(
+	ctx context.Context,
+	label string,
+	typeName string,
+	fieldName string,
+	trivial bool,
+	args map[string]interface{},
+) (context.Context, trace.TraceFieldFinishFunc) {
+	if trivial {
+		return ctx, func(*errors.QueryError) {}
+	}
+
+	ctx, span := StartSpan(ctx, label)
+	span.SetAttribute("graphql.type", typeName)
+	span.SetAttribute("graphql.field", fieldName)
+
+	return ctx, func(err *errors.QueryError) {
+		if err != nil {
+			span.SetError(err)
+		}
+		span.Finish()
+	}
+}
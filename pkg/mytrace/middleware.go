@@ -0,0 +1,27 @@
+package mytrace
+
+import (
+	"net/http"
+
+	"github.com/marksauter/markus-ninja-api/pkg/util"
+)
+
+// Middleware starts a server span for each request, continuing the trace of an
+// incoming `traceparent` header if there is one. The trace ID is reported to
+// the client in the X-Trace-ID header. Secrets in the URL are redacted.
+func Middleware(h http.Handler) http.Handler {
+	return http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		ctx := req.Context()
+		if traceID, parentID, err := ParseTraceparent(req.Header.Get("traceparent")); err == nil {
+			ctx = ContextWithSpan(ctx, &Span{TraceID: traceID, SpanID: parentID})
+		}
+		ctx, span := StartSpan(ctx, req.Method+" "+util.RedactPath(req.URL))
+		defer span.Finish()
+		span.Kind = ServerSpanKind
+		span.SetAttribute("http.method", req.Method)
+		span.SetAttribute("http.target", util.RedactURL(req.URL))
+
+		rw.Header().Set("X-Trace-ID", span.TraceID.String())
+		h.ServeHTTP(rw, req.WithContext(ctx))
+	})
+}
@@ -0,0 +1,218 @@
+// Package mytrace provides request-scoped tracing modeled on OpenTelemetry.
+// Spans are carried in a context.Context, and handed to the configured
+// Exporter when finished.
+package mytrace
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+	"time"
+)
+
+type TraceID [16]byte
+
+func (id TraceID) String() string {
+	return hex.EncodeToString(id[:])
+}
+
+func (id TraceID) IsValid() bool {
+	return id != TraceID{}
+}
+
+type SpanID [8]byte
+
+func (id SpanID) String() string {
+	return hex.EncodeToString(id[:])
+}
+
+func (id SpanID) IsValid() bool {
+	return id != SpanID{}
+}
+
+// SpanKind follows the values of the OTLP span kind enum.
+type SpanKind int
+
+const (
+	InternalSpanKind SpanKind = 1
+	ServerSpanKind   SpanKind = 2
+	ClientSpanKind   SpanKind = 3
+)
+
+type Span struct {
+	TraceID      TraceID
+	SpanID       SpanID
+	ParentSpanID SpanID
+	Name         string
+	Kind         SpanKind
+	Start        time.Time
+	End          time.Time
+
+	mu         sync.Mutex
+	attributes map[string]interface{}
+	err        string
+	finished   bool
+}
+
+// SetAttribute sets the attribute key of the span to value.
+func (s *Span) SetAttribute(key string, value interface{}) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.attributes == nil {
+		s.attributes = make(map[string]interface{})
+	}
+	s.attributes[key] = value
+}
+
+// Attributes returns a copy of the attributes of the span.
+func (s *Span) Attributes() map[string]interface{} {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	attributes := make(map[string]interface{}, len(s.attributes))
+	for k, v := range s.attributes {
+		attributes[k] = v
+	}
+	return attributes
+}
+
+// SetError marks the span as failed with err. A nil err is ignored.
+func (s *Span) SetError(err error) {
+	if err == nil {
+		return
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.err = err.Error()
+}
+
+// Error returns the error message set on the span, if any.
+func (s *Span) Error() string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.err
+}
+
+// Finish ends the span, and hands it to the exporter. Calls after the first
+// have no effect.
+func (s *Span) Finish() {
+	s.mu.Lock()
+	if s.finished {
+		s.mu.Unlock()
+		return
+	}
+	s.finished = true
+	s.End = time.Now()
+	s.mu.Unlock()
+
+	exporterMu.RLock()
+	e := exporter
+	exporterMu.RUnlock()
+	if e != nil {
+		e.ExportSpan(s)
+	}
+}
+
+// Exporter receives finished spans.
+type Exporter interface {
+	ExportSpan(*Span)
+	Close() error
+}
+
+var (
+	exporterMu sync.RWMutex
+	exporter   Exporter
+)
+
+// SetExporter sets the exporter that receives finished spans. A nil exporter
+// discards them. Spans are still created, so that trace IDs may be reported in
+// logs and errors.
+func SetExporter(e Exporter) {
+	exporterMu.Lock()
+	defer exporterMu.Unlock()
+	exporter = e
+}
+
+type spanContextKey struct{}
+
+// ContextWithSpan returns a copy of ctx carrying span.
+func ContextWithSpan(ctx context.Context, span *Span) context.Context {
+	return context.WithValue(ctx, spanContextKey{}, span)
+}
+
+// SpanFromContext returns the current span of ctx, or nil if there is none.
+func SpanFromContext(ctx context.Context) *Span {
+	span, _ := ctx.Value(spanContextKey{}).(*Span)
+	return span
+}
+
+// TraceIDFromContext returns the trace ID of the current span of ctx.
+func TraceIDFromContext(ctx context.Context) (string, bool) {
+	span := SpanFromContext(ctx)
+	if span == nil {
+		return "", false
+	}
+	return span.TraceID.String(), true
+}
+
+// StartSpan starts a span named name, as a child of the current span of ctx if
+// there is one, and returns a copy of ctx carrying the new span.
+func StartSpan(ctx context.Context, name string) (context.Context, *Span) {
+	span := &Span{
+		Name:  name,
+		Kind:  InternalSpanKind,
+		Start: time.Now(),
+	}
+	if parent := SpanFromContext(ctx); parent != nil {
+		span.TraceID = parent.TraceID
+		span.ParentSpanID = parent.SpanID
+	} else {
+		span.TraceID = newTraceID()
+	}
+	span.SpanID = newSpanID()
+
+	return ContextWithSpan(ctx, span), span
+}
+
+func newTraceID() (id TraceID) {
+	io.ReadFull(rand.Reader, id[:])
+	return
+}
+
+func newSpanID() (id SpanID) {
+	io.ReadFull(rand.Reader, id[:])
+	return
+}
+
+// ParseTraceparent parses a W3C `traceparent` header value.
+func ParseTraceparent(s string) (traceID TraceID, parentID SpanID, err error) {
+	parts := strings.Split(strings.TrimSpace(s), "-")
+	if len(parts) != 4 || parts[0] != "00" {
+		err = fmt.Errorf("invalid traceparent: %q", s)
+		return
+	}
+	if b, e := hex.DecodeString(parts[1]); e != nil || len(b) != len(traceID) {
+		err = fmt.Errorf("invalid traceparent trace-id: %q", parts[1])
+		return
+	} else {
+		copy(traceID[:], b)
+	}
+	if b, e := hex.DecodeString(parts[2]); e != nil || len(b) != len(parentID) {
+		err = fmt.Errorf("invalid traceparent parent-id: %q", parts[2])
+		return
+	} else {
+		copy(parentID[:], b)
+	}
+	if !traceID.IsValid() || !parentID.IsValid() {
+		err = fmt.Errorf("invalid traceparent: %q", s)
+	}
+	return
+}
+
+// Traceparent formats the W3C `traceparent` header value for span.
+func Traceparent(span *Span) string {
+	return fmt.Sprintf("00-%s-%s-01", span.TraceID, span.SpanID)
+}
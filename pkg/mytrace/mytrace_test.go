@@ -0,0 +1,97 @@
+package mytrace_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/marksauter/markus-ninja-api/pkg/mytrace"
+)
+
+var parseTraceparentTests = []struct {
+	s        string
+	traceID  string
+	parentID string
+	valid    bool
+}{
+	{
+		"00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01",
+		"4bf92f3577b34da6a3ce929d0e0e4736",
+		"00f067aa0ba902b7",
+		true,
+	},
+	{
+		"00-00000000000000000000000000000000-00f067aa0ba902b7-01",
+		"",
+		"",
+		false,
+	},
+	{
+		"01-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01",
+		"",
+		"",
+		false,
+	},
+	{
+		"00-4bf92f3577b34da6a3ce929d0e0e47-00f067aa0ba902b7-01",
+		"",
+		"",
+		false,
+	},
+	{
+		"",
+		"",
+		"",
+		false,
+	},
+}
+
+func TestParseTraceparent(t *testing.T) {
+	for _, tt := range parseTraceparentTests {
+		traceID, parentID, err := mytrace.ParseTraceparent(tt.s)
+		if !tt.valid {
+			if err == nil {
+				t.Errorf("TestParseTraceparent(%s): expected err", tt.s)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("TestParseTraceparent(%s): unexpected err: %s", tt.s, err)
+			continue
+		}
+		if traceID.String() != tt.traceID {
+			t.Errorf(
+				"TestParseTraceparent(%s): expected trace-id %s, actual %s",
+				tt.s,
+				tt.traceID,
+				traceID,
+			)
+		}
+		if parentID.String() != tt.parentID {
+			t.Errorf(
+				"TestParseTraceparent(%s): expected parent-id %s, actual %s",
+				tt.s,
+				tt.parentID,
+				parentID,
+			)
+		}
+	}
+}
+
+func TestStartSpan(t *testing.T) {
+	ctx, parent := mytrace.StartSpan(context.Background(), "parent")
+	_, child := mytrace.StartSpan(ctx, "child")
+	if child.TraceID != parent.TraceID {
+		t.Errorf(
+			"TestStartSpan: expected trace-id %s, actual %s",
+			parent.TraceID,
+			child.TraceID,
+		)
+	}
+	if child.ParentSpanID != parent.SpanID {
+		t.Errorf(
+			"TestStartSpan: expected parent-id %s, actual %s",
+			parent.SpanID,
+			child.ParentSpanID,
+		)
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"regexp"
 	"strconv"
+	"strings"
 
 	"github.com/jackc/pgx/pgtype"
 	"github.com/marksauter/markus-ninja-api/pkg/mylog"
@@ -145,6 +146,25 @@ func (src *Markdown) ToText() string {
 	return util.MarkdownToText(src.String)
 }
 
+// Headings -
+func (src *Markdown) Headings() []util.MarkdownHeading {
+	return util.MarkdownHeadings([]byte(src.String))
+}
+
+// WordCount returns the number of words in the text of the markdown.
+func (src *Markdown) WordCount() int32 {
+	return int32(len(strings.Fields(src.ToText())))
+}
+
+// WordsPerMinute is the reading speed assumed by ReadingTimeMinutes.
+const WordsPerMinute = 200
+
+// ReadingTimeMinutes returns the time it takes to read the markdown, rounded up
+// to the minute.
+func (src *Markdown) ReadingTimeMinutes() int32 {
+	return (src.WordCount() + WordsPerMinute - 1) / WordsPerMinute
+}
+
 // Set -
 func (dst *Markdown) Set(src interface{}) error {
 	if src == nil {
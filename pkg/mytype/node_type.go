@@ -25,6 +25,7 @@ const (
 	LabeledNodeType
 	LessonNodeType
 	LessonDraftBackupNodeType
+	LessonPrerequisiteNodeType
 	NotificationNodeType
 	PRTNodeType
 	StudyNodeType
@@ -68,6 +69,8 @@ func (nt NodeType) String() string {
 		return "Lesson"
 	case LessonDraftBackupNodeType:
 		return "LessonDraftBackup"
+	case LessonPrerequisiteNodeType:
+		return "LessonPrerequisite"
 	case NotificationNodeType:
 		return "Notification"
 	case PRTNodeType:
@@ -121,6 +124,8 @@ func ParseNodeType(nodeType string) (NodeType, error) {
 		return LessonNodeType, nil
 	case "lessondraftbackup":
 		return LessonDraftBackupNodeType, nil
+	case "lessonprerequisite":
+		return LessonPrerequisiteNodeType, nil
 	case "notification":
 		return NotificationNodeType, nil
 	case "prt":
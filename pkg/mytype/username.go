@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"io/ioutil"
 	"regexp"
+	"strings"
 
 	"github.com/jackc/pgx/pgtype"
 	"github.com/marksauter/markus-ninja-api/pkg/mylog"
@@ -65,8 +66,9 @@ func (src *Username) IsBlacklisted() (bool, error) {
 	if err != nil {
 		return false, err
 	}
+	login := strings.ToLower(src.String)
 	for _, name := range blacklist.Usernames {
-		if src.String == name {
+		if login == name {
 			return true, nil
 		}
 	}
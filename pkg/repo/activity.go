@@ -53,6 +53,11 @@ func (r *ActivityPermit) CreatedAt() (time.Time, error) {
 	return r.activity.CreatedAt.Time, nil
 }
 
+// Cursor returns the cursor of the activity in a connection ordered by field.
+func (r *ActivityPermit) Cursor(field string) (string, error) {
+	return data.EncodeCursor(r.activity, field)
+}
+
 func (r *ActivityPermit) Description() (string, error) {
 	if ok := r.checkFieldPermission("description"); !ok {
 		err := ErrAccessDenied
@@ -149,7 +154,7 @@ func (r *ActivityRepo) filterPermittable(
 		fieldPermFn, err := r.permit.Check(ctx, accessLevel, l)
 		if err != nil {
 			if err != ErrAccessDenied {
-				mylog.Log.WithError(err).Error(util.Trace(""))
+				mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 				return nil, err
 			}
 		} else {
@@ -193,7 +198,7 @@ func (r *ActivityRepo) CountByLesson(
 	db, ok := myctx.QueryerFromContext(ctx)
 	if !ok {
 		err := &myctx.ErrNotFound{"queryer"}
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return n, err
 	}
 	return data.CountActivityByLesson(db, lessonID, filters)
@@ -207,7 +212,7 @@ func (r *ActivityRepo) CountBySearch(
 	db, ok := myctx.QueryerFromContext(ctx)
 	if !ok {
 		err := &myctx.ErrNotFound{"queryer"}
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return n, err
 	}
 	return data.CountActivityBySearch(db, filters)
@@ -222,7 +227,7 @@ func (r *ActivityRepo) CountByStudy(
 	db, ok := myctx.QueryerFromContext(ctx)
 	if !ok {
 		err := &myctx.ErrNotFound{"queryer"}
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return n, err
 	}
 	return data.CountActivityByStudy(db, studyID, filters)
@@ -237,7 +242,7 @@ func (r *ActivityRepo) CountByUser(
 	db, ok := myctx.QueryerFromContext(ctx)
 	if !ok {
 		err := &myctx.ErrNotFound{"queryer"}
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return n, err
 	}
 	return data.CountActivityByUser(db, userID, filters)
@@ -248,27 +253,27 @@ func (r *ActivityRepo) Create(
 	c *data.Activity,
 ) (*ActivityPermit, error) {
 	if err := r.CheckConnection(); err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 	db, ok := myctx.QueryerFromContext(ctx)
 	if !ok {
 		err := &myctx.ErrNotFound{"queryer"}
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 	if _, err := r.permit.Check(ctx, mytype.CreateAccess, c); err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 	activity, err := data.CreateActivity(db, c)
 	if err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 	fieldPermFn, err := r.permit.Check(ctx, mytype.ReadAccess, activity)
 	if err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 	return &ActivityPermit{fieldPermFn, activity}, nil
@@ -279,17 +284,17 @@ func (r *ActivityRepo) Get(
 	id string,
 ) (*ActivityPermit, error) {
 	if err := r.CheckConnection(); err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 	activity, err := r.load.Get(ctx, id)
 	if err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 	fieldPermFn, err := r.permit.Check(ctx, mytype.ReadAccess, activity)
 	if err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 	return &ActivityPermit{fieldPermFn, activity}, nil
@@ -302,21 +307,21 @@ func (r *ActivityRepo) Pull(
 	db, ok := myctx.QueryerFromContext(ctx)
 	if !ok {
 		err := &myctx.ErrNotFound{"queryer"}
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 	if err := r.CheckConnection(); err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 	activity, err := data.GetActivity(db, id)
 	if err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 	fieldPermFn, err := r.permit.Check(ctx, mytype.ReadAccess, activity)
 	if err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 	return &ActivityPermit{fieldPermFn, activity}, nil
@@ -329,18 +334,18 @@ func (r *ActivityRepo) GetByLesson(
 	filters *data.ActivityFilterOptions,
 ) ([]*ActivityPermit, error) {
 	if err := r.CheckConnection(); err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 	db, ok := myctx.QueryerFromContext(ctx)
 	if !ok {
 		err := &myctx.ErrNotFound{"queryer"}
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 	activities, err := data.GetActivityByLesson(db, lessonID, po, filters)
 	if err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 	return r.filterPermittable(ctx, mytype.ReadAccess, activities)
@@ -353,18 +358,18 @@ func (r *ActivityRepo) GetByStudy(
 	filters *data.ActivityFilterOptions,
 ) ([]*ActivityPermit, error) {
 	if err := r.CheckConnection(); err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 	db, ok := myctx.QueryerFromContext(ctx)
 	if !ok {
 		err := &myctx.ErrNotFound{"queryer"}
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 	activities, err := data.GetActivityByStudy(db, studyID, po, filters)
 	if err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 	return r.filterPermittable(ctx, mytype.ReadAccess, activities)
@@ -377,18 +382,18 @@ func (r *ActivityRepo) GetByUser(
 	filters *data.ActivityFilterOptions,
 ) ([]*ActivityPermit, error) {
 	if err := r.CheckConnection(); err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 	db, ok := myctx.QueryerFromContext(ctx)
 	if !ok {
 		err := &myctx.ErrNotFound{"queryer"}
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 	activities, err := data.GetActivityByUser(db, userID, po, filters)
 	if err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 	return r.filterPermittable(ctx, mytype.ReadAccess, activities)
@@ -400,17 +405,17 @@ func (r *ActivityRepo) GetByName(
 	name string,
 ) (*ActivityPermit, error) {
 	if err := r.CheckConnection(); err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 	activity, err := r.load.GetByName(ctx, studyID, name)
 	if err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 	fieldPermFn, err := r.permit.Check(ctx, mytype.ReadAccess, activity)
 	if err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 	return &ActivityPermit{fieldPermFn, activity}, nil
@@ -422,17 +427,17 @@ func (r *ActivityRepo) GetByNumber(
 	number int32,
 ) (*ActivityPermit, error) {
 	if err := r.CheckConnection(); err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 	activity, err := r.load.GetByNumber(ctx, studyID, number)
 	if err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 	fieldPermFn, err := r.permit.Check(ctx, mytype.ReadAccess, activity)
 	if err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 	return &ActivityPermit{fieldPermFn, activity}, nil
@@ -444,17 +449,17 @@ func (r *ActivityRepo) GetByStudyAndName(
 	name string,
 ) (*ActivityPermit, error) {
 	if err := r.CheckConnection(); err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 	activity, err := r.load.GetByStudyAndName(ctx, study, name)
 	if err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 	fieldPermFn, err := r.permit.Check(ctx, mytype.ReadAccess, activity)
 	if err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 	return &ActivityPermit{fieldPermFn, activity}, nil
@@ -465,17 +470,17 @@ func (r *ActivityRepo) Delete(
 	activity *data.Activity,
 ) error {
 	if err := r.CheckConnection(); err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return err
 	}
 	db, ok := myctx.QueryerFromContext(ctx)
 	if !ok {
 		err := &myctx.ErrNotFound{"queryer"}
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return err
 	}
 	if _, err := r.permit.Check(ctx, mytype.DeleteAccess, activity); err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return err
 	}
 	return data.DeleteActivity(db, activity.ID.String)
@@ -487,18 +492,18 @@ func (r *ActivityRepo) Search(
 	filters *data.ActivityFilterOptions,
 ) ([]*ActivityPermit, error) {
 	if err := r.CheckConnection(); err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 	db, ok := myctx.QueryerFromContext(ctx)
 	if !ok {
 		err := &myctx.ErrNotFound{"queryer"}
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 	activities, err := data.SearchActivity(db, po, filters)
 	if err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 	return r.filterPermittable(ctx, mytype.ReadAccess, activities)
@@ -509,27 +514,27 @@ func (r *ActivityRepo) Update(
 	c *data.Activity,
 ) (*ActivityPermit, error) {
 	if err := r.CheckConnection(); err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 	db, ok := myctx.QueryerFromContext(ctx)
 	if !ok {
 		err := &myctx.ErrNotFound{"queryer"}
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 	if _, err := r.permit.Check(ctx, mytype.UpdateAccess, c); err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 	activity, err := data.UpdateActivity(db, c)
 	if err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 	fieldPermFn, err := r.permit.Check(ctx, mytype.ReadAccess, activity)
 	if err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 	return &ActivityPermit{fieldPermFn, activity}, nil
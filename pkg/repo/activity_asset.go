@@ -114,7 +114,7 @@ func (r *ActivityAssetRepo) CountByActivity(
 	db, ok := myctx.QueryerFromContext(ctx)
 	if !ok {
 		err := &myctx.ErrNotFound{"queryer"}
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return n, err
 	}
 	return data.CountActivityAssetByActivity(db, activityID)
@@ -125,27 +125,27 @@ func (r *ActivityAssetRepo) Connect(
 	activityAsset *data.ActivityAsset,
 ) (*ActivityAssetPermit, error) {
 	if err := r.CheckConnection(); err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 	db, ok := myctx.QueryerFromContext(ctx)
 	if !ok {
 		err := &myctx.ErrNotFound{"queryer"}
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 	if _, err := r.permit.Check(ctx, mytype.ConnectAccess, activityAsset); err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 	activityAsset, err := data.CreateActivityAsset(db, *activityAsset)
 	if err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 	fieldPermFn, err := r.permit.Check(ctx, mytype.ReadAccess, activityAsset)
 	if err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 	return &ActivityAssetPermit{fieldPermFn, activityAsset}, nil
@@ -156,17 +156,17 @@ func (r *ActivityAssetRepo) Get(
 	assetID string,
 ) (*ActivityAssetPermit, error) {
 	if err := r.CheckConnection(); err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 	activityAsset, err := r.load.Get(ctx, assetID)
 	if err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 	fieldPermFn, err := r.permit.Check(ctx, mytype.ReadAccess, activityAsset)
 	if err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 	return &ActivityAssetPermit{fieldPermFn, activityAsset}, nil
@@ -178,17 +178,17 @@ func (r *ActivityAssetRepo) GetByActivityAndNumber(
 	number int32,
 ) (*ActivityAssetPermit, error) {
 	if err := r.CheckConnection(); err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 	activityAsset, err := r.load.GetByActivityAndNumber(ctx, activityID, number)
 	if err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 	fieldPermFn, err := r.permit.Check(ctx, mytype.ReadAccess, activityAsset)
 	if err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 	return &ActivityAssetPermit{fieldPermFn, activityAsset}, nil
@@ -200,25 +200,25 @@ func (r *ActivityAssetRepo) GetByActivity(
 	po *data.PageOptions,
 ) ([]*ActivityAssetPermit, error) {
 	if err := r.CheckConnection(); err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 	db, ok := myctx.QueryerFromContext(ctx)
 	if !ok {
 		err := &myctx.ErrNotFound{"queryer"}
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 	activityAssets, err := data.GetActivityAssetByActivity(db, activityID, po)
 	if err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 	activityAssetPermits := make([]*ActivityAssetPermit, len(activityAssets))
 	if len(activityAssets) > 0 {
 		fieldPermFn, err := r.permit.Check(ctx, mytype.ReadAccess, activityAssets[0])
 		if err != nil {
-			mylog.Log.WithError(err).Error(util.Trace(""))
+			mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 			return nil, err
 		}
 		for i, l := range activityAssets {
@@ -233,17 +233,17 @@ func (r *ActivityAssetRepo) Disconnect(
 	activityAsset *data.ActivityAsset,
 ) error {
 	if err := r.CheckConnection(); err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return err
 	}
 	db, ok := myctx.QueryerFromContext(ctx)
 	if !ok {
 		err := &myctx.ErrNotFound{"queryer"}
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return err
 	}
 	if _, err := r.permit.Check(ctx, mytype.DisconnectAccess, activityAsset); err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return err
 	}
 	return data.DeleteActivityAsset(db, activityAsset.AssetID.String)
@@ -255,17 +255,17 @@ func (r *ActivityAssetRepo) Move(
 	afterAssetID string,
 ) (*data.ActivityAsset, error) {
 	if err := r.CheckConnection(); err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 	db, ok := myctx.QueryerFromContext(ctx)
 	if !ok {
 		err := &myctx.ErrNotFound{"queryer"}
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 	if _, err := r.permit.Check(ctx, mytype.UpdateAccess, activityAsset); err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 	return data.MoveActivityAsset(
@@ -116,7 +116,7 @@ func (r *AppledRepo) CountByAppleable(
 	db, ok := myctx.QueryerFromContext(ctx)
 	if !ok {
 		err := &myctx.ErrNotFound{"queryer"}
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return n, err
 	}
 	return data.CountAppledByAppleable(db, appleableID)
@@ -130,7 +130,7 @@ func (r *AppledRepo) CountByUser(
 	db, ok := myctx.QueryerFromContext(ctx)
 	if !ok {
 		err := &myctx.ErrNotFound{"queryer"}
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return n, err
 	}
 	return data.CountAppledByUser(db, userID)
@@ -141,27 +141,27 @@ func (r *AppledRepo) Connect(
 	appled *data.Appled,
 ) (*AppledPermit, error) {
 	if err := r.CheckConnection(); err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 	db, ok := myctx.QueryerFromContext(ctx)
 	if !ok {
 		err := &myctx.ErrNotFound{"queryer"}
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 	if _, err := r.permit.Check(ctx, mytype.ConnectAccess, appled); err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 	appled, err := data.CreateAppled(db, *appled)
 	if err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 	fieldPermFn, err := r.permit.Check(ctx, mytype.ReadAccess, appled)
 	if err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 	return &AppledPermit{fieldPermFn, appled}, nil
@@ -172,7 +172,7 @@ func (r *AppledRepo) Get(
 	a *data.Appled,
 ) (*AppledPermit, error) {
 	if err := r.CheckConnection(); err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 	var appled *data.Appled
@@ -180,26 +180,26 @@ func (r *AppledRepo) Get(
 	if a.ID.Status != pgtype.Undefined {
 		appled, err = r.load.Get(ctx, a.ID.Int)
 		if err != nil {
-			mylog.Log.WithError(err).Error(util.Trace(""))
+			mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 			return nil, err
 		}
 	} else if a.AppleableID.Status != pgtype.Undefined &&
 		a.UserID.Status != pgtype.Undefined {
 		appled, err = r.load.GetByAppleableAndUser(ctx, a.AppleableID.String, a.UserID.String)
 		if err != nil {
-			mylog.Log.WithError(err).Error(util.Trace(""))
+			mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 			return nil, err
 		}
 	} else {
 		err := errors.New(
 			"must include either appled `id` or `appleable_id` and `user_id` to get an appled",
 		)
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 	fieldPermFn, err := r.permit.Check(ctx, mytype.ReadAccess, appled)
 	if err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 	return &AppledPermit{fieldPermFn, appled}, nil
@@ -211,25 +211,25 @@ func (r *AppledRepo) GetByAppleable(
 	po *data.PageOptions,
 ) ([]*AppledPermit, error) {
 	if err := r.CheckConnection(); err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 	db, ok := myctx.QueryerFromContext(ctx)
 	if !ok {
 		err := &myctx.ErrNotFound{"queryer"}
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 	appleds, err := data.GetAppledByAppleable(db, appleableID, po)
 	if err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 	appledPermits := make([]*AppledPermit, len(appleds))
 	if len(appleds) > 0 {
 		fieldPermFn, err := r.permit.Check(ctx, mytype.ReadAccess, appleds[0])
 		if err != nil {
-			mylog.Log.WithError(err).Error(util.Trace(""))
+			mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 			return nil, err
 		}
 		for i, l := range appleds {
@@ -245,25 +245,25 @@ func (r *AppledRepo) GetByUser(
 	po *data.PageOptions,
 ) ([]*AppledPermit, error) {
 	if err := r.CheckConnection(); err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 	db, ok := myctx.QueryerFromContext(ctx)
 	if !ok {
 		err := &myctx.ErrNotFound{"queryer"}
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 	appleds, err := data.GetAppledByUser(db, userID, po)
 	if err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 	appledPermits := make([]*AppledPermit, len(appleds))
 	if len(appleds) > 0 {
 		fieldPermFn, err := r.permit.Check(ctx, mytype.ReadAccess, appleds[0])
 		if err != nil {
-			mylog.Log.WithError(err).Error(util.Trace(""))
+			mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 			return nil, err
 		}
 		for i, l := range appleds {
@@ -278,17 +278,17 @@ func (r *AppledRepo) Disconnect(
 	a *data.Appled,
 ) error {
 	if err := r.CheckConnection(); err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return err
 	}
 	db, ok := myctx.QueryerFromContext(ctx)
 	if !ok {
 		err := &myctx.ErrNotFound{"queryer"}
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return err
 	}
 	if _, err := r.permit.Check(ctx, mytype.DisconnectAccess, a); err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return err
 	}
 	if a.ID.Status != pgtype.Undefined {
@@ -300,7 +300,7 @@ func (r *AppledRepo) Disconnect(
 	err := errors.New(
 		"must include either appled `id` or `appleable_id` and `user_id` to delete a appled",
 	)
-	mylog.Log.WithError(err).Error(util.Trace(""))
+	mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 	return err
 }
 
@@ -312,7 +312,7 @@ func (r *AppledRepo) ViewerCanApple(
 		if err == ErrAccessDenied {
 			return false, nil
 		}
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return false, err
 	}
 	return true, nil
@@ -162,27 +162,27 @@ func (r *AssetRepo) Create(
 	a *data.Asset,
 ) (*AssetPermit, error) {
 	if err := r.CheckConnection(); err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 	db, ok := myctx.QueryerFromContext(ctx)
 	if !ok {
 		err := &myctx.ErrNotFound{"queryer"}
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 	if _, err := r.permit.Check(ctx, mytype.CreateAccess, a); err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 	asset, err := data.CreateAsset(db, a)
 	if err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 	fieldPermFn, err := r.permit.Check(ctx, mytype.ReadAccess, asset)
 	if err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 	return &AssetPermit{fieldPermFn, asset}, nil
@@ -193,17 +193,17 @@ func (r *AssetRepo) Delete(
 	asset *data.Asset,
 ) error {
 	if err := r.CheckConnection(); err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return err
 	}
 	db, ok := myctx.QueryerFromContext(ctx)
 	if !ok {
 		err := &myctx.ErrNotFound{"queryer"}
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return err
 	}
 	if _, err := r.permit.Check(ctx, mytype.DeleteAccess, asset); err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return err
 	}
 	return data.DeleteAsset(db, asset.ID.Int)
@@ -214,17 +214,17 @@ func (r *AssetRepo) Get(
 	id int64,
 ) (*AssetPermit, error) {
 	if err := r.CheckConnection(); err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 	asset, err := r.load.Get(ctx, id)
 	if err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 	fieldPermFn, err := r.permit.Check(ctx, mytype.ReadAccess, asset)
 	if err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 	return &AssetPermit{fieldPermFn, asset}, nil
@@ -235,17 +235,17 @@ func (r *AssetRepo) GetByKey(
 	key string,
 ) (*AssetPermit, error) {
 	if err := r.CheckConnection(); err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 	asset, err := r.load.GetByKey(ctx, key)
 	if err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 	fieldPermFn, err := r.permit.Check(ctx, mytype.ReadAccess, asset)
 	if err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 	return &AssetPermit{fieldPermFn, asset}, nil
@@ -59,6 +59,11 @@ func (r *CommentPermit) CreatedAt() (time.Time, error) {
 	return r.comment.CreatedAt.Time, nil
 }
 
+// Cursor returns the cursor of the comment in a connection ordered by field.
+func (r *CommentPermit) Cursor(field string) (string, error) {
+	return data.EncodeCursor(r.comment, field)
+}
+
 func (r *CommentPermit) Draft() (string, error) {
 	if ok := r.checkFieldPermission("draft"); !ok {
 		err := ErrAccessDenied
@@ -163,7 +168,7 @@ func (r *CommentRepo) filterPermittable(
 		fieldPermFn, err := r.permit.Check(ctx, accessLevel, l)
 		if err != nil {
 			if err != ErrAccessDenied {
-				mylog.Log.WithError(err).Error(util.Trace(""))
+				mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 				return nil, err
 			}
 		} else {
@@ -207,7 +212,7 @@ func (r *CommentRepo) CountByLabel(
 	db, ok := myctx.QueryerFromContext(ctx)
 	if !ok {
 		err := &myctx.ErrNotFound{"queryer"}
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return n, err
 	}
 	return data.CountCommentByLabel(db, labelID, filters)
@@ -222,12 +227,36 @@ func (r *CommentRepo) CountByCommentable(
 	db, ok := myctx.QueryerFromContext(ctx)
 	if !ok {
 		err := &myctx.ErrNotFound{"queryer"}
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return n, err
 	}
 	return data.CountCommentByCommentable(db, commentableID, filters)
 }
 
+func (r *CommentRepo) CountBySearch(
+	ctx context.Context,
+	filters *data.CommentFilterOptions,
+) (int32, error) {
+	var n int32
+	db, ok := myctx.QueryerFromContext(ctx)
+	if !ok {
+		err := &myctx.ErrNotFound{Name: "queryer"}
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
+		return n, err
+	}
+	viewer, ok := myctx.UserFromContext(ctx)
+	if !ok {
+		err := &myctx.ErrNotFound{Name: "viewer"}
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
+		return n, err
+	}
+	isAdmin, err := r.permit.viewerIsSiteAdmin(ctx)
+	if err != nil {
+		return n, err
+	}
+	return data.CountCommentBySearch(db, viewer.ID.String, isAdmin, filters)
+}
+
 func (r *CommentRepo) CountByStudy(
 	ctx context.Context,
 	studyID string,
@@ -237,7 +266,7 @@ func (r *CommentRepo) CountByStudy(
 	db, ok := myctx.QueryerFromContext(ctx)
 	if !ok {
 		err := &myctx.ErrNotFound{"queryer"}
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return n, err
 	}
 	return data.CountCommentByStudy(db, studyID, filters)
@@ -252,7 +281,7 @@ func (r *CommentRepo) CountByUser(
 	db, ok := myctx.QueryerFromContext(ctx)
 	if !ok {
 		err := &myctx.ErrNotFound{"queryer"}
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return n, err
 	}
 	return data.CountCommentByUser(db, userID, filters)
@@ -263,27 +292,27 @@ func (r *CommentRepo) Create(
 	lc *data.Comment,
 ) (*CommentPermit, error) {
 	if err := r.CheckConnection(); err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 	db, ok := myctx.QueryerFromContext(ctx)
 	if !ok {
 		err := &myctx.ErrNotFound{"queryer"}
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 	if _, err := r.permit.Check(ctx, mytype.CreateAccess, lc); err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 	comment, err := data.CreateComment(db, lc)
 	if err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 	fieldPermFn, err := r.permit.Check(ctx, mytype.ReadAccess, comment)
 	if err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 	return &CommentPermit{fieldPermFn, comment}, nil
@@ -294,17 +323,17 @@ func (r *CommentRepo) Get(
 	id string,
 ) (*CommentPermit, error) {
 	if err := r.CheckConnection(); err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 	comment, err := r.load.Get(ctx, id)
 	if err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 	fieldPermFn, err := r.permit.Check(ctx, mytype.ReadAccess, comment)
 	if err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 	return &CommentPermit{fieldPermFn, comment}, nil
@@ -315,18 +344,18 @@ func (r *CommentRepo) BatchGet(
 	ids []string,
 ) ([]*CommentPermit, error) {
 	if err := r.CheckConnection(); err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 	db, ok := myctx.QueryerFromContext(ctx)
 	if !ok {
 		err := &myctx.ErrNotFound{"queryer"}
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 	comments, err := data.BatchGetComment(db, ids)
 	if err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 	return r.filterPermittable(ctx, mytype.ReadAccess, comments)
@@ -338,23 +367,23 @@ func (r *CommentRepo) GetUserNewComment(
 	commentableID string,
 ) (*CommentPermit, error) {
 	if err := r.CheckConnection(); err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 	db, ok := myctx.QueryerFromContext(ctx)
 	if !ok {
 		err := &myctx.ErrNotFound{"queryer"}
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 	comment, err := data.GetUserNewComment(db, userID, commentableID)
 	if err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 	fieldPermFn, err := r.permit.Check(ctx, mytype.ReadAccess, comment)
 	if err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 	return &CommentPermit{fieldPermFn, comment}, nil
@@ -367,18 +396,18 @@ func (r *CommentRepo) GetByLabel(
 	filters *data.CommentFilterOptions,
 ) ([]*CommentPermit, error) {
 	if err := r.CheckConnection(); err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 	db, ok := myctx.QueryerFromContext(ctx)
 	if !ok {
 		err := &myctx.ErrNotFound{"queryer"}
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 	comments, err := data.GetCommentByLabel(db, labelID, po, filters)
 	if err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 	return r.filterPermittable(ctx, mytype.ReadAccess, comments)
@@ -391,18 +420,18 @@ func (r *CommentRepo) GetByCommentable(
 	filters *data.CommentFilterOptions,
 ) ([]*CommentPermit, error) {
 	if err := r.CheckConnection(); err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 	db, ok := myctx.QueryerFromContext(ctx)
 	if !ok {
 		err := &myctx.ErrNotFound{"queryer"}
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 	comments, err := data.GetCommentByCommentable(db, commentableID, po, filters)
 	if err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 	return r.filterPermittable(ctx, mytype.ReadAccess, comments)
@@ -415,18 +444,18 @@ func (r *CommentRepo) GetByStudy(
 	filters *data.CommentFilterOptions,
 ) ([]*CommentPermit, error) {
 	if err := r.CheckConnection(); err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 	db, ok := myctx.QueryerFromContext(ctx)
 	if !ok {
 		err := &myctx.ErrNotFound{"queryer"}
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 	comments, err := data.GetCommentByStudy(db, studyID, po, filters)
 	if err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 	return r.filterPermittable(ctx, mytype.ReadAccess, comments)
@@ -439,18 +468,18 @@ func (r *CommentRepo) GetByUser(
 	filters *data.CommentFilterOptions,
 ) ([]*CommentPermit, error) {
 	if err := r.CheckConnection(); err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 	db, ok := myctx.QueryerFromContext(ctx)
 	if !ok {
 		err := &myctx.ErrNotFound{"queryer"}
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 	comments, err := data.GetCommentByUser(db, userID, po, filters)
 	if err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 	return r.filterPermittable(ctx, mytype.ReadAccess, comments)
@@ -461,48 +490,81 @@ func (r *CommentRepo) Delete(
 	lc *data.Comment,
 ) error {
 	if err := r.CheckConnection(); err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return err
 	}
 	db, ok := myctx.QueryerFromContext(ctx)
 	if !ok {
 		err := &myctx.ErrNotFound{"queryer"}
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return err
 	}
 	if _, err := r.permit.Check(ctx, mytype.DeleteAccess, lc); err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return err
 	}
 	return data.DeleteComment(db, lc.ID.String)
 }
 
+func (r *CommentRepo) Search(
+	ctx context.Context,
+	po *data.PageOptions,
+	filters *data.CommentFilterOptions,
+) ([]*CommentPermit, error) {
+	if err := r.CheckConnection(); err != nil {
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
+		return nil, err
+	}
+	db, ok := myctx.QueryerFromContext(ctx)
+	if !ok {
+		err := &myctx.ErrNotFound{Name: "queryer"}
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
+		return nil, err
+	}
+	viewer, ok := myctx.UserFromContext(ctx)
+	if !ok {
+		err := &myctx.ErrNotFound{Name: "viewer"}
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
+		return nil, err
+	}
+	isAdmin, err := r.permit.viewerIsSiteAdmin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	comments, err := data.SearchComment(db, viewer.ID.String, isAdmin, po, filters)
+	if err != nil {
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
+		return nil, err
+	}
+	return r.filterPermittable(ctx, mytype.ReadAccess, comments)
+}
+
 func (r *CommentRepo) Update(
 	ctx context.Context,
 	lc *data.Comment,
 ) (*CommentPermit, error) {
 	if err := r.CheckConnection(); err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 	db, ok := myctx.QueryerFromContext(ctx)
 	if !ok {
 		err := &myctx.ErrNotFound{"queryer"}
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 	if _, err := r.permit.Check(ctx, mytype.UpdateAccess, lc); err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 	comment, err := data.UpdateComment(db, lc)
 	if err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 	fieldPermFn, err := r.permit.Check(ctx, mytype.ReadAccess, comment)
 	if err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 	return &CommentPermit{fieldPermFn, comment}, nil
@@ -126,7 +126,7 @@ func (r *EnrolledRepo) CountByEnrollable(
 	db, ok := myctx.QueryerFromContext(ctx)
 	if !ok {
 		err := &myctx.ErrNotFound{"queryer"}
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return n, err
 	}
 	return data.CountEnrolledByEnrollable(db, enrollableID, filters)
@@ -141,7 +141,7 @@ func (r *EnrolledRepo) CountByUser(
 	db, ok := myctx.QueryerFromContext(ctx)
 	if !ok {
 		err := &myctx.ErrNotFound{"queryer"}
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return n, err
 	}
 	return data.CountEnrolledByUser(db, userID, filters)
@@ -152,27 +152,27 @@ func (r *EnrolledRepo) Connect(
 	enrolled *data.Enrolled,
 ) (*EnrolledPermit, error) {
 	if err := r.CheckConnection(); err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 	db, ok := myctx.QueryerFromContext(ctx)
 	if !ok {
 		err := &myctx.ErrNotFound{"queryer"}
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 	if _, err := r.permit.Check(ctx, mytype.ConnectAccess, enrolled); err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 	enrolled, err := data.CreateEnrolled(db, *enrolled)
 	if err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 	fieldPermFn, err := r.permit.Check(ctx, mytype.ReadAccess, enrolled)
 	if err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 	return &EnrolledPermit{fieldPermFn, enrolled}, nil
@@ -183,7 +183,7 @@ func (r *EnrolledRepo) Get(
 	e *data.Enrolled,
 ) (*EnrolledPermit, error) {
 	if err := r.CheckConnection(); err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 	var enrolled *data.Enrolled
@@ -191,26 +191,26 @@ func (r *EnrolledRepo) Get(
 	if e.ID.Status != pgtype.Undefined {
 		enrolled, err = r.load.Get(ctx, e.ID.Int)
 		if err != nil {
-			mylog.Log.WithError(err).Error(util.Trace(""))
+			mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 			return nil, err
 		}
 	} else if e.EnrollableID.Status != pgtype.Undefined &&
 		e.UserID.Status != pgtype.Undefined {
 		enrolled, err = r.load.GetByEnrollableAndUser(ctx, e.EnrollableID.String, e.UserID.String)
 		if err != nil {
-			mylog.Log.WithError(err).Error(util.Trace(""))
+			mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 			return nil, err
 		}
 	} else {
 		err := errors.New(
 			"must include either enrolled `id` or `enrollable_id` and `user_id` to get an enrolled",
 		)
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 	fieldPermFn, err := r.permit.Check(ctx, mytype.ReadAccess, enrolled)
 	if err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 	return &EnrolledPermit{fieldPermFn, enrolled}, nil
@@ -223,25 +223,25 @@ func (r *EnrolledRepo) GetByEnrollable(
 	filters *data.EnrolledFilterOptions,
 ) ([]*EnrolledPermit, error) {
 	if err := r.CheckConnection(); err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 	db, ok := myctx.QueryerFromContext(ctx)
 	if !ok {
 		err := &myctx.ErrNotFound{"queryer"}
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 	enrolleds, err := data.GetEnrolledByEnrollable(db, enrollableID, po, filters)
 	if err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 	enrolledPermits := make([]*EnrolledPermit, len(enrolleds))
 	if len(enrolleds) > 0 {
 		fieldPermFn, err := r.permit.Check(ctx, mytype.ReadAccess, enrolleds[0])
 		if err != nil {
-			mylog.Log.WithError(err).Error(util.Trace(""))
+			mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 			return nil, err
 		}
 		for i, l := range enrolleds {
@@ -258,25 +258,25 @@ func (r *EnrolledRepo) GetByUser(
 	filters *data.EnrolledFilterOptions,
 ) ([]*EnrolledPermit, error) {
 	if err := r.CheckConnection(); err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 	db, ok := myctx.QueryerFromContext(ctx)
 	if !ok {
 		err := &myctx.ErrNotFound{"queryer"}
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 	enrolleds, err := data.GetEnrolledByUser(db, userID, po, filters)
 	if err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 	enrolledPermits := make([]*EnrolledPermit, len(enrolleds))
 	if len(enrolleds) > 0 {
 		fieldPermFn, err := r.permit.Check(ctx, mytype.ReadAccess, enrolleds[0])
 		if err != nil {
-			mylog.Log.WithError(err).Error(util.Trace(""))
+			mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 			return nil, err
 		}
 		for i, l := range enrolleds {
@@ -291,17 +291,17 @@ func (r *EnrolledRepo) Disconnect(
 	enrolled *data.Enrolled,
 ) error {
 	if err := r.CheckConnection(); err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return err
 	}
 	db, ok := myctx.QueryerFromContext(ctx)
 	if !ok {
 		err := &myctx.ErrNotFound{"queryer"}
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return err
 	}
 	if _, err := r.permit.Check(ctx, mytype.DisconnectAccess, enrolled); err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return err
 	}
 	if enrolled.ID.Status != pgtype.Undefined {
@@ -315,7 +315,7 @@ func (r *EnrolledRepo) Disconnect(
 		)
 	}
 	err := errors.New("must include either `id` or `enrollable_id` and `user_id` to delete an enrolled")
-	mylog.Log.WithError(err).Error(util.Trace(""))
+	mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 	return err
 }
 
@@ -328,11 +328,11 @@ func (r *EnrolledRepo) Pull(
 	db, ok := myctx.QueryerFromContext(ctx)
 	if !ok {
 		err := &myctx.ErrNotFound{"queryer"}
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 	if err := r.CheckConnection(); err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 	var enrolled *data.Enrolled
@@ -340,26 +340,26 @@ func (r *EnrolledRepo) Pull(
 	if e.ID.Status != pgtype.Undefined {
 		enrolled, err = data.GetEnrolled(db, e.ID.Int)
 		if err != nil {
-			mylog.Log.WithError(err).Error(util.Trace(""))
+			mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 			return nil, err
 		}
 	} else if e.EnrollableID.Status != pgtype.Undefined &&
 		e.UserID.Status != pgtype.Undefined {
 		enrolled, err = data.GetEnrolledByEnrollableAndUser(db, e.EnrollableID.String, e.UserID.String)
 		if err != nil {
-			mylog.Log.WithError(err).Error(util.Trace(""))
+			mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 			return nil, err
 		}
 	} else {
 		err := errors.New(
 			"must include either enrolled `id` or `enrollable_id` and `user_id` to get an enrolled",
 		)
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 	fieldPermFn, err := r.permit.Check(ctx, mytype.ReadAccess, enrolled)
 	if err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 	return &EnrolledPermit{fieldPermFn, enrolled}, nil
@@ -370,27 +370,27 @@ func (r *EnrolledRepo) Update(
 	e *data.Enrolled,
 ) (*EnrolledPermit, error) {
 	if err := r.CheckConnection(); err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 	db, ok := myctx.QueryerFromContext(ctx)
 	if !ok {
 		err := &myctx.ErrNotFound{"queryer"}
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 	if _, err := r.permit.Check(ctx, mytype.UpdateAccess, e); err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 	enrolled, err := data.UpdateEnrolled(db, e)
 	if err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 	fieldPermFn, err := r.permit.Check(ctx, mytype.ReadAccess, enrolled)
 	if err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 	return &EnrolledPermit{fieldPermFn, enrolled}, nil
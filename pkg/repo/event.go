@@ -41,6 +41,11 @@ func (r *EventPermit) CreatedAt() (time.Time, error) {
 	return r.event.CreatedAt.Time, nil
 }
 
+// Cursor returns the cursor of the event in a connection ordered by field.
+func (r *EventPermit) Cursor(field string) (string, error) {
+	return data.EncodeCursor(r.event, field)
+}
+
 func (r *EventPermit) ID() (*mytype.OID, error) {
 	if ok := r.checkFieldPermission("id"); !ok {
 		err := ErrAccessDenied
@@ -142,7 +147,7 @@ func (r *EventRepo) CountByLesson(
 	db, ok := myctx.QueryerFromContext(ctx)
 	if !ok {
 		err := &myctx.ErrNotFound{"queryer"}
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return n, err
 	}
 	return data.CountEventByLesson(db, lessonID, filters)
@@ -157,7 +162,7 @@ func (r *EventRepo) CountReceivedByUser(
 	db, ok := myctx.QueryerFromContext(ctx)
 	if !ok {
 		err := &myctx.ErrNotFound{"queryer"}
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return n, err
 	}
 	return data.CountReceivedEventByUser(db, userID, filters)
@@ -172,7 +177,7 @@ func (r *EventRepo) CountByStudy(
 	db, ok := myctx.QueryerFromContext(ctx)
 	if !ok {
 		err := &myctx.ErrNotFound{"queryer"}
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return n, err
 	}
 	return data.CountEventByStudy(db, studyID, filters)
@@ -187,7 +192,7 @@ func (r *EventRepo) CountByUser(
 	db, ok := myctx.QueryerFromContext(ctx)
 	if !ok {
 		err := &myctx.ErrNotFound{"queryer"}
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return n, err
 	}
 	return data.CountEventByUser(db, userID, filters)
@@ -202,7 +207,7 @@ func (r *EventRepo) CountByUserAsset(
 	db, ok := myctx.QueryerFromContext(ctx)
 	if !ok {
 		err := &myctx.ErrNotFound{"queryer"}
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return n, err
 	}
 	return data.CountEventByUserAsset(db, assetID, filters)
@@ -213,29 +218,29 @@ func (r *EventRepo) Create(
 	event *data.Event,
 ) (*EventPermit, error) {
 	if err := r.CheckConnection(); err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 	db, ok := myctx.QueryerFromContext(ctx)
 	if !ok {
 		err := &myctx.ErrNotFound{"queryer"}
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 	if _, err := r.permit.Check(ctx, mytype.CreateAccess, event); err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 	event, err := data.CreateEvent(db, event)
 	if err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	} else if event == nil {
 		return nil, nil
 	}
 	fieldPermFn, err := r.permit.Check(ctx, mytype.ReadAccess, event)
 	if err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 	return &EventPermit{fieldPermFn, event}, nil
@@ -246,17 +251,17 @@ func (r *EventRepo) Get(
 	id string,
 ) (*EventPermit, error) {
 	if err := r.CheckConnection(); err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 	event, err := r.load.Get(ctx, id)
 	if err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 	fieldPermFn, err := r.permit.Check(ctx, mytype.ReadAccess, event)
 	if err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 	return &EventPermit{fieldPermFn, event}, nil
@@ -269,25 +274,25 @@ func (r *EventRepo) GetByLesson(
 	filters *data.EventFilterOptions,
 ) ([]*EventPermit, error) {
 	if err := r.CheckConnection(); err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 	db, ok := myctx.QueryerFromContext(ctx)
 	if !ok {
 		err := &myctx.ErrNotFound{"queryer"}
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 	events, err := data.GetEventByLesson(db, lessonID, po, filters)
 	if err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 	eventPermits := make([]*EventPermit, len(events))
 	if len(events) > 0 {
 		fieldPermFn, err := r.permit.Check(ctx, mytype.ReadAccess, events[0])
 		if err != nil {
-			mylog.Log.WithError(err).Error(util.Trace(""))
+			mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 			return nil, err
 		}
 		for i, l := range events {
@@ -304,25 +309,25 @@ func (r *EventRepo) GetReceivedByUser(
 	filters *data.EventFilterOptions,
 ) ([]*EventPermit, error) {
 	if err := r.CheckConnection(); err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 	db, ok := myctx.QueryerFromContext(ctx)
 	if !ok {
 		err := &myctx.ErrNotFound{"queryer"}
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 	events, err := data.GetReceivedEventByUser(db, userID, po, filters)
 	if err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 	eventPermits := make([]*EventPermit, len(events))
 	if len(events) > 0 {
 		fieldPermFn, err := r.permit.Check(ctx, mytype.ReadAccess, events[0])
 		if err != nil {
-			mylog.Log.WithError(err).Error(util.Trace(""))
+			mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 			return nil, err
 		}
 		for i, l := range events {
@@ -339,25 +344,60 @@ func (r *EventRepo) GetByStudy(
 	filters *data.EventFilterOptions,
 ) ([]*EventPermit, error) {
 	if err := r.CheckConnection(); err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 	db, ok := myctx.QueryerFromContext(ctx)
 	if !ok {
 		err := &myctx.ErrNotFound{"queryer"}
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 	events, err := data.GetEventByStudy(db, studyID, po, filters)
 	if err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
+		return nil, err
+	}
+	eventPermits := make([]*EventPermit, len(events))
+	if len(events) > 0 {
+		fieldPermFn, err := r.permit.Check(ctx, mytype.ReadAccess, events[0])
+		if err != nil {
+			mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
+			return nil, err
+		}
+		for i, l := range events {
+			eventPermits[i] = &EventPermit{fieldPermFn, l}
+		}
+	}
+	return eventPermits, nil
+}
+
+func (r *EventRepo) GetByTopic(
+	ctx context.Context,
+	topicID string,
+	po *data.PageOptions,
+	filters *data.EventFilterOptions,
+) ([]*EventPermit, error) {
+	if err := r.CheckConnection(); err != nil {
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
+		return nil, err
+	}
+	db, ok := myctx.QueryerFromContext(ctx)
+	if !ok {
+		err := &myctx.ErrNotFound{Name: "queryer"}
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
+		return nil, err
+	}
+	events, err := data.GetEventByTopic(db, topicID, po, filters)
+	if err != nil {
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 	eventPermits := make([]*EventPermit, len(events))
 	if len(events) > 0 {
 		fieldPermFn, err := r.permit.Check(ctx, mytype.ReadAccess, events[0])
 		if err != nil {
-			mylog.Log.WithError(err).Error(util.Trace(""))
+			mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 			return nil, err
 		}
 		for i, l := range events {
@@ -374,25 +414,25 @@ func (r *EventRepo) GetByUser(
 	filters *data.EventFilterOptions,
 ) ([]*EventPermit, error) {
 	if err := r.CheckConnection(); err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 	db, ok := myctx.QueryerFromContext(ctx)
 	if !ok {
 		err := &myctx.ErrNotFound{"queryer"}
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 	events, err := data.GetEventByUser(db, userID, po, filters)
 	if err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 	eventPermits := make([]*EventPermit, len(events))
 	if len(events) > 0 {
 		fieldPermFn, err := r.permit.Check(ctx, mytype.ReadAccess, events[0])
 		if err != nil {
-			mylog.Log.WithError(err).Error(util.Trace(""))
+			mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 			return nil, err
 		}
 		for i, l := range events {
@@ -409,25 +449,25 @@ func (r *EventRepo) GetByUserAsset(
 	filters *data.EventFilterOptions,
 ) ([]*EventPermit, error) {
 	if err := r.CheckConnection(); err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 	db, ok := myctx.QueryerFromContext(ctx)
 	if !ok {
 		err := &myctx.ErrNotFound{"queryer"}
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 	events, err := data.GetEventByUserAsset(db, userID, po, filters)
 	if err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 	eventPermits := make([]*EventPermit, len(events))
 	if len(events) > 0 {
 		fieldPermFn, err := r.permit.Check(ctx, mytype.ReadAccess, events[0])
 		if err != nil {
-			mylog.Log.WithError(err).Error(util.Trace(""))
+			mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 			return nil, err
 		}
 		for i, l := range events {
@@ -442,17 +482,17 @@ func (r *EventRepo) Delete(
 	event *data.Event,
 ) error {
 	if err := r.CheckConnection(); err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return err
 	}
 	db, ok := myctx.QueryerFromContext(ctx)
 	if !ok {
 		err := &myctx.ErrNotFound{"queryer"}
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return err
 	}
 	if _, err := r.permit.Check(ctx, mytype.DeleteAccess, event); err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return err
 	}
 	return data.DeleteEvent(db, event.ID.String)
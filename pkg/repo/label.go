@@ -49,6 +49,11 @@ func (r *LabelPermit) CreatedAt() (time.Time, error) {
 	return r.label.CreatedAt.Time, nil
 }
 
+// Cursor returns the cursor of the label in a connection ordered by field.
+func (r *LabelPermit) Cursor(field string) (string, error) {
+	return data.EncodeCursor(r.label, field)
+}
+
 func (r *LabelPermit) Description() (string, error) {
 	if ok := r.checkFieldPermission("description"); !ok {
 		err := ErrAccessDenied
@@ -116,6 +121,26 @@ type LabelRepo struct {
 	permit *Permitter
 }
 
+func (r *LabelRepo) filterPermittable(
+	ctx context.Context,
+	accessLevel mytype.AccessLevel,
+	labels []*data.Label,
+) ([]*LabelPermit, error) {
+	labelPermits := make([]*LabelPermit, 0, len(labels))
+	for _, l := range labels {
+		fieldPermFn, err := r.permit.Check(ctx, accessLevel, l)
+		if err != nil {
+			if err != ErrAccessDenied {
+				mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
+				return nil, err
+			}
+		} else {
+			labelPermits = append(labelPermits, &LabelPermit{fieldPermFn, l})
+		}
+	}
+	return labelPermits, nil
+}
+
 func (r *LabelRepo) Open(p *Permitter) error {
 	if p == nil {
 		err := ErrNilPermitter
@@ -150,7 +175,7 @@ func (r *LabelRepo) CountByLabelable(
 	db, ok := myctx.QueryerFromContext(ctx)
 	if !ok {
 		err := &myctx.ErrNotFound{"queryer"}
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return n, err
 	}
 	return data.CountLabelByLabelable(db, labelableID, filters)
@@ -164,7 +189,7 @@ func (r *LabelRepo) CountBySearch(
 	db, ok := myctx.QueryerFromContext(ctx)
 	if !ok {
 		err := &myctx.ErrNotFound{"queryer"}
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return n, err
 	}
 	return data.CountLabelBySearch(db, filters)
@@ -179,7 +204,7 @@ func (r *LabelRepo) CountByStudy(
 	db, ok := myctx.QueryerFromContext(ctx)
 	if !ok {
 		err := &myctx.ErrNotFound{"queryer"}
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return n, err
 	}
 	return data.CountLabelByStudy(db, studyID, filters)
@@ -190,27 +215,27 @@ func (r *LabelRepo) Create(
 	l *data.Label,
 ) (*LabelPermit, error) {
 	if err := r.CheckConnection(); err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 	db, ok := myctx.QueryerFromContext(ctx)
 	if !ok {
 		err := &myctx.ErrNotFound{"queryer"}
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 	if _, err := r.permit.Check(ctx, mytype.CreateAccess, l); err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 	label, err := data.CreateLabel(db, l)
 	if err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 	fieldPermFn, err := r.permit.Check(ctx, mytype.ReadAccess, label)
 	if err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 	return &LabelPermit{fieldPermFn, label}, nil
@@ -221,17 +246,17 @@ func (r *LabelRepo) Get(
 	id string,
 ) (*LabelPermit, error) {
 	if err := r.CheckConnection(); err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 	label, err := r.load.Get(ctx, id)
 	if err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 	fieldPermFn, err := r.permit.Check(ctx, mytype.ReadAccess, label)
 	if err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 	return &LabelPermit{fieldPermFn, label}, nil
@@ -244,32 +269,21 @@ func (r *LabelRepo) GetByLabelable(
 	filters *data.LabelFilterOptions,
 ) ([]*LabelPermit, error) {
 	if err := r.CheckConnection(); err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 	db, ok := myctx.QueryerFromContext(ctx)
 	if !ok {
 		err := &myctx.ErrNotFound{"queryer"}
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 	labels, err := data.GetLabelByLabelable(db, labelableID, po, filters)
 	if err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
-	labelPermits := make([]*LabelPermit, len(labels))
-	if len(labels) > 0 {
-		fieldPermFn, err := r.permit.Check(ctx, mytype.ReadAccess, labels[0])
-		if err != nil {
-			mylog.Log.WithError(err).Error(util.Trace(""))
-			return nil, err
-		}
-		for i, l := range labels {
-			labelPermits[i] = &LabelPermit{fieldPermFn, l}
-		}
-	}
-	return labelPermits, nil
+	return r.filterPermittable(ctx, mytype.ReadAccess, labels)
 }
 
 func (r *LabelRepo) GetByStudy(
@@ -279,32 +293,21 @@ func (r *LabelRepo) GetByStudy(
 	filters *data.LabelFilterOptions,
 ) ([]*LabelPermit, error) {
 	if err := r.CheckConnection(); err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 	db, ok := myctx.QueryerFromContext(ctx)
 	if !ok {
 		err := &myctx.ErrNotFound{"queryer"}
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 	labels, err := data.GetLabelByStudy(db, studyID, po, filters)
 	if err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
-	labelPermits := make([]*LabelPermit, len(labels))
-	if len(labels) > 0 {
-		fieldPermFn, err := r.permit.Check(ctx, mytype.ReadAccess, labels[0])
-		if err != nil {
-			mylog.Log.WithError(err).Error(util.Trace(""))
-			return nil, err
-		}
-		for i, l := range labels {
-			labelPermits[i] = &LabelPermit{fieldPermFn, l}
-		}
-	}
-	return labelPermits, nil
+	return r.filterPermittable(ctx, mytype.ReadAccess, labels)
 }
 
 func (r *LabelRepo) GetByName(
@@ -313,17 +316,17 @@ func (r *LabelRepo) GetByName(
 	name string,
 ) (*LabelPermit, error) {
 	if err := r.CheckConnection(); err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 	label, err := r.load.GetByName(ctx, studyID, name)
 	if err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 	fieldPermFn, err := r.permit.Check(ctx, mytype.ReadAccess, label)
 	if err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 	return &LabelPermit{fieldPermFn, label}, nil
@@ -334,17 +337,17 @@ func (r *LabelRepo) Delete(
 	label *data.Label,
 ) error {
 	if err := r.CheckConnection(); err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return err
 	}
 	db, ok := myctx.QueryerFromContext(ctx)
 	if !ok {
 		err := &myctx.ErrNotFound{"queryer"}
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return err
 	}
 	if _, err := r.permit.Check(ctx, mytype.DeleteAccess, label); err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return err
 	}
 	return data.DeleteLabel(db, label.ID.String)
@@ -356,32 +359,21 @@ func (r *LabelRepo) Search(
 	filters *data.LabelFilterOptions,
 ) ([]*LabelPermit, error) {
 	if err := r.CheckConnection(); err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 	db, ok := myctx.QueryerFromContext(ctx)
 	if !ok {
 		err := &myctx.ErrNotFound{"queryer"}
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 	labels, err := data.SearchLabel(db, po, filters)
 	if err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
-	labelPermits := make([]*LabelPermit, len(labels))
-	if len(labels) > 0 {
-		fieldPermFn, err := r.permit.Check(ctx, mytype.ReadAccess, labels[0])
-		if err != nil {
-			mylog.Log.WithError(err).Error(util.Trace(""))
-			return nil, err
-		}
-		for i, l := range labels {
-			labelPermits[i] = &LabelPermit{fieldPermFn, l}
-		}
-	}
-	return labelPermits, nil
+	return r.filterPermittable(ctx, mytype.ReadAccess, labels)
 }
 
 func (r *LabelRepo) Update(
@@ -389,27 +381,27 @@ func (r *LabelRepo) Update(
 	l *data.Label,
 ) (*LabelPermit, error) {
 	if err := r.CheckConnection(); err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 	db, ok := myctx.QueryerFromContext(ctx)
 	if !ok {
 		err := &myctx.ErrNotFound{"queryer"}
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 	if _, err := r.permit.Check(ctx, mytype.UpdateAccess, l); err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 	label, err := data.UpdateLabel(db, l)
 	if err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 	fieldPermFn, err := r.permit.Check(ctx, mytype.ReadAccess, label)
 	if err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 	return &LabelPermit{fieldPermFn, label}, nil
@@ -71,6 +71,11 @@ func (r *LessonPermit) CreatedAt() (time.Time, error) {
 	return r.lesson.CreatedAt.Time, nil
 }
 
+// Cursor returns the cursor of the lesson in a connection ordered by field.
+func (r *LessonPermit) Cursor(field string) (string, error) {
+	return data.EncodeCursor(r.lesson, field)
+}
+
 func (r *LessonPermit) Draft() (string, error) {
 	if ok := r.checkFieldPermission("draft"); !ok {
 		err := ErrAccessDenied
@@ -126,6 +131,28 @@ func (r *LessonPermit) PublishedAt() (time.Time, error) {
 	return r.lesson.PublishedAt.Time, nil
 }
 
+func (r *LessonPermit) ReadingTimeMinutes() (int32, error) {
+	if ok := r.checkFieldPermission("reading_time_minutes"); !ok {
+		err := ErrAccessDenied
+		mylog.Log.WithError(err).Error(util.Trace(""))
+		var n int32
+		return n, err
+	}
+	return r.lesson.ReadingTimeMinutes.Int, nil
+}
+
+func (r *LessonPermit) ScheduledPublishAt() (*time.Time, error) {
+	if ok := r.checkFieldPermission("scheduled_publish_at"); !ok {
+		err := ErrAccessDenied
+		mylog.Log.WithError(err).Error(util.Trace(""))
+		return nil, err
+	}
+	if r.lesson.ScheduledPublishAt.Status == pgtype.Null {
+		return nil, nil
+	}
+	return &r.lesson.ScheduledPublishAt.Time, nil
+}
+
 func (r *LessonPermit) StudyID() (*mytype.OID, error) {
 	if ok := r.checkFieldPermission("study_id"); !ok {
 		err := ErrAccessDenied
@@ -144,6 +171,20 @@ func (r *LessonPermit) Title() (string, error) {
 	return r.lesson.Title.String, nil
 }
 
+func (r *LessonPermit) TOC() ([]util.MarkdownHeading, error) {
+	if ok := r.checkFieldPermission("toc"); !ok {
+		err := ErrAccessDenied
+		mylog.Log.WithError(err).Error(util.Trace(""))
+		return nil, err
+	}
+	var toc []util.MarkdownHeading
+	if err := r.lesson.TOC.AssignTo(&toc); err != nil {
+		mylog.Log.WithError(err).Error(util.Trace(""))
+		return nil, err
+	}
+	return toc, nil
+}
+
 func (r *LessonPermit) UpdatedAt() (time.Time, error) {
 	if ok := r.checkFieldPermission("updated_at"); !ok {
 		err := ErrAccessDenied
@@ -162,6 +203,16 @@ func (r *LessonPermit) UserID() (*mytype.OID, error) {
 	return &r.lesson.UserID, nil
 }
 
+func (r *LessonPermit) WordCount() (int32, error) {
+	if ok := r.checkFieldPermission("word_count"); !ok {
+		err := ErrAccessDenied
+		mylog.Log.WithError(err).Error(util.Trace(""))
+		var n int32
+		return n, err
+	}
+	return r.lesson.WordCount.Int, nil
+}
+
 func NewLessonRepo(conf *myconf.Config) *LessonRepo {
 	return &LessonRepo{
 		conf: conf,
@@ -185,7 +236,7 @@ func (r *LessonRepo) filterPermittable(
 		fieldPermFn, err := r.permit.Check(ctx, accessLevel, l)
 		if err != nil {
 			if err != ErrAccessDenied {
-				mylog.Log.WithError(err).Error(util.Trace(""))
+				mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 				return nil, err
 			}
 		} else {
@@ -233,7 +284,7 @@ func (r *LessonRepo) CountByEnrollee(
 	db, ok := myctx.QueryerFromContext(ctx)
 	if !ok {
 		err := &myctx.ErrNotFound{"queryer"}
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return n, err
 	}
 	return data.CountLessonByEnrollee(db, userID, filters)
@@ -248,12 +299,42 @@ func (r *LessonRepo) CountByLabel(
 	db, ok := myctx.QueryerFromContext(ctx)
 	if !ok {
 		err := &myctx.ErrNotFound{"queryer"}
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return n, err
 	}
 	return data.CountLessonByLabel(db, labelID, filters)
 }
 
+func (r *LessonRepo) CountByDependent(
+	ctx context.Context,
+	dependentID string,
+	filters *data.LessonFilterOptions,
+) (int32, error) {
+	var n int32
+	db, ok := myctx.QueryerFromContext(ctx)
+	if !ok {
+		err := &myctx.ErrNotFound{Name: "queryer"}
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
+		return n, err
+	}
+	return data.CountLessonByDependent(db, dependentID, filters)
+}
+
+func (r *LessonRepo) CountByPrerequisite(
+	ctx context.Context,
+	prerequisiteID string,
+	filters *data.LessonFilterOptions,
+) (int32, error) {
+	var n int32
+	db, ok := myctx.QueryerFromContext(ctx)
+	if !ok {
+		err := &myctx.ErrNotFound{Name: "queryer"}
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
+		return n, err
+	}
+	return data.CountLessonByPrerequisite(db, prerequisiteID, filters)
+}
+
 func (r *LessonRepo) CountBySearch(
 	ctx context.Context,
 	filters *data.LessonFilterOptions,
@@ -262,7 +343,7 @@ func (r *LessonRepo) CountBySearch(
 	db, ok := myctx.QueryerFromContext(ctx)
 	if !ok {
 		err := &myctx.ErrNotFound{"queryer"}
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return n, err
 	}
 	return data.CountLessonBySearch(db, filters)
@@ -277,7 +358,7 @@ func (r *LessonRepo) CountByCourse(
 	db, ok := myctx.QueryerFromContext(ctx)
 	if !ok {
 		err := &myctx.ErrNotFound{"queryer"}
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return n, err
 	}
 	return data.CountLessonByCourse(db, courseID, filters)
@@ -292,7 +373,7 @@ func (r *LessonRepo) CountByStudy(
 	db, ok := myctx.QueryerFromContext(ctx)
 	if !ok {
 		err := &myctx.ErrNotFound{"queryer"}
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return n, err
 	}
 	return data.CountLessonByStudy(db, studyID, filters)
@@ -307,7 +388,7 @@ func (r *LessonRepo) CountByUser(
 	db, ok := myctx.QueryerFromContext(ctx)
 	if !ok {
 		err := &myctx.ErrNotFound{"queryer"}
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return n, err
 	}
 	return data.CountLessonByUser(db, userID, filters)
@@ -318,27 +399,27 @@ func (r *LessonRepo) Create(
 	l *data.Lesson,
 ) (*LessonPermit, error) {
 	if err := r.CheckConnection(); err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 	db, ok := myctx.QueryerFromContext(ctx)
 	if !ok {
 		err := &myctx.ErrNotFound{"queryer"}
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 	if _, err := r.permit.Check(ctx, mytype.CreateAccess, l); err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 	lesson, err := data.CreateLesson(db, l)
 	if err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 	fieldPermFn, err := r.permit.Check(ctx, mytype.ReadAccess, lesson)
 	if err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 	return &LessonPermit{fieldPermFn, lesson}, nil
@@ -365,7 +446,7 @@ func (r *LessonRepo) ExistsByNumber(
 	db, ok := myctx.QueryerFromContext(ctx)
 	if !ok {
 		err := &myctx.ErrNotFound{"queryer"}
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return false, err
 	}
 	return data.ExistsLessonByNumber(db, studyID, number)
@@ -383,7 +464,7 @@ func (r *LessonRepo) ExistsByOwnerStudyAndNumber(
 	db, ok := myctx.QueryerFromContext(ctx)
 	if !ok {
 		err := &myctx.ErrNotFound{"queryer"}
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return false, err
 	}
 	return data.ExistsLessonByOwnerStudyAndNumber(db, ownerLogin, studyName, number)
@@ -394,17 +475,17 @@ func (r *LessonRepo) Get(
 	id string,
 ) (*LessonPermit, error) {
 	if err := r.CheckConnection(); err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 	lesson, err := r.load.Get(ctx, id)
 	if err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 	fieldPermFn, err := r.permit.Check(ctx, mytype.ReadAccess, lesson)
 	if err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 	return &LessonPermit{fieldPermFn, lesson}, nil
@@ -418,21 +499,21 @@ func (r *LessonRepo) Pull(
 	db, ok := myctx.QueryerFromContext(ctx)
 	if !ok {
 		err := &myctx.ErrNotFound{"queryer"}
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 	if err := r.CheckConnection(); err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 	lesson, err := data.GetLesson(db, id)
 	if err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 	fieldPermFn, err := r.permit.Check(ctx, mytype.ReadAccess, lesson)
 	if err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 	return &LessonPermit{fieldPermFn, lesson}, nil
@@ -445,18 +526,18 @@ func (r *LessonRepo) GetByEnrollee(
 	filters *data.LessonFilterOptions,
 ) ([]*LessonPermit, error) {
 	if err := r.CheckConnection(); err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 	db, ok := myctx.QueryerFromContext(ctx)
 	if !ok {
 		err := &myctx.ErrNotFound{"queryer"}
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 	lessons, err := data.GetLessonByEnrollee(db, userID, po, filters)
 	if err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 	return r.filterPermittable(ctx, mytype.ReadAccess, lessons)
@@ -469,18 +550,66 @@ func (r *LessonRepo) GetByLabel(
 	filters *data.LessonFilterOptions,
 ) ([]*LessonPermit, error) {
 	if err := r.CheckConnection(); err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 	db, ok := myctx.QueryerFromContext(ctx)
 	if !ok {
 		err := &myctx.ErrNotFound{"queryer"}
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 	lessons, err := data.GetLessonByLabel(db, labelID, po, filters)
 	if err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
+		return nil, err
+	}
+	return r.filterPermittable(ctx, mytype.ReadAccess, lessons)
+}
+
+func (r *LessonRepo) GetByDependent(
+	ctx context.Context,
+	dependentID string,
+	po *data.PageOptions,
+	filters *data.LessonFilterOptions,
+) ([]*LessonPermit, error) {
+	if err := r.CheckConnection(); err != nil {
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
+		return nil, err
+	}
+	db, ok := myctx.QueryerFromContext(ctx)
+	if !ok {
+		err := &myctx.ErrNotFound{Name: "queryer"}
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
+		return nil, err
+	}
+	lessons, err := data.GetLessonByDependent(db, dependentID, po, filters)
+	if err != nil {
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
+		return nil, err
+	}
+	return r.filterPermittable(ctx, mytype.ReadAccess, lessons)
+}
+
+func (r *LessonRepo) GetByPrerequisite(
+	ctx context.Context,
+	prerequisiteID string,
+	po *data.PageOptions,
+	filters *data.LessonFilterOptions,
+) ([]*LessonPermit, error) {
+	if err := r.CheckConnection(); err != nil {
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
+		return nil, err
+	}
+	db, ok := myctx.QueryerFromContext(ctx)
+	if !ok {
+		err := &myctx.ErrNotFound{Name: "queryer"}
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
+		return nil, err
+	}
+	lessons, err := data.GetLessonByPrerequisite(db, prerequisiteID, po, filters)
+	if err != nil {
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 	return r.filterPermittable(ctx, mytype.ReadAccess, lessons)
@@ -493,18 +622,18 @@ func (r *LessonRepo) GetByCourse(
 	filters *data.LessonFilterOptions,
 ) ([]*LessonPermit, error) {
 	if err := r.CheckConnection(); err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 	db, ok := myctx.QueryerFromContext(ctx)
 	if !ok {
 		err := &myctx.ErrNotFound{"queryer"}
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 	lessons, err := data.GetLessonByCourse(db, courseID, po, filters)
 	if err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 	return r.filterPermittable(ctx, mytype.ReadAccess, lessons)
@@ -517,18 +646,18 @@ func (r *LessonRepo) GetByStudy(
 	filters *data.LessonFilterOptions,
 ) ([]*LessonPermit, error) {
 	if err := r.CheckConnection(); err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 	db, ok := myctx.QueryerFromContext(ctx)
 	if !ok {
 		err := &myctx.ErrNotFound{"queryer"}
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 	lessons, err := data.GetLessonByStudy(db, studyID, po, filters)
 	if err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 	return r.filterPermittable(ctx, mytype.ReadAccess, lessons)
@@ -541,18 +670,18 @@ func (r *LessonRepo) GetByUser(
 	filters *data.LessonFilterOptions,
 ) ([]*LessonPermit, error) {
 	if err := r.CheckConnection(); err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 	db, ok := myctx.QueryerFromContext(ctx)
 	if !ok {
 		err := &myctx.ErrNotFound{"queryer"}
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 	lessons, err := data.GetLessonByUser(db, userID, po, filters)
 	if err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 	return r.filterPermittable(ctx, mytype.ReadAccess, lessons)
@@ -564,23 +693,23 @@ func (r *LessonRepo) GetByCourseNumber(
 	courseNumber int32,
 ) (*LessonPermit, error) {
 	if err := r.CheckConnection(); err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 	db, ok := myctx.QueryerFromContext(ctx)
 	if !ok {
 		err := &myctx.ErrNotFound{"queryer"}
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 	lesson, err := data.GetLessonByCourseNumber(db, courseID, courseNumber)
 	if err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 	fieldPermFn, err := r.permit.Check(ctx, mytype.ReadAccess, lesson)
 	if err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 	return &LessonPermit{fieldPermFn, lesson}, nil
@@ -592,23 +721,23 @@ func (r *LessonRepo) GetByNumber(
 	number int32,
 ) (*LessonPermit, error) {
 	if err := r.CheckConnection(); err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 	db, ok := myctx.QueryerFromContext(ctx)
 	if !ok {
 		err := &myctx.ErrNotFound{"queryer"}
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 	lesson, err := data.GetLessonByNumber(db, studyID, number)
 	if err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 	fieldPermFn, err := r.permit.Check(ctx, mytype.ReadAccess, lesson)
 	if err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 	return &LessonPermit{fieldPermFn, lesson}, nil
@@ -620,18 +749,18 @@ func (r *LessonRepo) BatchGetByNumber(
 	numbers []int32,
 ) ([]*LessonPermit, error) {
 	if err := r.CheckConnection(); err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 	db, ok := myctx.QueryerFromContext(ctx)
 	if !ok {
 		err := &myctx.ErrNotFound{"queryer"}
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 	lessons, err := data.BatchGetLessonByNumber(db, studyID, numbers)
 	if err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 	return r.filterPermittable(ctx, mytype.ReadAccess, lessons)
@@ -644,23 +773,23 @@ func (r *LessonRepo) GetByOwnerStudyAndNumber(
 	number int32,
 ) (*LessonPermit, error) {
 	if err := r.CheckConnection(); err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 	db, ok := myctx.QueryerFromContext(ctx)
 	if !ok {
 		err := &myctx.ErrNotFound{"queryer"}
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 	lesson, err := data.GetLessonByOwnerStudyAndNumber(db, owner, study, number)
 	if err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 	fieldPermFn, err := r.permit.Check(ctx, mytype.ReadAccess, lesson)
 	if err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 	return &LessonPermit{fieldPermFn, lesson}, nil
@@ -671,17 +800,17 @@ func (r *LessonRepo) Delete(
 	lesson *data.Lesson,
 ) error {
 	if err := r.CheckConnection(); err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return err
 	}
 	db, ok := myctx.QueryerFromContext(ctx)
 	if !ok {
 		err := &myctx.ErrNotFound{"queryer"}
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return err
 	}
 	if _, err := r.permit.Check(ctx, mytype.DeleteAccess, lesson); err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return err
 	}
 	return data.DeleteLesson(db, lesson.ID.String)
@@ -693,18 +822,18 @@ func (r *LessonRepo) Search(
 	filters *data.LessonFilterOptions,
 ) ([]*LessonPermit, error) {
 	if err := r.CheckConnection(); err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 	db, ok := myctx.QueryerFromContext(ctx)
 	if !ok {
 		err := &myctx.ErrNotFound{"queryer"}
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 	lessons, err := data.SearchLesson(db, po, filters)
 	if err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 	return r.filterPermittable(ctx, mytype.ReadAccess, lessons)
@@ -715,27 +844,27 @@ func (r *LessonRepo) Update(
 	l *data.Lesson,
 ) (*LessonPermit, error) {
 	if err := r.CheckConnection(); err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 	db, ok := myctx.QueryerFromContext(ctx)
 	if !ok {
 		err := &myctx.ErrNotFound{"queryer"}
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 	if _, err := r.permit.Check(ctx, mytype.UpdateAccess, l); err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 	lesson, err := data.UpdateLesson(db, l)
 	if err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 	fieldPermFn, err := r.permit.Check(ctx, mytype.ReadAccess, lesson)
 	if err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 	return &LessonPermit{fieldPermFn, lesson}, nil
@@ -747,7 +876,7 @@ func (r *LessonRepo) ViewerCanDelete(
 ) bool {
 	if _, err := r.permit.Check(ctx, mytype.DeleteAccess, l); err != nil {
 		if err != ErrAccessDenied {
-			mylog.Log.WithError(err).Error(util.Trace(""))
+			mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		}
 		return false
 	}
@@ -762,7 +891,7 @@ func (r *LessonRepo) ViewerCanUpdate(
 		if err == ErrFieldAccessDenied {
 			return true
 		} else if err != ErrAccessDenied {
-			mylog.Log.WithError(err).Error(util.Trace(""))
+			mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		}
 		return false
 	}
@@ -0,0 +1,240 @@
+package repo
+
+import (
+	"context"
+	"time"
+
+	"github.com/fatih/structs"
+	"github.com/marksauter/markus-ninja-api/pkg/data"
+	"github.com/marksauter/markus-ninja-api/pkg/loader"
+	"github.com/marksauter/markus-ninja-api/pkg/myconf"
+	"github.com/marksauter/markus-ninja-api/pkg/myctx"
+	"github.com/marksauter/markus-ninja-api/pkg/mylog"
+	"github.com/marksauter/markus-ninja-api/pkg/mytype"
+	"github.com/marksauter/markus-ninja-api/pkg/util"
+)
+
+type LessonPrerequisitePermit struct {
+	checkFieldPermission FieldPermissionFunc
+	lessonPrerequisite   *data.LessonPrerequisite
+}
+
+func (r *LessonPrerequisitePermit) Get() *data.LessonPrerequisite {
+	lessonPrerequisite := r.lessonPrerequisite
+	fields := structs.Fields(lessonPrerequisite)
+	for _, f := range fields {
+		name := f.Tag("db")
+		if ok := r.checkFieldPermission(name); !ok {
+			f.Zero()
+		}
+	}
+	return lessonPrerequisite
+}
+
+func (r *LessonPrerequisitePermit) CreatedAt() (time.Time, error) {
+	if ok := r.checkFieldPermission("created_at"); !ok {
+		err := ErrAccessDenied
+		mylog.Log.WithError(err).Error(util.Trace(""))
+		return time.Time{}, err
+	}
+	return r.lessonPrerequisite.CreatedAt.Time, nil
+}
+
+func (r *LessonPrerequisitePermit) LessonID() (*mytype.OID, error) {
+	if ok := r.checkFieldPermission("lesson_id"); !ok {
+		err := ErrAccessDenied
+		mylog.Log.WithError(err).Error(util.Trace(""))
+		return nil, err
+	}
+	return &r.lessonPrerequisite.LessonID, nil
+}
+
+func (r *LessonPrerequisitePermit) PrerequisiteID() (*mytype.OID, error) {
+	if ok := r.checkFieldPermission("prerequisite_id"); !ok {
+		err := ErrAccessDenied
+		mylog.Log.WithError(err).Error(util.Trace(""))
+		return nil, err
+	}
+	return &r.lessonPrerequisite.PrerequisiteID, nil
+}
+
+func NewLessonPrerequisiteRepo(conf *myconf.Config) *LessonPrerequisiteRepo {
+	return &LessonPrerequisiteRepo{
+		conf: conf,
+		load: loader.NewLessonPrerequisiteLoader(),
+	}
+}
+
+type LessonPrerequisiteRepo struct {
+	conf   *myconf.Config
+	load   *loader.LessonPrerequisiteLoader
+	permit *Permitter
+}
+
+func (r *LessonPrerequisiteRepo) Open(p *Permitter) error {
+	if p == nil {
+		err := ErrNilPermitter
+		mylog.Log.WithError(err).Error(util.Trace(""))
+		return err
+	}
+	r.permit = p
+	return nil
+}
+
+func (r *LessonPrerequisiteRepo) Close() {
+	r.load.ClearAll()
+}
+
+func (r *LessonPrerequisiteRepo) CheckConnection() error {
+	if r.load == nil {
+		err := ErrConnClosed
+		mylog.Log.WithError(err).Error(util.Trace(""))
+		return err
+	}
+	return nil
+}
+
+// Service methods
+
+func (r *LessonPrerequisiteRepo) Connect(
+	ctx context.Context,
+	lessonPrerequisite *data.LessonPrerequisite,
+) (*LessonPrerequisitePermit, error) {
+	if err := r.CheckConnection(); err != nil {
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
+		return nil, err
+	}
+	db, ok := myctx.QueryerFromContext(ctx)
+	if !ok {
+		err := &myctx.ErrNotFound{Name: "queryer"}
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
+		return nil, err
+	}
+	if _, err := r.permit.Check(ctx, mytype.ConnectAccess, lessonPrerequisite); err != nil {
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
+		return nil, err
+	}
+	lessonPrerequisite, err := data.CreateLessonPrerequisite(db, *lessonPrerequisite)
+	if err != nil {
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
+		return nil, err
+	}
+	fieldPermFn, err := r.permit.Check(ctx, mytype.ReadAccess, lessonPrerequisite)
+	if err != nil {
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
+		return nil, err
+	}
+	return &LessonPrerequisitePermit{fieldPermFn, lessonPrerequisite}, nil
+}
+
+func (r *LessonPrerequisiteRepo) Get(
+	ctx context.Context,
+	lessonID,
+	prerequisiteID string,
+) (*LessonPrerequisitePermit, error) {
+	if err := r.CheckConnection(); err != nil {
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
+		return nil, err
+	}
+	lessonPrerequisite, err := r.load.Get(ctx, lessonID, prerequisiteID)
+	if err != nil {
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
+		return nil, err
+	}
+	fieldPermFn, err := r.permit.Check(ctx, mytype.ReadAccess, lessonPrerequisite)
+	if err != nil {
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
+		return nil, err
+	}
+	return &LessonPrerequisitePermit{fieldPermFn, lessonPrerequisite}, nil
+}
+
+func (r *LessonPrerequisiteRepo) filterPermittable(
+	ctx context.Context,
+	lessonPrerequisites []*data.LessonPrerequisite,
+) ([]*LessonPrerequisitePermit, error) {
+	lessonPrerequisitePermits := make([]*LessonPrerequisitePermit, len(lessonPrerequisites))
+	if len(lessonPrerequisites) > 0 {
+		fieldPermFn, err := r.permit.Check(ctx, mytype.ReadAccess, lessonPrerequisites[0])
+		if err != nil {
+			mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
+			return nil, err
+		}
+		for i, l := range lessonPrerequisites {
+			lessonPrerequisitePermits[i] = &LessonPrerequisitePermit{fieldPermFn, l}
+		}
+	}
+	return lessonPrerequisitePermits, nil
+}
+
+// GetAncestry returns the edges between the lesson and all of its direct and
+// indirect prerequisites.
+func (r *LessonPrerequisiteRepo) GetAncestry(
+	ctx context.Context,
+	lessonID string,
+) ([]*LessonPrerequisitePermit, error) {
+	if err := r.CheckConnection(); err != nil {
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
+		return nil, err
+	}
+	db, ok := myctx.QueryerFromContext(ctx)
+	if !ok {
+		err := &myctx.ErrNotFound{Name: "queryer"}
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
+		return nil, err
+	}
+	lessonPrerequisites, err := data.GetLessonPrerequisiteAncestry(db, lessonID)
+	if err != nil {
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
+		return nil, err
+	}
+	return r.filterPermittable(ctx, lessonPrerequisites)
+}
+
+// GetByStudy returns the edges with a lesson of the study at either end.
+func (r *LessonPrerequisiteRepo) GetByStudy(
+	ctx context.Context,
+	studyID string,
+) ([]*LessonPrerequisitePermit, error) {
+	if err := r.CheckConnection(); err != nil {
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
+		return nil, err
+	}
+	db, ok := myctx.QueryerFromContext(ctx)
+	if !ok {
+		err := &myctx.ErrNotFound{Name: "queryer"}
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
+		return nil, err
+	}
+	lessonPrerequisites, err := data.GetLessonPrerequisiteByStudy(db, studyID)
+	if err != nil {
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
+		return nil, err
+	}
+	return r.filterPermittable(ctx, lessonPrerequisites)
+}
+
+func (r *LessonPrerequisiteRepo) Disconnect(
+	ctx context.Context,
+	lessonPrerequisite *data.LessonPrerequisite,
+) error {
+	if err := r.CheckConnection(); err != nil {
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
+		return err
+	}
+	db, ok := myctx.QueryerFromContext(ctx)
+	if !ok {
+		err := &myctx.ErrNotFound{Name: "queryer"}
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
+		return err
+	}
+	if _, err := r.permit.Check(ctx, mytype.DisconnectAccess, lessonPrerequisite); err != nil {
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
+		return err
+	}
+	return data.DeleteLessonPrerequisite(
+		db,
+		lessonPrerequisite.LessonID.String,
+		lessonPrerequisite.PrerequisiteID.String,
+	)
+}
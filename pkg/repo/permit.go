@@ -7,6 +7,7 @@ import (
 )
 
 type NodePermit interface {
+	Cursor(field string) (string, error)
 	ID() (*mytype.OID, error)
 }
 
@@ -12,23 +12,37 @@ import (
 	"github.com/marksauter/markus-ninja-api/pkg/myconf"
 	"github.com/marksauter/markus-ninja-api/pkg/myctx"
 	"github.com/marksauter/markus-ninja-api/pkg/mylog"
+	"github.com/marksauter/markus-ninja-api/pkg/mymetrics"
 	"github.com/marksauter/markus-ninja-api/pkg/mytype"
 	"github.com/marksauter/markus-ninja-api/pkg/util"
 )
 
 const Guest = "guest"
 
+// Ghost is the login of the user that the comments of deleted users are
+// reassigned to. The login is reserved, but the user is identified by GhostID.
+const Ghost = "ghost"
+
+// GhostID is the fixed ID of the ghost user, which the migration creates.
+const GhostID = "MDA0VXNlcjAwMDAwMDAwMDAwMDAwMGdob3N0"
+
 func NewPermitter(repos *Repos, conf *myconf.Config) *Permitter {
 	return &Permitter{
-		load:  loader.NewQueryPermLoader(),
-		repos: repos,
+		access:     loader.NewStudyAccessLoader(),
+		blocks:     loader.NewUserBlockLoader(),
+		load:       loader.NewQueryPermLoader(),
+		moderation: loader.NewModerationLoader(),
+		repos:      repos,
 	}
 }
 
 type Permitter struct {
-	conf  *myconf.Config
-	load  *loader.QueryPermLoader
-	repos *Repos
+	access     *loader.StudyAccessLoader
+	blocks     *loader.UserBlockLoader
+	conf       *myconf.Config
+	load       *loader.QueryPermLoader
+	moderation *loader.ModerationLoader
+	repos      *Repos
 }
 
 func (r *Permitter) CheckConnection() error {
@@ -44,7 +58,10 @@ func (r *Permitter) ClearCacheOperation(o mytype.Operation) {
 }
 
 func (r *Permitter) ClearCache() {
+	r.access.ClearAll()
+	r.blocks.ClearAll()
 	r.load.ClearAll()
+	r.moderation.ClearAll()
 }
 
 func (r *Permitter) Check(
@@ -58,11 +75,11 @@ func (r *Permitter) Check(
 	}
 	if node == nil {
 		err := errors.New("node is nil")
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return f, err
 	} else if !structs.IsStruct(node) {
 		err := errors.New("node is not a struct")
-		mylog.Log.WithField("node", node).WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithField("node", node).WithError(err).Error(util.Trace(""))
 		return f, err
 	}
 
@@ -72,6 +89,17 @@ func (r *Permitter) Check(
 	}
 	o := mytype.NewOperation(a, nt)
 
+	// Suspended users may still read, but nothing else.
+	if a != mytype.ReadAccess {
+		suspended, err := r.viewerIsSuspended(ctx)
+		if err != nil {
+			return f, err
+		} else if suspended {
+			mymetrics.PermissionDenialsTotal.WithLabelValues(o.String()).Inc()
+			return f, ErrAccessDenied
+		}
+	}
+
 	// If we are attempting to read the object, then check if the viewer has
 	// access to the object.
 	if a == mytype.ReadAccess {
@@ -79,6 +107,29 @@ func (r *Permitter) Check(
 		if err != nil {
 			return f, err
 		} else if !ok {
+			mymetrics.PermissionDenialsTotal.WithLabelValues(o.String()).Inc()
+			return f, ErrAccessDenied
+		}
+	}
+
+	// Users blocked by the owner of the parent object may not create or connect
+	// to it.
+	if a == mytype.CreateAccess || a == mytype.ConnectAccess {
+		blocked, err := r.viewerIsBlockedByParentOwner(ctx, node)
+		if err != nil {
+			return f, err
+		} else if blocked {
+			mymetrics.PermissionDenialsTotal.WithLabelValues(o.String()).Inc()
+			return f, ErrAccessDenied
+		}
+	}
+	// Only users granted enroll access may enroll in private studies.
+	if a == mytype.ConnectAccess {
+		ok, err := r.viewerCanEnrollInStudy(ctx, node)
+		if err != nil {
+			return f, err
+		} else if !ok {
+			mymetrics.PermissionDenialsTotal.WithLabelValues(o.String()).Inc()
 			return f, ErrAccessDenied
 		}
 	}
@@ -109,6 +160,7 @@ func (r *Permitter) Check(
 	queryPerm, err := r.load.Get(ctx, o, additionalRoles)
 	if err != nil {
 		if err == data.ErrNotFound {
+			mymetrics.PermissionDenialsTotal.WithLabelValues(o.String()).Inc()
 			return f, ErrAccessDenied
 		} else {
 			return f, err
@@ -140,6 +192,7 @@ func (r *Permitter) Check(
 			if createable && !field.IsZero() {
 				dbField := field.Tag("db")
 				if ok := f(dbField); !ok {
+					mymetrics.PermissionDenialsTotal.WithLabelValues(o.String()).Inc()
 					return f, ErrFieldAccessDenied
 				}
 			}
@@ -151,6 +204,7 @@ func (r *Permitter) Check(
 			if updateable && !field.IsZero() {
 				dbField := field.Tag("db")
 				if ok := f(dbField); !ok {
+					mymetrics.PermissionDenialsTotal.WithLabelValues(o.String()).Inc()
 					return f, ErrFieldAccessDenied
 				}
 			}
@@ -164,6 +218,31 @@ func (r *Permitter) ViewerCanRead(
 	ctx context.Context,
 	node interface{},
 ) (bool, error) {
+	// Hidden nodes may only be read by their owners and site admins.
+	if id := moderatedID(node); id != nil {
+		hidden, err := r.moderation.IsHidden(ctx, id.String)
+		if err != nil {
+			return false, err
+		}
+		if hidden {
+			if ok, err := r.viewerIsSiteAdmin(ctx); err != nil || ok {
+				return ok, err
+			}
+			return r.ViewerCanAdmin(ctx, node)
+		}
+	}
+
+	// Private studies, and their content, may only be read by their owners,
+	// site admins, and invited users.
+	if study, err := r.privateStudy(ctx, node); err != nil {
+		return false, err
+	} else if study != nil {
+		ok, err := r.viewerCanAccessStudy(ctx, study, data.StudyAccessRead)
+		if err != nil || !ok {
+			return ok, err
+		}
+	}
+
 	switch node := node.(type) {
 	case data.Comment:
 		// If the comment has not been published, then check if the viewer can admin
@@ -229,6 +308,296 @@ func (r *Permitter) ViewerCanRead(
 	return true, nil
 }
 
+// moderatedID returns the ID of the node, if it is of a type admins may hide.
+func moderatedID(node interface{}) *mytype.OID {
+	var id *mytype.OID
+	switch node := node.(type) {
+	case data.Activity:
+		id = &node.ID
+	case *data.Activity:
+		id = &node.ID
+	case data.Comment:
+		id = &node.ID
+	case *data.Comment:
+		id = &node.ID
+	case data.Course:
+		id = &node.ID
+	case *data.Course:
+		id = &node.ID
+	case data.Lesson:
+		id = &node.ID
+	case *data.Lesson:
+		id = &node.ID
+	case data.Study:
+		id = &node.ID
+	case *data.Study:
+		id = &node.ID
+	case data.UserAsset:
+		id = &node.ID
+	case *data.UserAsset:
+		id = &node.ID
+	}
+	if id == nil || id.Status != pgtype.Present {
+		return nil
+	}
+	return id
+}
+
+// privateStudy returns the study the node belongs to, if the node is of a type
+// limited by the study's privacy, and the study is private.
+func (r *Permitter) privateStudy(
+	ctx context.Context,
+	node interface{},
+) (*data.Study, error) {
+	var studyID *mytype.OID
+	switch node := node.(type) {
+	case data.Activity:
+		studyID = &node.StudyID
+	case *data.Activity:
+		studyID = &node.StudyID
+	case data.Comment:
+		studyID = &node.StudyID
+	case *data.Comment:
+		studyID = &node.StudyID
+	case data.Course:
+		studyID = &node.StudyID
+	case *data.Course:
+		studyID = &node.StudyID
+	case data.Label:
+		studyID = &node.StudyID
+	case *data.Label:
+		studyID = &node.StudyID
+	case data.Lesson:
+		studyID = &node.StudyID
+	case *data.Lesson:
+		studyID = &node.StudyID
+	case data.Study:
+		return r.privateStudy(ctx, &node)
+	case *data.Study:
+		if node.Private.Status == pgtype.Present {
+			if !node.Private.Bool {
+				return nil, nil
+			}
+			return node, nil
+		}
+		studyID = &node.ID
+	case data.UserAsset:
+		studyID = &node.StudyID
+	case *data.UserAsset:
+		studyID = &node.StudyID
+	}
+	if studyID == nil || studyID.Status != pgtype.Present {
+		return nil, nil
+	}
+	study, err := r.repos.Study().load.Get(ctx, studyID.String)
+	if err != nil {
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
+		return nil, err
+	}
+	if !study.Private.Bool {
+		return nil, nil
+	}
+	return study, nil
+}
+
+// viewerCanAccessStudy returns whether the viewer has the access to the
+// private study, i.e. is its owner, a site admin, or has been granted the
+// access by an invitation. Enroll access includes read access.
+func (r *Permitter) viewerCanAccessStudy(
+	ctx context.Context,
+	study *data.Study,
+	access string,
+) (bool, error) {
+	viewer, ok := myctx.UserFromContext(ctx)
+	if !ok {
+		err := &myctx.ErrNotFound{Name: "viewer"}
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
+		return false, err
+	}
+	if viewer.Login.String == Guest {
+		return false, nil
+	}
+	if viewer.ID.String == study.UserID.String {
+		return true, nil
+	}
+	if ok, err := r.viewerIsSiteAdmin(ctx); err != nil || ok {
+		return ok, err
+	}
+	granted, err := r.access.Get(ctx, viewer.ID.String, study.ID.String)
+	if err != nil {
+		return false, err
+	}
+	if access == data.StudyAccessRead {
+		return granted != "", nil
+	}
+	return granted == access, nil
+}
+
+// viewerCanEnrollInStudy returns whether the viewer may enroll in the study,
+// or in one of its lessons, if the node is an enrollment in a private study.
+func (r *Permitter) viewerCanEnrollInStudy(
+	ctx context.Context,
+	node interface{},
+) (bool, error) {
+	var enrollableID *mytype.OID
+	switch node := node.(type) {
+	case data.Enrolled:
+		enrollableID = &node.EnrollableID
+	case *data.Enrolled:
+		enrollableID = &node.EnrollableID
+	default:
+		return true, nil
+	}
+	var study *data.Study
+	var err error
+	switch enrollableID.Type {
+	case "Lesson":
+		lesson, lessonErr := r.repos.Lesson().load.Get(ctx, enrollableID.String)
+		if lessonErr != nil {
+			mylog.Log.WithContext(ctx).WithError(lessonErr).Error(util.Trace(""))
+			return false, lessonErr
+		}
+		study, err = r.privateStudy(ctx, lesson)
+	case "Study":
+		study, err = r.privateStudy(ctx, &data.Study{ID: *enrollableID})
+	}
+	if err != nil {
+		return false, err
+	} else if study == nil {
+		return true, nil
+	}
+	return r.viewerCanAccessStudy(ctx, study, data.StudyAccessEnroll)
+}
+
+// ClearStudyAccess clears the cached study access of the user.
+func (r *Permitter) ClearStudyAccess(userID string) {
+	r.access.Clear(userID)
+}
+
+func (r *Permitter) viewerIsSiteAdmin(ctx context.Context) (bool, error) {
+	viewer, ok := myctx.UserFromContext(ctx)
+	if !ok {
+		err := &myctx.ErrNotFound{Name: "viewer"}
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
+		return false, err
+	}
+	for _, role := range viewer.Roles.Elements {
+		if role.String == data.AdminRole {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func (r *Permitter) viewerIsSuspended(ctx context.Context) (bool, error) {
+	viewer, ok := myctx.UserFromContext(ctx)
+	if !ok {
+		err := &myctx.ErrNotFound{Name: "viewer"}
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
+		return false, err
+	}
+	if viewer.Login.String == Guest {
+		return false, nil
+	}
+	return r.moderation.IsSuspended(ctx, viewer.ID.String)
+}
+
+// Has the viewer blocked the user?
+func (r *Permitter) ViewerHasBlocked(
+	ctx context.Context,
+	userID string,
+) (bool, error) {
+	viewer, ok := myctx.UserFromContext(ctx)
+	if !ok {
+		err := &myctx.ErrNotFound{Name: "viewer"}
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
+		return false, err
+	}
+	if viewer.Login.String == Guest {
+		return false, nil
+	}
+	return r.blocks.IsBlocked(ctx, viewer.ID.String, userID)
+}
+
+// ClearBlocks clears the cached blocks of the user.
+func (r *Permitter) ClearBlocks(blockerID string) {
+	r.blocks.Clear(blockerID)
+}
+
+// viewerIsBlockedByParentOwner returns whether the owner of the object the
+// node would be created in, or connected to, has blocked the viewer.
+func (r *Permitter) viewerIsBlockedByParentOwner(
+	ctx context.Context,
+	node interface{},
+) (bool, error) {
+	viewer, ok := myctx.UserFromContext(ctx)
+	if !ok {
+		err := &myctx.ErrNotFound{Name: "viewer"}
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
+		return false, err
+	}
+	if viewer.Login.String == Guest {
+		return false, nil
+	}
+
+	var ownerID string
+	switch node := node.(type) {
+	case data.Appled:
+		return r.viewerIsBlockedByParentOwner(ctx, &node)
+	case *data.Appled:
+		switch node.AppleableID.Type {
+		case "Course":
+			course, err := r.repos.Course().load.Get(ctx, node.AppleableID.String)
+			if err != nil {
+				mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
+				return false, err
+			}
+			ownerID = course.UserID.String
+		case "Study":
+			study, err := r.repos.Study().load.Get(ctx, node.AppleableID.String)
+			if err != nil {
+				mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
+				return false, err
+			}
+			ownerID = study.UserID.String
+		}
+	case data.Comment:
+		return r.viewerIsBlockedByParentOwner(ctx, &node)
+	case *data.Comment:
+		study, err := r.repos.Study().load.Get(ctx, node.StudyID.String)
+		if err != nil {
+			mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
+			return false, err
+		}
+		ownerID = study.UserID.String
+	case data.Enrolled:
+		return r.viewerIsBlockedByParentOwner(ctx, &node)
+	case *data.Enrolled:
+		switch node.EnrollableID.Type {
+		case "Lesson":
+			lesson, err := r.repos.Lesson().load.Get(ctx, node.EnrollableID.String)
+			if err != nil {
+				mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
+				return false, err
+			}
+			ownerID = lesson.UserID.String
+		case "Study":
+			study, err := r.repos.Study().load.Get(ctx, node.EnrollableID.String)
+			if err != nil {
+				mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
+				return false, err
+			}
+			ownerID = study.UserID.String
+		case "User":
+			ownerID = node.EnrollableID.String
+		}
+	}
+	if ownerID == "" || ownerID == viewer.ID.String {
+		return false, nil
+	}
+	return r.blocks.IsBlocked(ctx, ownerID, viewer.ID.String)
+}
+
 // Can the viewer admin the node, i.e. is the viewer the owner of the object?
 func (r *Permitter) ViewerCanAdmin(
 	ctx context.Context,
@@ -237,7 +606,7 @@ func (r *Permitter) ViewerCanAdmin(
 	viewer, ok := myctx.UserFromContext(ctx)
 	if !ok {
 		err := &myctx.ErrNotFound{"viewer"}
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return false, err
 	}
 	if viewer.Login.String == Guest {
@@ -250,7 +619,7 @@ func (r *Permitter) ViewerCanAdmin(
 		if node.UserID.Status == pgtype.Undefined {
 			activity, err := r.repos.Activity().load.Get(ctx, node.ID.String)
 			if err != nil {
-				mylog.Log.WithError(err).Error(util.Trace(""))
+				mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 				return false, err
 			}
 			userID = &activity.UserID
@@ -261,7 +630,7 @@ func (r *Permitter) ViewerCanAdmin(
 		if node.UserID.Status == pgtype.Undefined {
 			activity, err := r.repos.Activity().load.Get(ctx, node.ID.String)
 			if err != nil {
-				mylog.Log.WithError(err).Error(util.Trace(""))
+				mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 				return false, err
 			}
 			userID = &activity.UserID
@@ -272,14 +641,14 @@ func (r *Permitter) ViewerCanAdmin(
 		if activityID.Status == pgtype.Undefined {
 			activityAsset, err := r.repos.ActivityAsset().load.Get(ctx, node.AssetID.String)
 			if err != nil {
-				mylog.Log.WithError(err).Error(util.Trace(""))
+				mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 				return false, err
 			}
 			activityID = &activityAsset.ActivityID
 		}
 		activity, err := r.repos.Activity().load.Get(ctx, activityID.String)
 		if err != nil {
-			mylog.Log.WithError(err).Error(util.Trace(""))
+			mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 			return false, err
 		}
 		return vid == activity.UserID.String, nil
@@ -288,14 +657,14 @@ func (r *Permitter) ViewerCanAdmin(
 		if activityID.Status == pgtype.Undefined {
 			activityAsset, err := r.repos.ActivityAsset().load.Get(ctx, node.AssetID.String)
 			if err != nil {
-				mylog.Log.WithError(err).Error(util.Trace(""))
+				mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 				return false, err
 			}
 			activityID = &activityAsset.ActivityID
 		}
 		activity, err := r.repos.Activity().load.Get(ctx, activityID.String)
 		if err != nil {
-			mylog.Log.WithError(err).Error(util.Trace(""))
+			mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 			return false, err
 		}
 		return vid == activity.UserID.String, nil
@@ -304,7 +673,7 @@ func (r *Permitter) ViewerCanAdmin(
 		if node.UserID.Status == pgtype.Undefined {
 			appled, err := r.repos.Appled().load.Get(ctx, node.ID.Int)
 			if err != nil {
-				mylog.Log.WithError(err).Error(util.Trace(""))
+				mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 				return false, err
 			}
 			userID = &appled.UserID
@@ -315,7 +684,7 @@ func (r *Permitter) ViewerCanAdmin(
 		if node.UserID.Status == pgtype.Undefined {
 			appled, err := r.repos.Appled().load.Get(ctx, node.ID.Int)
 			if err != nil {
-				mylog.Log.WithError(err).Error(util.Trace(""))
+				mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 				return false, err
 			}
 			userID = &appled.UserID
@@ -326,7 +695,7 @@ func (r *Permitter) ViewerCanAdmin(
 		if node.UserID.Status == pgtype.Undefined {
 			comment, err := r.repos.Comment().load.Get(ctx, node.ID.String)
 			if err != nil {
-				mylog.Log.WithError(err).Error(util.Trace(""))
+				mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 				return false, err
 			}
 			userID = &comment.UserID
@@ -337,7 +706,7 @@ func (r *Permitter) ViewerCanAdmin(
 		if node.UserID.Status == pgtype.Undefined {
 			comment, err := r.repos.Comment().load.Get(ctx, node.ID.String)
 			if err != nil {
-				mylog.Log.WithError(err).Error(util.Trace(""))
+				mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 				return false, err
 			}
 			userID = &comment.UserID
@@ -346,7 +715,7 @@ func (r *Permitter) ViewerCanAdmin(
 	case data.CommentDraftBackup:
 		comment, err := r.repos.Comment().load.Get(ctx, node.CommentID.String)
 		if err != nil {
-			mylog.Log.WithError(err).Error(util.Trace(""))
+			mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 			return false, err
 		}
 		userID := &comment.UserID
@@ -354,7 +723,7 @@ func (r *Permitter) ViewerCanAdmin(
 	case *data.CommentDraftBackup:
 		comment, err := r.repos.Comment().load.Get(ctx, node.CommentID.String)
 		if err != nil {
-			mylog.Log.WithError(err).Error(util.Trace(""))
+			mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 			return false, err
 		}
 		userID := &comment.UserID
@@ -364,7 +733,7 @@ func (r *Permitter) ViewerCanAdmin(
 		if node.UserID.Status == pgtype.Undefined {
 			course, err := r.repos.Course().load.Get(ctx, node.ID.String)
 			if err != nil {
-				mylog.Log.WithError(err).Error(util.Trace(""))
+				mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 				return false, err
 			}
 			userID = &course.UserID
@@ -375,7 +744,7 @@ func (r *Permitter) ViewerCanAdmin(
 		if node.UserID.Status == pgtype.Undefined {
 			course, err := r.repos.Course().load.Get(ctx, node.ID.String)
 			if err != nil {
-				mylog.Log.WithError(err).Error(util.Trace(""))
+				mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 				return false, err
 			}
 			userID = &course.UserID
@@ -386,14 +755,14 @@ func (r *Permitter) ViewerCanAdmin(
 		if courseID.Status == pgtype.Undefined {
 			courseLesson, err := r.repos.CourseLesson().load.Get(ctx, node.LessonID.String)
 			if err != nil {
-				mylog.Log.WithError(err).Error(util.Trace(""))
+				mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 				return false, err
 			}
 			courseID = &courseLesson.CourseID
 		}
 		course, err := r.repos.Course().load.Get(ctx, courseID.String)
 		if err != nil {
-			mylog.Log.WithError(err).Error(util.Trace(""))
+			mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 			return false, err
 		}
 		return vid == course.UserID.String, nil
@@ -402,14 +771,14 @@ func (r *Permitter) ViewerCanAdmin(
 		if courseID.Status == pgtype.Undefined {
 			courseLesson, err := r.repos.CourseLesson().load.Get(ctx, node.LessonID.String)
 			if err != nil {
-				mylog.Log.WithError(err).Error(util.Trace(""))
+				mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 				return false, err
 			}
 			courseID = &courseLesson.CourseID
 		}
 		course, err := r.repos.Course().load.Get(ctx, courseID.String)
 		if err != nil {
-			mylog.Log.WithError(err).Error(util.Trace(""))
+			mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 			return false, err
 		}
 		return vid == course.UserID.String, nil
@@ -418,7 +787,7 @@ func (r *Permitter) ViewerCanAdmin(
 		if node.UserID.Status == pgtype.Undefined {
 			email, err := r.repos.Email().load.Get(ctx, node.ID.String)
 			if err != nil {
-				mylog.Log.WithError(err).Error(util.Trace(""))
+				mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 				return false, err
 			}
 			userID = &email.UserID
@@ -429,7 +798,7 @@ func (r *Permitter) ViewerCanAdmin(
 		if node.UserID.Status == pgtype.Undefined {
 			email, err := r.repos.Email().load.Get(ctx, node.ID.String)
 			if err != nil {
-				mylog.Log.WithError(err).Error(util.Trace(""))
+				mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 				return false, err
 			}
 			userID = &email.UserID
@@ -440,7 +809,7 @@ func (r *Permitter) ViewerCanAdmin(
 		if node.UserID.Status == pgtype.Undefined {
 			enrolled, err := r.repos.Enrolled().load.Get(ctx, node.ID.Int)
 			if err != nil {
-				mylog.Log.WithError(err).Error(util.Trace(""))
+				mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 				return false, err
 			}
 			userID = &enrolled.UserID
@@ -451,7 +820,7 @@ func (r *Permitter) ViewerCanAdmin(
 		if node.UserID.Status == pgtype.Undefined {
 			enrolled, err := r.repos.Enrolled().load.Get(ctx, node.ID.Int)
 			if err != nil {
-				mylog.Log.WithError(err).Error(util.Trace(""))
+				mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 				return false, err
 			}
 			userID = &enrolled.UserID
@@ -462,7 +831,7 @@ func (r *Permitter) ViewerCanAdmin(
 		if node.UserID.Status == pgtype.Undefined {
 			evt, err := r.repos.EVT().load.Get(ctx, node.EmailID.String, node.Token.String)
 			if err != nil {
-				mylog.Log.WithError(err).Error(util.Trace(""))
+				mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 				return false, err
 			}
 			userID = &evt.UserID
@@ -473,7 +842,7 @@ func (r *Permitter) ViewerCanAdmin(
 		if node.UserID.Status == pgtype.Undefined {
 			evt, err := r.repos.EVT().load.Get(ctx, node.EmailID.String, node.Token.String)
 			if err != nil {
-				mylog.Log.WithError(err).Error(util.Trace(""))
+				mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 				return false, err
 			}
 			userID = &evt.UserID
@@ -482,24 +851,24 @@ func (r *Permitter) ViewerCanAdmin(
 	case data.Label:
 		label, err := r.repos.Label().load.Get(ctx, node.ID.String)
 		if err != nil {
-			mylog.Log.WithError(err).Error(util.Trace(""))
+			mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 			return false, err
 		}
 		study, err := r.repos.Study().load.Get(ctx, label.StudyID.String)
 		if err != nil {
-			mylog.Log.WithError(err).Error(util.Trace(""))
+			mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 			return false, err
 		}
 		return vid == study.UserID.String, nil
 	case *data.Label:
 		label, err := r.repos.Label().load.Get(ctx, node.ID.String)
 		if err != nil {
-			mylog.Log.WithError(err).Error(util.Trace(""))
+			mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 			return false, err
 		}
 		study, err := r.repos.Study().load.Get(ctx, label.StudyID.String)
 		if err != nil {
-			mylog.Log.WithError(err).Error(util.Trace(""))
+			mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 			return false, err
 		}
 		return vid == study.UserID.String, nil
@@ -509,21 +878,21 @@ func (r *Permitter) ViewerCanAdmin(
 		case "Comment":
 			comment, err := r.repos.Comment().load.Get(ctx, node.LabelableID.String)
 			if err != nil {
-				mylog.Log.WithError(err).Error(util.Trace(""))
+				mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 				return false, err
 			}
 			userID = comment.UserID
 		case "Lesson":
 			lesson, err := r.repos.Lesson().load.Get(ctx, node.LabelableID.String)
 			if err != nil {
-				mylog.Log.WithError(err).Error(util.Trace(""))
+				mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 				return false, err
 			}
 			userID = lesson.UserID
 		case "UserAsset":
 			lesson, err := r.repos.UserAsset().load.Get(ctx, node.LabelableID.String)
 			if err != nil {
-				mylog.Log.WithError(err).Error(util.Trace(""))
+				mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 				return false, err
 			}
 			userID = lesson.UserID
@@ -535,21 +904,21 @@ func (r *Permitter) ViewerCanAdmin(
 		case "Comment":
 			comment, err := r.repos.Comment().load.Get(ctx, node.LabelableID.String)
 			if err != nil {
-				mylog.Log.WithError(err).Error(util.Trace(""))
+				mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 				return false, err
 			}
 			userID = comment.UserID
 		case "Lesson":
 			lesson, err := r.repos.Lesson().load.Get(ctx, node.LabelableID.String)
 			if err != nil {
-				mylog.Log.WithError(err).Error(util.Trace(""))
+				mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 				return false, err
 			}
 			userID = lesson.UserID
 		case "UserAsset":
 			lesson, err := r.repos.UserAsset().load.Get(ctx, node.LabelableID.String)
 			if err != nil {
-				mylog.Log.WithError(err).Error(util.Trace(""))
+				mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 				return false, err
 			}
 			userID = lesson.UserID
@@ -560,7 +929,7 @@ func (r *Permitter) ViewerCanAdmin(
 		if node.UserID.Status == pgtype.Undefined {
 			lesson, err := r.repos.Lesson().load.Get(ctx, node.ID.String)
 			if err != nil {
-				mylog.Log.WithError(err).Error(util.Trace(""))
+				mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 				return false, err
 			}
 			userID = &lesson.UserID
@@ -571,7 +940,7 @@ func (r *Permitter) ViewerCanAdmin(
 		if node.UserID.Status == pgtype.Undefined {
 			lesson, err := r.repos.Lesson().load.Get(ctx, node.ID.String)
 			if err != nil {
-				mylog.Log.WithError(err).Error(util.Trace(""))
+				mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 				return false, err
 			}
 			userID = &lesson.UserID
@@ -580,7 +949,7 @@ func (r *Permitter) ViewerCanAdmin(
 	case data.LessonDraftBackup:
 		lesson, err := r.repos.Lesson().load.Get(ctx, node.LessonID.String)
 		if err != nil {
-			mylog.Log.WithError(err).Error(util.Trace(""))
+			mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 			return false, err
 		}
 		userID := &lesson.UserID
@@ -588,17 +957,31 @@ func (r *Permitter) ViewerCanAdmin(
 	case *data.LessonDraftBackup:
 		lesson, err := r.repos.Lesson().load.Get(ctx, node.LessonID.String)
 		if err != nil {
-			mylog.Log.WithError(err).Error(util.Trace(""))
+			mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 			return false, err
 		}
 		userID := &lesson.UserID
 		return vid == userID.String, nil
+	case data.LessonPrerequisite:
+		lesson, err := r.repos.Lesson().load.Get(ctx, node.LessonID.String)
+		if err != nil {
+			mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
+			return false, err
+		}
+		return vid == lesson.UserID.String, nil
+	case *data.LessonPrerequisite:
+		lesson, err := r.repos.Lesson().load.Get(ctx, node.LessonID.String)
+		if err != nil {
+			mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
+			return false, err
+		}
+		return vid == lesson.UserID.String, nil
 	case data.Notification:
 		userID := &node.UserID
 		if node.UserID.Status == pgtype.Undefined {
 			notification, err := r.repos.Notification().load.Get(ctx, node.ID.String)
 			if err != nil {
-				mylog.Log.WithError(err).Error(util.Trace(""))
+				mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 				return false, err
 			}
 			userID = &notification.UserID
@@ -609,7 +992,7 @@ func (r *Permitter) ViewerCanAdmin(
 		if node.UserID.Status == pgtype.Undefined {
 			notification, err := r.repos.Notification().load.Get(ctx, node.ID.String)
 			if err != nil {
-				mylog.Log.WithError(err).Error(util.Trace(""))
+				mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 				return false, err
 			}
 			userID = &notification.UserID
@@ -624,7 +1007,7 @@ func (r *Permitter) ViewerCanAdmin(
 		if node.UserID.Status == pgtype.Undefined {
 			study, err := r.repos.Study().load.Get(ctx, node.ID.String)
 			if err != nil {
-				mylog.Log.WithError(err).Error(util.Trace(""))
+				mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 				return false, err
 			}
 			userID = &study.UserID
@@ -635,7 +1018,7 @@ func (r *Permitter) ViewerCanAdmin(
 		if node.UserID.Status == pgtype.Undefined {
 			study, err := r.repos.Study().load.Get(ctx, node.ID.String)
 			if err != nil {
-				mylog.Log.WithError(err).Error(util.Trace(""))
+				mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 				return false, err
 			}
 			userID = &study.UserID
@@ -647,14 +1030,14 @@ func (r *Permitter) ViewerCanAdmin(
 		case "Course":
 			course, err := r.repos.Course().load.Get(ctx, node.TopicableID.String)
 			if err != nil {
-				mylog.Log.WithError(err).Error(util.Trace(""))
+				mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 				return false, err
 			}
 			userID = course.UserID
 		case "Study":
 			study, err := r.repos.Study().load.Get(ctx, node.TopicableID.String)
 			if err != nil {
-				mylog.Log.WithError(err).Error(util.Trace(""))
+				mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 				return false, err
 			}
 			userID = study.UserID
@@ -666,14 +1049,14 @@ func (r *Permitter) ViewerCanAdmin(
 		case "Course":
 			course, err := r.repos.Course().load.Get(ctx, node.TopicableID.String)
 			if err != nil {
-				mylog.Log.WithError(err).Error(util.Trace(""))
+				mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 				return false, err
 			}
 			userID = course.UserID
 		case "Study":
 			study, err := r.repos.Study().load.Get(ctx, node.TopicableID.String)
 			if err != nil {
-				mylog.Log.WithError(err).Error(util.Trace(""))
+				mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 				return false, err
 			}
 			userID = study.UserID
@@ -688,7 +1071,7 @@ func (r *Permitter) ViewerCanAdmin(
 		if node.UserID.Status == pgtype.Undefined {
 			userAsset, err := r.repos.UserAsset().load.Get(ctx, node.ID.String)
 			if err != nil {
-				mylog.Log.WithError(err).Error(util.Trace(""))
+				mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 				return false, err
 			}
 			userID = &userAsset.UserID
@@ -699,7 +1082,7 @@ func (r *Permitter) ViewerCanAdmin(
 		if node.UserID.Status == pgtype.Undefined {
 			userAsset, err := r.repos.UserAsset().load.Get(ctx, node.ID.String)
 			if err != nil {
-				mylog.Log.WithError(err).Error(util.Trace(""))
+				mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 				return false, err
 			}
 			userID = &userAsset.UserID
@@ -721,7 +1104,7 @@ func (r *Permitter) ViewerCanCreateWithOwnership(
 	viewer, ok := myctx.UserFromContext(ctx)
 	if !ok {
 		err := &myctx.ErrNotFound{"viewer"}
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return false, err
 	}
 	if viewer.Login.String == Guest {
@@ -732,84 +1115,84 @@ func (r *Permitter) ViewerCanCreateWithOwnership(
 	case data.Activity:
 		study, err := r.repos.Study().load.Get(ctx, node.StudyID.String)
 		if err != nil {
-			mylog.Log.WithError(err).Error(util.Trace(""))
+			mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 			return false, err
 		}
 		return vid == study.UserID.String, nil
 	case *data.Activity:
 		study, err := r.repos.Study().load.Get(ctx, node.StudyID.String)
 		if err != nil {
-			mylog.Log.WithError(err).Error(util.Trace(""))
+			mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 			return false, err
 		}
 		return vid == study.UserID.String, nil
 	case data.ActivityAsset:
 		activity, err := r.repos.Activity().load.Get(ctx, node.ActivityID.String)
 		if err != nil {
-			mylog.Log.WithError(err).Error(util.Trace(""))
+			mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 			return false, err
 		}
 		return vid == activity.UserID.String, nil
 	case *data.ActivityAsset:
 		activity, err := r.repos.Activity().load.Get(ctx, node.ActivityID.String)
 		if err != nil {
-			mylog.Log.WithError(err).Error(util.Trace(""))
+			mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 			return false, err
 		}
 		return vid == activity.UserID.String, nil
 	case data.Course:
 		study, err := r.repos.Study().load.Get(ctx, node.StudyID.String)
 		if err != nil {
-			mylog.Log.WithError(err).Error(util.Trace(""))
+			mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 			return false, err
 		}
 		return vid == study.UserID.String, nil
 	case *data.Course:
 		study, err := r.repos.Study().load.Get(ctx, node.StudyID.String)
 		if err != nil {
-			mylog.Log.WithError(err).Error(util.Trace(""))
+			mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 			return false, err
 		}
 		return vid == study.UserID.String, nil
 	case data.CourseLesson:
 		course, err := r.repos.Course().load.Get(ctx, node.CourseID.String)
 		if err != nil {
-			mylog.Log.WithError(err).Error(util.Trace(""))
+			mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 			return false, err
 		}
 		return vid == course.UserID.String, nil
 	case *data.CourseLesson:
 		course, err := r.repos.Course().load.Get(ctx, node.CourseID.String)
 		if err != nil {
-			mylog.Log.WithError(err).Error(util.Trace(""))
+			mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 			return false, err
 		}
 		return vid == course.UserID.String, nil
 	case data.EVT:
 		email, err := r.repos.Email().load.Get(ctx, node.EmailID.String)
 		if err != nil {
-			mylog.Log.WithError(err).Error(util.Trace(""))
+			mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 			return false, err
 		}
 		return vid == node.UserID.String && vid == email.UserID.String, nil
 	case *data.EVT:
 		email, err := r.repos.Email().load.Get(ctx, node.EmailID.String)
 		if err != nil {
-			mylog.Log.WithError(err).Error(util.Trace(""))
+			mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 			return false, err
 		}
 		return vid == node.UserID.String && vid == email.UserID.String, nil
 	case data.Label:
 		study, err := r.repos.Study().load.Get(ctx, node.StudyID.String)
 		if err != nil {
-			mylog.Log.WithError(err).Error(util.Trace(""))
+			mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 			return false, err
 		}
 		return vid == study.UserID.String, nil
 	case *data.Label:
 		study, err := r.repos.Study().load.Get(ctx, node.StudyID.String)
 		if err != nil {
-			mylog.Log.WithError(err).Error(util.Trace(""))
+			mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 			return false, err
 		}
 		return vid == study.UserID.String, nil
@@ -819,21 +1202,21 @@ func (r *Permitter) ViewerCanCreateWithOwnership(
 		case "Comment":
 			comment, err := r.repos.Comment().load.Get(ctx, node.LabelableID.String)
 			if err != nil {
-				mylog.Log.WithError(err).Error(util.Trace(""))
+				mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 				return false, err
 			}
 			userID = comment.UserID
 		case "Lesson":
 			lesson, err := r.repos.Lesson().load.Get(ctx, node.LabelableID.String)
 			if err != nil {
-				mylog.Log.WithError(err).Error(util.Trace(""))
+				mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 				return false, err
 			}
 			userID = lesson.UserID
 		case "UserAsset":
 			userAsset, err := r.repos.UserAsset().load.Get(ctx, node.LabelableID.String)
 			if err != nil {
-				mylog.Log.WithError(err).Error(util.Trace(""))
+				mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 				return false, err
 			}
 			userID = userAsset.UserID
@@ -845,21 +1228,21 @@ func (r *Permitter) ViewerCanCreateWithOwnership(
 		case "Comment":
 			comment, err := r.repos.Comment().load.Get(ctx, node.LabelableID.String)
 			if err != nil {
-				mylog.Log.WithError(err).Error(util.Trace(""))
+				mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 				return false, err
 			}
 			userID = comment.UserID
 		case "Lesson":
 			lesson, err := r.repos.Lesson().load.Get(ctx, node.LabelableID.String)
 			if err != nil {
-				mylog.Log.WithError(err).Error(util.Trace(""))
+				mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 				return false, err
 			}
 			userID = lesson.UserID
 		case "UserAsset":
 			userAsset, err := r.repos.UserAsset().load.Get(ctx, node.LabelableID.String)
 			if err != nil {
-				mylog.Log.WithError(err).Error(util.Trace(""))
+				mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 				return false, err
 			}
 			userID = userAsset.UserID
@@ -868,31 +1251,45 @@ func (r *Permitter) ViewerCanCreateWithOwnership(
 	case data.Lesson:
 		study, err := r.repos.Study().load.Get(ctx, node.StudyID.String)
 		if err != nil {
-			mylog.Log.WithError(err).Error(util.Trace(""))
+			mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 			return false, err
 		}
 		return vid == study.UserID.String, nil
 	case *data.Lesson:
 		study, err := r.repos.Study().load.Get(ctx, node.StudyID.String)
 		if err != nil {
-			mylog.Log.WithError(err).Error(util.Trace(""))
+			mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 			return false, err
 		}
 		return vid == study.UserID.String, nil
+	case data.LessonPrerequisite:
+		lesson, err := r.repos.Lesson().load.Get(ctx, node.LessonID.String)
+		if err != nil {
+			mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
+			return false, err
+		}
+		return vid == lesson.UserID.String, nil
+	case *data.LessonPrerequisite:
+		lesson, err := r.repos.Lesson().load.Get(ctx, node.LessonID.String)
+		if err != nil {
+			mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
+			return false, err
+		}
+		return vid == lesson.UserID.String, nil
 	case data.Topiced:
 		userID := mytype.OID{}
 		switch node.TopicableID.Type {
 		case "Course":
 			course, err := r.repos.Course().load.Get(ctx, node.TopicableID.String)
 			if err != nil {
-				mylog.Log.WithError(err).Error(util.Trace(""))
+				mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 				return false, err
 			}
 			userID = course.UserID
 		case "Study":
 			study, err := r.repos.Study().load.Get(ctx, node.TopicableID.String)
 			if err != nil {
-				mylog.Log.WithError(err).Error(util.Trace(""))
+				mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 				return false, err
 			}
 			userID = study.UserID
@@ -904,14 +1301,14 @@ func (r *Permitter) ViewerCanCreateWithOwnership(
 		case "Course":
 			course, err := r.repos.Course().load.Get(ctx, node.TopicableID.String)
 			if err != nil {
-				mylog.Log.WithError(err).Error(util.Trace(""))
+				mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 				return false, err
 			}
 			userID = course.UserID
 		case "Study":
 			study, err := r.repos.Study().load.Get(ctx, node.TopicableID.String)
 			if err != nil {
-				mylog.Log.WithError(err).Error(util.Trace(""))
+				mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 				return false, err
 			}
 			userID = study.UserID
@@ -920,14 +1317,14 @@ func (r *Permitter) ViewerCanCreateWithOwnership(
 	case data.UserAsset:
 		study, err := r.repos.Study().load.Get(ctx, node.StudyID.String)
 		if err != nil {
-			mylog.Log.WithError(err).Error(util.Trace(""))
+			mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 			return false, err
 		}
 		return vid == study.UserID.String, nil
 	case *data.UserAsset:
 		study, err := r.repos.Study().load.Get(ctx, node.StudyID.String)
 		if err != nil {
-			mylog.Log.WithError(err).Error(util.Trace(""))
+			mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 			return false, err
 		}
 		return vid == study.UserID.String, nil
@@ -0,0 +1,329 @@
+package repo
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/marksauter/markus-ninja-api/pkg/data"
+	"github.com/marksauter/markus-ninja-api/pkg/myctx"
+	"github.com/marksauter/markus-ninja-api/pkg/mydb"
+	"github.com/marksauter/markus-ninja-api/pkg/mytype"
+)
+
+func newTestOID(t *testing.T, objType string) mytype.OID {
+	id, err := mytype.NewOID(objType)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return *id
+}
+
+func newTestPermitter(db data.Queryer) *Permitter {
+	repos := NewRepos(db, nil)
+	permitter := NewPermitter(repos, nil)
+	repos.OpenAll(permitter)
+	return permitter
+}
+
+func newTestViewerContext(db data.Queryer, viewer *data.User) context.Context {
+	ctx := myctx.NewUserContext(context.Background(), viewer)
+	if db != nil {
+		ctx = myctx.NewQueryerContext(ctx, db)
+	}
+	return ctx
+}
+
+func newTestGuest() *data.User {
+	guest := &data.User{}
+	guest.Login.Set(Guest)
+	return guest
+}
+
+func newTestAdmin(t *testing.T) *data.User {
+	admin := &data.User{ID: newTestOID(t, "User")}
+	admin.Login.Set("admin")
+	admin.Roles.Set([]string{data.AdminRole})
+	return admin
+}
+
+func createTestUser(t *testing.T, db data.Queryer, login string) *data.User {
+	user := &data.User{}
+	user.Login.Set(login)
+	user.Password.Set([]byte("password"))
+	user.PrimaryEmail.Set(login + "@example.com")
+	user, err := data.CreateUser(db, user)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return user
+}
+
+func createTestStudy(t *testing.T, db data.Queryer, userID *mytype.OID, private bool) *data.Study {
+	study := &data.Study{}
+	study.Name.Set("test")
+	study.Private.Set(private)
+	study.UserID.Set(userID)
+	study, err := data.CreateStudy(db, study)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return study
+}
+
+func TestModeratedID(t *testing.T) {
+	var tests = []struct {
+		node      interface{}
+		moderated bool
+	}{
+		{&data.Activity{ID: newTestOID(t, "Activity")}, true},
+		{data.Comment{ID: newTestOID(t, "Comment")}, true},
+		{&data.Course{ID: newTestOID(t, "Course")}, true},
+		{&data.Lesson{ID: newTestOID(t, "Lesson")}, true},
+		{&data.Study{ID: newTestOID(t, "Study")}, true},
+		{&data.UserAsset{ID: newTestOID(t, "UserAsset")}, true},
+		{&data.Study{}, false},
+		{&data.Label{ID: newTestOID(t, "Label")}, false},
+		{&data.Topic{ID: newTestOID(t, "Topic")}, false},
+		{&data.User{ID: newTestOID(t, "User")}, false},
+	}
+
+	for _, tt := range tests {
+		actual := moderatedID(tt.node) != nil
+		if actual != tt.moderated {
+			t.Errorf("moderatedID(%T): expected moderated %v, got %v", tt.node, tt.moderated, actual)
+		}
+	}
+}
+
+func TestPermitterViewerCanReadHiddenStudy(t *testing.T) {
+	testDb := mydb.NewTestDB(t)
+
+	owner := createTestUser(t, testDb.DB, "owner")
+	other := createTestUser(t, testDb.DB, "other")
+	study := createTestStudy(t, testDb.DB, &owner.ID, false)
+	if err := data.HideContent(testDb.DB, study.ID.String); err != nil {
+		t.Fatal(err)
+	}
+
+	var tests = []struct {
+		name     string
+		viewer   *data.User
+		expected bool
+	}{
+		{"guest", newTestGuest(), false},
+		{"other", other, false},
+		{"owner", owner, true},
+		{"admin", newTestAdmin(t), true},
+	}
+
+	for _, tt := range tests {
+		ctx := newTestViewerContext(testDb.DB, tt.viewer)
+		actual, err := newTestPermitter(testDb.DB).ViewerCanRead(ctx, study)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if actual != tt.expected {
+			t.Errorf("%s: expected ViewerCanRead %v, got %v", tt.name, tt.expected, actual)
+		}
+	}
+}
+
+func TestPermitterViewerIsBlockedByParentOwner(t *testing.T) {
+	testDb := mydb.NewTestDB(t)
+
+	owner := createTestUser(t, testDb.DB, "owner")
+	other := createTestUser(t, testDb.DB, "other")
+	blocked := createTestUser(t, testDb.DB, "blocked")
+	study := createTestStudy(t, testDb.DB, &owner.ID, false)
+	if err := data.BlockUser(testDb.DB, owner.ID.String, blocked.ID.String); err != nil {
+		t.Fatal(err)
+	}
+
+	var tests = []struct {
+		name     string
+		viewer   *data.User
+		expected bool
+	}{
+		{"guest", newTestGuest(), false},
+		{"other", other, false},
+		{"owner", owner, false},
+		{"blocked", blocked, true},
+	}
+
+	for _, tt := range tests {
+		ctx := newTestViewerContext(testDb.DB, tt.viewer)
+		comment := &data.Comment{StudyID: study.ID}
+		actual, err := newTestPermitter(testDb.DB).viewerIsBlockedByParentOwner(ctx, comment)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if actual != tt.expected {
+			t.Errorf("%s: expected blocked %v, got %v", tt.name, tt.expected, actual)
+		}
+	}
+
+	ctx := newTestViewerContext(testDb.DB, blocked)
+	comment := &data.Comment{StudyID: study.ID}
+	if _, err := newTestPermitter(testDb.DB).Check(ctx, mytype.CreateAccess, comment); err != ErrAccessDenied {
+		t.Errorf("expected ErrAccessDenied creating a comment, got %v", err)
+	}
+}
+
+func TestPermitterPrivateStudy(t *testing.T) {
+	public := &data.Study{}
+	public.Private.Set(false)
+	private := &data.Study{}
+	private.Private.Set(true)
+
+	var tests = []struct {
+		name     string
+		node     interface{}
+		expected *data.Study
+	}{
+		{"public study", public, nil},
+		{"private study", private, private},
+		{"activity without study", &data.Activity{}, nil},
+		{"comment without study", &data.Comment{}, nil},
+		{"label without study", &data.Label{}, nil},
+		{"topic", &data.Topic{}, nil},
+	}
+
+	permitter := newTestPermitter(nil)
+	for _, tt := range tests {
+		ctx := newTestViewerContext(nil, newTestGuest())
+		actual, err := permitter.privateStudy(ctx, tt.node)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if actual != tt.expected {
+			t.Errorf("%s: expected private study %v, got %v", tt.name, tt.expected, actual)
+		}
+	}
+}
+
+func TestPermitterViewerCanAccessStudy(t *testing.T) {
+	owner := &data.User{ID: newTestOID(t, "User")}
+	owner.Login.Set("owner")
+	study := &data.Study{ID: newTestOID(t, "Study"), UserID: owner.ID}
+	study.Private.Set(true)
+
+	var tests = []struct {
+		name     string
+		viewer   *data.User
+		expected bool
+	}{
+		{"guest", newTestGuest(), false},
+		{"owner", owner, true},
+		{"admin", newTestAdmin(t), true},
+	}
+
+	permitter := newTestPermitter(nil)
+	for _, tt := range tests {
+		ctx := newTestViewerContext(nil, tt.viewer)
+		actual, err := permitter.viewerCanAccessStudy(ctx, study, data.StudyAccessRead)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if actual != tt.expected {
+			t.Errorf("%s: expected access %v, got %v", tt.name, tt.expected, actual)
+		}
+	}
+}
+
+func TestPermitterViewerCanReadPrivateStudy(t *testing.T) {
+	testDb := mydb.NewTestDB(t)
+
+	owner := createTestUser(t, testDb.DB, "owner")
+	reader := createTestUser(t, testDb.DB, "reader")
+	stranger := createTestUser(t, testDb.DB, "stranger")
+	study := createTestStudy(t, testDb.DB, &owner.ID, true)
+
+	access := &data.StudyAccess{}
+	access.Access.Set(data.StudyAccessRead)
+	access.InvitationID.Set(nil)
+	access.StudyID.Set(&study.ID)
+	access.UserID.Set(&reader.ID)
+	if _, err := data.GrantStudyAccess(testDb.DB, access); err != nil {
+		t.Fatal(err)
+	}
+
+	var tests = []struct {
+		name     string
+		viewer   *data.User
+		expected bool
+	}{
+		{"guest", newTestGuest(), false},
+		{"stranger", stranger, false},
+		{"reader", reader, true},
+		{"owner", owner, true},
+	}
+
+	for _, tt := range tests {
+		ctx := newTestViewerContext(testDb.DB, tt.viewer)
+		actual, err := newTestPermitter(testDb.DB).ViewerCanRead(ctx, study)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if actual != tt.expected {
+			t.Errorf("%s: expected ViewerCanRead %v, got %v", tt.name, tt.expected, actual)
+		}
+	}
+}
+
+func TestPermitterViewerCanReadPrivateStudyContent(t *testing.T) {
+	testDb := mydb.NewTestDB(t)
+
+	owner := createTestUser(t, testDb.DB, "owner")
+	reader := createTestUser(t, testDb.DB, "reader")
+	stranger := createTestUser(t, testDb.DB, "stranger")
+	study := createTestStudy(t, testDb.DB, &owner.ID, true)
+
+	access := &data.StudyAccess{}
+	access.Access.Set(data.StudyAccessRead)
+	access.InvitationID.Set(nil)
+	access.StudyID.Set(&study.ID)
+	access.UserID.Set(&reader.ID)
+	if _, err := data.GrantStudyAccess(testDb.DB, access); err != nil {
+		t.Fatal(err)
+	}
+
+	activity := &data.Activity{StudyID: study.ID}
+	comment := &data.Comment{StudyID: study.ID}
+	comment.PublishedAt.Set(time.Now())
+
+	var tests = []struct {
+		name     string
+		viewer   *data.User
+		node     interface{}
+		expected bool
+	}{
+		{"guest reading activity", newTestGuest(), activity, false},
+		{"stranger reading activity", stranger, activity, false},
+		{"reader reading activity", reader, activity, true},
+		{"guest reading comment", newTestGuest(), comment, false},
+		{"stranger reading comment", stranger, comment, false},
+		{"reader reading comment", reader, comment, true},
+	}
+
+	for _, tt := range tests {
+		ctx := newTestViewerContext(testDb.DB, tt.viewer)
+		actual, err := newTestPermitter(testDb.DB).ViewerCanRead(ctx, tt.node)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if actual != tt.expected {
+			t.Errorf("%s: expected ViewerCanRead %v, got %v", tt.name, tt.expected, actual)
+		}
+	}
+
+	if err := data.RevokeStudyAccess(testDb.DB, study.ID.String, reader.ID.String); err != nil {
+		t.Fatal(err)
+	}
+	ctx := newTestViewerContext(testDb.DB, reader)
+	if ok, err := newTestPermitter(testDb.DB).ViewerCanRead(ctx, activity); err != nil {
+		t.Fatal(err)
+	} else if ok {
+		t.Error("expected revoked reader to be denied reading activity")
+	}
+}
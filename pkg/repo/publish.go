@@ -0,0 +1,210 @@
+package repo
+
+import (
+	"context"
+	"time"
+
+	"github.com/marksauter/markus-ninja-api/pkg/data"
+	"github.com/marksauter/markus-ninja-api/pkg/myctx"
+	"github.com/marksauter/markus-ninja-api/pkg/myerr"
+	"github.com/marksauter/markus-ninja-api/pkg/mylog"
+	"github.com/marksauter/markus-ninja-api/pkg/mytype"
+	"github.com/marksauter/markus-ninja-api/pkg/util"
+)
+
+// PublishCourse publishes the course, and creates an added to course event for
+// each of its lessons. Any publish scheduled for the course is cancelled.
+func (r *Repos) PublishCourse(
+	ctx context.Context,
+	courseID string,
+) (*CoursePermit, error) {
+	viewer, ok := myctx.UserFromContext(ctx)
+	if !ok {
+		err := &myctx.ErrNotFound{Name: "viewer"}
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
+		return nil, err
+	}
+	tx, err, newTx := myctx.TransactionFromContext(ctx)
+	if err != nil {
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
+		return nil, err
+	} else if newTx {
+		defer data.RollbackTransaction(tx)
+	}
+	ctx = myctx.NewQueryerContext(ctx, tx)
+
+	course := &data.Course{}
+	if err := course.ID.Set(courseID); err != nil {
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
+		return nil, err
+	}
+	if err := course.PublishedAt.Set(time.Now()); err != nil {
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
+		return nil, err
+	}
+	if err := course.ScheduledPublishAt.Set(nil); err != nil {
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
+		return nil, err
+	}
+
+	isPublishable, err := r.Course().IsPublishable(ctx, courseID)
+	if err != nil {
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
+		return nil, err
+	}
+	if !isPublishable {
+		err := myerr.ValidationError{Message: "course is not publishable"}
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
+		return nil, err
+	}
+
+	coursePermit, err := r.Course().Update(ctx, course)
+	if err != nil {
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
+		return nil, err
+	}
+
+	studyID, err := coursePermit.StudyID()
+	if err != nil {
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
+		return nil, err
+	}
+
+	courseLessons, err := r.CourseLesson().GetByCourse(ctx, courseID, nil)
+	if err != nil {
+		return nil, err
+	}
+	lessonIDs := make([]*mytype.OID, len(courseLessons))
+	for i, cl := range courseLessons {
+		lessonID, err := cl.LessonID()
+		if err != nil {
+			return nil, err
+		}
+		lessonIDs[i] = lessonID
+	}
+
+	for _, lid := range lessonIDs {
+		eventPayload, err := data.NewLessonAddedToCoursePayload(
+			lid,
+			&course.ID,
+		)
+		if err != nil {
+			mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
+			return nil, err
+		}
+		event, err := data.NewLessonEvent(eventPayload, studyID, &viewer.ID, true)
+		if err != nil {
+			mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
+			return nil, err
+		}
+		if _, err := r.Event().Create(ctx, event); err != nil {
+			mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
+			return nil, err
+		}
+	}
+
+	if newTx {
+		err := data.CommitTransaction(tx)
+		if err != nil {
+			mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
+			return nil, err
+		}
+	}
+
+	return coursePermit, nil
+}
+
+// PublishLessonDraft publishes the draft of the lesson as its body. Any
+// publish scheduled for the lesson is cancelled.
+func (r *Repos) PublishLessonDraft(
+	ctx context.Context,
+	lessonID string,
+) (*LessonPermit, error) {
+	tx, err, newTx := myctx.TransactionFromContext(ctx)
+	if err != nil {
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
+		return nil, err
+	} else if newTx {
+		defer data.RollbackTransaction(tx)
+	}
+	ctx = myctx.NewQueryerContext(ctx, tx)
+
+	currentLessonPermit, err := r.Lesson().Get(ctx, lessonID)
+	if err != nil {
+		return nil, err
+	}
+	draft, err := currentLessonPermit.Draft()
+	if err != nil {
+		return nil, err
+	}
+	studyID, err := currentLessonPermit.StudyID()
+	if err != nil {
+		return nil, err
+	}
+	userID, err := currentLessonPermit.UserID()
+	if err != nil {
+		return nil, err
+	}
+
+	lesson := &data.Lesson{}
+	if err := lesson.ID.Set(lessonID); err != nil {
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
+		return nil, err
+	}
+	if err := lesson.Body.Set(draft); err != nil {
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
+		return nil, err
+	}
+
+	err = r.ParseLessonBodyForEvents(
+		ctx,
+		&lesson.Body,
+		&lesson.ID,
+		studyID,
+		userID,
+	)
+	if err != nil {
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
+		return nil, err
+	}
+
+	body, err, updated := r.ReplaceMarkdownRefsWithLinks(
+		ctx,
+		lesson.Body,
+		studyID.String,
+	)
+	if err != nil {
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
+		return nil, err
+	}
+	if updated {
+		if err := lesson.Body.Set(body); err != nil {
+			mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
+			return nil, err
+		}
+	}
+
+	if err := lesson.PublishedAt.Set(time.Now()); err != nil {
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
+		return nil, err
+	}
+	if err := lesson.ScheduledPublishAt.Set(nil); err != nil {
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
+		return nil, err
+	}
+
+	lessonPermit, err := r.Lesson().Update(ctx, lesson)
+	if err != nil {
+		return nil, err
+	}
+
+	if newTx {
+		err := data.CommitTransaction(tx)
+		if err != nil {
+			mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
+			return nil, err
+		}
+	}
+
+	return lessonPermit, nil
+}
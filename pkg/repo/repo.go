@@ -10,6 +10,7 @@ import (
 	"strconv"
 	"strings"
 
+	"github.com/jackc/pgx/pgtype"
 	"github.com/marksauter/markus-ninja-api/pkg/data"
 	"github.com/marksauter/markus-ninja-api/pkg/myconf"
 	"github.com/marksauter/markus-ninja-api/pkg/myctx"
@@ -36,6 +37,7 @@ const (
 	labeledRepoKey            key = "labeled"
 	lessonRepoKey             key = "lesson"
 	lessonDraftBackupRepoKey  key = "lesson_draft_backup"
+	lessonPrerequisiteRepoKey key = "lesson_prerequisite"
 	notificationRepoKey       key = "notification"
 	permRepoKey               key = "perm"
 	prtRepoKey                key = "prt"
@@ -87,6 +89,7 @@ func NewRepos(db data.Queryer, conf *myconf.Config) *Repos {
 			labeledRepoKey:            NewLabeledRepo(conf),
 			lessonRepoKey:             NewLessonRepo(conf),
 			lessonDraftBackupRepoKey:  NewLessonDraftBackupRepo(conf),
+			lessonPrerequisiteRepoKey: NewLessonPrerequisiteRepo(conf),
 			notificationRepoKey:       NewNotificationRepo(conf),
 			prtRepoKey:                NewPRTRepo(conf),
 			eventRepoKey:              NewEventRepo(conf),
@@ -197,6 +200,11 @@ func (r *Repos) LessonDraftBackup() *LessonDraftBackupRepo {
 	return repo
 }
 
+func (r *Repos) LessonPrerequisite() *LessonPrerequisiteRepo {
+	repo, _ := r.lookup[lessonPrerequisiteRepoKey].(*LessonPrerequisiteRepo)
+	return repo
+}
+
 func (r *Repos) Notification() *NotificationRepo {
 	repo, _ := r.lookup[notificationRepoKey].(*NotificationRepo)
 	return repo
@@ -258,7 +266,7 @@ func (r *Repos) GetAppleable(
 		return r.Study().Get(ctx, appleableID.String)
 	default:
 		err := fmt.Errorf("invalid type '%s' for appleable id", appleableID.Type)
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 }
@@ -274,7 +282,7 @@ func (r *Repos) GetCommentable(
 		return r.UserAsset().Get(ctx, nodeID.String)
 	default:
 		err := fmt.Errorf("invalid type '%s' for commentable id", nodeID.Type)
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 }
@@ -294,7 +302,7 @@ func (r *Repos) GetCreateable(
 		return r.Study().Get(ctx, nodeID.String)
 	default:
 		err := fmt.Errorf("invalid type '%s' for createable id", nodeID.Type)
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 }
@@ -312,7 +320,7 @@ func (r *Repos) GetEnrollable(
 		return r.User().Get(ctx, enrollableID.String)
 	default:
 		err := fmt.Errorf("invalid type '%s' for enrollable id", enrollableID.Type)
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 }
@@ -330,7 +338,7 @@ func (r *Repos) GetPublishable(
 		return r.Comment().Get(ctx, nodeID.String)
 	default:
 		err := fmt.Errorf("invalid type '%s' for publishable id", nodeID.Type)
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 }
@@ -346,7 +354,7 @@ func (r *Repos) GetReferenceable(
 		return r.UserAsset().Get(ctx, nodeID.String)
 	default:
 		err := fmt.Errorf("invalid type '%s' for referenceable id", nodeID.Type)
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 }
@@ -362,7 +370,7 @@ func (r *Repos) GetRenameable(
 		return r.UserAsset().Get(ctx, nodeID.String)
 	default:
 		err := fmt.Errorf("invalid type '%s' for renameable id", nodeID.Type)
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 }
@@ -380,7 +388,7 @@ func (r *Repos) GetLabelable(
 		return r.UserAsset().Get(ctx, labelableID.String)
 	default:
 		err := fmt.Errorf("invalid type '%s' for labelable id", labelableID.Type)
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 }
@@ -416,7 +424,7 @@ func (r *Repos) GetNode(
 		return r.UserAsset().Get(ctx, nodeID.String)
 	default:
 		err := fmt.Errorf("invalid type '%s' for node id", nodeID.Type)
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 }
@@ -432,7 +440,7 @@ func (r *Repos) GetNotificationSubject(
 		return r.UserAsset().Get(ctx, nodeID.String)
 	default:
 		err := fmt.Errorf("invalid type '%s' for notification subject id", nodeID.Type)
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 }
@@ -448,9 +456,120 @@ func (r *Repos) GetTopicable(
 		return r.Study().Get(ctx, topicableID.String)
 	default:
 		err := fmt.Errorf("invalid type '%s' for topicable id", topicableID.Type)
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
+		return nil, err
+	}
+}
+
+func (r *Repos) CountStudyContentBySearch(
+	ctx context.Context,
+	studyID string,
+	filters *data.StudyContentFilterOptions,
+) (int32, error) {
+	var n int32
+	db, ok := myctx.QueryerFromContext(ctx)
+	if !ok {
+		err := &myctx.ErrNotFound{Name: "queryer"}
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
+		return n, err
+	}
+	return data.CountStudyContentBySearch(db, studyID, filters)
+}
+
+// SearchStudyContent returns the content of the study matching the search,
+// ranked together, that the viewer may read.
+func (r *Repos) SearchStudyContent(
+	ctx context.Context,
+	studyID string,
+	po *data.PageOptions,
+	filters *data.StudyContentFilterOptions,
+) ([]NodePermit, error) {
+	db, ok := myctx.QueryerFromContext(ctx)
+	if !ok {
+		err := &myctx.ErrNotFound{Name: "queryer"}
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
+		return nil, err
+	}
+	contents, err := data.SearchStudyContent(db, studyID, po, filters)
+	if err != nil {
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
+	r.loadStudyContent(ctx, contents)
+	permits := make([]NodePermit, 0, len(contents))
+	for _, c := range contents {
+		permit, err := r.GetNode(ctx, &c.ID)
+		if err != nil {
+			if err == ErrAccessDenied {
+				continue
+			}
+			mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
+			return nil, err
+		}
+		permits = append(permits, withBestMatchRank(permit, c.BestMatchRank))
+	}
+	return permits, nil
+}
+
+// loadStudyContent loads the contents in one batch per type, so that getting
+// each of them afterwards hits the loaders' caches. Errors are left to the
+// gets of the contents that failed to load.
+func (r *Repos) loadStudyContent(
+	ctx context.Context,
+	contents []*data.StudyContent,
+) {
+	ids := make(map[string][]string)
+	for _, c := range contents {
+		ids[c.ID.Type] = append(ids[c.ID.Type], c.ID.String)
+	}
+	for t, typeIDs := range ids {
+		var errs []error
+		switch t {
+		case data.StudyContentTypeActivity:
+			_, errs = r.Activity().load.GetMany(ctx, &typeIDs)
+		case data.StudyContentTypeComment:
+			_, errs = r.Comment().load.GetMany(ctx, &typeIDs)
+		case data.StudyContentTypeCourse:
+			_, errs = r.Course().load.GetMany(ctx, &typeIDs)
+		case data.StudyContentTypeLesson:
+			_, errs = r.Lesson().load.GetMany(ctx, &typeIDs)
+		case data.StudyContentTypeUserAsset:
+			_, errs = r.UserAsset().load.GetMany(ctx, typeIDs)
+		}
+		if errs != nil {
+			mylog.Log.WithContext(ctx).WithField("errors", errs).Warn(util.Trace(""))
+		}
+	}
+}
+
+// withBestMatchRank returns the permit of a node with the best match rank it
+// was searched with, so that its cursor carries the rank. The node is copied,
+// as it may be shared with other loads of it.
+func withBestMatchRank(permit NodePermit, rank pgtype.Float8) NodePermit {
+	switch p := permit.(type) {
+	case *ActivityPermit:
+		activity := *p.activity
+		activity.BestMatchRank = rank
+		return &ActivityPermit{p.checkFieldPermission, &activity}
+	case *CommentPermit:
+		comment := *p.comment
+		comment.BestMatchRank = rank
+		return &CommentPermit{p.checkFieldPermission, &comment}
+	case *CoursePermit:
+		course := *p.course
+		course.BestMatchRank = rank
+		return &CoursePermit{p.checkFieldPermission, &course}
+	case *LessonPermit:
+		lesson := *p.lesson
+		lesson.BestMatchRank = rank
+		return &LessonPermit{p.checkFieldPermission, &lesson}
+	case *UserAssetPermit:
+		userAsset := *p.userAsset
+		userAsset.BestMatchRank = rank
+		return &UserAssetPermit{p.checkFieldPermission, &userAsset}
+	default:
+		return permit
+	}
 }
 
 func (r *Repos) ReplaceMarkdownRefsWithLinks(
@@ -462,14 +581,14 @@ func (r *Repos) ReplaceMarkdownRefsWithLinks(
 	body := markdown.String
 	studyPermit, err := r.Study().Get(ctx, studyID)
 	if err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err, false
 	}
 	study := studyPermit.Get()
 
 	userPermit, err := r.User().Get(ctx, study.UserID.String)
 	if err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err, false
 	}
 	user := userPermit.Get()
@@ -488,7 +607,7 @@ func (r *Repos) ReplaceMarkdownRefsWithLinks(
 			query = result[2]
 			queryValues, err = url.ParseQuery(query)
 			if err != nil {
-				mylog.Log.WithError(err).Error(util.Trace(""))
+				mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 				return s
 			}
 			class = queryValues.Get("class")
@@ -505,7 +624,7 @@ func (r *Repos) ReplaceMarkdownRefsWithLinks(
 			name,
 		)
 		if err != nil {
-			mylog.Log.WithError(err).Error(util.Trace(""))
+			mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 			return s
 		}
 		userAsset := userAssetPermit.Get()
@@ -554,7 +673,7 @@ func (r *Repos) ReplaceMarkdownRefsWithLinks(
 		}
 		exists, err := r.Lesson().ExistsByNumber(ctx, studyID, int32(n))
 		if err != nil {
-			mylog.Log.WithError(err).Error(util.Trace(""))
+			mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 			return s
 		}
 		if !exists {
@@ -586,7 +705,7 @@ func (r *Repos) ReplaceMarkdownRefsWithLinks(
 		}
 		exists, err := r.Lesson().ExistsByOwnerStudyAndNumber(ctx, owner, name, int32(n))
 		if err != nil {
-			mylog.Log.WithError(err).Error(util.Trace(""))
+			mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 			return s
 		}
 		if !exists {
@@ -613,7 +732,7 @@ func (r *Repos) ReplaceMarkdownRefsWithLinks(
 		name := result[1]
 		exists, err := r.User().ExistsByLogin(ctx, name)
 		if err != nil {
-			mylog.Log.WithError(err).Error(util.Trace(""))
+			mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 			return s
 		}
 		if !exists {
@@ -627,7 +746,7 @@ func (r *Repos) ReplaceMarkdownRefsWithLinks(
 	body = mytype.AtRefRegexp.ReplaceAllStringFunc(body, userRefToLink)
 
 	if err := markdown.Set(body); err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err, false
 	}
 
@@ -663,7 +782,7 @@ func (r *Repos) ReplaceMarkdownLinksWithRefs(
 			src = result[2]
 			uri, err := url.ParseRequestURI(src)
 			if err != nil {
-				mylog.Log.WithError(err).Error(util.Trace(""))
+				mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 				return s
 			}
 			query = uri.RawQuery
@@ -727,7 +846,7 @@ func (r *Repos) ParseLessonBodyForEvents(
 ) error {
 	tx, err, newTx := myctx.TransactionFromContext(ctx)
 	if err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return err
 	} else if newTx {
 		defer data.RollbackTransaction(tx)
@@ -746,34 +865,34 @@ func (r *Repos) ParseLessonBodyForEvents(
 			names,
 		)
 		if err != nil {
-			mylog.Log.WithError(err).Error(util.Trace(""))
+			mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 			return err
 		}
 		for _, a := range userAssets {
 			aID, err := a.ID()
 			if err != nil {
-				mylog.Log.WithError(err).Error(util.Trace(""))
+				mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 				return err
 			}
 			payload, err := data.NewUserAssetReferencedPayload(aID, lessonID)
 			if err != nil {
-				mylog.Log.WithError(err).Error(util.Trace(""))
+				mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 				return err
 			}
 			event, err := data.NewUserAssetEvent(payload, studyID, userID, true)
 			if err != nil {
-				mylog.Log.WithError(err).Error(util.Trace(""))
+				mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 				return err
 			}
 			if _, err = r.Event().Create(ctx, event); err != nil {
-				mylog.Log.WithError(err).Error(util.Trace(""))
+				mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 				return err
 			}
 		}
 	}
 	lessonNumberRefs, err := body.NumberRefs()
 	if err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return err
 	}
 	if len(lessonNumberRefs) > 0 {
@@ -787,28 +906,28 @@ func (r *Repos) ParseLessonBodyForEvents(
 			numbers,
 		)
 		if err != nil {
-			mylog.Log.WithError(err).Error(util.Trace(""))
+			mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 			return err
 		}
 		for _, l := range lessons {
 			lID, err := l.ID()
 			if err != nil {
-				mylog.Log.WithError(err).Error(util.Trace(""))
+				mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 				return err
 			}
 			if lID.String != lessonID.String {
 				payload, err := data.NewLessonReferencedPayload(lID, lessonID)
 				if err != nil {
-					mylog.Log.WithError(err).Error(util.Trace(""))
+					mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 					return err
 				}
 				event, err := data.NewLessonEvent(payload, studyID, userID, true)
 				if err != nil {
-					mylog.Log.WithError(err).Error(util.Trace(""))
+					mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 					return err
 				}
 				if _, err = r.Event().Create(ctx, event); err != nil {
-					mylog.Log.WithError(err).Error(util.Trace(""))
+					mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 					return err
 				}
 			}
@@ -816,7 +935,7 @@ func (r *Repos) ParseLessonBodyForEvents(
 	}
 	crossStudyRefs, err := body.CrossStudyRefs()
 	if err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return err
 	}
 	for _, ref := range crossStudyRefs {
@@ -827,27 +946,27 @@ func (r *Repos) ParseLessonBodyForEvents(
 			ref.Number,
 		)
 		if err != nil {
-			mylog.Log.WithError(err).Error(util.Trace(""))
+			mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 			return err
 		}
 		lID, err := l.ID()
 		if err != nil {
-			mylog.Log.WithError(err).Error(util.Trace(""))
+			mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 			return err
 		}
 		if lID.String != lessonID.String {
 			payload, err := data.NewLessonReferencedPayload(lID, lessonID)
 			if err != nil {
-				mylog.Log.WithError(err).Error(util.Trace(""))
+				mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 				return err
 			}
 			event, err := data.NewLessonEvent(payload, studyID, userID, true)
 			if err != nil {
-				mylog.Log.WithError(err).Error(util.Trace(""))
+				mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 				return err
 			}
 			if _, err = r.Event().Create(ctx, event); err != nil {
-				mylog.Log.WithError(err).Error(util.Trace(""))
+				mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 				return err
 			}
 		}
@@ -863,28 +982,28 @@ func (r *Repos) ParseLessonBodyForEvents(
 			names,
 		)
 		if err != nil {
-			mylog.Log.WithError(err).Error(util.Trace(""))
+			mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 			return err
 		}
 		for _, u := range users {
 			uID, err := u.ID()
 			if err != nil {
-				mylog.Log.WithError(err).Error(util.Trace(""))
+				mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 				return err
 			}
 			if uID.String != userID.String {
-				payload, err := data.NewLessonMentionedPayload(lessonID)
+				payload, err := data.NewLessonMentionedPayload(lessonID, userID)
 				if err != nil {
-					mylog.Log.WithError(err).Error(util.Trace(""))
+					mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 					return err
 				}
 				event, err := data.NewLessonEvent(payload, studyID, uID, true)
 				if err != nil {
-					mylog.Log.WithError(err).Error(util.Trace(""))
+					mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 					return err
 				}
 				if _, err = r.Event().Create(ctx, event); err != nil {
-					mylog.Log.WithError(err).Error(util.Trace(""))
+					mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 					return err
 				}
 			}
@@ -894,7 +1013,7 @@ func (r *Repos) ParseLessonBodyForEvents(
 	if newTx {
 		err = data.CommitTransaction(tx)
 		if err != nil {
-			mylog.Log.WithError(err).Error(util.Trace(""))
+			mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 			return err
 		}
 	}
@@ -911,7 +1030,7 @@ func (r *Repos) ParseUserAssetBodyForEvents(
 ) error {
 	tx, err, newTx := myctx.TransactionFromContext(ctx)
 	if err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return err
 	} else if newTx {
 		defer data.RollbackTransaction(tx)
@@ -929,28 +1048,28 @@ func (r *Repos) ParseUserAssetBodyForEvents(
 			names,
 		)
 		if err != nil {
-			mylog.Log.WithError(err).Error(util.Trace(""))
+			mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 			return err
 		}
 		for _, u := range users {
 			uID, err := u.ID()
 			if err != nil {
-				mylog.Log.WithError(err).Error(util.Trace(""))
+				mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 				return err
 			}
 			if uID.String != userID.String {
-				payload, err := data.NewUserAssetMentionedPayload(assetID)
+				payload, err := data.NewUserAssetMentionedPayload(assetID, userID)
 				if err != nil {
-					mylog.Log.WithError(err).Error(util.Trace(""))
+					mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 					return err
 				}
 				event, err := data.NewUserAssetEvent(payload, studyID, uID, true)
 				if err != nil {
-					mylog.Log.WithError(err).Error(util.Trace(""))
+					mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 					return err
 				}
 				if _, err = r.Event().Create(ctx, event); err != nil {
-					mylog.Log.WithError(err).Error(util.Trace(""))
+					mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 					return err
 				}
 			}
@@ -960,7 +1079,7 @@ func (r *Repos) ParseUserAssetBodyForEvents(
 	if newTx {
 		err = data.CommitTransaction(tx)
 		if err != nil {
-			mylog.Log.WithError(err).Error(util.Trace(""))
+			mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 			return err
 		}
 	}
@@ -57,6 +57,11 @@ func (r *StudyPermit) CreatedAt() (time.Time, error) {
 	return r.study.CreatedAt.Time, nil
 }
 
+// Cursor returns the cursor of the study in a connection ordered by field.
+func (r *StudyPermit) Cursor(field string) (string, error) {
+	return data.EncodeCursor(r.study, field)
+}
+
 func (r *StudyPermit) Description() (string, error) {
 	if ok := r.checkFieldPermission("description"); !ok {
 		err := ErrAccessDenied
@@ -97,6 +102,15 @@ func (r *StudyPermit) Private() (bool, error) {
 	return r.study.Private.Bool, nil
 }
 
+func (r *StudyPermit) SearchLanguage() (string, error) {
+	if ok := r.checkFieldPermission("search_language"); !ok {
+		err := ErrAccessDenied
+		mylog.Log.WithError(err).Error(util.Trace(""))
+		return "", err
+	}
+	return r.study.SearchLanguage.String, nil
+}
+
 func (r *StudyPermit) TopicedAt() time.Time {
 	return r.study.TopicedAt.Time
 }
@@ -132,6 +146,26 @@ type StudyRepo struct {
 	permit *Permitter
 }
 
+func (r *StudyRepo) filterPermittable(
+	ctx context.Context,
+	accessLevel mytype.AccessLevel,
+	studies []*data.Study,
+) ([]*StudyPermit, error) {
+	studyPermits := make([]*StudyPermit, 0, len(studies))
+	for _, l := range studies {
+		fieldPermFn, err := r.permit.Check(ctx, accessLevel, l)
+		if err != nil {
+			if err != ErrAccessDenied {
+				mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
+				return nil, err
+			}
+		} else {
+			studyPermits = append(studyPermits, &StudyPermit{fieldPermFn, l})
+		}
+	}
+	return studyPermits, nil
+}
+
 func (r *StudyRepo) Open(p *Permitter) error {
 	if p == nil {
 		err := ErrNilPermitter
@@ -166,7 +200,7 @@ func (r *StudyRepo) CountByApplee(
 	db, ok := myctx.QueryerFromContext(ctx)
 	if !ok {
 		err := &myctx.ErrNotFound{"queryer"}
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return n, err
 	}
 	return data.CountStudyByApplee(db, appleeID, filters)
@@ -181,7 +215,7 @@ func (r *StudyRepo) CountByEnrollee(
 	db, ok := myctx.QueryerFromContext(ctx)
 	if !ok {
 		err := &myctx.ErrNotFound{"queryer"}
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return n, err
 	}
 	return data.CountStudyByEnrollee(db, enrolleeID, filters)
@@ -196,12 +230,27 @@ func (r *StudyRepo) CountByTopic(
 	db, ok := myctx.QueryerFromContext(ctx)
 	if !ok {
 		err := &myctx.ErrNotFound{"queryer"}
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return n, err
 	}
 	return data.CountStudyByTopic(db, topicID, filters)
 }
 
+func (r *StudyRepo) CountRecommended(
+	ctx context.Context,
+	recommendeeID string,
+	filters *data.StudyFilterOptions,
+) (int32, error) {
+	var n int32
+	db, ok := myctx.QueryerFromContext(ctx)
+	if !ok {
+		err := &myctx.ErrNotFound{Name: "queryer"}
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
+		return n, err
+	}
+	return data.CountRecommendedStudy(db, recommendeeID, filters)
+}
+
 func (r *StudyRepo) CountBySearch(
 	ctx context.Context,
 	filters *data.StudyFilterOptions,
@@ -210,12 +259,27 @@ func (r *StudyRepo) CountBySearch(
 	db, ok := myctx.QueryerFromContext(ctx)
 	if !ok {
 		err := &myctx.ErrNotFound{"queryer"}
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return n, err
 	}
 	return data.CountStudyBySearch(db, filters)
 }
 
+func (r *StudyRepo) CountTrending(
+	ctx context.Context,
+	period string,
+	filters *data.StudyFilterOptions,
+) (int32, error) {
+	var n int32
+	db, ok := myctx.QueryerFromContext(ctx)
+	if !ok {
+		err := &myctx.ErrNotFound{Name: "queryer"}
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
+		return n, err
+	}
+	return data.CountTrendingStudy(db, period, filters)
+}
+
 func (r *StudyRepo) CountByUser(
 	ctx context.Context,
 	userID string,
@@ -225,7 +289,7 @@ func (r *StudyRepo) CountByUser(
 	db, ok := myctx.QueryerFromContext(ctx)
 	if !ok {
 		err := &myctx.ErrNotFound{"queryer"}
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return n, err
 	}
 	return data.CountStudyByUser(db, userID, filters)
@@ -236,27 +300,27 @@ func (r *StudyRepo) Create(
 	s *data.Study,
 ) (*StudyPermit, error) {
 	if err := r.CheckConnection(); err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 	db, ok := myctx.QueryerFromContext(ctx)
 	if !ok {
 		err := &myctx.ErrNotFound{"queryer"}
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 	if _, err := r.permit.Check(ctx, mytype.CreateAccess, s); err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 	study, err := data.CreateStudy(db, s)
 	if err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 	fieldPermFn, err := r.permit.Check(ctx, mytype.ReadAccess, study)
 	if err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 	return &StudyPermit{fieldPermFn, study}, nil
@@ -267,17 +331,17 @@ func (r *StudyRepo) Get(
 	id string,
 ) (*StudyPermit, error) {
 	if err := r.CheckConnection(); err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 	study, err := r.load.Get(ctx, id)
 	if err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 	fieldPermFn, err := r.permit.Check(ctx, mytype.ReadAccess, study)
 	if err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 	return &StudyPermit{fieldPermFn, study}, nil
@@ -290,32 +354,21 @@ func (r *StudyRepo) GetByApplee(
 	filters *data.StudyFilterOptions,
 ) ([]*StudyPermit, error) {
 	if err := r.CheckConnection(); err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 	db, ok := myctx.QueryerFromContext(ctx)
 	if !ok {
 		err := &myctx.ErrNotFound{"queryer"}
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 	studies, err := data.GetStudyByApplee(db, appleeID, po, filters)
 	if err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
-	studyPermits := make([]*StudyPermit, len(studies))
-	if len(studies) > 0 {
-		fieldPermFn, err := r.permit.Check(ctx, mytype.ReadAccess, studies[0])
-		if err != nil {
-			mylog.Log.WithError(err).Error(util.Trace(""))
-			return nil, err
-		}
-		for i, l := range studies {
-			studyPermits[i] = &StudyPermit{fieldPermFn, l}
-		}
-	}
-	return studyPermits, nil
+	return r.filterPermittable(ctx, mytype.ReadAccess, studies)
 }
 
 func (r *StudyRepo) GetByEnrollee(
@@ -325,32 +378,45 @@ func (r *StudyRepo) GetByEnrollee(
 	filters *data.StudyFilterOptions,
 ) ([]*StudyPermit, error) {
 	if err := r.CheckConnection(); err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 	db, ok := myctx.QueryerFromContext(ctx)
 	if !ok {
 		err := &myctx.ErrNotFound{"queryer"}
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 	studies, err := data.GetStudyByEnrollee(db, enrolleeID, po, filters)
 	if err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
-	studyPermits := make([]*StudyPermit, len(studies))
-	if len(studies) > 0 {
-		fieldPermFn, err := r.permit.Check(ctx, mytype.ReadAccess, studies[0])
-		if err != nil {
-			mylog.Log.WithError(err).Error(util.Trace(""))
-			return nil, err
-		}
-		for i, l := range studies {
-			studyPermits[i] = &StudyPermit{fieldPermFn, l}
-		}
+	return r.filterPermittable(ctx, mytype.ReadAccess, studies)
+}
+
+func (r *StudyRepo) GetRecommended(
+	ctx context.Context,
+	recommendeeID string,
+	po *data.PageOptions,
+	filters *data.StudyFilterOptions,
+) ([]*StudyPermit, error) {
+	if err := r.CheckConnection(); err != nil {
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
+		return nil, err
 	}
-	return studyPermits, nil
+	db, ok := myctx.QueryerFromContext(ctx)
+	if !ok {
+		err := &myctx.ErrNotFound{Name: "queryer"}
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
+		return nil, err
+	}
+	studies, err := data.GetRecommendedStudy(db, recommendeeID, po, filters)
+	if err != nil {
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
+		return nil, err
+	}
+	return r.filterPermittable(ctx, mytype.ReadAccess, studies)
 }
 
 func (r *StudyRepo) GetByTopic(
@@ -360,32 +426,45 @@ func (r *StudyRepo) GetByTopic(
 	filters *data.StudyFilterOptions,
 ) ([]*StudyPermit, error) {
 	if err := r.CheckConnection(); err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 	db, ok := myctx.QueryerFromContext(ctx)
 	if !ok {
 		err := &myctx.ErrNotFound{"queryer"}
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 	studies, err := data.GetStudyByTopic(db, topicID, po, filters)
 	if err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
-	studyPermits := make([]*StudyPermit, len(studies))
-	if len(studies) > 0 {
-		fieldPermFn, err := r.permit.Check(ctx, mytype.ReadAccess, studies[0])
-		if err != nil {
-			mylog.Log.WithError(err).Error(util.Trace(""))
-			return nil, err
-		}
-		for i, l := range studies {
-			studyPermits[i] = &StudyPermit{fieldPermFn, l}
-		}
+	return r.filterPermittable(ctx, mytype.ReadAccess, studies)
+}
+
+func (r *StudyRepo) GetTrending(
+	ctx context.Context,
+	period string,
+	po *data.PageOptions,
+	filters *data.StudyFilterOptions,
+) ([]*StudyPermit, error) {
+	if err := r.CheckConnection(); err != nil {
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
+		return nil, err
 	}
-	return studyPermits, nil
+	db, ok := myctx.QueryerFromContext(ctx)
+	if !ok {
+		err := &myctx.ErrNotFound{Name: "queryer"}
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
+		return nil, err
+	}
+	studies, err := data.GetTrendingStudy(db, period, po, filters)
+	if err != nil {
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
+		return nil, err
+	}
+	return r.filterPermittable(ctx, mytype.ReadAccess, studies)
 }
 
 func (r *StudyRepo) GetByUser(
@@ -395,32 +474,21 @@ func (r *StudyRepo) GetByUser(
 	filters *data.StudyFilterOptions,
 ) ([]*StudyPermit, error) {
 	if err := r.CheckConnection(); err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 	db, ok := myctx.QueryerFromContext(ctx)
 	if !ok {
 		err := &myctx.ErrNotFound{"queryer"}
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 	studies, err := data.GetStudyByUser(db, userID, po, filters)
 	if err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
-	studyPermits := make([]*StudyPermit, len(studies))
-	if len(studies) > 0 {
-		fieldPermFn, err := r.permit.Check(ctx, mytype.ReadAccess, studies[0])
-		if err != nil {
-			mylog.Log.WithError(err).Error(util.Trace(""))
-			return nil, err
-		}
-		for i, l := range studies {
-			studyPermits[i] = &StudyPermit{fieldPermFn, l}
-		}
-	}
-	return studyPermits, nil
+	return r.filterPermittable(ctx, mytype.ReadAccess, studies)
 }
 
 func (r *StudyRepo) GetByName(
@@ -429,17 +497,17 @@ func (r *StudyRepo) GetByName(
 	name string,
 ) (*StudyPermit, error) {
 	if err := r.CheckConnection(); err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 	study, err := r.load.GetByName(ctx, userID, name)
 	if err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 	fieldPermFn, err := r.permit.Check(ctx, mytype.ReadAccess, study)
 	if err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 	return &StudyPermit{fieldPermFn, study}, nil
@@ -451,17 +519,17 @@ func (r *StudyRepo) GetByUserAndName(
 	name string,
 ) (*StudyPermit, error) {
 	if err := r.CheckConnection(); err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 	study, err := r.load.GetByUserAndName(ctx, owner, name)
 	if err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 	fieldPermFn, err := r.permit.Check(ctx, mytype.ReadAccess, study)
 	if err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 	return &StudyPermit{fieldPermFn, study}, nil
@@ -472,17 +540,17 @@ func (r *StudyRepo) Delete(
 	study *data.Study,
 ) error {
 	if err := r.CheckConnection(); err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return err
 	}
 	db, ok := myctx.QueryerFromContext(ctx)
 	if !ok {
 		err := &myctx.ErrNotFound{"queryer"}
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return err
 	}
 	if _, err := r.permit.Check(ctx, mytype.DeleteAccess, study); err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return err
 	}
 	return data.DeleteStudy(db, study.ID.String)
@@ -494,32 +562,21 @@ func (r *StudyRepo) Search(
 	filters *data.StudyFilterOptions,
 ) ([]*StudyPermit, error) {
 	if err := r.CheckConnection(); err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 	db, ok := myctx.QueryerFromContext(ctx)
 	if !ok {
 		err := &myctx.ErrNotFound{"queryer"}
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 	studies, err := data.SearchStudy(db, po, filters)
 	if err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
-	studyPermits := make([]*StudyPermit, len(studies))
-	if len(studies) > 0 {
-		fieldPermFn, err := r.permit.Check(ctx, mytype.ReadAccess, studies[0])
-		if err != nil {
-			mylog.Log.WithError(err).Error(util.Trace(""))
-			return nil, err
-		}
-		for i, l := range studies {
-			studyPermits[i] = &StudyPermit{fieldPermFn, l}
-		}
-	}
-	return studyPermits, nil
+	return r.filterPermittable(ctx, mytype.ReadAccess, studies)
 }
 
 func (r *StudyRepo) Update(
@@ -527,32 +584,80 @@ func (r *StudyRepo) Update(
 	s *data.Study,
 ) (*StudyPermit, error) {
 	if err := r.CheckConnection(); err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 	db, ok := myctx.QueryerFromContext(ctx)
 	if !ok {
 		err := &myctx.ErrNotFound{"queryer"}
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 	if _, err := r.permit.Check(ctx, mytype.UpdateAccess, s); err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 	study, err := data.UpdateStudy(db, s)
 	if err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 	fieldPermFn, err := r.permit.Check(ctx, mytype.ReadAccess, study)
 	if err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 	return &StudyPermit{fieldPermFn, study}, nil
 }
 
+// GrantAccess grants the user access to the private study.
+func (r *StudyRepo) GrantAccess(
+	ctx context.Context,
+	a *data.StudyAccess,
+) (*data.StudyAccess, error) {
+	if err := r.CheckConnection(); err != nil {
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
+		return nil, err
+	}
+	db, ok := myctx.QueryerFromContext(ctx)
+	if !ok {
+		err := &myctx.ErrNotFound{Name: "queryer"}
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
+		return nil, err
+	}
+	studyAccess, err := data.GrantStudyAccess(db, a)
+	if err != nil {
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
+		return nil, err
+	}
+	r.permit.ClearStudyAccess(a.UserID.String)
+	return studyAccess, nil
+}
+
+// RevokeAccess removes the user's access to the private study.
+func (r *StudyRepo) RevokeAccess(
+	ctx context.Context,
+	studyID,
+	userID string,
+) error {
+	if err := r.CheckConnection(); err != nil {
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
+		return err
+	}
+	db, ok := myctx.QueryerFromContext(ctx)
+	if !ok {
+		err := &myctx.ErrNotFound{Name: "queryer"}
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
+		return err
+	}
+	if err := data.RevokeStudyAccess(db, studyID, userID); err != nil {
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
+		return err
+	}
+	r.permit.ClearStudyAccess(userID)
+	return nil
+}
+
 func (r *StudyRepo) ViewerCanAdmin(
 	ctx context.Context,
 	s *data.Study,
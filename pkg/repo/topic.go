@@ -5,6 +5,7 @@ import (
 	"time"
 
 	"github.com/fatih/structs"
+	"github.com/jackc/pgx/pgtype"
 	"github.com/marksauter/markus-ninja-api/pkg/data"
 	"github.com/marksauter/markus-ninja-api/pkg/loader"
 	"github.com/marksauter/markus-ninja-api/pkg/myconf"
@@ -40,6 +41,11 @@ func (r *TopicPermit) CreatedAt() (time.Time, error) {
 	return r.topic.CreatedAt.Time, nil
 }
 
+// Cursor returns the cursor of the topic in a connection ordered by field.
+func (r *TopicPermit) Cursor(field string) (string, error) {
+	return data.EncodeCursor(r.topic, field)
+}
+
 func (r *TopicPermit) Description() (string, error) {
 	if ok := r.checkFieldPermission("description"); !ok {
 		err := ErrAccessDenied
@@ -49,6 +55,32 @@ func (r *TopicPermit) Description() (string, error) {
 	return r.topic.Description.String, nil
 }
 
+// DisplayName returns the name the topic is shown with, or its name if it has
+// none.
+func (r *TopicPermit) DisplayName() (string, error) {
+	if ok := r.checkFieldPermission("display_name"); !ok {
+		err := ErrAccessDenied
+		mylog.Log.WithError(err).Error(util.Trace(""))
+		return "", err
+	}
+	if r.topic.DisplayName.Status != pgtype.Present {
+		return r.Name()
+	}
+	return r.topic.DisplayName.String, nil
+}
+
+func (r *TopicPermit) FeaturedAt() (*time.Time, error) {
+	if ok := r.checkFieldPermission("featured_at"); !ok {
+		err := ErrAccessDenied
+		mylog.Log.WithError(err).Error(util.Trace(""))
+		return nil, err
+	}
+	if r.topic.FeaturedAt.Status != pgtype.Present {
+		return nil, nil
+	}
+	return &r.topic.FeaturedAt.Time, nil
+}
+
 func (r *TopicPermit) ID() (*mytype.OID, error) {
 	if ok := r.checkFieldPermission("id"); !ok {
 		err := ErrAccessDenied
@@ -58,6 +90,18 @@ func (r *TopicPermit) ID() (*mytype.OID, error) {
 	return &r.topic.ID, nil
 }
 
+func (r *TopicPermit) LogoID() (*mytype.OID, error) {
+	if ok := r.checkFieldPermission("logo_id"); !ok {
+		err := ErrAccessDenied
+		mylog.Log.WithError(err).Error(util.Trace(""))
+		return nil, err
+	}
+	if r.topic.LogoID.Status != pgtype.Present {
+		return nil, nil
+	}
+	return &r.topic.LogoID, nil
+}
+
 func (r *TopicPermit) Name() (string, error) {
 	if ok := r.checkFieldPermission("name"); !ok {
 		err := ErrAccessDenied
@@ -114,6 +158,17 @@ func (r *TopicRepo) CheckConnection() error {
 
 // Service methods
 
+func (r *TopicRepo) CountFeatured(ctx context.Context) (int32, error) {
+	var n int32
+	db, ok := myctx.QueryerFromContext(ctx)
+	if !ok {
+		err := &myctx.ErrNotFound{Name: "queryer"}
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
+		return n, err
+	}
+	return data.CountFeaturedTopic(db)
+}
+
 func (r *TopicRepo) CountBySearch(
 	ctx context.Context,
 	filters *data.TopicFilterOptions,
@@ -122,7 +177,7 @@ func (r *TopicRepo) CountBySearch(
 	db, ok := myctx.QueryerFromContext(ctx)
 	if !ok {
 		err := &myctx.ErrNotFound{"queryer"}
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return n, err
 	}
 	return data.CountTopicBySearch(db, filters)
@@ -137,7 +192,7 @@ func (r *TopicRepo) CountByTopicable(
 	db, ok := myctx.QueryerFromContext(ctx)
 	if !ok {
 		err := &myctx.ErrNotFound{"queryer"}
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return n, err
 	}
 	return data.CountTopicByTopicable(db, topicableID, filters)
@@ -148,27 +203,27 @@ func (r *TopicRepo) Create(
 	s *data.Topic,
 ) (*TopicPermit, error) {
 	if err := r.CheckConnection(); err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 	db, ok := myctx.QueryerFromContext(ctx)
 	if !ok {
 		err := &myctx.ErrNotFound{"queryer"}
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 	if _, err := r.permit.Check(ctx, mytype.CreateAccess, s); err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 	topic, err := data.CreateTopic(db, s)
 	if err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 	fieldPermFn, err := r.permit.Check(ctx, mytype.ReadAccess, topic)
 	if err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 	return &TopicPermit{fieldPermFn, topic}, nil
@@ -179,22 +234,69 @@ func (r *TopicRepo) Get(
 	id string,
 ) (*TopicPermit, error) {
 	if err := r.CheckConnection(); err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 	topic, err := r.load.Get(ctx, id)
 	if err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 	fieldPermFn, err := r.permit.Check(ctx, mytype.ReadAccess, topic)
 	if err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 	return &TopicPermit{fieldPermFn, topic}, nil
 }
 
+// GetAliasNames returns the aliases of the topic.
+func (r *TopicRepo) GetAliasNames(
+	ctx context.Context,
+	id string,
+) ([]string, error) {
+	db, ok := myctx.QueryerFromContext(ctx)
+	if !ok {
+		err := &myctx.ErrNotFound{Name: "queryer"}
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
+		return nil, err
+	}
+	return data.GetTopicAliasNames(db, id)
+}
+
+func (r *TopicRepo) GetFeatured(
+	ctx context.Context,
+	po *data.PageOptions,
+) ([]*TopicPermit, error) {
+	if err := r.CheckConnection(); err != nil {
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
+		return nil, err
+	}
+	db, ok := myctx.QueryerFromContext(ctx)
+	if !ok {
+		err := &myctx.ErrNotFound{Name: "queryer"}
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
+		return nil, err
+	}
+	topics, err := data.GetFeaturedTopic(db, po)
+	if err != nil {
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
+		return nil, err
+	}
+	topicPermits := make([]*TopicPermit, len(topics))
+	if len(topics) > 0 {
+		fieldPermFn, err := r.permit.Check(ctx, mytype.ReadAccess, topics[0])
+		if err != nil {
+			mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
+			return nil, err
+		}
+		for i, l := range topics {
+			topicPermits[i] = &TopicPermit{fieldPermFn, l}
+		}
+	}
+	return topicPermits, nil
+}
+
 func (r *TopicRepo) GetByTopicable(
 	ctx context.Context,
 	topicableID string,
@@ -202,25 +304,25 @@ func (r *TopicRepo) GetByTopicable(
 	filters *data.TopicFilterOptions,
 ) ([]*TopicPermit, error) {
 	if err := r.CheckConnection(); err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 	db, ok := myctx.QueryerFromContext(ctx)
 	if !ok {
 		err := &myctx.ErrNotFound{"queryer"}
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 	topics, err := data.GetTopicByTopicable(db, topicableID, po, filters)
 	if err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 	topicPermits := make([]*TopicPermit, len(topics))
 	if len(topics) > 0 {
 		fieldPermFn, err := r.permit.Check(ctx, mytype.ReadAccess, topics[0])
 		if err != nil {
-			mylog.Log.WithError(err).Error(util.Trace(""))
+			mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 			return nil, err
 		}
 		for i, l := range topics {
@@ -235,47 +337,80 @@ func (r *TopicRepo) GetByName(
 	name string,
 ) (*TopicPermit, error) {
 	if err := r.CheckConnection(); err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 	topic, err := r.load.GetByName(ctx, name)
 	if err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 	fieldPermFn, err := r.permit.Check(ctx, mytype.ReadAccess, topic)
 	if err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 	return &TopicPermit{fieldPermFn, topic}, nil
 }
 
+func (r *TopicRepo) GetRelated(
+	ctx context.Context,
+	id string,
+) ([]*TopicPermit, error) {
+	if err := r.CheckConnection(); err != nil {
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
+		return nil, err
+	}
+	db, ok := myctx.QueryerFromContext(ctx)
+	if !ok {
+		err := &myctx.ErrNotFound{Name: "queryer"}
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
+		return nil, err
+	}
+	topics, err := data.GetRelatedTopic(db, id)
+	if err != nil {
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
+		return nil, err
+	}
+	topicPermits := make([]*TopicPermit, len(topics))
+	if len(topics) > 0 {
+		fieldPermFn, err := r.permit.Check(ctx, mytype.ReadAccess, topics[0])
+		if err != nil {
+			mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
+			return nil, err
+		}
+		for i, l := range topics {
+			topicPermits[i] = &TopicPermit{fieldPermFn, l}
+		}
+	}
+	return topicPermits, nil
+}
+
 func (r *TopicRepo) Search(
 	ctx context.Context,
 	po *data.PageOptions,
 	filters *data.TopicFilterOptions,
 ) ([]*TopicPermit, error) {
 	if err := r.CheckConnection(); err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 	db, ok := myctx.QueryerFromContext(ctx)
 	if !ok {
 		err := &myctx.ErrNotFound{"queryer"}
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 	topics, err := data.SearchTopic(db, po, filters)
 	if err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 	topicPermits := make([]*TopicPermit, len(topics))
 	if len(topics) > 0 {
 		fieldPermFn, err := r.permit.Check(ctx, mytype.ReadAccess, topics[0])
 		if err != nil {
-			mylog.Log.WithError(err).Error(util.Trace(""))
+			mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 			return nil, err
 		}
 		for i, l := range topics {
@@ -290,27 +425,27 @@ func (r *TopicRepo) Update(
 	s *data.Topic,
 ) (*TopicPermit, error) {
 	if err := r.CheckConnection(); err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 	db, ok := myctx.QueryerFromContext(ctx)
 	if !ok {
 		err := &myctx.ErrNotFound{"queryer"}
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 	if _, err := r.permit.Check(ctx, mytype.UpdateAccess, s); err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 	topic, err := data.UpdateTopic(db, s)
 	if err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 	fieldPermFn, err := r.permit.Check(ctx, mytype.ReadAccess, topic)
 	if err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 	return &TopicPermit{fieldPermFn, topic}, nil
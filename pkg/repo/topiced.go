@@ -116,7 +116,7 @@ func (r *TopicedRepo) CountByTopic(
 	db, ok := myctx.QueryerFromContext(ctx)
 	if !ok {
 		err := &myctx.ErrNotFound{"queryer"}
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return n, err
 	}
 	return data.CountTopicedByTopic(db, topicID)
@@ -130,7 +130,7 @@ func (r *TopicedRepo) CountByTopicable(
 	db, ok := myctx.QueryerFromContext(ctx)
 	if !ok {
 		err := &myctx.ErrNotFound{"queryer"}
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return n, err
 	}
 	return data.CountTopicedByTopicable(db, topicableID)
@@ -141,27 +141,27 @@ func (r *TopicedRepo) Connect(
 	topiced *data.Topiced,
 ) (*TopicedPermit, error) {
 	if err := r.CheckConnection(); err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 	db, ok := myctx.QueryerFromContext(ctx)
 	if !ok {
 		err := &myctx.ErrNotFound{"queryer"}
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 	if _, err := r.permit.Check(ctx, mytype.ConnectAccess, topiced); err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 	topiced, err := data.CreateTopiced(db, *topiced)
 	if err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 	fieldPermFn, err := r.permit.Check(ctx, mytype.ReadAccess, topiced)
 	if err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 	return &TopicedPermit{fieldPermFn, topiced}, nil
@@ -172,7 +172,7 @@ func (r *TopicedRepo) Get(
 	t *data.Topiced,
 ) (*TopicedPermit, error) {
 	if err := r.CheckConnection(); err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 	var topiced *data.Topiced
@@ -180,7 +180,7 @@ func (r *TopicedRepo) Get(
 	if t.ID.Status != pgtype.Undefined {
 		topiced, err = r.load.Get(ctx, t.ID.Int)
 		if err != nil {
-			mylog.Log.WithError(err).Error(util.Trace(""))
+			mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 			return nil, err
 		}
 	} else if t.TopicableID.Status != pgtype.Undefined &&
@@ -191,19 +191,19 @@ func (r *TopicedRepo) Get(
 			t.TopicID.String,
 		)
 		if err != nil {
-			mylog.Log.WithError(err).Error(util.Trace(""))
+			mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 			return nil, err
 		}
 	} else {
 		err := errors.New(
 			"must include either topiced `id` or `topicable_id` and `topic_id` to get an topiced",
 		)
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 	fieldPermFn, err := r.permit.Check(ctx, mytype.ReadAccess, topiced)
 	if err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 	return &TopicedPermit{fieldPermFn, topiced}, nil
@@ -215,25 +215,25 @@ func (r *TopicedRepo) GetByTopic(
 	po *data.PageOptions,
 ) ([]*TopicedPermit, error) {
 	if err := r.CheckConnection(); err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 	db, ok := myctx.QueryerFromContext(ctx)
 	if !ok {
 		err := &myctx.ErrNotFound{"queryer"}
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 	topiceds, err := data.GetTopicedByTopic(db, topicID, po)
 	if err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 	topicedPermits := make([]*TopicedPermit, len(topiceds))
 	if len(topiceds) > 0 {
 		fieldPermFn, err := r.permit.Check(ctx, mytype.ReadAccess, topiceds[0])
 		if err != nil {
-			mylog.Log.WithError(err).Error(util.Trace(""))
+			mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 			return nil, err
 		}
 		for i, t := range topiceds {
@@ -249,25 +249,25 @@ func (r *TopicedRepo) GetByTopicable(
 	po *data.PageOptions,
 ) ([]*TopicedPermit, error) {
 	if err := r.CheckConnection(); err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 	db, ok := myctx.QueryerFromContext(ctx)
 	if !ok {
 		err := &myctx.ErrNotFound{"queryer"}
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 	topiceds, err := data.GetTopicedByTopicable(db, topicableID, po)
 	if err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 	topicedPermits := make([]*TopicedPermit, len(topiceds))
 	if len(topiceds) > 0 {
 		fieldPermFn, err := r.permit.Check(ctx, mytype.ReadAccess, topiceds[0])
 		if err != nil {
-			mylog.Log.WithError(err).Error(util.Trace(""))
+			mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 			return nil, err
 		}
 		for i, t := range topiceds {
@@ -282,17 +282,17 @@ func (r *TopicedRepo) Disconnect(
 	topiced *data.Topiced,
 ) error {
 	if err := r.CheckConnection(); err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return err
 	}
 	db, ok := myctx.QueryerFromContext(ctx)
 	if !ok {
 		err := &myctx.ErrNotFound{"queryer"}
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return err
 	}
 	if _, err := r.permit.Check(ctx, mytype.DisconnectAccess, topiced); err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return err
 	}
 	if topiced.ID.Status != pgtype.Undefined {
@@ -304,6 +304,6 @@ func (r *TopicedRepo) Disconnect(
 	err := errors.New(
 		"must include either topiced `id` or `topicable_id` and `topic_id` to delete a topiced",
 	)
-	mylog.Log.WithError(err).Error(util.Trace(""))
+	mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 	return err
 }
@@ -62,6 +62,11 @@ func (r *UserAssetPermit) CreatedAt() (time.Time, error) {
 	return r.userAsset.CreatedAt.Time, nil
 }
 
+// Cursor returns the cursor of the user asset in a connection ordered by field.
+func (r *UserAssetPermit) Cursor(field string) (string, error) {
+	return data.EncodeCursor(r.userAsset, field)
+}
+
 func (r *UserAssetPermit) Description() (string, error) {
 	if ok := r.checkFieldPermission("description"); !ok {
 		err := ErrAccessDenied
@@ -175,6 +180,26 @@ type UserAssetRepo struct {
 	permit *Permitter
 }
 
+func (r *UserAssetRepo) filterPermittable(
+	ctx context.Context,
+	accessLevel mytype.AccessLevel,
+	userAssets []*data.UserAsset,
+) ([]*UserAssetPermit, error) {
+	userAssetPermits := make([]*UserAssetPermit, 0, len(userAssets))
+	for _, l := range userAssets {
+		fieldPermFn, err := r.permit.Check(ctx, accessLevel, l)
+		if err != nil {
+			if err != ErrAccessDenied {
+				mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
+				return nil, err
+			}
+		} else {
+			userAssetPermits = append(userAssetPermits, &UserAssetPermit{fieldPermFn, l})
+		}
+	}
+	return userAssetPermits, nil
+}
+
 func (r *UserAssetRepo) Open(p *Permitter) error {
 	if p == nil {
 		err := ErrNilPermitter
@@ -209,7 +234,7 @@ func (r *UserAssetRepo) CountByLabel(
 	db, ok := myctx.QueryerFromContext(ctx)
 	if !ok {
 		err := &myctx.ErrNotFound{"queryer"}
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return n, err
 	}
 	return data.CountUserAssetByLabel(db, labelID, filters)
@@ -224,7 +249,7 @@ func (r *UserAssetRepo) CountByActivity(
 	db, ok := myctx.QueryerFromContext(ctx)
 	if !ok {
 		err := &myctx.ErrNotFound{"queryer"}
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return n, err
 	}
 	return data.CountUserAssetByActivity(db, activityID, filters)
@@ -238,7 +263,7 @@ func (r *UserAssetRepo) CountBySearch(
 	db, ok := myctx.QueryerFromContext(ctx)
 	if !ok {
 		err := &myctx.ErrNotFound{"queryer"}
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return n, err
 	}
 	return data.CountUserAssetBySearch(db, filters)
@@ -253,7 +278,7 @@ func (r *UserAssetRepo) CountByStudy(
 	db, ok := myctx.QueryerFromContext(ctx)
 	if !ok {
 		err := &myctx.ErrNotFound{"queryer"}
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return n, err
 	}
 	return data.CountUserAssetByStudy(db, studyID, filters)
@@ -268,7 +293,7 @@ func (r *UserAssetRepo) CountByUser(
 	db, ok := myctx.QueryerFromContext(ctx)
 	if !ok {
 		err := &myctx.ErrNotFound{"queryer"}
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return n, err
 	}
 	return data.CountUserAssetByUser(db, userID, filters)
@@ -279,27 +304,27 @@ func (r *UserAssetRepo) Create(
 	a *data.UserAsset,
 ) (*UserAssetPermit, error) {
 	if err := r.CheckConnection(); err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 	db, ok := myctx.QueryerFromContext(ctx)
 	if !ok {
 		err := &myctx.ErrNotFound{"queryer"}
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 	if _, err := r.permit.Check(ctx, mytype.CreateAccess, a); err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 	userAsset, err := data.CreateUserAsset(db, a)
 	if err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 	fieldPermFn, err := r.permit.Check(ctx, mytype.ReadAccess, userAsset)
 	if err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 	return &UserAssetPermit{fieldPermFn, userAsset}, nil
@@ -310,17 +335,17 @@ func (r *UserAssetRepo) Delete(
 	userAsset *data.UserAsset,
 ) error {
 	if err := r.CheckConnection(); err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return err
 	}
 	db, ok := myctx.QueryerFromContext(ctx)
 	if !ok {
 		err := &myctx.ErrNotFound{"queryer"}
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return err
 	}
 	if _, err := r.permit.Check(ctx, mytype.DeleteAccess, userAsset); err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return err
 	}
 	return data.DeleteUserAsset(db, userAsset.ID.String)
@@ -331,17 +356,17 @@ func (r *UserAssetRepo) Get(
 	id string,
 ) (*UserAssetPermit, error) {
 	if err := r.CheckConnection(); err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 	userAsset, err := r.load.Get(ctx, id)
 	if err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 	fieldPermFn, err := r.permit.Check(ctx, mytype.ReadAccess, userAsset)
 	if err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 	return &UserAssetPermit{fieldPermFn, userAsset}, nil
@@ -353,17 +378,17 @@ func (r *UserAssetRepo) GetByName(
 	name string,
 ) (*UserAssetPermit, error) {
 	if err := r.CheckConnection(); err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 	userAsset, err := r.load.GetByName(ctx, studyID, name)
 	if err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 	fieldPermFn, err := r.permit.Check(ctx, mytype.ReadAccess, userAsset)
 	if err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 	return &UserAssetPermit{fieldPermFn, userAsset}, nil
@@ -375,14 +400,14 @@ func (r *UserAssetRepo) BatchGetByName(
 	names []string,
 ) ([]*UserAssetPermit, error) {
 	if err := r.CheckConnection(); err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 	userAssets, errs := r.load.GetManyByName(ctx, studyID, names)
 	if errs != nil {
 		for _, err := range errs {
 			if err != data.ErrNotFound {
-				mylog.Log.WithError(err).Error(util.Trace(""))
+				mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 				return nil, err
 			}
 		}
@@ -391,7 +416,7 @@ func (r *UserAssetRepo) BatchGetByName(
 	for i, userAsset := range userAssets {
 		fieldPermFn, err := r.permit.Check(ctx, mytype.ReadAccess, userAsset)
 		if err != nil {
-			mylog.Log.WithError(err).Error(util.Trace(""))
+			mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 			return nil, err
 		}
 		userAssetPermits[i] = &UserAssetPermit{fieldPermFn, userAsset}
@@ -405,23 +430,23 @@ func (r *UserAssetRepo) GetByActivityNumber(
 	activityNumber int32,
 ) (*UserAssetPermit, error) {
 	if err := r.CheckConnection(); err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 	db, ok := myctx.QueryerFromContext(ctx)
 	if !ok {
 		err := &myctx.ErrNotFound{"queryer"}
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 	userAsset, err := data.GetUserAssetByActivityNumber(db, activityID, activityNumber)
 	if err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 	fieldPermFn, err := r.permit.Check(ctx, mytype.ReadAccess, userAsset)
 	if err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 	return &UserAssetPermit{fieldPermFn, userAsset}, nil
@@ -434,17 +459,17 @@ func (r *UserAssetRepo) GetByUserStudyAndName(
 	name string,
 ) (*UserAssetPermit, error) {
 	if err := r.CheckConnection(); err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 	userAsset, err := r.load.GetByUserStudyAndName(ctx, userLogin, studyName, name)
 	if err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 	fieldPermFn, err := r.permit.Check(ctx, mytype.ReadAccess, userAsset)
 	if err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 	return &UserAssetPermit{fieldPermFn, userAsset}, nil
@@ -457,32 +482,21 @@ func (r *UserAssetRepo) GetByActivity(
 	filters *data.UserAssetFilterOptions,
 ) ([]*UserAssetPermit, error) {
 	if err := r.CheckConnection(); err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 	db, ok := myctx.QueryerFromContext(ctx)
 	if !ok {
 		err := &myctx.ErrNotFound{"queryer"}
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 	userAssets, err := data.GetUserAssetByActivity(db, activityID, po, filters)
 	if err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
-	userAssetPermits := make([]*UserAssetPermit, len(userAssets))
-	if len(userAssets) > 0 {
-		fieldPermFn, err := r.permit.Check(ctx, mytype.ReadAccess, userAssets[0])
-		if err != nil {
-			mylog.Log.WithError(err).Error(util.Trace(""))
-			return nil, err
-		}
-		for i, l := range userAssets {
-			userAssetPermits[i] = &UserAssetPermit{fieldPermFn, l}
-		}
-	}
-	return userAssetPermits, nil
+	return r.filterPermittable(ctx, mytype.ReadAccess, userAssets)
 }
 
 func (r *UserAssetRepo) GetByLabel(
@@ -492,32 +506,21 @@ func (r *UserAssetRepo) GetByLabel(
 	filters *data.UserAssetFilterOptions,
 ) ([]*UserAssetPermit, error) {
 	if err := r.CheckConnection(); err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 	db, ok := myctx.QueryerFromContext(ctx)
 	if !ok {
 		err := &myctx.ErrNotFound{"queryer"}
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 	userAssets, err := data.GetUserAssetByLabel(db, labelID, po, filters)
 	if err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
-	userAssetPermits := make([]*UserAssetPermit, len(userAssets))
-	if len(userAssets) > 0 {
-		fieldPermFn, err := r.permit.Check(ctx, mytype.ReadAccess, userAssets[0])
-		if err != nil {
-			mylog.Log.WithError(err).Error(util.Trace(""))
-			return nil, err
-		}
-		for i, l := range userAssets {
-			userAssetPermits[i] = &UserAssetPermit{fieldPermFn, l}
-		}
-	}
-	return userAssetPermits, nil
+	return r.filterPermittable(ctx, mytype.ReadAccess, userAssets)
 }
 
 func (r *UserAssetRepo) GetByStudy(
@@ -527,32 +530,21 @@ func (r *UserAssetRepo) GetByStudy(
 	filters *data.UserAssetFilterOptions,
 ) ([]*UserAssetPermit, error) {
 	if err := r.CheckConnection(); err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 	db, ok := myctx.QueryerFromContext(ctx)
 	if !ok {
 		err := &myctx.ErrNotFound{"queryer"}
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 	userAssets, err := data.GetUserAssetByStudy(db, studyID, po, filters)
 	if err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
-	userAssetPermits := make([]*UserAssetPermit, len(userAssets))
-	if len(userAssets) > 0 {
-		fieldPermFn, err := r.permit.Check(ctx, mytype.ReadAccess, userAssets[0])
-		if err != nil {
-			mylog.Log.WithError(err).Error(util.Trace(""))
-			return nil, err
-		}
-		for i, l := range userAssets {
-			userAssetPermits[i] = &UserAssetPermit{fieldPermFn, l}
-		}
-	}
-	return userAssetPermits, nil
+	return r.filterPermittable(ctx, mytype.ReadAccess, userAssets)
 }
 
 func (r *UserAssetRepo) GetByUser(
@@ -562,32 +554,21 @@ func (r *UserAssetRepo) GetByUser(
 	filters *data.UserAssetFilterOptions,
 ) ([]*UserAssetPermit, error) {
 	if err := r.CheckConnection(); err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 	db, ok := myctx.QueryerFromContext(ctx)
 	if !ok {
 		err := &myctx.ErrNotFound{"queryer"}
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 	userAssets, err := data.GetUserAssetByUser(db, userID, po, filters)
 	if err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
-	userAssetPermits := make([]*UserAssetPermit, len(userAssets))
-	if len(userAssets) > 0 {
-		fieldPermFn, err := r.permit.Check(ctx, mytype.ReadAccess, userAssets[0])
-		if err != nil {
-			mylog.Log.WithError(err).Error(util.Trace(""))
-			return nil, err
-		}
-		for i, l := range userAssets {
-			userAssetPermits[i] = &UserAssetPermit{fieldPermFn, l}
-		}
-	}
-	return userAssetPermits, nil
+	return r.filterPermittable(ctx, mytype.ReadAccess, userAssets)
 }
 
 func (r *UserAssetRepo) Search(
@@ -596,32 +577,21 @@ func (r *UserAssetRepo) Search(
 	filters *data.UserAssetFilterOptions,
 ) ([]*UserAssetPermit, error) {
 	if err := r.CheckConnection(); err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 	db, ok := myctx.QueryerFromContext(ctx)
 	if !ok {
 		err := &myctx.ErrNotFound{"queryer"}
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 	userAssets, err := data.SearchUserAsset(db, po, filters)
 	if err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
-	userAssetPermits := make([]*UserAssetPermit, len(userAssets))
-	if len(userAssets) > 0 {
-		fieldPermFn, err := r.permit.Check(ctx, mytype.ReadAccess, userAssets[0])
-		if err != nil {
-			mylog.Log.WithError(err).Error(util.Trace(""))
-			return nil, err
-		}
-		for i, l := range userAssets {
-			userAssetPermits[i] = &UserAssetPermit{fieldPermFn, l}
-		}
-	}
-	return userAssetPermits, nil
+	return r.filterPermittable(ctx, mytype.ReadAccess, userAssets)
 }
 
 func (r *UserAssetRepo) Update(
@@ -629,27 +599,27 @@ func (r *UserAssetRepo) Update(
 	a *data.UserAsset,
 ) (*UserAssetPermit, error) {
 	if err := r.CheckConnection(); err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 	db, ok := myctx.QueryerFromContext(ctx)
 	if !ok {
 		err := &myctx.ErrNotFound{"queryer"}
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 	if _, err := r.permit.Check(ctx, mytype.UpdateAccess, a); err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 	userAsset, err := data.UpdateUserAsset(db, a)
 	if err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 	fieldPermFn, err := r.permit.Check(ctx, mytype.ReadAccess, userAsset)
 	if err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 	return &UserAssetPermit{fieldPermFn, userAsset}, nil
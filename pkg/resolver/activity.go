@@ -64,13 +64,13 @@ func (r *activityResolver) Assets(
 	activityID, err := r.Activity.ID()
 	if err != nil {
 		if err != repo.ErrAccessDenied {
-			mylog.Log.WithError(err).Error(util.Trace(""))
+			mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		}
 		return &resolver, err
 	}
 	userAssetOrder, err := ParseUserAssetOrder(args.OrderBy)
 	if err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return &resolver, err
 	}
 
@@ -82,7 +82,7 @@ func (r *activityResolver) Assets(
 		userAssetOrder,
 	)
 	if err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return &resolver, err
 	}
 
@@ -98,7 +98,7 @@ func (r *activityResolver) Assets(
 		&filters,
 	)
 	if err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return &resolver, err
 	}
 	userAssetConnectionResolver, err := NewUserAssetConnectionResolver(
@@ -110,7 +110,7 @@ func (r *activityResolver) Assets(
 		r.Conf,
 	)
 	if err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return &resolver, err
 	}
 	return userAssetConnectionResolver, nil
@@ -20,7 +20,7 @@ func NewActivityConnectionResolver(
 ) (*activityConnectionResolver, error) {
 	edges := make([]*activityEdgeResolver, len(activitys))
 	for i := range edges {
-		edge, err := NewActivityEdgeResolver(activitys[i], repos, conf)
+		edge, err := NewActivityEdgeResolver(activitys[i], pageOptions, repos, conf)
 		if err != nil {
 			return nil, err
 		}
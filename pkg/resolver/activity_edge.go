@@ -8,14 +8,11 @@ import (
 
 func NewActivityEdgeResolver(
 	node *repo.ActivityPermit,
+	pageOptions *data.PageOptions,
 	repos *repo.Repos,
 	conf *myconf.Config,
 ) (*activityEdgeResolver, error) {
-	id, err := node.ID()
-	if err != nil {
-		return nil, err
-	}
-	cursor, err := data.EncodeCursor(id.String)
+	cursor, err := node.Cursor(pageOptions.OrderField())
 	if err != nil {
 		return nil, err
 	}
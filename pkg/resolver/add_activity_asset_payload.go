@@ -22,7 +22,7 @@ func (r *addActivityAssetPayloadResolver) Activity(
 ) (*activityResolver, error) {
 	activity, err := r.Repos.Activity().Get(ctx, r.ActivityID.String)
 	if err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 
@@ -37,5 +37,5 @@ func (r *addActivityAssetPayloadResolver) AssetEdge(
 		return nil, err
 	}
 
-	return NewUserAssetEdgeResolver(userAsset, r.Repos, r.Conf)
+	return NewUserAssetEdgeResolver(userAsset, nil, r.Repos, r.Conf)
 }
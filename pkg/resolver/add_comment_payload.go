@@ -15,7 +15,7 @@ type addCommentPayloadResolver struct {
 }
 
 func (r *addCommentPayloadResolver) CommentEdge() (*commentEdgeResolver, error) {
-	return NewCommentEdgeResolver(r.Comment, r.Repos, r.Conf)
+	return NewCommentEdgeResolver(r.Comment, nil, r.Repos, r.Conf)
 }
 
 func (r *addCommentPayloadResolver) Commentable(
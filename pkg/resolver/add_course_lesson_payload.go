@@ -22,7 +22,7 @@ func (r *addCourseLessonPayloadResolver) Course(
 ) (*courseResolver, error) {
 	course, err := r.Repos.Course().Get(ctx, r.CourseID.String)
 	if err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 
@@ -37,5 +37,5 @@ func (r *addCourseLessonPayloadResolver) LessonEdge(
 		return nil, err
 	}
 
-	return NewLessonEdgeResolver(lesson, r.Repos, r.Conf)
+	return NewLessonEdgeResolver(lesson, nil, r.Repos, r.Conf)
 }
@@ -15,7 +15,7 @@ type addEmailPayloadResolver struct {
 }
 
 func (r *addEmailPayloadResolver) EmailEdge() (*emailEdgeResolver, error) {
-	return NewEmailEdgeResolver(r.Email, r.Repos, r.Conf)
+	return NewEmailEdgeResolver(r.Email, nil, r.Repos, r.Conf)
 }
 
 func (r *addEmailPayloadResolver) Token() *evtResolver {
@@ -23,7 +23,7 @@ func (r *addLabelPayloadResolver) LabelEdge(
 	if err != nil {
 		return nil, err
 	}
-	return NewLabelEdgeResolver(labelPermit, r.Repos, r.Conf)
+	return NewLabelEdgeResolver(labelPermit, nil, r.Repos, r.Conf)
 }
 
 func (r *addLabelPayloadResolver) Labelable(
@@ -0,0 +1,42 @@
+package resolver
+
+import (
+	"context"
+
+	"github.com/marksauter/markus-ninja-api/pkg/myconf"
+	"github.com/marksauter/markus-ninja-api/pkg/mylog"
+	"github.com/marksauter/markus-ninja-api/pkg/mytype"
+	"github.com/marksauter/markus-ninja-api/pkg/repo"
+	"github.com/marksauter/markus-ninja-api/pkg/util"
+)
+
+type addLessonPrerequisitePayloadResolver struct {
+	Conf           *myconf.Config
+	LessonID       *mytype.OID
+	PrerequisiteID *mytype.OID
+	Repos          *repo.Repos
+}
+
+func (r *addLessonPrerequisitePayloadResolver) Lesson(
+	ctx context.Context,
+) (*lessonResolver, error) {
+	lesson, err := r.Repos.Lesson().Get(ctx, r.LessonID.String)
+	if err != nil {
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
+		return nil, err
+	}
+
+	return &lessonResolver{Lesson: lesson, Conf: r.Conf, Repos: r.Repos}, nil
+}
+
+func (r *addLessonPrerequisitePayloadResolver) Prerequisite(
+	ctx context.Context,
+) (*lessonResolver, error) {
+	lesson, err := r.Repos.Lesson().Get(ctx, r.PrerequisiteID.String)
+	if err != nil {
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
+		return nil, err
+	}
+
+	return &lessonResolver{Lesson: lesson, Conf: r.Conf, Repos: r.Repos}, nil
+}
@@ -19,7 +19,7 @@ func NewAppleGiverConnectionResolver(
 ) (*appleGiverConnectionResolver, error) {
 	edges := make([]*appleGiverEdgeResolver, len(users))
 	for i := range edges {
-		edge, err := NewAppleGiverEdgeResolver(users[i], repos, conf)
+		edge, err := NewAppleGiverEdgeResolver(users[i], pageOptions, repos, conf)
 		if err != nil {
 			return nil, err
 		}
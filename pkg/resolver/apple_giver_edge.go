@@ -9,14 +9,11 @@ import (
 
 func NewAppleGiverEdgeResolver(
 	node *repo.UserPermit,
+	pageOptions *data.PageOptions,
 	repos *repo.Repos,
 	conf *myconf.Config,
 ) (*appleGiverEdgeResolver, error) {
-	id, err := node.ID()
-	if err != nil {
-		return nil, err
-	}
-	cursor, err := data.EncodeCursor(id.String)
+	cursor, err := node.Cursor(pageOptions.OrderField())
 	if err != nil {
 		return nil, err
 	}
@@ -20,7 +20,7 @@ func NewAppleableConnectionResolver(
 ) (*appleableConnectionResolver, error) {
 	edges := make([]*appleableEdgeResolver, len(appleables))
 	for i := range edges {
-		edge, err := NewAppleableEdgeResolver(appleables[i], repos, conf)
+		edge, err := NewAppleableEdgeResolver(appleables[i], pageOptions, repos, conf)
 		if err != nil {
 			return nil, err
 		}
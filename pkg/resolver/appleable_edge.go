@@ -11,14 +11,11 @@ import (
 
 func NewAppleableEdgeResolver(
 	node repo.NodePermit,
+	pageOptions *data.PageOptions,
 	repos *repo.Repos,
 	conf *myconf.Config,
 ) (*appleableEdgeResolver, error) {
-	id, err := node.ID()
-	if err != nil {
-		return nil, err
-	}
-	cursor, err := data.EncodeCursor(id.String)
+	cursor, err := node.Cursor(pageOptions.OrderField())
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,83 @@
+package resolver
+
+import (
+	"context"
+
+	"github.com/marksauter/markus-ninja-api/pkg/data"
+	"github.com/marksauter/markus-ninja-api/pkg/myconf"
+	"github.com/marksauter/markus-ninja-api/pkg/mytype"
+	"github.com/marksauter/markus-ninja-api/pkg/repo"
+)
+
+func NewBlockedUserConnectionResolver(
+	users []*repo.UserPermit,
+	pageOptions *data.PageOptions,
+	blockerID *mytype.OID,
+	filters *data.UserFilterOptions,
+	repos *repo.Repos,
+	conf *myconf.Config,
+) (*blockedUserConnectionResolver, error) {
+	edges := make([]*blockedUserEdgeResolver, len(users))
+	for i := range edges {
+		edge, err := NewBlockedUserEdgeResolver(users[i], pageOptions, repos, conf)
+		if err != nil {
+			return nil, err
+		}
+		edges[i] = edge
+	}
+	edgeResolvers := make([]EdgeResolver, len(edges))
+	for i, e := range edges {
+		edgeResolvers[i] = e
+	}
+
+	pageInfo := NewPageInfoResolver(edgeResolvers, pageOptions)
+
+	resolver := &blockedUserConnectionResolver{
+		blockerID: blockerID,
+		conf:      conf,
+		edges:     edges,
+		filters:   filters,
+		pageInfo:  pageInfo,
+		repos:     repos,
+		users:     users,
+	}
+	return resolver, nil
+}
+
+type blockedUserConnectionResolver struct {
+	blockerID *mytype.OID
+	conf      *myconf.Config
+	edges     []*blockedUserEdgeResolver
+	filters   *data.UserFilterOptions
+	pageInfo  *pageInfoResolver
+	repos     *repo.Repos
+	users     []*repo.UserPermit
+}
+
+func (r *blockedUserConnectionResolver) Edges() *[]*blockedUserEdgeResolver {
+	if len(r.edges) > 0 && !r.pageInfo.isEmpty {
+		edges := r.edges[r.pageInfo.start : r.pageInfo.end+1]
+		return &edges
+	}
+	return &[]*blockedUserEdgeResolver{}
+}
+
+func (r *blockedUserConnectionResolver) Nodes() *[]*userResolver {
+	n := len(r.users)
+	nodes := make([]*userResolver, 0, n)
+	if n > 0 && !r.pageInfo.isEmpty {
+		users := r.users[r.pageInfo.start : r.pageInfo.end+1]
+		for _, s := range users {
+			nodes = append(nodes, &userResolver{User: s, Conf: r.conf, Repos: r.repos})
+		}
+	}
+	return &nodes
+}
+
+func (r *blockedUserConnectionResolver) PageInfo() (*pageInfoResolver, error) {
+	return r.pageInfo, nil
+}
+
+func (r *blockedUserConnectionResolver) TotalCount(ctx context.Context) (int32, error) {
+	return r.repos.User().CountByBlocker(ctx, r.blockerID.String, r.filters)
+}
@@ -0,0 +1,45 @@
+package resolver
+
+import (
+	graphql "github.com/marksauter/graphql-go"
+	"github.com/marksauter/markus-ninja-api/pkg/data"
+	"github.com/marksauter/markus-ninja-api/pkg/myconf"
+	"github.com/marksauter/markus-ninja-api/pkg/repo"
+)
+
+func NewBlockedUserEdgeResolver(
+	node *repo.UserPermit,
+	pageOptions *data.PageOptions,
+	repos *repo.Repos,
+	conf *myconf.Config,
+) (*blockedUserEdgeResolver, error) {
+	cursor, err := node.Cursor(pageOptions.OrderField())
+	if err != nil {
+		return nil, err
+	}
+	return &blockedUserEdgeResolver{
+		conf:   conf,
+		cursor: cursor,
+		node:   node,
+		repos:  repos,
+	}, nil
+}
+
+type blockedUserEdgeResolver struct {
+	conf   *myconf.Config
+	cursor string
+	node   *repo.UserPermit
+	repos  *repo.Repos
+}
+
+func (r *blockedUserEdgeResolver) Cursor() string {
+	return r.cursor
+}
+
+func (r *blockedUserEdgeResolver) BlockedAt() graphql.Time {
+	return graphql.Time{Time: r.node.BlockedAt()}
+}
+
+func (r *blockedUserEdgeResolver) Node() *userResolver {
+	return &userResolver{User: r.node, Conf: r.conf, Repos: r.repos}
+}
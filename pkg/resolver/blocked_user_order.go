@@ -0,0 +1,92 @@
+package resolver
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/marksauter/markus-ninja-api/pkg/data"
+)
+
+type BlockedUserOrderField int
+
+const (
+	BlockedUserBlockedAt BlockedUserOrderField = iota
+)
+
+func ParseBlockedUserOrderField(s string) (BlockedUserOrderField, error) {
+	switch strings.ToUpper(s) {
+	case "BLOCKED_AT":
+		return BlockedUserBlockedAt, nil
+	default:
+		var f BlockedUserOrderField
+		return f, fmt.Errorf("invalid BlockedUserOrderField: %q", s)
+	}
+}
+
+func (f BlockedUserOrderField) String() string {
+	switch f {
+	case BlockedUserBlockedAt:
+		return "blocked_at"
+	default:
+		return "unknown"
+	}
+}
+
+type BlockedUserOrder struct {
+	direction data.OrderDirection
+	field     BlockedUserOrderField
+}
+
+func NewBlockedUserOrder(d data.OrderDirection, f BlockedUserOrderField) *BlockedUserOrder {
+	return &BlockedUserOrder{
+		direction: d,
+		field:     f,
+	}
+}
+
+func (o *BlockedUserOrder) Direction() data.OrderDirection {
+	return o.direction
+}
+
+func (o *BlockedUserOrder) Field() string {
+	return o.field.String()
+}
+
+type BlockedUserOrderArg struct {
+	Direction string
+	Field     string
+}
+
+func ParseBlockedUserOrder(arg *OrderArg) (*BlockedUserOrder, error) {
+	if arg == nil {
+		return &BlockedUserOrder{
+			direction: data.DESC,
+			field:     BlockedUserBlockedAt,
+		}, nil
+	}
+	direction, err := data.ParseOrderDirection(arg.Direction)
+	if err != nil {
+		return nil, err
+	}
+	field, err := ParseBlockedUserOrderField(arg.Field)
+	if err != nil {
+		return nil, err
+	}
+	blockedUserOrder := &BlockedUserOrder{
+		direction: direction,
+		field:     field,
+	}
+	return blockedUserOrder, nil
+}
+
+type blockedUserOrderResolver struct {
+	BlockedUserOrder
+}
+
+func (r *blockedUserOrderResolver) Direction() string {
+	return r.BlockedUserOrder.Direction().String()
+}
+
+func (r *blockedUserOrderResolver) Field() string {
+	return r.BlockedUserOrder.Field()
+}
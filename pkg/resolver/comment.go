@@ -142,6 +142,15 @@ func (r *commentResolver) ID() (graphql.ID, error) {
 	return graphql.ID(id.String), err
 }
 
+// IsCollapsed is true when the viewer has blocked the comment's author.
+func (r *commentResolver) IsCollapsed(ctx context.Context) (bool, error) {
+	userID, err := r.Comment.UserID()
+	if err != nil {
+		return false, err
+	}
+	return r.Repos.User().ViewerHasBlocked(ctx, userID.String)
+}
+
 func (r *commentResolver) IsPublished() (bool, error) {
 	return r.Comment.IsPublished()
 }
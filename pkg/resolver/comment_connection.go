@@ -20,7 +20,7 @@ func NewCommentConnectionResolver(
 ) (*commentConnectionResolver, error) {
 	edges := make([]*commentEdgeResolver, len(comments))
 	for i := range edges {
-		edge, err := NewCommentEdgeResolver(comments[i], repos, conf)
+		edge, err := NewCommentEdgeResolver(comments[i], pageOptions, repos, conf)
 		if err != nil {
 			return nil, err
 		}
@@ -0,0 +1,55 @@
+package resolver
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/pgtype"
+	graphql "github.com/marksauter/graphql-go"
+	"github.com/marksauter/markus-ninja-api/pkg/data"
+	"github.com/marksauter/markus-ninja-api/pkg/myconf"
+	"github.com/marksauter/markus-ninja-api/pkg/repo"
+)
+
+type contentReportResolver struct {
+	Conf          *myconf.Config
+	ContentReport *data.ContentReport
+	Repos         *repo.Repos
+}
+
+func (r *contentReportResolver) CreatedAt() graphql.Time {
+	return graphql.Time{Time: r.ContentReport.CreatedAt.Time}
+}
+
+func (r *contentReportResolver) ID() graphql.ID {
+	return graphql.ID(r.ContentReport.ID.String)
+}
+
+func (r *contentReportResolver) Note() *string {
+	if r.ContentReport.Note.Status != pgtype.Present {
+		return nil
+	}
+	return &r.ContentReport.Note.String
+}
+
+func (r *contentReportResolver) Reason() string {
+	return r.ContentReport.Reason.String
+}
+
+func (r *contentReportResolver) Reporter(ctx context.Context) (*userResolver, error) {
+	return getModerationUser(ctx, r.ContentReport.ReporterID.String, r.Repos, r.Conf)
+}
+
+func (r *contentReportResolver) ResolvedAt() *graphql.Time {
+	if r.ContentReport.ResolvedAt.Status != pgtype.Present {
+		return nil
+	}
+	return &graphql.Time{Time: r.ContentReport.ResolvedAt.Time}
+}
+
+func (r *contentReportResolver) Status() string {
+	return r.ContentReport.Status.String
+}
+
+func (r *contentReportResolver) Subject(ctx context.Context) (*nodeResolver, error) {
+	return getModerationSubject(ctx, &r.ContentReport.SubjectID, r.Repos, r.Conf)
+}
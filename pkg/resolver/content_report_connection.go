@@ -0,0 +1,95 @@
+package resolver
+
+import (
+	"context"
+
+	"github.com/marksauter/markus-ninja-api/pkg/data"
+	"github.com/marksauter/markus-ninja-api/pkg/myconf"
+	"github.com/marksauter/markus-ninja-api/pkg/myctx"
+	"github.com/marksauter/markus-ninja-api/pkg/repo"
+)
+
+// contentReportOrder orders the moderation queue oldest first.
+type contentReportOrder struct{}
+
+func (contentReportOrder) Direction() data.OrderDirection {
+	return data.ASC
+}
+
+func (contentReportOrder) Field() string {
+	return "created_at"
+}
+
+func NewContentReportConnectionResolver(
+	contentReports []*data.ContentReport,
+	pageOptions *data.PageOptions,
+	status string,
+	repos *repo.Repos,
+	conf *myconf.Config,
+) (*contentReportConnectionResolver, error) {
+	edges := make([]*contentReportEdgeResolver, len(contentReports))
+	for i := range edges {
+		edge, err := NewContentReportEdgeResolver(contentReports[i], pageOptions, repos, conf)
+		if err != nil {
+			return nil, err
+		}
+		edges[i] = edge
+	}
+	edgeResolvers := make([]EdgeResolver, len(edges))
+	for i, e := range edges {
+		edgeResolvers[i] = e
+	}
+
+	pageInfo := NewPageInfoResolver(edgeResolvers, pageOptions)
+
+	resolver := &contentReportConnectionResolver{
+		conf:           conf,
+		contentReports: contentReports,
+		edges:          edges,
+		pageInfo:       pageInfo,
+		repos:          repos,
+		status:         status,
+	}
+	return resolver, nil
+}
+
+type contentReportConnectionResolver struct {
+	conf           *myconf.Config
+	contentReports []*data.ContentReport
+	edges          []*contentReportEdgeResolver
+	pageInfo       *pageInfoResolver
+	repos          *repo.Repos
+	status         string
+}
+
+func (r *contentReportConnectionResolver) Edges() *[]*contentReportEdgeResolver {
+	if len(r.edges) > 0 && !r.pageInfo.isEmpty {
+		edges := r.edges[r.pageInfo.start : r.pageInfo.end+1]
+		return &edges
+	}
+	return &[]*contentReportEdgeResolver{}
+}
+
+func (r *contentReportConnectionResolver) Nodes() *[]*contentReportResolver {
+	n := len(r.contentReports)
+	nodes := make([]*contentReportResolver, 0, n)
+	if n > 0 && !r.pageInfo.isEmpty {
+		contentReports := r.contentReports[r.pageInfo.start : r.pageInfo.end+1]
+		for _, c := range contentReports {
+			nodes = append(nodes, &contentReportResolver{ContentReport: c, Conf: r.conf, Repos: r.repos})
+		}
+	}
+	return &nodes
+}
+
+func (r *contentReportConnectionResolver) PageInfo() (*pageInfoResolver, error) {
+	return r.pageInfo, nil
+}
+
+func (r *contentReportConnectionResolver) TotalCount(ctx context.Context) (int32, error) {
+	db, ok := myctx.QueryerFromContext(ctx)
+	if !ok {
+		return 0, &myctx.ErrNotFound{Name: "queryer"}
+	}
+	return data.CountContentReportByStatus(db, r.status)
+}
@@ -0,0 +1,40 @@
+package resolver
+
+import (
+	"github.com/marksauter/markus-ninja-api/pkg/data"
+	"github.com/marksauter/markus-ninja-api/pkg/myconf"
+	"github.com/marksauter/markus-ninja-api/pkg/repo"
+)
+
+func NewContentReportEdgeResolver(
+	node *data.ContentReport,
+	pageOptions *data.PageOptions,
+	repos *repo.Repos,
+	conf *myconf.Config,
+) (*contentReportEdgeResolver, error) {
+	cursor, err := data.EncodeCursor(node, pageOptions.OrderField())
+	if err != nil {
+		return nil, err
+	}
+	return &contentReportEdgeResolver{
+		conf:   conf,
+		cursor: cursor,
+		node:   node,
+		repos:  repos,
+	}, nil
+}
+
+type contentReportEdgeResolver struct {
+	conf   *myconf.Config
+	cursor string
+	node   *data.ContentReport
+	repos  *repo.Repos
+}
+
+func (r *contentReportEdgeResolver) Cursor() string {
+	return r.cursor
+}
+
+func (r *contentReportEdgeResolver) Node() *contentReportResolver {
+	return &contentReportResolver{ContentReport: r.node, Conf: r.conf, Repos: r.repos}
+}
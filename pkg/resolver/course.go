@@ -167,13 +167,13 @@ func (r *courseResolver) Lessons(
 	courseID, err := r.Course.ID()
 	if err != nil {
 		if err != repo.ErrAccessDenied {
-			mylog.Log.WithError(err).Error(util.Trace(""))
+			mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		}
 		return &resolver, err
 	}
 	lessonOrder, err := ParseLessonOrder(args.OrderBy)
 	if err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return &resolver, err
 	}
 
@@ -185,7 +185,7 @@ func (r *courseResolver) Lessons(
 		lessonOrder,
 	)
 	if err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return &resolver, err
 	}
 
@@ -196,7 +196,7 @@ func (r *courseResolver) Lessons(
 
 	ok, err := r.ViewerCanAdmin(ctx)
 	if err != nil && err != repo.ErrAccessDenied {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return &resolver, err
 	} else if !ok {
 		filters.IsPublished = util.NewBool(true)
@@ -208,7 +208,7 @@ func (r *courseResolver) Lessons(
 		&filters,
 	)
 	if err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return &resolver, err
 	}
 	lessonConnectionResolver, err := NewLessonConnectionResolver(
@@ -220,12 +220,17 @@ func (r *courseResolver) Lessons(
 		r.Conf,
 	)
 	if err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return &resolver, err
 	}
 	return lessonConnectionResolver, nil
 }
 
+func (r *courseResolver) LessonCount() (int32, error) {
+	n, err := r.Course.LessonCount()
+	return int32(n), err
+}
+
 func (r *courseResolver) Name() (string, error) {
 	return r.Course.Name()
 }
@@ -277,6 +282,17 @@ func (r *courseResolver) ResourcePath(
 	return uri, nil
 }
 
+func (r *courseResolver) ScheduledPublishAt() (*graphql.Time, error) {
+	t, err := r.Course.ScheduledPublishAt()
+	if err != nil {
+		return nil, err
+	}
+	if t != nil {
+		return &graphql.Time{Time: *t}, nil
+	}
+	return nil, nil
+}
+
 func (r *courseResolver) Status() (string, error) {
 	status, err := r.Course.Status()
 	if err != nil {
@@ -351,6 +367,11 @@ func (r *courseResolver) Topics(
 	return topicConnectionResolver, nil
 }
 
+func (r *courseResolver) TotalReadingTimeMinutes() (int32, error) {
+	n, err := r.Course.ReadingTimeMinutes()
+	return int32(n), err
+}
+
 func (r *courseResolver) UpdatedAt() (graphql.Time, error) {
 	t, err := r.Course.UpdatedAt()
 	return graphql.Time{t}, err
@@ -20,7 +20,7 @@ func NewCourseConnectionResolver(
 ) (*courseConnectionResolver, error) {
 	edges := make([]*courseEdgeResolver, len(courses))
 	for i := range edges {
-		edge, err := NewCourseEdgeResolver(courses[i], repos, conf)
+		edge, err := NewCourseEdgeResolver(courses[i], pageOptions, repos, conf)
 		if err != nil {
 			return nil, err
 		}
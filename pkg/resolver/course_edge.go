@@ -8,14 +8,11 @@ import (
 
 func NewCourseEdgeResolver(
 	node *repo.CoursePermit,
+	pageOptions *data.PageOptions,
 	repos *repo.Repos,
 	conf *myconf.Config,
 ) (*courseEdgeResolver, error) {
-	id, err := node.ID()
-	if err != nil {
-		return nil, err
-	}
-	cursor, err := data.EncodeCursor(id.String)
+	cursor, err := node.Cursor(pageOptions.OrderField())
 	if err != nil {
 		return nil, err
 	}
@@ -16,7 +16,7 @@ type createActivityPayloadResolver struct {
 }
 
 func (r *createActivityPayloadResolver) ActivityEdge() (*activityEdgeResolver, error) {
-	return NewActivityEdgeResolver(r.Activity, r.Repos, r.Conf)
+	return NewActivityEdgeResolver(r.Activity, nil, r.Repos, r.Conf)
 }
 
 func (r *createActivityPayloadResolver) Study(ctx context.Context) (*studyResolver, error) {
@@ -16,7 +16,7 @@ type createCoursePayloadResolver struct {
 }
 
 func (r *createCoursePayloadResolver) CourseEdge() (*courseEdgeResolver, error) {
-	return NewCourseEdgeResolver(r.Course, r.Repos, r.Conf)
+	return NewCourseEdgeResolver(r.Course, nil, r.Repos, r.Conf)
 }
 
 func (r *createCoursePayloadResolver) Study(ctx context.Context) (*studyResolver, error) {
@@ -16,7 +16,7 @@ type createLabelPayloadResolver struct {
 }
 
 func (r *createLabelPayloadResolver) LabelEdge() (*labelEdgeResolver, error) {
-	return NewLabelEdgeResolver(r.Label, r.Repos, r.Conf)
+	return NewLabelEdgeResolver(r.Label, nil, r.Repos, r.Conf)
 }
 
 func (r *createLabelPayloadResolver) Study(ctx context.Context) (*studyResolver, error) {
@@ -16,7 +16,7 @@ type createLessonPayloadResolver struct {
 }
 
 func (r *createLessonPayloadResolver) LessonEdge() (*lessonEdgeResolver, error) {
-	return NewLessonEdgeResolver(r.Lesson, r.Repos, r.Conf)
+	return NewLessonEdgeResolver(r.Lesson, nil, r.Repos, r.Conf)
 }
 
 func (r *createLessonPayloadResolver) Study(ctx context.Context) (*studyResolver, error) {
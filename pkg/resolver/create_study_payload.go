@@ -16,7 +16,7 @@ type createStudyPayloadResolver struct {
 }
 
 func (r *createStudyPayloadResolver) StudyEdge() (*studyEdgeResolver, error) {
-	return NewStudyEdgeResolver(r.Study, r.Repos, r.Conf)
+	return NewStudyEdgeResolver(r.Study, nil, r.Repos, r.Conf)
 }
 
 func (r *createStudyPayloadResolver) User(ctx context.Context) (*userResolver, error) {
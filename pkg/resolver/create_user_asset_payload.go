@@ -17,7 +17,7 @@ type createUserAssetPayloadResolver struct {
 }
 
 func (r *createUserAssetPayloadResolver) UserAssetEdge() (*userAssetEdgeResolver, error) {
-	return NewUserAssetEdgeResolver(r.UserAsset, r.Repos, r.Conf)
+	return NewUserAssetEdgeResolver(r.UserAsset, nil, r.Repos, r.Conf)
 }
 
 func (r *createUserAssetPayloadResolver) Owner(ctx context.Context) (*userResolver, error) {
@@ -4,15 +4,17 @@ import (
 	"context"
 
 	graphql "github.com/marksauter/graphql-go"
+	"github.com/marksauter/markus-ninja-api/pkg/data"
 	"github.com/marksauter/markus-ninja-api/pkg/myconf"
 	"github.com/marksauter/markus-ninja-api/pkg/mytype"
 	"github.com/marksauter/markus-ninja-api/pkg/repo"
 )
 
 type deleteViewerAccountPayloadResolver struct {
-	Conf     *myconf.Config
-	Repos    *repo.Repos
-	ViewerID *mytype.OID
+	AccountDeletion *data.AccountDeletion
+	Conf            *myconf.Config
+	Repos           *repo.Repos
+	ViewerID        *mytype.OID
 }
 
 func (r *deleteViewerAccountPayloadResolver) DeletedViewerID(
@@ -20,3 +22,7 @@ func (r *deleteViewerAccountPayloadResolver) DeletedViewerID(
 ) graphql.ID {
 	return graphql.ID(r.ViewerID.String)
 }
+
+func (r *deleteViewerAccountPayloadResolver) DeletionScheduledFor() graphql.Time {
+	return graphql.Time{Time: r.AccountDeletion.ScheduledFor.Time}
+}
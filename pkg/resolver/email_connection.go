@@ -19,7 +19,7 @@ func NewEmailConnectionResolver(
 ) (*emailConnectionResolver, error) {
 	edges := make([]*emailEdgeResolver, len(emails))
 	for i := range edges {
-		edge, err := NewEmailEdgeResolver(emails[i], repos, conf)
+		edge, err := NewEmailEdgeResolver(emails[i], pageOptions, repos, conf)
 		if err != nil {
 			return nil, err
 		}
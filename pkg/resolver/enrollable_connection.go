@@ -19,7 +19,7 @@ func NewEnrollableConnectionResolver(
 ) (*enrollableConnectionResolver, error) {
 	edges := make([]*enrollableEdgeResolver, len(enrollables))
 	for i := range edges {
-		edge, err := NewEnrollableEdgeResolver(enrollables[i], repos, conf)
+		edge, err := NewEnrollableEdgeResolver(enrollables[i], pageOptions, repos, conf)
 		if err != nil {
 			return nil, err
 		}
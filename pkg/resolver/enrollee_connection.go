@@ -19,7 +19,7 @@ func NewEnrolleeConnectionResolver(
 ) (*enrolleeConnectionResolver, error) {
 	edges := make([]*enrolleeEdgeResolver, len(users))
 	for i := range edges {
-		edge, err := NewEnrolleeEdgeResolver(users[i], repos, conf)
+		edge, err := NewEnrolleeEdgeResolver(users[i], pageOptions, repos, conf)
 		if err != nil {
 			return nil, err
 		}
@@ -20,7 +20,7 @@ func NewEventConnectionResolver(
 ) (*eventConnectionResolver, error) {
 	edges := make([]*eventEdgeResolver, len(events))
 	for i := range edges {
-		edge, err := NewEventEdgeResolver(events[i], repos, conf)
+		edge, err := NewEventEdgeResolver(events[i], pageOptions, repos, conf)
 		if err != nil {
 			return nil, err
 		}
@@ -20,7 +20,7 @@ func NewLabelConnectionResolver(
 ) (*labelConnectionResolver, error) {
 	edges := make([]*labelEdgeResolver, len(labels))
 	for i := range edges {
-		edge, err := NewLabelEdgeResolver(labels[i], repos, conf)
+		edge, err := NewLabelEdgeResolver(labels[i], pageOptions, repos, conf)
 		if err != nil {
 			return nil, err
 		}
@@ -19,7 +19,7 @@ func NewLabelableConnectionResolver(
 ) (*labelableConnectionResolver, error) {
 	edges := make([]*labelableEdgeResolver, len(labelables))
 	for i := range edges {
-		edge, err := NewLabelableEdgeResolver(labelables[i], repos, conf)
+		edge, err := NewLabelableEdgeResolver(labelables[i], pageOptions, repos, conf)
 		if err != nil {
 			return nil, err
 		}
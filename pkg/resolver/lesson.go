@@ -40,13 +40,13 @@ func (r *lessonResolver) Activities(
 	lessonID, err := r.Lesson.ID()
 	if err != nil {
 		if err != repo.ErrAccessDenied {
-			mylog.Log.WithError(err).Error(util.Trace(""))
+			mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		}
 		return &resolver, err
 	}
 	activityOrder, err := ParseActivityOrder(args.OrderBy)
 	if err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return &resolver, err
 	}
 
@@ -58,7 +58,7 @@ func (r *lessonResolver) Activities(
 		activityOrder,
 	)
 	if err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return &resolver, err
 	}
 
@@ -74,7 +74,7 @@ func (r *lessonResolver) Activities(
 		&filters,
 	)
 	if err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return &resolver, err
 	}
 	activityConnectionResolver, err := NewActivityConnectionResolver(
@@ -86,7 +86,7 @@ func (r *lessonResolver) Activities(
 		r.Conf,
 	)
 	if err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return &resolver, err
 	}
 	return activityConnectionResolver, nil
@@ -208,6 +208,29 @@ func (r *lessonResolver) CreatedAt() (graphql.Time, error) {
 	return graphql.Time{t}, err
 }
 
+func (r *lessonResolver) Dependents(
+	ctx context.Context,
+	args struct {
+		After    *string
+		Before   *string
+		FilterBy *data.LessonFilterOptions
+		First    *int32
+		Last     *int32
+		OrderBy  *OrderArg
+	},
+) (*lessonConnectionResolver, error) {
+	return r.relatedLessons(
+		ctx,
+		args.After,
+		args.Before,
+		args.FilterBy,
+		args.First,
+		args.Last,
+		args.OrderBy,
+		true,
+	)
+}
+
 func (r *lessonResolver) Draft() (string, error) {
 	return r.Lesson.Draft()
 }
@@ -466,6 +489,29 @@ func (r *lessonResolver) PreviousLesson(ctx context.Context) (*lessonResolver, e
 	return &lessonResolver{Lesson: lesson, Conf: r.Conf, Repos: r.Repos}, nil
 }
 
+func (r *lessonResolver) Prerequisites(
+	ctx context.Context,
+	args struct {
+		After    *string
+		Before   *string
+		FilterBy *data.LessonFilterOptions
+		First    *int32
+		Last     *int32
+		OrderBy  *OrderArg
+	},
+) (*lessonConnectionResolver, error) {
+	return r.relatedLessons(
+		ctx,
+		args.After,
+		args.Before,
+		args.FilterBy,
+		args.First,
+		args.Last,
+		args.OrderBy,
+		false,
+	)
+}
+
 func (r *lessonResolver) PublishedAt() (*graphql.Time, error) {
 	t, err := r.Lesson.PublishedAt()
 	if err != nil {
@@ -474,6 +520,10 @@ func (r *lessonResolver) PublishedAt() (*graphql.Time, error) {
 	return &graphql.Time{t}, nil
 }
 
+func (r *lessonResolver) ReadingTimeMinutes() (int32, error) {
+	return r.Lesson.ReadingTimeMinutes()
+}
+
 func (r *lessonResolver) ResourcePath(
 	ctx context.Context,
 ) (mygql.URI, error) {
@@ -494,6 +544,17 @@ func (r *lessonResolver) ResourcePath(
 	return uri, nil
 }
 
+func (r *lessonResolver) ScheduledPublishAt() (*graphql.Time, error) {
+	t, err := r.Lesson.ScheduledPublishAt()
+	if err != nil {
+		return nil, err
+	}
+	if t != nil {
+		return &graphql.Time{Time: *t}, nil
+	}
+	return nil, nil
+}
+
 func (r *lessonResolver) Study(ctx context.Context) (*studyResolver, error) {
 	studyID, err := r.Lesson.StudyID()
 	if err != nil {
@@ -506,6 +567,60 @@ func (r *lessonResolver) Study(ctx context.Context) (*studyResolver, error) {
 	return &studyResolver{Study: study, Conf: r.Conf, Repos: r.Repos}, nil
 }
 
+// SuggestedOrder returns the lesson's direct and indirect prerequisites that
+// the viewer can read, followed by the lesson, in an order where each lesson
+// comes after its prerequisites.
+func (r *lessonResolver) SuggestedOrder(ctx context.Context) ([]*lessonResolver, error) {
+	lessonID, err := r.Lesson.ID()
+	if err != nil {
+		return nil, err
+	}
+	lessonPrerequisites, err := r.Repos.LessonPrerequisite().GetAncestry(ctx, lessonID.String)
+	if err != nil {
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
+		return nil, err
+	}
+
+	lessons := map[string]*repo.LessonPermit{lessonID.String: r.Lesson}
+	dependencies := make(map[string][]string)
+	for _, lp := range lessonPrerequisites {
+		dependentID, err := lp.LessonID()
+		if err != nil {
+			return nil, err
+		}
+		prerequisiteID, err := lp.PrerequisiteID()
+		if err != nil {
+			return nil, err
+		}
+		dependencies[dependentID.String] = append(
+			dependencies[dependentID.String],
+			prerequisiteID.String,
+		)
+		if _, ok := lessons[prerequisiteID.String]; ok {
+			continue
+		}
+		lesson, err := r.Repos.Lesson().Get(ctx, prerequisiteID.String)
+		if err == repo.ErrAccessDenied {
+			continue
+		} else if err != nil {
+			mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
+			return nil, err
+		}
+		lessons[prerequisiteID.String] = lesson
+	}
+
+	nodes, err := sortLessonIDs(lessons)
+	if err != nil {
+		return nil, err
+	}
+	order, _ := util.TopologicalSort(nodes, dependencies)
+	resolvers := make([]*lessonResolver, len(order))
+	for i, id := range order {
+		resolvers[i] = &lessonResolver{Lesson: lessons[id], Conf: r.Conf, Repos: r.Repos}
+	}
+	return resolvers, nil
+}
+
 func (r *lessonResolver) Timeline(
 	ctx context.Context,
 	args struct {
@@ -577,6 +692,14 @@ func (r *lessonResolver) Title() (string, error) {
 	return r.Lesson.Title()
 }
 
+func (r *lessonResolver) TOC() ([]*lessonTOCEntryResolver, error) {
+	toc, err := r.Lesson.TOC()
+	if err != nil {
+		return nil, err
+	}
+	return newLessonTOC(toc), nil
+}
+
 func (r *lessonResolver) UpdatedAt() (graphql.Time, error) {
 	t, err := r.Lesson.UpdatedAt()
 	return graphql.Time{t}, err
@@ -658,15 +781,15 @@ func (r *lessonResolver) ViewerNewComment(ctx context.Context) (*commentResolver
 		}
 		comment := &data.Comment{}
 		if err := comment.CommentableID.Set(lessonID); err != nil {
-			mylog.Log.WithError(err).Error("failed to set comment commentable_id")
+			mylog.Log.WithContext(ctx).WithError(err).Error("failed to set comment commentable_id")
 			return nil, myerr.SomethingWentWrongError
 		}
 		if err := comment.StudyID.Set(studyID); err != nil {
-			mylog.Log.WithError(err).Error("failed to set comment user_id")
+			mylog.Log.WithContext(ctx).WithError(err).Error("failed to set comment user_id")
 			return nil, myerr.SomethingWentWrongError
 		}
 		if err := comment.UserID.Set(&viewer.ID); err != nil {
-			mylog.Log.WithError(err).Error("failed to set comment user_id")
+			mylog.Log.WithContext(ctx).WithError(err).Error("failed to set comment user_id")
 			return nil, myerr.SomethingWentWrongError
 		}
 		commentPermit, err = r.Repos.Comment().Create(ctx, comment)
@@ -677,3 +800,90 @@ func (r *lessonResolver) ViewerNewComment(ctx context.Context) (*commentResolver
 
 	return &commentResolver{Comment: commentPermit, Conf: r.Conf, Repos: r.Repos}, nil
 }
+
+func (r *lessonResolver) WordCount() (int32, error) {
+	return r.Lesson.WordCount()
+}
+
+// relatedLessons returns the connection of the lesson's dependents, or of its
+// prerequisites. Viewers who cannot update the lesson only see published
+// lessons.
+func (r *lessonResolver) relatedLessons(
+	ctx context.Context,
+	after *string,
+	before *string,
+	filterBy *data.LessonFilterOptions,
+	first *int32,
+	last *int32,
+	orderBy *OrderArg,
+	dependents bool,
+) (*lessonConnectionResolver, error) {
+	resolver := lessonConnectionResolver{}
+	lessonID, err := r.Lesson.ID()
+	if err != nil {
+		if err != repo.ErrAccessDenied {
+			mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
+		}
+		return &resolver, err
+	}
+	lessonOrder, err := ParseLessonOrder(orderBy)
+	if err != nil {
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
+		return &resolver, err
+	}
+
+	pageOptions, err := data.NewPageOptions(
+		after,
+		before,
+		first,
+		last,
+		lessonOrder,
+	)
+	if err != nil {
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
+		return &resolver, err
+	}
+
+	filters := data.LessonFilterOptions{}
+	if filterBy != nil {
+		filters = *filterBy
+	}
+	if !r.ViewerCanUpdate(ctx) {
+		filters.IsPublished = util.NewBool(true)
+	}
+
+	var lessons []*repo.LessonPermit
+	if dependents {
+		lessons, err = r.Repos.Lesson().GetByPrerequisite(
+			ctx,
+			lessonID.String,
+			pageOptions,
+			&filters,
+		)
+	} else {
+		lessons, err = r.Repos.Lesson().GetByDependent(
+			ctx,
+			lessonID.String,
+			pageOptions,
+			&filters,
+		)
+	}
+	if err != nil {
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
+		return &resolver, err
+	}
+	lessonConnectionResolver, err := NewLessonConnectionResolver(
+		lessons,
+		pageOptions,
+		lessonID,
+		&filters,
+		r.Repos,
+		r.Conf,
+	)
+	if err != nil {
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
+		return &resolver, err
+	}
+	lessonConnectionResolver.dependents = dependents
+	return lessonConnectionResolver, nil
+}
@@ -20,7 +20,7 @@ func NewLessonConnectionResolver(
 ) (*lessonConnectionResolver, error) {
 	edges := make([]*lessonEdgeResolver, len(lessons))
 	for i := range edges {
-		edge, err := NewLessonEdgeResolver(lessons[i], repos, conf)
+		edge, err := NewLessonEdgeResolver(lessons[i], pageOptions, repos, conf)
 		if err != nil {
 			return nil, err
 		}
@@ -46,13 +46,16 @@ func NewLessonConnectionResolver(
 }
 
 type lessonConnectionResolver struct {
-	conf     *myconf.Config
-	edges    []*lessonEdgeResolver
-	filters  *data.LessonFilterOptions
-	lessons  []*repo.LessonPermit
-	nodeID   *mytype.OID
-	pageInfo *pageInfoResolver
-	repos    *repo.Repos
+	conf *myconf.Config
+	// dependents is set for the dependents of the lesson with nodeID, rather
+	// than its prerequisites.
+	dependents bool
+	edges      []*lessonEdgeResolver
+	filters    *data.LessonFilterOptions
+	lessons    []*repo.LessonPermit
+	nodeID     *mytype.OID
+	pageInfo   *pageInfoResolver
+	repos      *repo.Repos
 }
 
 func (r *lessonConnectionResolver) Edges() *[]*lessonEdgeResolver {
@@ -87,6 +90,11 @@ func (r *lessonConnectionResolver) TotalCount(ctx context.Context) (int32, error
 	switch r.nodeID.Type {
 	case "Course":
 		return r.repos.Lesson().CountByCourse(ctx, r.nodeID.String, r.filters)
+	case "Lesson":
+		if r.dependents {
+			return r.repos.Lesson().CountByPrerequisite(ctx, r.nodeID.String, r.filters)
+		}
+		return r.repos.Lesson().CountByDependent(ctx, r.nodeID.String, r.filters)
 	case "Study":
 		return r.repos.Lesson().CountByStudy(ctx, r.nodeID.String, r.filters)
 	case "User":
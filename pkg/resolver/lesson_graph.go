@@ -0,0 +1,158 @@
+package resolver
+
+import (
+	"context"
+	"sort"
+
+	graphql "github.com/marksauter/graphql-go"
+	"github.com/marksauter/markus-ninja-api/pkg/myconf"
+	"github.com/marksauter/markus-ninja-api/pkg/mylog"
+	"github.com/marksauter/markus-ninja-api/pkg/repo"
+	"github.com/marksauter/markus-ninja-api/pkg/util"
+)
+
+// NewLessonGraphResolver returns the graph of the lessons, and of the
+// prerequisites between them. Lessons at the other end of a dependency that are
+// not in lessons are loaded, so dependencies may cross studies, and
+// dependencies on lessons the viewer cannot read are left out.
+func NewLessonGraphResolver(
+	ctx context.Context,
+	lessons []*repo.LessonPermit,
+	lessonPrerequisites []*repo.LessonPrerequisitePermit,
+	repos *repo.Repos,
+	conf *myconf.Config,
+) (*lessonGraphResolver, error) {
+	lessonsByID := make(map[string]*repo.LessonPermit, len(lessons))
+	for _, l := range lessons {
+		id, err := l.ID()
+		if err != nil {
+			return nil, err
+		}
+		lessonsByID[id.String] = l
+	}
+	load := func(id string) (*repo.LessonPermit, error) {
+		if l, ok := lessonsByID[id]; ok {
+			return l, nil
+		}
+		l, err := repos.Lesson().Get(ctx, id)
+		if err == repo.ErrAccessDenied {
+			return nil, nil
+		} else if err != nil {
+			mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
+			return nil, err
+		}
+		lessonsByID[id] = l
+		return l, nil
+	}
+
+	dependencies := make([]*lessonDependencyResolver, 0, len(lessonPrerequisites))
+	for _, lp := range lessonPrerequisites {
+		lessonID, err := lp.LessonID()
+		if err != nil {
+			return nil, err
+		}
+		prerequisiteID, err := lp.PrerequisiteID()
+		if err != nil {
+			return nil, err
+		}
+		lesson, err := load(lessonID.String)
+		if err != nil {
+			return nil, err
+		}
+		prerequisite, err := load(prerequisiteID.String)
+		if err != nil {
+			return nil, err
+		}
+		if lesson == nil || prerequisite == nil {
+			continue
+		}
+		dependencies = append(dependencies, &lessonDependencyResolver{
+			conf:               conf,
+			lesson:             lesson,
+			lessonPrerequisite: lp,
+			prerequisite:       prerequisite,
+			repos:              repos,
+		})
+	}
+
+	ids, err := sortLessonIDs(lessonsByID)
+	if err != nil {
+		return nil, err
+	}
+	nodes := make([]*lessonResolver, len(ids))
+	for i, id := range ids {
+		nodes[i] = &lessonResolver{Lesson: lessonsByID[id], Conf: conf, Repos: repos}
+	}
+
+	return &lessonGraphResolver{
+		dependencies: dependencies,
+		lessons:      nodes,
+	}, nil
+}
+
+type lessonGraphResolver struct {
+	dependencies []*lessonDependencyResolver
+	lessons      []*lessonResolver
+}
+
+func (r *lessonGraphResolver) Dependencies() []*lessonDependencyResolver {
+	return r.dependencies
+}
+
+func (r *lessonGraphResolver) Lessons() []*lessonResolver {
+	return r.lessons
+}
+
+type lessonDependencyResolver struct {
+	conf               *myconf.Config
+	lesson             *repo.LessonPermit
+	lessonPrerequisite *repo.LessonPrerequisitePermit
+	prerequisite       *repo.LessonPermit
+	repos              *repo.Repos
+}
+
+func (r *lessonDependencyResolver) CreatedAt() (graphql.Time, error) {
+	t, err := r.lessonPrerequisite.CreatedAt()
+	return graphql.Time{Time: t}, err
+}
+
+func (r *lessonDependencyResolver) Lesson() *lessonResolver {
+	return &lessonResolver{Lesson: r.lesson, Conf: r.conf, Repos: r.repos}
+}
+
+func (r *lessonDependencyResolver) Prerequisite() *lessonResolver {
+	return &lessonResolver{Lesson: r.prerequisite, Conf: r.conf, Repos: r.repos}
+}
+
+// sortLessonIDs returns the IDs of the lessons ordered by study, and by number
+// within each study.
+func sortLessonIDs(lessons map[string]*repo.LessonPermit) ([]string, error) {
+	type key struct {
+		id      string
+		number  int32
+		studyID string
+	}
+	keys := make([]key, 0, len(lessons))
+	for id, l := range lessons {
+		number, err := l.Number()
+		if err != nil {
+			return nil, err
+		}
+		studyID, err := l.StudyID()
+		if err != nil {
+			return nil, err
+		}
+		keys = append(keys, key{id, number, studyID.String})
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		if keys[i].studyID != keys[j].studyID {
+			return keys[i].studyID < keys[j].studyID
+		}
+		return keys[i].number < keys[j].number
+	})
+	ids := make([]string, len(keys))
+	for i, k := range keys {
+		ids[i] = k.id
+	}
+	return ids, nil
+}
@@ -20,7 +20,7 @@ func NewLessonTimelineConnectionResolver(
 ) (*lessonTimelineConnectionResolver, error) {
 	edges := make([]*lessonTimelineEventEdgeResolver, len(events))
 	for i := range edges {
-		edge, err := NewLessonTimelineEventEdgeResolver(events[i], repos, conf)
+		edge, err := NewLessonTimelineEventEdgeResolver(events[i], pageOptions, repos, conf)
 		if err != nil {
 			return nil, err
 		}
@@ -0,0 +1,48 @@
+package resolver
+
+import "github.com/marksauter/markus-ninja-api/pkg/util"
+
+type lessonTOCEntryResolver struct {
+	Heading util.MarkdownHeading
+	Entries []*lessonTOCEntryResolver
+}
+
+func (r *lessonTOCEntryResolver) Children() []*lessonTOCEntryResolver {
+	return r.Entries
+}
+
+func (r *lessonTOCEntryResolver) Level() int32 {
+	return int32(r.Heading.Level)
+}
+
+func (r *lessonTOCEntryResolver) Slug() string {
+	return r.Heading.Slug
+}
+
+func (r *lessonTOCEntryResolver) Text() string {
+	return r.Heading.Text
+}
+
+// newLessonTOC nests each heading under the nearest preceding heading of a
+// lower level.
+func newLessonTOC(headings []util.MarkdownHeading) []*lessonTOCEntryResolver {
+	toc := []*lessonTOCEntryResolver{}
+	var parents []*lessonTOCEntryResolver
+	for _, h := range headings {
+		entry := &lessonTOCEntryResolver{
+			Heading: h,
+			Entries: []*lessonTOCEntryResolver{},
+		}
+		for len(parents) > 0 && parents[len(parents)-1].Heading.Level >= h.Level {
+			parents = parents[:len(parents)-1]
+		}
+		if len(parents) == 0 {
+			toc = append(toc, entry)
+		} else {
+			parent := parents[len(parents)-1]
+			parent.Entries = append(parent.Entries, entry)
+		}
+		parents = append(parents, entry)
+	}
+	return toc
+}
@@ -0,0 +1,117 @@
+package resolver
+
+import (
+	"context"
+	"errors"
+
+	"github.com/jackc/pgx/pgtype"
+	graphql "github.com/marksauter/graphql-go"
+	"github.com/marksauter/markus-ninja-api/pkg/data"
+	"github.com/marksauter/markus-ninja-api/pkg/myconf"
+	"github.com/marksauter/markus-ninja-api/pkg/myctx"
+	"github.com/marksauter/markus-ninja-api/pkg/mytype"
+	"github.com/marksauter/markus-ninja-api/pkg/repo"
+)
+
+type moderationActionResolver struct {
+	Conf             *myconf.Config
+	ModerationAction *data.ModerationAction
+	Repos            *repo.Repos
+}
+
+func (r *moderationActionResolver) Action() string {
+	return r.ModerationAction.Action.String
+}
+
+func (r *moderationActionResolver) Actor(ctx context.Context) (*userResolver, error) {
+	return getModerationUser(ctx, r.ModerationAction.ActorID.String, r.Repos, r.Conf)
+}
+
+func (r *moderationActionResolver) CreatedAt() graphql.Time {
+	return graphql.Time{Time: r.ModerationAction.CreatedAt.Time}
+}
+
+func (r *moderationActionResolver) ID() graphql.ID {
+	return graphql.ID(r.ModerationAction.ID.String)
+}
+
+func (r *moderationActionResolver) Note() *string {
+	if r.ModerationAction.Note.Status != pgtype.Present {
+		return nil
+	}
+	return &r.ModerationAction.Note.String
+}
+
+func (r *moderationActionResolver) Subject(ctx context.Context) (*nodeResolver, error) {
+	return getModerationSubject(ctx, &r.ModerationAction.SubjectID, r.Repos, r.Conf)
+}
+
+func (r *moderationActionResolver) SubjectID() graphql.ID {
+	return graphql.ID(r.ModerationAction.SubjectID.String)
+}
+
+func (r *moderationActionResolver) User(ctx context.Context) (*userResolver, error) {
+	if r.ModerationAction.UserID.Status != pgtype.Present {
+		return nil, nil
+	}
+	return getModerationUser(ctx, r.ModerationAction.UserID.String, r.Repos, r.Conf)
+}
+
+// checkViewerIsSiteAdmin returns ErrAccessDenied unless the viewer is a site
+// admin.
+func checkViewerIsSiteAdmin(ctx context.Context) error {
+	viewer, ok := myctx.UserFromContext(ctx)
+	if !ok {
+		return errors.New("viewer not found")
+	}
+	for _, role := range viewer.Roles.Elements {
+		if role.String == data.AdminRole {
+			return nil
+		}
+	}
+	return repo.ErrAccessDenied
+}
+
+// getModerationUser returns the user, or nil if the user has since been
+// deleted.
+func getModerationUser(
+	ctx context.Context,
+	userID string,
+	repos *repo.Repos,
+	conf *myconf.Config,
+) (*userResolver, error) {
+	user, err := repos.User().Get(ctx, userID)
+	if err != nil {
+		if err == data.ErrNotFound {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &userResolver{User: user, Conf: conf, Repos: repos}, nil
+}
+
+// getModerationSubject returns the subject, or nil if it has since been
+// deleted or the viewer can no longer read it.
+func getModerationSubject(
+	ctx context.Context,
+	subjectID *mytype.OID,
+	repos *repo.Repos,
+	conf *myconf.Config,
+) (*nodeResolver, error) {
+	permit, err := repos.GetNode(ctx, subjectID)
+	if err != nil {
+		if err == data.ErrNotFound || err == repo.ErrAccessDenied {
+			return nil, nil
+		}
+		return nil, err
+	}
+	resolver, err := nodePermitToResolver(permit, repos, conf)
+	if err != nil {
+		return nil, err
+	}
+	node, ok := resolver.(node)
+	if !ok {
+		return nil, errors.New("cannot convert resolver to node")
+	}
+	return &nodeResolver{node}, nil
+}
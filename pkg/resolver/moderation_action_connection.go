@@ -0,0 +1,95 @@
+package resolver
+
+import (
+	"context"
+
+	"github.com/marksauter/markus-ninja-api/pkg/data"
+	"github.com/marksauter/markus-ninja-api/pkg/myconf"
+	"github.com/marksauter/markus-ninja-api/pkg/myctx"
+	"github.com/marksauter/markus-ninja-api/pkg/repo"
+)
+
+// moderationActionOrder orders the moderation log newest first.
+type moderationActionOrder struct{}
+
+func (moderationActionOrder) Direction() data.OrderDirection {
+	return data.DESC
+}
+
+func (moderationActionOrder) Field() string {
+	return "created_at"
+}
+
+func NewModerationActionConnectionResolver(
+	moderationActions []*data.ModerationAction,
+	pageOptions *data.PageOptions,
+	filters *data.ModerationActionFilterOptions,
+	repos *repo.Repos,
+	conf *myconf.Config,
+) (*moderationActionConnectionResolver, error) {
+	edges := make([]*moderationActionEdgeResolver, len(moderationActions))
+	for i := range edges {
+		edge, err := NewModerationActionEdgeResolver(moderationActions[i], pageOptions, repos, conf)
+		if err != nil {
+			return nil, err
+		}
+		edges[i] = edge
+	}
+	edgeResolvers := make([]EdgeResolver, len(edges))
+	for i, e := range edges {
+		edgeResolvers[i] = e
+	}
+
+	pageInfo := NewPageInfoResolver(edgeResolvers, pageOptions)
+
+	resolver := &moderationActionConnectionResolver{
+		conf:              conf,
+		edges:             edges,
+		filters:           filters,
+		moderationActions: moderationActions,
+		pageInfo:          pageInfo,
+		repos:             repos,
+	}
+	return resolver, nil
+}
+
+type moderationActionConnectionResolver struct {
+	conf              *myconf.Config
+	edges             []*moderationActionEdgeResolver
+	filters           *data.ModerationActionFilterOptions
+	moderationActions []*data.ModerationAction
+	pageInfo          *pageInfoResolver
+	repos             *repo.Repos
+}
+
+func (r *moderationActionConnectionResolver) Edges() *[]*moderationActionEdgeResolver {
+	if len(r.edges) > 0 && !r.pageInfo.isEmpty {
+		edges := r.edges[r.pageInfo.start : r.pageInfo.end+1]
+		return &edges
+	}
+	return &[]*moderationActionEdgeResolver{}
+}
+
+func (r *moderationActionConnectionResolver) Nodes() *[]*moderationActionResolver {
+	n := len(r.moderationActions)
+	nodes := make([]*moderationActionResolver, 0, n)
+	if n > 0 && !r.pageInfo.isEmpty {
+		moderationActions := r.moderationActions[r.pageInfo.start : r.pageInfo.end+1]
+		for _, m := range moderationActions {
+			nodes = append(nodes, &moderationActionResolver{ModerationAction: m, Conf: r.conf, Repos: r.repos})
+		}
+	}
+	return &nodes
+}
+
+func (r *moderationActionConnectionResolver) PageInfo() (*pageInfoResolver, error) {
+	return r.pageInfo, nil
+}
+
+func (r *moderationActionConnectionResolver) TotalCount(ctx context.Context) (int32, error) {
+	db, ok := myctx.QueryerFromContext(ctx)
+	if !ok {
+		return 0, &myctx.ErrNotFound{Name: "queryer"}
+	}
+	return data.CountModerationAction(db, r.filters)
+}
@@ -0,0 +1,40 @@
+package resolver
+
+import (
+	"github.com/marksauter/markus-ninja-api/pkg/data"
+	"github.com/marksauter/markus-ninja-api/pkg/myconf"
+	"github.com/marksauter/markus-ninja-api/pkg/repo"
+)
+
+func NewModerationActionEdgeResolver(
+	node *data.ModerationAction,
+	pageOptions *data.PageOptions,
+	repos *repo.Repos,
+	conf *myconf.Config,
+) (*moderationActionEdgeResolver, error) {
+	cursor, err := data.EncodeCursor(node, pageOptions.OrderField())
+	if err != nil {
+		return nil, err
+	}
+	return &moderationActionEdgeResolver{
+		conf:   conf,
+		cursor: cursor,
+		node:   node,
+		repos:  repos,
+	}, nil
+}
+
+type moderationActionEdgeResolver struct {
+	conf   *myconf.Config
+	cursor string
+	node   *data.ModerationAction
+	repos  *repo.Repos
+}
+
+func (r *moderationActionEdgeResolver) Cursor() string {
+	return r.cursor
+}
+
+func (r *moderationActionEdgeResolver) Node() *moderationActionResolver {
+	return &moderationActionResolver{ModerationAction: r.node, Conf: r.conf, Repos: r.repos}
+}
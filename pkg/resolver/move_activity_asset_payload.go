@@ -34,5 +34,5 @@ func (r *moveActivityAssetPayloadResolver) AssetEdge(
 		return nil, err
 	}
 
-	return NewUserAssetEdgeResolver(userAsset, r.Repos, r.Conf)
+	return NewUserAssetEdgeResolver(userAsset, nil, r.Repos, r.Conf)
 }
@@ -34,5 +34,5 @@ func (r *moveCourseLessonPayloadResolver) LessonEdge(
 		return nil, err
 	}
 
-	return NewLessonEdgeResolver(lesson, r.Repos, r.Conf)
+	return NewLessonEdgeResolver(lesson, nil, r.Repos, r.Conf)
 }
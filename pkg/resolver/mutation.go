@@ -3,6 +3,7 @@ package resolver
 import (
 	"context"
 	"errors"
+	"fmt"
 	"regexp"
 	"strconv"
 	"strings"
@@ -22,6 +23,92 @@ import (
 	"github.com/marksauter/markus-ninja-api/pkg/util"
 )
 
+type AcceptStudyInvitationInput struct {
+	Token string
+}
+
+func (r *RootResolver) AcceptStudyInvitation(
+	ctx context.Context,
+	args struct{ Input AcceptStudyInvitationInput },
+) (*studyResolver, error) {
+	viewer, ok := myctx.UserFromContext(ctx)
+	if !ok {
+		return nil, errors.New("viewer not found")
+	}
+	if viewer.Login.String == repo.Guest {
+		return nil, repo.ErrAccessDenied
+	}
+	tx, err, newTx := myctx.TransactionFromContext(ctx)
+	if err != nil {
+		return nil, err
+	} else if newTx {
+		defer data.RollbackTransaction(tx)
+	}
+	ctx = myctx.NewQueryerContext(ctx, tx)
+
+	invitation, err := data.UseStudyInvitation(tx, args.Input.Token)
+	if err != nil {
+		if err == data.ErrNotFound {
+			return nil, myerr.ValidationError{
+				Field:   "token",
+				Message: "invitation is invalid, expired, or used up",
+			}
+		}
+		return nil, err
+	}
+	// Email invitations may only be accepted by the owner of the email, once
+	// they have verified it.
+	if invitation.Email.Status == pgtype.Present {
+		email, err := r.Repos.Email().GetByValue(ctx, invitation.Email.String)
+		if err != nil && err != data.ErrNotFound && err != repo.ErrAccessDenied {
+			return nil, err
+		}
+		if email == nil || email.Get().UserID.String != viewer.ID.String {
+			return nil, myerr.ValidationError{
+				Field:   "token",
+				Message: "invitation was sent to another email",
+			}
+		}
+		if email.Get().VerifiedAt.Status != pgtype.Present {
+			return nil, myerr.ValidationError{
+				Field:   "token",
+				Message: "invitation was sent to an unverified email",
+			}
+		}
+	}
+
+	studyAccess := &data.StudyAccess{}
+	if err := studyAccess.Access.Set(invitation.Access.String); err != nil {
+		return nil, myerr.SomethingWentWrongError
+	}
+	if err := studyAccess.InvitationID.Set(&invitation.ID); err != nil {
+		return nil, myerr.SomethingWentWrongError
+	}
+	if err := studyAccess.StudyID.Set(&invitation.StudyID); err != nil {
+		return nil, myerr.SomethingWentWrongError
+	}
+	if err := studyAccess.UserID.Set(&viewer.ID); err != nil {
+		return nil, myerr.SomethingWentWrongError
+	}
+	if _, err := r.Repos.Study().GrantAccess(ctx, studyAccess); err != nil {
+		return nil, err
+	}
+
+	study, err := r.Repos.Study().Get(ctx, invitation.StudyID.String)
+	if err != nil {
+		return nil, err
+	}
+
+	if newTx {
+		err := data.CommitTransaction(tx)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &studyResolver{Study: study, Conf: r.Conf, Repos: r.Repos}, nil
+}
+
 type AddActivityAssetInput struct {
 	ActivityID string
 	AssetID    string
@@ -34,12 +121,12 @@ func (r *RootResolver) AddActivityAsset(
 	viewer, ok := myctx.UserFromContext(ctx)
 	if !ok {
 		err := errors.New("viewer not found")
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 	tx, err, newTx := myctx.TransactionFromContext(ctx)
 	if err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	} else if newTx {
 		defer data.RollbackTransaction(tx)
@@ -48,29 +135,29 @@ func (r *RootResolver) AddActivityAsset(
 
 	activityAsset := &data.ActivityAsset{}
 	if err := activityAsset.ActivityID.Set(args.Input.ActivityID); err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
-		return nil, errors.New("invalid value for activityId")
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
+		return nil, myerr.ValidationError{Field: "activityId", Message: "invalid value for activityId"}
 	}
 	if err := activityAsset.AssetID.Set(args.Input.AssetID); err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
-		return nil, errors.New("invalid value for assetId")
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
+		return nil, myerr.ValidationError{Field: "assetId", Message: "invalid value for assetId"}
 	}
 
 	activity, err := r.Repos.Activity().Pull(ctx, args.Input.ActivityID)
 	if err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
-		return nil, errors.New("activity not found")
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
+		return nil, myerr.NotFoundError{Resource: "activity"}
 	}
 
 	_, err = r.Repos.ActivityAsset().Connect(ctx, activityAsset)
 	if err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 
 	studyID, err := activity.StudyID()
 	if err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, myerr.SomethingWentWrongError
 	}
 
@@ -79,23 +166,23 @@ func (r *RootResolver) AddActivityAsset(
 		&activityAsset.ActivityID,
 	)
 	if err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 	event, err := data.NewUserAssetEvent(eventPayload, studyID, &viewer.ID, true)
 	if err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 	if _, err := r.Repos.Event().Create(ctx, event); err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 
 	if newTx {
 		err := data.CommitTransaction(tx)
 		if err != nil {
-			mylog.Log.WithError(err).Error(util.Trace(""))
+			mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 			return nil, err
 		}
 	}
@@ -120,12 +207,12 @@ func (r *RootResolver) AddCourseLesson(
 	viewer, ok := myctx.UserFromContext(ctx)
 	if !ok {
 		err := errors.New("viewer not found")
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 	tx, err, newTx := myctx.TransactionFromContext(ctx)
 	if err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	} else if newTx {
 		defer data.RollbackTransaction(tx)
@@ -134,52 +221,52 @@ func (r *RootResolver) AddCourseLesson(
 
 	courseLesson := &data.CourseLesson{}
 	if err := courseLesson.CourseID.Set(args.Input.CourseID); err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
-		return nil, errors.New("invalid value for courseId")
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
+		return nil, myerr.ValidationError{Field: "courseId", Message: "invalid value for courseId"}
 	}
 	if err := courseLesson.LessonID.Set(args.Input.LessonID); err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
-		return nil, errors.New("invalid value for lessonId")
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
+		return nil, myerr.ValidationError{Field: "lessonId", Message: "invalid value for lessonId"}
 	}
 
 	course, err := r.Repos.Course().Pull(ctx, args.Input.CourseID)
 	if err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
-		return nil, errors.New("course not found")
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
+		return nil, myerr.NotFoundError{Resource: "course"}
 	}
 	lesson, err := r.Repos.Lesson().Pull(ctx, args.Input.LessonID)
 	if err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
-		return nil, errors.New("lesson not found")
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
+		return nil, myerr.NotFoundError{Resource: "lesson"}
 	}
 
 	courseIsPublished, err := course.IsPublished()
 	if err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, myerr.SomethingWentWrongError
 	}
 	if courseIsPublished {
 		lessonIsPublished, err := lesson.IsPublished()
 		if err != nil {
-			mylog.Log.WithError(err).Error(util.Trace(""))
+			mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 			return nil, myerr.SomethingWentWrongError
 		}
 		if !lessonIsPublished {
-			err := errors.New("lesson not published")
-			mylog.Log.WithError(err).Error(util.Trace(""))
+			err := myerr.ValidationError{Message: "lesson not published"}
+			mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 			return nil, err
 		}
 	}
 
 	_, err = r.Repos.CourseLesson().Connect(ctx, courseLesson)
 	if err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 
 	studyID, err := course.StudyID()
 	if err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, myerr.SomethingWentWrongError
 	}
 
@@ -189,16 +276,16 @@ func (r *RootResolver) AddCourseLesson(
 			&courseLesson.CourseID,
 		)
 		if err != nil {
-			mylog.Log.WithError(err).Error(util.Trace(""))
+			mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 			return nil, err
 		}
 		event, err := data.NewLessonEvent(eventPayload, studyID, &viewer.ID, true)
 		if err != nil {
-			mylog.Log.WithError(err).Error(util.Trace(""))
+			mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 			return nil, err
 		}
 		if _, err := r.Repos.Event().Create(ctx, event); err != nil {
-			mylog.Log.WithError(err).Error(util.Trace(""))
+			mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 			return nil, err
 		}
 	}
@@ -206,7 +293,7 @@ func (r *RootResolver) AddCourseLesson(
 	if newTx {
 		err := data.CommitTransaction(tx)
 		if err != nil {
-			mylog.Log.WithError(err).Error(util.Trace(""))
+			mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 			return nil, err
 		}
 	}
@@ -241,11 +328,11 @@ func (r *RootResolver) AddEmail(
 
 	email := &data.Email{}
 	if err := email.Value.Set(args.Input.Email); err != nil {
-		return nil, errors.New("Invalid email")
+		return nil, myerr.ValidationError{Field: "email", Message: "Invalid email"}
 	}
 	email.UserID.Set(&viewer.ID)
 	if err := email.UserID.Set(&viewer.ID); err != nil {
-		return nil, errors.New("Invalid userId")
+		return nil, myerr.UnexpectedError{Message: "failed to set user id"}
 	}
 
 	emailPermit, err := r.Repos.Email().Create(ctx, email)
@@ -255,11 +342,11 @@ func (r *RootResolver) AddEmail(
 
 	evt := &data.EVT{}
 	if err := evt.EmailID.Set(&email.ID); err != nil {
-		mylog.Log.WithError(err).Error("failed to set evt email_id")
+		mylog.Log.WithContext(ctx).WithError(err).Error("failed to set evt email_id")
 		return nil, myerr.SomethingWentWrongError
 	}
 	if err := evt.UserID.Set(&viewer.ID); err != nil {
-		mylog.Log.WithError(err).Error("failed to set evt user_id")
+		mylog.Log.WithContext(ctx).WithError(err).Error("failed to set evt user_id")
 		return nil, myerr.SomethingWentWrongError
 	}
 
@@ -318,10 +405,10 @@ func (r *RootResolver) AddLabel(
 
 	labeled := &data.Labeled{}
 	if err := labeled.LabelID.Set(args.Input.LabelID); err != nil {
-		return nil, errors.New("invalid labeled label_id")
+		return nil, myerr.ValidationError{Field: "labelId", Message: "invalid labeled label_id"}
 	}
 	if err := labeled.LabelableID.Set(args.Input.LabelableID); err != nil {
-		return nil, errors.New("invalid labeled labelable_id")
+		return nil, myerr.ValidationError{Field: "labelableId", Message: "invalid labeled labelable_id"}
 	}
 
 	_, err = r.Repos.Labeled().Connect(ctx, labeled)
@@ -332,17 +419,17 @@ func (r *RootResolver) AddLabel(
 	if labeled.LabelableID.Type == mytype.LessonNodeType.String() {
 		lesson, err := r.Repos.Lesson().Pull(ctx, args.Input.LabelableID)
 		if err != nil {
-			mylog.Log.WithError(err).Error(util.Trace(""))
-			return nil, errors.New("lesson not found")
+			mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
+			return nil, myerr.NotFoundError{Resource: "lesson"}
 		}
 		isPublished, err := lesson.IsPublished()
 		if err != nil {
-			mylog.Log.WithError(err).Error(util.Trace(""))
+			mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 			return nil, myerr.SomethingWentWrongError
 		}
 		studyID, err := lesson.StudyID()
 		if err != nil {
-			mylog.Log.WithError(err).Error(util.Trace(""))
+			mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 			return nil, myerr.SomethingWentWrongError
 		}
 
@@ -351,17 +438,17 @@ func (r *RootResolver) AddLabel(
 			&labeled.LabelID,
 		)
 		if err != nil {
-			mylog.Log.WithError(err).Error(util.Trace(""))
+			mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 			return nil, err
 		}
 		isPublic := isPublished
 		event, err := data.NewLessonEvent(eventPayload, studyID, &viewer.ID, isPublic)
 		if err != nil {
-			mylog.Log.WithError(err).Error(util.Trace(""))
+			mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 			return nil, err
 		}
 		if _, err := r.Repos.Event().Create(ctx, event); err != nil {
-			mylog.Log.WithError(err).Error(util.Trace(""))
+			mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 			return nil, err
 		}
 	}
@@ -381,6 +468,63 @@ func (r *RootResolver) AddLabel(
 	}, nil
 }
 
+type AddLessonPrerequisiteInput struct {
+	LessonID       string
+	PrerequisiteID string
+}
+
+func (r *RootResolver) AddLessonPrerequisite(
+	ctx context.Context,
+	args struct{ Input AddLessonPrerequisiteInput },
+) (*addLessonPrerequisitePayloadResolver, error) {
+	tx, err, newTx := myctx.TransactionFromContext(ctx)
+	if err != nil {
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
+		return nil, err
+	} else if newTx {
+		defer data.RollbackTransaction(tx)
+	}
+	ctx = myctx.NewQueryerContext(ctx, tx)
+
+	lessonPrerequisite := &data.LessonPrerequisite{}
+	if err := lessonPrerequisite.LessonID.Set(args.Input.LessonID); err != nil {
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
+		return nil, myerr.ValidationError{Field: "lessonId", Message: "invalid value for lessonId"}
+	}
+	if err := lessonPrerequisite.PrerequisiteID.Set(args.Input.PrerequisiteID); err != nil {
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
+		return nil, myerr.ValidationError{Field: "prerequisiteId", Message: "invalid value for prerequisiteId"}
+	}
+	if lessonPrerequisite.LessonID.String == lessonPrerequisite.PrerequisiteID.String {
+		return nil, myerr.ValidationError{Field: "prerequisiteId", Message: "lesson cannot be its own prerequisite"}
+	}
+
+	if _, err := r.Repos.Lesson().Get(ctx, args.Input.PrerequisiteID); err != nil {
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
+		return nil, myerr.NotFoundError{Resource: "lesson"}
+	}
+
+	if _, err := r.Repos.LessonPrerequisite().Connect(ctx, lessonPrerequisite); err != nil {
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
+		return nil, err
+	}
+
+	if newTx {
+		err := data.CommitTransaction(tx)
+		if err != nil {
+			mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
+			return nil, err
+		}
+	}
+
+	return &addLessonPrerequisitePayloadResolver{
+		Conf:           r.Conf,
+		LessonID:       &lessonPrerequisite.LessonID,
+		PrerequisiteID: &lessonPrerequisite.PrerequisiteID,
+		Repos:          r.Repos,
+	}, nil
+}
+
 type AddCommentInput struct {
 	CommentID string
 }
@@ -399,7 +543,7 @@ func (r *RootResolver) AddComment(
 
 	currentCommentPermit, err := r.Repos.Comment().Get(ctx, args.Input.CommentID)
 	if err != nil {
-		return nil, errors.New("comment not found")
+		return nil, myerr.NotFoundError{Resource: "comment"}
 	}
 
 	draft, err := currentCommentPermit.Draft()
@@ -420,19 +564,19 @@ func (r *RootResolver) AddComment(
 	}
 
 	if strings.TrimSpace(draft) == "" {
-		return nil, errors.New("comment draft must not be empty")
+		return nil, myerr.ValidationError{Message: "comment draft must not be empty"}
 	}
 
 	comment := &data.Comment{}
 	if err := comment.ID.Set(args.Input.CommentID); err != nil {
-		return nil, errors.New("Invalid commentId")
+		return nil, myerr.ValidationError{Field: "commentId", Message: "Invalid commentId"}
 	}
 	if err := comment.Body.Set(draft); err != nil {
-		mylog.Log.WithError(err).Error("failed to set comment's body to its draft")
+		mylog.Log.WithContext(ctx).WithError(err).Error("failed to set comment's body to its draft")
 		return nil, myerr.SomethingWentWrongError
 	}
 	if err := comment.PublishedAt.Set(time.Now()); err != nil {
-		mylog.Log.WithError(err).Error("failed to set comment published_at")
+		mylog.Log.WithContext(ctx).WithError(err).Error("failed to set comment published_at")
 		return nil, myerr.SomethingWentWrongError
 	}
 
@@ -445,7 +589,7 @@ func (r *RootResolver) AddComment(
 			userID,
 		)
 		if err != nil {
-			mylog.Log.WithError(err).Error(util.Trace(""))
+			mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 			return nil, err
 		}
 	} else if commentableID.Type == "UserAsset" {
@@ -457,7 +601,7 @@ func (r *RootResolver) AddComment(
 			userID,
 		)
 		if err != nil {
-			mylog.Log.WithError(err).Error(util.Trace(""))
+			mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 			return nil, err
 		}
 	}
@@ -468,12 +612,12 @@ func (r *RootResolver) AddComment(
 		studyID.String,
 	)
 	if err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 	if updated {
 		if err := comment.Body.Set(body); err != nil {
-			mylog.Log.WithError(err).Error(util.Trace(""))
+			mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 			return nil, err
 		}
 	}
@@ -497,6 +641,200 @@ func (r *RootResolver) AddComment(
 	}, nil
 }
 
+type AddTopicAliasInput struct {
+	Name    string
+	TopicID string
+}
+
+// AddTopicAlias makes the name resolve to the topic, e.g. "golang" to "go".
+func (r *RootResolver) AddTopicAlias(
+	ctx context.Context,
+	args struct{ Input AddTopicAliasInput },
+) (*topicResolver, error) {
+	if err := checkViewerIsSiteAdmin(ctx); err != nil {
+		return nil, err
+	}
+	if !validTopicName.MatchString(args.Input.Name) {
+		return nil, myerr.ValidationError{Field: "name", Message: "invalid value for name"}
+	}
+	topicID, err := mytype.ParseOID(args.Input.TopicID)
+	if err != nil || topicID.Type != "Topic" {
+		return nil, myerr.ValidationError{Field: "topicId", Message: "invalid value for topicId"}
+	}
+
+	tx, err, newTx := myctx.TransactionFromContext(ctx)
+	if err != nil {
+		return nil, err
+	} else if newTx {
+		defer data.RollbackTransaction(tx)
+	}
+	ctx = myctx.NewQueryerContext(ctx, tx)
+
+	if err := data.CreateTopicAlias(tx, args.Input.Name, topicID.String); err != nil {
+		return nil, err
+	}
+	topic, err := r.Repos.Topic().Get(ctx, topicID.String)
+	if err != nil {
+		return nil, err
+	}
+
+	if newTx {
+		err := data.CommitTransaction(tx)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &topicResolver{
+		Conf:  r.Conf,
+		Topic: topic,
+		Repos: r.Repos,
+	}, nil
+}
+
+type CancelScheduledPublishInput struct {
+	PublishableID string
+}
+
+type BlockUserInput struct {
+	UserID string
+}
+
+func (r *RootResolver) BlockUser(
+	ctx context.Context,
+	args struct{ Input BlockUserInput },
+) (*userResolver, error) {
+	tx, err, newTx := myctx.TransactionFromContext(ctx)
+	if err != nil {
+		return nil, err
+	} else if newTx {
+		defer data.RollbackTransaction(tx)
+	}
+	ctx = myctx.NewQueryerContext(ctx, tx)
+
+	userID, err := mytype.ParseOID(args.Input.UserID)
+	if err != nil || userID.Type != "User" {
+		return nil, myerr.ValidationError{Field: "userId", Message: "invalid value for userId"}
+	}
+	user, err := r.Repos.User().Get(ctx, userID.String)
+	if err != nil {
+		return nil, err
+	}
+	if err := r.Repos.User().Block(ctx, userID.String); err != nil {
+		return nil, err
+	}
+
+	if newTx {
+		err := data.CommitTransaction(tx)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &userResolver{User: user, Conf: r.Conf, Repos: r.Repos}, nil
+}
+
+func (r *RootResolver) CancelScheduledPublish(
+	ctx context.Context,
+	args struct{ Input CancelScheduledPublishInput },
+) (*publishableResolver, error) {
+	tx, err, newTx := myctx.TransactionFromContext(ctx)
+	if err != nil {
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
+		return nil, err
+	} else if newTx {
+		defer data.RollbackTransaction(tx)
+	}
+	ctx = myctx.NewQueryerContext(ctx, tx)
+
+	publishableID, err := mytype.ParseOID(args.Input.PublishableID)
+	if err != nil {
+		return nil, myerr.ValidationError{Field: "publishableId", Message: "invalid publishable id"}
+	}
+
+	var publishablePermit repo.NodePermit
+	switch publishableID.Type {
+	case "Course":
+		course := &data.Course{}
+		if err := course.ID.Set(publishableID); err != nil {
+			mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
+			return nil, myerr.SomethingWentWrongError
+		}
+		if err := course.ScheduledPublishAt.Set(nil); err != nil {
+			mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
+			return nil, myerr.SomethingWentWrongError
+		}
+		publishablePermit, err = r.Repos.Course().Update(ctx, course)
+	case "Lesson":
+		lesson := &data.Lesson{}
+		if err := lesson.ID.Set(publishableID); err != nil {
+			mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
+			return nil, myerr.SomethingWentWrongError
+		}
+		if err := lesson.ScheduledPublishAt.Set(nil); err != nil {
+			mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
+			return nil, myerr.SomethingWentWrongError
+		}
+		publishablePermit, err = r.Repos.Lesson().Update(ctx, lesson)
+	default:
+		return nil, myerr.ValidationError{
+			Field:   "publishableId",
+			Message: "only courses and lessons may be scheduled",
+		}
+	}
+	if err != nil {
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
+		return nil, err
+	}
+
+	if newTx {
+		err := data.CommitTransaction(tx)
+		if err != nil {
+			mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
+			return nil, err
+		}
+	}
+
+	resolver, err := nodePermitToResolver(publishablePermit, r.Repos, r.Conf)
+	if err != nil {
+		return nil, err
+	}
+	publishable, ok := resolver.(publishable)
+	if !ok {
+		return nil, errors.New("cannot convert resolver to publishable")
+	}
+	return &publishableResolver{publishable}, nil
+}
+
+func (r *RootResolver) CancelViewerAccountDeletion(
+	ctx context.Context,
+) (bool, error) {
+	viewer, ok := myctx.UserFromContext(ctx)
+	if !ok {
+		err := errors.New("viewer not found")
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
+		return false, err
+	}
+	if viewer.Login.String == repo.Guest {
+		return false, repo.ErrAccessDenied
+	}
+	db, ok := myctx.QueryerFromContext(ctx)
+	if !ok {
+		err := &myctx.ErrNotFound{Name: "queryer"}
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
+		return false, err
+	}
+
+	if err := data.CancelAccountDeletion(db, viewer.ID.String); err != nil {
+		if err == data.ErrNotFound {
+			return false, nil
+		}
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
+		return false, err
+	}
+	return true, nil
+}
+
 type CreateActivityInput struct {
 	Description *string
 	LessonID    string
@@ -515,31 +853,31 @@ func (r *RootResolver) CreateActivity(
 
 	lesson, err := r.Repos.Lesson().Pull(ctx, args.Input.LessonID)
 	if err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
-		return nil, errors.New("lesson not found")
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
+		return nil, myerr.NotFoundError{Resource: "lesson"}
 	}
 	lessonIsPublished, err := lesson.IsPublished()
 	if err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, myerr.SomethingWentWrongError
 	}
 
 	if !lessonIsPublished {
-		return nil, errors.New("lesson must be published")
+		return nil, myerr.ValidationError{Message: "lesson must be published"}
 	}
 
 	activity := &data.Activity{}
 	if err := activity.Description.Set(args.Input.Description); err != nil {
-		return nil, errors.New("invalid activity description")
+		return nil, myerr.ValidationError{Field: "description", Message: "invalid activity description"}
 	}
 	if err := activity.LessonID.Set(args.Input.LessonID); err != nil {
-		return nil, errors.New("invalid activity lesson_id")
+		return nil, myerr.ValidationError{Field: "lessonId", Message: "invalid activity lesson_id"}
 	}
 	if err := activity.Name.Set(args.Input.Name); err != nil {
-		return nil, errors.New("invalid activity name")
+		return nil, myerr.ValidationError{Field: "name", Message: "invalid activity name"}
 	}
 	if err := activity.StudyID.Set(args.Input.StudyID); err != nil {
-		return nil, errors.New("invalid activity study_id")
+		return nil, myerr.ValidationError{Field: "studyId", Message: "invalid activity study_id"}
 	}
 	if err := activity.UserID.Set(&viewer.ID); err != nil {
 		return nil, errors.New("invalid activity user_id")
@@ -575,13 +913,13 @@ func (r *RootResolver) CreateCourse(
 
 	course := &data.Course{}
 	if err := course.Description.Set(args.Input.Description); err != nil {
-		return nil, errors.New("invalid course description")
+		return nil, myerr.ValidationError{Field: "description", Message: "invalid course description"}
 	}
 	if err := course.Name.Set(args.Input.Name); err != nil {
-		return nil, errors.New("invalid course name")
+		return nil, myerr.ValidationError{Field: "name", Message: "invalid course name"}
 	}
 	if err := course.StudyID.Set(args.Input.StudyID); err != nil {
-		return nil, errors.New("invalid course study_id")
+		return nil, myerr.ValidationError{Field: "studyId", Message: "invalid course study_id"}
 	}
 	if err := course.UserID.Set(&viewer.ID); err != nil {
 		return nil, errors.New("invalid course user_id")
@@ -613,16 +951,16 @@ func (r *RootResolver) CreateLabel(
 ) (*createLabelPayloadResolver, error) {
 	label := &data.Label{}
 	if err := label.Color.Set(args.Input.Color); err != nil {
-		return nil, errors.New("Invalid color")
+		return nil, myerr.ValidationError{Field: "color", Message: "Invalid color"}
 	}
 	if err := label.Description.Set(args.Input.Description); err != nil {
-		return nil, errors.New("Invalid description")
+		return nil, myerr.ValidationError{Field: "description", Message: "Invalid description"}
 	}
 	if err := label.Name.Set(args.Input.Name); err != nil {
-		return nil, errors.New("Invalid name")
+		return nil, myerr.ValidationError{Field: "name", Message: "Invalid name"}
 	}
 	if err := label.StudyID.Set(args.Input.StudyID); err != nil {
-		return nil, errors.New("Invalid studyId")
+		return nil, myerr.ValidationError{Field: "studyId", Message: "Invalid studyId"}
 	}
 	labelPermit, err := r.Repos.Label().Create(ctx, label)
 	if err != nil {
@@ -661,13 +999,13 @@ func (r *RootResolver) CreateLesson(
 
 	lesson := &data.Lesson{}
 	if err := lesson.StudyID.Set(args.Input.StudyID); err != nil {
-		return nil, errors.New("Invalid studyId")
+		return nil, myerr.ValidationError{Field: "studyId", Message: "Invalid studyId"}
 	}
 	if err := lesson.Title.Set(args.Input.Title); err != nil {
-		return nil, errors.New("Invalid title")
+		return nil, myerr.ValidationError{Field: "title", Message: "Invalid title"}
 	}
 	if err := lesson.UserID.Set(&viewer.ID); err != nil {
-		mylog.Log.WithError(err).Error("failed to set lesson user_id")
+		mylog.Log.WithContext(ctx).WithError(err).Error("failed to set lesson user_id")
 		return nil, myerr.SomethingWentWrongError
 	}
 
@@ -692,8 +1030,9 @@ func (r *RootResolver) CreateLesson(
 }
 
 type CreateStudyInput struct {
-	Description *string
-	Name        string
+	Description    *string
+	Name           string
+	SearchLanguage *string
 }
 
 func (r *RootResolver) CreateStudy(
@@ -707,10 +1046,16 @@ func (r *RootResolver) CreateStudy(
 
 	study := &data.Study{}
 	if err := study.Description.Set(args.Input.Description); err != nil {
-		return nil, errors.New("Invalid description")
+		return nil, myerr.ValidationError{Field: "description", Message: "Invalid description"}
 	}
 	if err := study.Name.Set(args.Input.Name); err != nil {
-		return nil, errors.New("Invalid name")
+		return nil, myerr.ValidationError{Field: "name", Message: "Invalid name"}
+	}
+	if args.Input.SearchLanguage != nil {
+		language := strings.ToLower(*args.Input.SearchLanguage)
+		if err := study.SearchLanguage.Set(language); err != nil || !data.IsSearchLanguage(language) {
+			return nil, myerr.ValidationError{Field: "searchLanguage", Message: "Invalid search language"}
+		}
 	}
 	if err := study.UserID.Set(&viewer.ID); err != nil {
 		mylog.Log.Error("failed to set study user_id")
@@ -719,7 +1064,7 @@ func (r *RootResolver) CreateStudy(
 
 	studyPermit, err := r.Repos.Study().Create(ctx, study)
 	if err != nil {
-		mylog.Log.WithError(err).Error("failed to create study")
+		mylog.Log.WithContext(ctx).WithError(err).Error("failed to create study")
 		return nil, myerr.SomethingWentWrongError
 	}
 	study = studyPermit.Get()
@@ -732,62 +1077,176 @@ func (r *RootResolver) CreateStudy(
 	}, nil
 }
 
-type CreateUserInput struct {
-	Email    string
-	Login    string
-	Password string
+type CreateStudyInvitationInput struct {
+	Access    string
+	Email     *string
+	ExpiresAt *graphql.Time
+	MaxUses   *int32
+	StudyID   string
 }
 
-func (r *RootResolver) CreateUser(
+func (r *RootResolver) CreateStudyInvitation(
 	ctx context.Context,
-	args struct{ Input CreateUserInput },
-) (*userResolver, error) {
-	user := &data.User{}
-	if err := user.PrimaryEmail.Set(args.Input.Email); err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
-		return nil, errors.New("Invalid email")
-	}
-	if err := user.Login.Set(args.Input.Login); err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
-		return nil, errors.New("Invalid login")
-	}
-	if err := user.Password.Set(args.Input.Password); err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
-		return nil, errors.New("Invalid password")
+	args struct{ Input CreateStudyInvitationInput },
+) (*studyInvitationResolver, error) {
+	viewer, ok := myctx.UserFromContext(ctx)
+	if !ok {
+		return nil, errors.New("viewer not found")
 	}
-
-	ok, err := user.Login.IsBlacklisted()
+	tx, err, newTx := myctx.TransactionFromContext(ctx)
 	if err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
-		return nil, myerr.SomethingWentWrongError
-	} else if ok {
-		err := errors.New("Username unavailable")
-		mylog.Log.WithError(err).Error(util.Trace(""))
 		return nil, err
+	} else if newTx {
+		defer data.RollbackTransaction(tx)
 	}
+	ctx = myctx.NewQueryerContext(ctx, tx)
 
-	userPermit, err := r.Repos.User().Create(ctx, user)
+	study, err := r.getAdministeredStudy(ctx, args.Input.StudyID)
 	if err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 
-	return &userResolver{
-		Conf:  r.Conf,
-		Repos: r.Repos,
-		User:  userPermit,
-	}, nil
-}
-
-type CreateUserAssetInput struct {
-	AssetID     string
-	Description *string
-	Name        string
-	StudyID     string
-}
-
-func (r *RootResolver) CreateUserAsset(
-	ctx context.Context,
+	invitation := &data.StudyInvitation{}
+	if err := invitation.Access.Set(args.Input.Access); err != nil {
+		return nil, myerr.ValidationError{Field: "access", Message: "invalid value for access"}
+	}
+	if err := invitation.Email.Set(nil); err != nil {
+		return nil, myerr.SomethingWentWrongError
+	}
+	if args.Input.Email != nil {
+		email := &mytype.Email{}
+		if err := email.Set(*args.Input.Email); err != nil {
+			return nil, myerr.ValidationError{Field: "email", Message: "invalid value for email"}
+		}
+		if err := invitation.Email.Set(email.String); err != nil {
+			return nil, myerr.SomethingWentWrongError
+		}
+	}
+	if err := invitation.ExpiresAt.Set(nil); err != nil {
+		return nil, myerr.SomethingWentWrongError
+	}
+	if args.Input.ExpiresAt != nil {
+		if !args.Input.ExpiresAt.Time.After(time.Now()) {
+			return nil, myerr.ValidationError{Field: "expiresAt", Message: "must be in the future"}
+		}
+		if err := invitation.ExpiresAt.Set(args.Input.ExpiresAt.Time); err != nil {
+			return nil, myerr.SomethingWentWrongError
+		}
+	}
+	if err := invitation.InviterID.Set(&viewer.ID); err != nil {
+		return nil, myerr.SomethingWentWrongError
+	}
+	if err := invitation.MaxUses.Set(nil); err != nil {
+		return nil, myerr.SomethingWentWrongError
+	}
+	if args.Input.MaxUses != nil {
+		if *args.Input.MaxUses < 1 {
+			return nil, myerr.ValidationError{Field: "maxUses", Message: "must be at least 1"}
+		}
+		if err := invitation.MaxUses.Set(*args.Input.MaxUses); err != nil {
+			return nil, myerr.SomethingWentWrongError
+		}
+	}
+	if err := invitation.StudyID.Set(&study.Get().ID); err != nil {
+		return nil, myerr.SomethingWentWrongError
+	}
+
+	invitation, err = data.CreateStudyInvitation(tx, invitation)
+	if err != nil {
+		return nil, err
+	}
+
+	if invitation.Email.Status == pgtype.Present {
+		owner, err := r.Repos.User().Get(ctx, study.Get().UserID.String)
+		if err != nil {
+			return nil, err
+		}
+		ownerLogin, err := owner.Login()
+		if err != nil {
+			return nil, err
+		}
+		err = r.Svcs.Mail.SendStudyInvitationMail(&service.SendStudyInvitationMailInput{
+			InviterLogin: viewer.Login.String,
+			StudyName:    study.Get().Name.String,
+			StudyOwner:   ownerLogin,
+			To:           invitation.Email.String,
+			Token:        invitation.Token.String,
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if newTx {
+		err := data.CommitTransaction(tx)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &studyInvitationResolver{
+		Conf:            r.Conf,
+		Repos:           r.Repos,
+		StudyInvitation: invitation,
+	}, nil
+}
+
+type CreateUserInput struct {
+	Email    string
+	Login    string
+	Password string
+}
+
+func (r *RootResolver) CreateUser(
+	ctx context.Context,
+	args struct{ Input CreateUserInput },
+) (*userResolver, error) {
+	user := &data.User{}
+	if err := user.PrimaryEmail.Set(args.Input.Email); err != nil {
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
+		return nil, myerr.ValidationError{Field: "email", Message: "Invalid email"}
+	}
+	if err := user.Login.Set(args.Input.Login); err != nil {
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
+		return nil, myerr.ValidationError{Field: "login", Message: "Invalid login"}
+	}
+	if err := user.Password.Set(args.Input.Password); err != nil {
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
+		return nil, myerr.ValidationError{Field: "password", Message: "Invalid password"}
+	}
+
+	ok, err := user.Login.IsBlacklisted()
+	if err != nil {
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
+		return nil, myerr.SomethingWentWrongError
+	} else if ok {
+		err := myerr.ValidationError{Field: "login", Message: "Username unavailable"}
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
+		return nil, err
+	}
+
+	userPermit, err := r.Repos.User().Create(ctx, user)
+	if err != nil {
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
+		return nil, err
+	}
+
+	return &userResolver{
+		Conf:  r.Conf,
+		Repos: r.Repos,
+		User:  userPermit,
+	}, nil
+}
+
+type CreateUserAssetInput struct {
+	AssetID     string
+	Description *string
+	Name        string
+	StudyID     string
+}
+
+func (r *RootResolver) CreateUserAsset(
+	ctx context.Context,
 	args struct{ Input CreateUserAssetInput },
 ) (*createUserAssetPayloadResolver, error) {
 	viewer, ok := myctx.UserFromContext(ctx)
@@ -802,19 +1261,19 @@ func (r *RootResolver) CreateUserAsset(
 
 	userAsset := &data.UserAsset{}
 	if err := userAsset.AssetID.Set(assetID); err != nil {
-		return nil, errors.New("Invalid assetId")
+		return nil, myerr.ValidationError{Field: "assetId", Message: "Invalid assetId"}
 	}
 	if err := userAsset.Description.Set(args.Input.Description); err != nil {
-		return nil, errors.New("Invalid description")
+		return nil, myerr.ValidationError{Field: "description", Message: "Invalid description"}
 	}
 	if err := userAsset.Name.Set(args.Input.Name); err != nil {
-		return nil, errors.New("Invalid name")
+		return nil, myerr.ValidationError{Field: "name", Message: "Invalid name"}
 	}
 	if err := userAsset.StudyID.Set(args.Input.StudyID); err != nil {
-		return nil, errors.New("Invalid studyId")
+		return nil, myerr.ValidationError{Field: "studyId", Message: "Invalid studyId"}
 	}
 	if err := userAsset.UserID.Set(&viewer.ID); err != nil {
-		return nil, errors.New("Invalid userId")
+		return nil, myerr.UnexpectedError{Message: "failed to set user id"}
 	}
 
 	userAssetPermit, err := r.Repos.UserAsset().Create(ctx, userAsset)
@@ -867,7 +1326,7 @@ func (r *RootResolver) DeleteEmail(
 		return nil, err
 	}
 	if n == 1 {
-		return nil, errors.New("cannot delete your only verified email")
+		return nil, myerr.ValidationError{Message: "cannot delete your only verified email"}
 	}
 
 	if err := r.Repos.Email().Delete(ctx, email); err != nil {
@@ -1216,14 +1675,24 @@ func (r *RootResolver) DeleteViewerAccount(
 		return nil, InvalidCredentialsError
 	}
 
-	if err := r.Repos.User().Delete(ctx, user); err != nil {
+	if !r.Repos.User().ViewerCanDelete(ctx, user) {
+		return nil, repo.ErrAccessDenied
+	}
+
+	accountDeletion, err := data.ScheduleAccountDeletion(
+		db,
+		user.ID.String,
+		time.Now().Add(r.Conf.AccountDeletionGracePeriod),
+	)
+	if err != nil {
 		return nil, err
 	}
 
 	return &deleteViewerAccountPayloadResolver{
-		Conf:     r.Conf,
-		Repos:    r.Repos,
-		ViewerID: &user.ID,
+		AccountDeletion: accountDeletion,
+		Conf:            r.Conf,
+		Repos:           r.Repos,
+		ViewerID:        &user.ID,
 	}, nil
 }
 
@@ -1249,7 +1718,7 @@ func (r *RootResolver) GiveApple(
 
 	appled := &data.Appled{}
 	if err := appled.AppleableID.Set(args.Input.AppleableID); err != nil {
-		return nil, errors.New("invalid appleable id")
+		return nil, myerr.ValidationError{Field: "appleableId", Message: "invalid appleable id"}
 	}
 	if err := appled.UserID.Set(&viewer.ID); err != nil {
 		return nil, errors.New("invalid appleable user_id")
@@ -1281,7 +1750,7 @@ func (r *RootResolver) GiveApple(
 	return &appleableResolver{appleable}, nil
 }
 
-var InvalidCredentialsError = errors.New("invalid credentials")
+var InvalidCredentialsError = myerr.AuthenticationError{Message: "invalid credentials"}
 
 type LoginUserInput struct {
 	Login    string
@@ -1352,7 +1821,7 @@ func (r *RootResolver) MarkNotificationAsRead(
 ) (*graphql.ID, error) {
 	notification := &data.Notification{}
 	if err := notification.ID.Set(args.Input.NotificationID); err != nil {
-		return nil, errors.New("invalid notification id")
+		return nil, myerr.ValidationError{Field: "notificationId", Message: "invalid notification id"}
 	}
 
 	err := r.Repos.Notification().Delete(ctx, notification)
@@ -1400,7 +1869,7 @@ func (r *RootResolver) MarkAllStudyNotificationsAsRead(
 
 	notification := &data.Notification{}
 	if err := notification.StudyID.Set(args.Input.StudyID); err != nil {
-		return false, errors.New("invalid notification study_id")
+		return false, myerr.ValidationError{Field: "studyId", Message: "invalid notification study_id"}
 	}
 	if err := notification.UserID.Set(&viewer.ID); err != nil {
 		return false, errors.New("invalid notification user_id")
@@ -1412,6 +1881,221 @@ func (r *RootResolver) MarkAllStudyNotificationsAsRead(
 	return true, nil
 }
 
+type ModerateContentInput struct {
+	Action    string
+	Note      *string
+	SubjectID string
+}
+
+// ModerateContent takes an admin action on a subject, usually one that was
+// reported, and records it in the moderation log. Any open reports of the
+// subject are closed, except when it is restored.
+func (r *RootResolver) ModerateContent(
+	ctx context.Context,
+	args struct{ Input ModerateContentInput },
+) (*moderationActionResolver, error) {
+	if err := checkViewerIsSiteAdmin(ctx); err != nil {
+		return nil, err
+	}
+	viewer, ok := myctx.UserFromContext(ctx)
+	if !ok {
+		return nil, errors.New("viewer not found")
+	}
+	subjectID, err := mytype.ParseOID(args.Input.SubjectID)
+	if err != nil {
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
+		return nil, myerr.ValidationError{Field: "subjectId", Message: "invalid value for subjectId"}
+	}
+
+	tx, err, newTx := myctx.TransactionFromContext(ctx)
+	if err != nil {
+		return nil, err
+	} else if newTx {
+		defer data.RollbackTransaction(tx)
+	}
+	ctx = myctx.NewQueryerContext(ctx, tx)
+
+	subject, err := r.Repos.GetNode(ctx, subjectID)
+	if err != nil {
+		return nil, err
+	}
+	userID := subjectID
+	if subjectID.Type != "User" {
+		owned, ok := subject.(interface {
+			UserID() (*mytype.OID, error)
+		})
+		if !ok || !hideableTypes[subjectID.Type] {
+			return nil, myerr.ValidationError{Field: "subjectId", Message: "subject cannot be moderated"}
+		}
+		if userID, err = owned.UserID(); err != nil {
+			return nil, err
+		}
+	}
+
+	moderationAction := &data.ModerationAction{
+		ActorID:   viewer.ID,
+		SubjectID: *subjectID,
+		UserID:    *userID,
+	}
+	if err := moderationAction.Action.Set(args.Input.Action); err != nil {
+		return nil, err
+	}
+	if err := moderationAction.Note.Set(args.Input.Note); err != nil {
+		return nil, err
+	}
+
+	reportStatus := data.ContentReportResolved
+	switch args.Input.Action {
+	case data.ModerationActionDelete, data.ModerationActionHide:
+		if subjectID.Type == "User" {
+			return nil, myerr.ValidationError{Field: "action", Message: "users may only be warned, suspended or restored"}
+		}
+		if args.Input.Action == data.ModerationActionHide {
+			err = data.HideContent(tx, subjectID.String)
+		} else {
+			err = deleteModeratedNode(tx, subjectID)
+		}
+	case data.ModerationActionDismiss:
+		reportStatus = data.ContentReportDismissed
+	case data.ModerationActionRestore:
+		reportStatus = ""
+		if subjectID.Type == "User" {
+			err = data.UnsuspendUser(tx, userID.String)
+		} else {
+			err = data.RestoreContent(tx, subjectID.String)
+		}
+	case data.ModerationActionSuspend:
+		err = data.SuspendUser(tx, userID.String)
+	case data.ModerationActionWarn:
+		var user *data.User
+		if user, err = data.GetUserCredentials(tx, userID.String); err == nil {
+			note := ""
+			if args.Input.Note != nil {
+				note = *args.Input.Note
+			}
+			err = r.Svcs.Mail.SendModerationWarningMail(&service.SendModerationWarningMailInput{
+				Note:      note,
+				To:        user.PrimaryEmail.String,
+				UserLogin: user.Login.String,
+			})
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if reportStatus != "" {
+		if _, err := data.CloseContentReportBySubject(tx, subjectID.String, reportStatus); err != nil {
+			return nil, err
+		}
+	}
+	moderationAction, err = data.CreateModerationAction(tx, moderationAction)
+	if err != nil {
+		return nil, err
+	}
+
+	if newTx {
+		err := data.CommitTransaction(tx)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &moderationActionResolver{
+		Conf:             r.Conf,
+		ModerationAction: moderationAction,
+		Repos:            r.Repos,
+	}, nil
+}
+
+// hideableTypes are the types of nodes admins may hide or delete.
+var hideableTypes = map[string]bool{
+	"Activity":  true,
+	"Comment":   true,
+	"Course":    true,
+	"Lesson":    true,
+	"Study":     true,
+	"UserAsset": true,
+}
+
+// deleteModeratedNode deletes the node on behalf of an admin, who is not its
+// owner, so the repos' permission checks are skipped.
+func deleteModeratedNode(db data.Queryer, id *mytype.OID) error {
+	switch id.Type {
+	case "Activity":
+		return data.DeleteActivity(db, id.String)
+	case "Comment":
+		return data.DeleteComment(db, id.String)
+	case "Course":
+		return data.DeleteCourse(db, id.String)
+	case "Lesson":
+		return data.DeleteLesson(db, id.String)
+	case "Study":
+		return data.DeleteStudy(db, id.String)
+	case "UserAsset":
+		return data.DeleteUserAsset(db, id.String)
+	default:
+		return fmt.Errorf("invalid type '%s' for moderated node id", id.Type)
+	}
+}
+
+type MergeTopicsInput struct {
+	SourceTopicID string
+	TargetTopicID string
+}
+
+// MergeTopics moves everything topiced with the source topic to the target
+// topic, and then deletes the source topic, leaving its name as an alias of
+// the target topic.
+func (r *RootResolver) MergeTopics(
+	ctx context.Context,
+	args struct{ Input MergeTopicsInput },
+) (*topicResolver, error) {
+	if err := checkViewerIsSiteAdmin(ctx); err != nil {
+		return nil, err
+	}
+	sourceID, err := mytype.ParseOID(args.Input.SourceTopicID)
+	if err != nil || sourceID.Type != "Topic" {
+		return nil, myerr.ValidationError{Field: "sourceTopicId", Message: "invalid value for sourceTopicId"}
+	}
+	targetID, err := mytype.ParseOID(args.Input.TargetTopicID)
+	if err != nil || targetID.Type != "Topic" {
+		return nil, myerr.ValidationError{Field: "targetTopicId", Message: "invalid value for targetTopicId"}
+	}
+	if sourceID.String == targetID.String {
+		return nil, myerr.ValidationError{Field: "targetTopicId", Message: "topic cannot be merged into itself"}
+	}
+
+	tx, err, newTx := myctx.TransactionFromContext(ctx)
+	if err != nil {
+		return nil, err
+	} else if newTx {
+		defer data.RollbackTransaction(tx)
+	}
+	ctx = myctx.NewQueryerContext(ctx, tx)
+
+	if _, err := data.MergeTopics(tx, sourceID.String, targetID.String); err != nil {
+		return nil, err
+	}
+	topic, err := r.Repos.Topic().Get(ctx, targetID.String)
+	if err != nil {
+		return nil, err
+	}
+
+	if newTx {
+		err := data.CommitTransaction(tx)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &topicResolver{
+		Conf:  r.Conf,
+		Topic: topic,
+		Repos: r.Repos,
+	}, nil
+}
+
 type MoveActivityAssetInput struct {
 	ActivityID   string
 	AfterAssetID string
@@ -1424,14 +2108,14 @@ func (r *RootResolver) MoveActivityAsset(
 ) (*moveActivityAssetPayloadResolver, error) {
 	activityAsset := &data.ActivityAsset{}
 	if err := activityAsset.ActivityID.Set(args.Input.ActivityID); err != nil {
-		return nil, errors.New("invalid value for activityId")
+		return nil, myerr.ValidationError{Field: "activityId", Message: "invalid value for activityId"}
 	}
 	if err := activityAsset.AssetID.Set(args.Input.AssetID); err != nil {
-		return nil, errors.New("invalid value for assetId")
+		return nil, myerr.ValidationError{Field: "assetId", Message: "invalid value for assetId"}
 	}
 	afterAssetID, err := mytype.ParseOID(args.Input.AfterAssetID)
 	if err != nil {
-		return nil, errors.New("invalid value for afterAssetId")
+		return nil, myerr.ValidationError{Field: "afterAssetId", Message: "invalid value for afterAssetId"}
 	}
 
 	_, err = r.Repos.ActivityAsset().Move(ctx, activityAsset, afterAssetID.String)
@@ -1459,14 +2143,14 @@ func (r *RootResolver) MoveCourseLesson(
 ) (*moveCourseLessonPayloadResolver, error) {
 	courseLesson := &data.CourseLesson{}
 	if err := courseLesson.CourseID.Set(args.Input.CourseID); err != nil {
-		return nil, errors.New("invalid value for courseId")
+		return nil, myerr.ValidationError{Field: "courseId", Message: "invalid value for courseId"}
 	}
 	if err := courseLesson.LessonID.Set(args.Input.LessonID); err != nil {
-		return nil, errors.New("invalid value for lessonId")
+		return nil, myerr.ValidationError{Field: "lessonId", Message: "invalid value for lessonId"}
 	}
 	afterLessonID, err := mytype.ParseOID(args.Input.AfterLessonID)
 	if err != nil {
-		return nil, errors.New("invalid value for afterLessonId")
+		return nil, myerr.ValidationError{Field: "afterLessonId", Message: "invalid value for afterLessonId"}
 	}
 
 	_, err = r.Repos.CourseLesson().Move(ctx, courseLesson, afterLessonID.String)
@@ -1483,22 +2167,17 @@ func (r *RootResolver) MoveCourseLesson(
 }
 
 type PublishCourseInput struct {
-	CourseID string
+	CourseID  string
+	PublishAt *graphql.Time
 }
 
 func (r *RootResolver) PublishCourse(
 	ctx context.Context,
 	args struct{ Input PublishCourseInput },
 ) (*courseResolver, error) {
-	viewer, ok := myctx.UserFromContext(ctx)
-	if !ok {
-		err := errors.New("viewer not found")
-		mylog.Log.WithError(err).Error(util.Trace(""))
-		return nil, err
-	}
 	tx, err, newTx := myctx.TransactionFromContext(ctx)
 	if err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	} else if newTx {
 		defer data.RollbackTransaction(tx)
@@ -1507,65 +2186,39 @@ func (r *RootResolver) PublishCourse(
 
 	course := &data.Course{}
 	if err := course.ID.Set(args.Input.CourseID); err != nil {
-		return nil, errors.New("Invalid courseId")
-	}
-	if err := course.PublishedAt.Set(time.Now()); err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
-		return nil, myerr.SomethingWentWrongError
+		return nil, myerr.ValidationError{Field: "courseId", Message: "Invalid courseId"}
 	}
 
-	isPublishable, err := r.Repos.Course().IsPublishable(ctx, course.ID.String)
-	if err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
-		return nil, err
-	}
-	if !isPublishable {
-		err := errors.New("course is not publishable")
-		mylog.Log.WithError(err).Error(util.Trace(""))
-		return nil, err
-	}
-
-	coursePermit, err := r.Repos.Course().Update(ctx, course)
-	if err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
-		return nil, err
-	}
-
-	studyID, err := coursePermit.StudyID()
-	if err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
-		return nil, myerr.SomethingWentWrongError
-	}
+	var coursePermit *repo.CoursePermit
+	if args.Input.PublishAt != nil {
+		if !args.Input.PublishAt.Time.After(time.Now()) {
+			return nil, myerr.ValidationError{Field: "publishAt", Message: "must be in the future"}
+		}
+		if err := course.ScheduledPublishAt.Set(args.Input.PublishAt.Time); err != nil {
+			mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
+			return nil, myerr.SomethingWentWrongError
+		}
 
-	courseLessons, err := r.Repos.CourseLesson().GetByCourse(ctx, course.ID.String, nil)
-	if err != nil {
-		return nil, err
-	}
-	lessonIDs := make([]*mytype.OID, len(courseLessons))
-	for i, cl := range courseLessons {
-		lessonID, err := cl.LessonID()
+		isPublishable, err := r.Repos.Course().IsPublishable(ctx, course.ID.String)
 		if err != nil {
+			mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 			return nil, err
 		}
-		lessonIDs[i] = lessonID
-	}
-
-	for _, lid := range lessonIDs {
-		eventPayload, err := data.NewLessonAddedToCoursePayload(
-			lid,
-			&course.ID,
-		)
-		if err != nil {
-			mylog.Log.WithError(err).Error(util.Trace(""))
+		if !isPublishable {
+			err := myerr.ValidationError{Message: "course is not publishable"}
+			mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 			return nil, err
 		}
-		event, err := data.NewLessonEvent(eventPayload, studyID, &viewer.ID, true)
+
+		coursePermit, err = r.Repos.Course().Update(ctx, course)
 		if err != nil {
-			mylog.Log.WithError(err).Error(util.Trace(""))
+			mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 			return nil, err
 		}
-		if _, err := r.Repos.Event().Create(ctx, event); err != nil {
-			mylog.Log.WithError(err).Error(util.Trace(""))
+	} else {
+		coursePermit, err = r.Repos.PublishCourse(ctx, course.ID.String)
+		if err != nil {
+			mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 			return nil, err
 		}
 	}
@@ -1573,7 +2226,7 @@ func (r *RootResolver) PublishCourse(
 	if newTx {
 		err := data.CommitTransaction(tx)
 		if err != nil {
-			mylog.Log.WithError(err).Error(util.Trace(""))
+			mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 			return nil, err
 		}
 	}
@@ -1586,7 +2239,8 @@ func (r *RootResolver) PublishCourse(
 }
 
 type PublishLessonDraftInput struct {
-	LessonID string
+	LessonID  string
+	PublishAt *graphql.Time
 }
 
 func (r *RootResolver) PublishLessonDraft(
@@ -1601,69 +2255,33 @@ func (r *RootResolver) PublishLessonDraft(
 	}
 	ctx = myctx.NewQueryerContext(ctx, tx)
 
-	currentLessonPermit, err := r.Repos.Lesson().Get(ctx, args.Input.LessonID)
-	if err != nil {
-		return nil, errors.New("lesson not found")
-	}
-	draft, err := currentLessonPermit.Draft()
-	if err != nil {
-		return nil, err
-	}
-	studyID, err := currentLessonPermit.StudyID()
-	if err != nil {
-		return nil, err
-	}
-	userID, err := currentLessonPermit.UserID()
-	if err != nil {
-		return nil, err
+	if _, err := r.Repos.Lesson().Get(ctx, args.Input.LessonID); err != nil {
+		return nil, myerr.NotFoundError{Resource: "lesson"}
 	}
 
 	lesson := &data.Lesson{}
 	if err := lesson.ID.Set(args.Input.LessonID); err != nil {
-		return nil, errors.New("Invalid lessonId")
-	}
-
-	if err := lesson.Body.Set(draft); err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
-		return nil, myerr.SomethingWentWrongError
+		return nil, myerr.ValidationError{Field: "lessonId", Message: "Invalid lessonId"}
 	}
 
-	err = r.Repos.ParseLessonBodyForEvents(
-		ctx,
-		&lesson.Body,
-		&lesson.ID,
-		studyID,
-		userID,
-	)
-	if err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
-		return nil, err
-	}
-
-	body, err, updated := r.Repos.ReplaceMarkdownRefsWithLinks(
-		ctx,
-		lesson.Body,
-		studyID.String,
-	)
-	if err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
-		return nil, err
-	}
-	if updated {
-		if err := lesson.Body.Set(body); err != nil {
-			mylog.Log.WithError(err).Error(util.Trace(""))
+	var lessonPermit *repo.LessonPermit
+	if args.Input.PublishAt != nil {
+		if !args.Input.PublishAt.Time.After(time.Now()) {
+			return nil, myerr.ValidationError{Field: "publishAt", Message: "must be in the future"}
+		}
+		if err := lesson.ScheduledPublishAt.Set(args.Input.PublishAt.Time); err != nil {
+			mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
+			return nil, myerr.SomethingWentWrongError
+		}
+		lessonPermit, err = r.Repos.Lesson().Update(ctx, lesson)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		lessonPermit, err = r.Repos.PublishLessonDraft(ctx, lesson.ID.String)
+		if err != nil {
 			return nil, err
 		}
-	}
-
-	if err := lesson.PublishedAt.Set(time.Now()); err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
-		return nil, myerr.SomethingWentWrongError
-	}
-
-	lessonPermit, err := r.Repos.Lesson().Update(ctx, lesson)
-	if err != nil {
-		return nil, err
 	}
 
 	if newTx {
@@ -1692,7 +2310,7 @@ func (r *RootResolver) PublishCommentDraft(
 ) (*commentResolver, error) {
 	currentCommentPermit, err := r.Repos.Comment().Get(ctx, args.Input.CommentID)
 	if err != nil {
-		return nil, errors.New("comment not found")
+		return nil, myerr.NotFoundError{Resource: "comment"}
 	}
 	draft, err := currentCommentPermit.Draft()
 	if err != nil {
@@ -1712,16 +2330,16 @@ func (r *RootResolver) PublishCommentDraft(
 	}
 
 	if strings.TrimSpace(draft) == "" {
-		return nil, errors.New("comment draft must not be empty")
+		return nil, myerr.ValidationError{Message: "comment draft must not be empty"}
 	}
 
 	comment := &data.Comment{}
 	if err := comment.ID.Set(args.Input.CommentID); err != nil {
-		return nil, errors.New("Invalid commentId")
+		return nil, myerr.ValidationError{Field: "commentId", Message: "Invalid commentId"}
 	}
 
 	if err := comment.Body.Set(draft); err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, myerr.SomethingWentWrongError
 	}
 
@@ -1734,7 +2352,7 @@ func (r *RootResolver) PublishCommentDraft(
 			userID,
 		)
 		if err != nil {
-			mylog.Log.WithError(err).Error(util.Trace(""))
+			mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 			return nil, err
 		}
 	} else if commentableID.Type == "UserAsset" {
@@ -1746,7 +2364,7 @@ func (r *RootResolver) PublishCommentDraft(
 			userID,
 		)
 		if err != nil {
-			mylog.Log.WithError(err).Error(util.Trace(""))
+			mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 			return nil, err
 		}
 	}
@@ -1757,18 +2375,18 @@ func (r *RootResolver) PublishCommentDraft(
 		studyID.String,
 	)
 	if err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 	if updated {
 		if err := comment.Body.Set(body); err != nil {
-			mylog.Log.WithError(err).Error(util.Trace(""))
+			mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 			return nil, err
 		}
 	}
 
 	if err := comment.PublishedAt.Set(time.Now()); err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, myerr.SomethingWentWrongError
 	}
 
@@ -1796,12 +2414,12 @@ func (r *RootResolver) RemoveActivityAsset(
 	viewer, ok := myctx.UserFromContext(ctx)
 	if !ok {
 		err := errors.New("viewer not found")
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 	tx, err, newTx := myctx.TransactionFromContext(ctx)
 	if err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	} else if newTx {
 		defer data.RollbackTransaction(tx)
@@ -1810,27 +2428,27 @@ func (r *RootResolver) RemoveActivityAsset(
 
 	activityAsset := &data.ActivityAsset{}
 	if err := activityAsset.ActivityID.Set(args.Input.ActivityID); err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
-		return nil, errors.New("invalid value for activityId")
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
+		return nil, myerr.ValidationError{Field: "activityId", Message: "invalid value for activityId"}
 	}
 	if err := activityAsset.AssetID.Set(args.Input.AssetID); err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
-		return nil, errors.New("invalid value for assetId")
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
+		return nil, myerr.ValidationError{Field: "assetId", Message: "invalid value for assetId"}
 	}
 
 	if err = r.Repos.ActivityAsset().Disconnect(ctx, activityAsset); err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 
 	activity, err := r.Repos.Activity().Pull(ctx, args.Input.ActivityID)
 	if err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
-		return nil, errors.New("activity not found")
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
+		return nil, myerr.NotFoundError{Resource: "activity"}
 	}
 	studyID, err := activity.StudyID()
 	if err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, myerr.SomethingWentWrongError
 	}
 
@@ -1839,23 +2457,23 @@ func (r *RootResolver) RemoveActivityAsset(
 		&activityAsset.ActivityID,
 	)
 	if err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 	event, err := data.NewUserAssetEvent(eventPayload, studyID, &viewer.ID, true)
 	if err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 	if _, err := r.Repos.Event().Create(ctx, event); err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 
 	if newTx {
 		err := data.CommitTransaction(tx)
 		if err != nil {
-			mylog.Log.WithError(err).Error(util.Trace(""))
+			mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 			return nil, err
 		}
 	}
@@ -1880,12 +2498,12 @@ func (r *RootResolver) RemoveCourseLesson(
 	viewer, ok := myctx.UserFromContext(ctx)
 	if !ok {
 		err := errors.New("viewer not found")
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 	tx, err, newTx := myctx.TransactionFromContext(ctx)
 	if err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	} else if newTx {
 		defer data.RollbackTransaction(tx)
@@ -1894,38 +2512,38 @@ func (r *RootResolver) RemoveCourseLesson(
 
 	courseLesson := &data.CourseLesson{}
 	if err := courseLesson.CourseID.Set(args.Input.CourseID); err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
-		return nil, errors.New("invalid value for courseId")
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
+		return nil, myerr.ValidationError{Field: "courseId", Message: "invalid value for courseId"}
 	}
 	if err := courseLesson.LessonID.Set(args.Input.LessonID); err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
-		return nil, errors.New("invalid value for lessonId")
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
+		return nil, myerr.ValidationError{Field: "lessonId", Message: "invalid value for lessonId"}
 	}
 
 	if err = r.Repos.CourseLesson().Disconnect(ctx, courseLesson); err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 
 	course, err := r.Repos.Course().Pull(ctx, args.Input.CourseID)
 	if err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
-		return nil, errors.New("course not found")
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
+		return nil, myerr.NotFoundError{Resource: "course"}
 	}
 	lesson, err := r.Repos.Lesson().Pull(ctx, args.Input.LessonID)
 	if err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
-		return nil, errors.New("lesson not found")
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
+		return nil, myerr.NotFoundError{Resource: "lesson"}
 	}
 	studyID, err := lesson.StudyID()
 	if err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, myerr.SomethingWentWrongError
 	}
 
 	courseIsPublished, err := course.IsPublished()
 	if err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, myerr.SomethingWentWrongError
 	}
 	if courseIsPublished {
@@ -1934,16 +2552,16 @@ func (r *RootResolver) RemoveCourseLesson(
 			&courseLesson.CourseID,
 		)
 		if err != nil {
-			mylog.Log.WithError(err).Error(util.Trace(""))
+			mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 			return nil, err
 		}
 		event, err := data.NewLessonEvent(eventPayload, studyID, &viewer.ID, true)
 		if err != nil {
-			mylog.Log.WithError(err).Error(util.Trace(""))
+			mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 			return nil, err
 		}
 		if _, err := r.Repos.Event().Create(ctx, event); err != nil {
-			mylog.Log.WithError(err).Error(util.Trace(""))
+			mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 			return nil, err
 		}
 	}
@@ -1951,7 +2569,7 @@ func (r *RootResolver) RemoveCourseLesson(
 	if newTx {
 		err := data.CommitTransaction(tx)
 		if err != nil {
-			mylog.Log.WithError(err).Error(util.Trace(""))
+			mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 			return nil, err
 		}
 	}
@@ -1988,51 +2606,144 @@ func (r *RootResolver) RemoveLabel(
 
 	labeled := &data.Labeled{}
 	if err := labeled.LabelID.Set(args.Input.LabelID); err != nil {
-		return nil, errors.New("invalid labeled label_id")
+		return nil, myerr.ValidationError{Field: "labelId", Message: "invalid labeled label_id"}
 	}
 	if err := labeled.LabelableID.Set(args.Input.LabelableID); err != nil {
-		return nil, errors.New("invalid labeled labelable_id")
+		return nil, myerr.ValidationError{Field: "labelableId", Message: "invalid labeled labelable_id"}
+	}
+
+	if err := r.Repos.Labeled().Disconnect(ctx, labeled); err != nil {
+		return nil, err
+	}
+
+	if labeled.LabelableID.Type == mytype.LessonNodeType.String() {
+		lesson, err := r.Repos.Lesson().Pull(ctx, args.Input.LabelableID)
+		if err != nil {
+			mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
+			return nil, myerr.NotFoundError{Resource: "lesson"}
+		}
+		isPublished, err := lesson.IsPublished()
+		if err != nil {
+			mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
+			return nil, myerr.SomethingWentWrongError
+		}
+		studyID, err := lesson.StudyID()
+		if err != nil {
+			mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
+			return nil, myerr.SomethingWentWrongError
+		}
+
+		eventPayload, err := data.NewLessonUnlabeledPayload(
+			&labeled.LabelableID,
+			&labeled.LabelID,
+		)
+		if err != nil {
+			mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
+			return nil, err
+		}
+		isPublic := isPublished
+		event, err := data.NewLessonEvent(eventPayload, studyID, &viewer.ID, isPublic)
+		if err != nil {
+			mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
+			return nil, err
+		}
+		if _, err := r.Repos.Event().Create(ctx, event); err != nil {
+			mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
+			return nil, err
+		}
+	}
+
+	if newTx {
+		err := data.CommitTransaction(tx)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &removeLabelPayloadResolver{
+		Conf:        r.Conf,
+		LabelID:     &labeled.LabelID,
+		LabelableID: &labeled.LabelableID,
+		Repos:       r.Repos,
+	}, nil
+}
+
+type RemoveLessonPrerequisiteInput struct {
+	LessonID       string
+	PrerequisiteID string
+}
+
+func (r *RootResolver) RemoveLessonPrerequisite(
+	ctx context.Context,
+	args struct{ Input RemoveLessonPrerequisiteInput },
+) (*removeLessonPrerequisitePayloadResolver, error) {
+	tx, err, newTx := myctx.TransactionFromContext(ctx)
+	if err != nil {
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
+		return nil, err
+	} else if newTx {
+		defer data.RollbackTransaction(tx)
+	}
+	ctx = myctx.NewQueryerContext(ctx, tx)
+
+	lessonPrerequisite := &data.LessonPrerequisite{}
+	if err := lessonPrerequisite.LessonID.Set(args.Input.LessonID); err != nil {
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
+		return nil, myerr.ValidationError{Field: "lessonId", Message: "invalid value for lessonId"}
+	}
+	if err := lessonPrerequisite.PrerequisiteID.Set(args.Input.PrerequisiteID); err != nil {
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
+		return nil, myerr.ValidationError{Field: "prerequisiteId", Message: "invalid value for prerequisiteId"}
+	}
+
+	if err := r.Repos.LessonPrerequisite().Disconnect(ctx, lessonPrerequisite); err != nil {
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
+		return nil, err
+	}
+
+	if newTx {
+		err := data.CommitTransaction(tx)
+		if err != nil {
+			mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
+			return nil, err
+		}
+	}
+
+	return &removeLessonPrerequisitePayloadResolver{
+		Conf:           r.Conf,
+		LessonID:       &lessonPrerequisite.LessonID,
+		PrerequisiteID: &lessonPrerequisite.PrerequisiteID,
+		Repos:          r.Repos,
+	}, nil
+}
+
+type RemoveTopicAliasInput struct {
+	Name string
+}
+
+func (r *RootResolver) RemoveTopicAlias(
+	ctx context.Context,
+	args struct{ Input RemoveTopicAliasInput },
+) (*topicResolver, error) {
+	if err := checkViewerIsSiteAdmin(ctx); err != nil {
+		return nil, err
+	}
+
+	tx, err, newTx := myctx.TransactionFromContext(ctx)
+	if err != nil {
+		return nil, err
+	} else if newTx {
+		defer data.RollbackTransaction(tx)
 	}
+	ctx = myctx.NewQueryerContext(ctx, tx)
 
-	if err := r.Repos.Labeled().Disconnect(ctx, labeled); err != nil {
+	topicID, err := data.DeleteTopicAlias(tx, args.Input.Name)
+	if err != nil {
 		return nil, err
 	}
-
-	if labeled.LabelableID.Type == mytype.LessonNodeType.String() {
-		lesson, err := r.Repos.Lesson().Pull(ctx, args.Input.LabelableID)
-		if err != nil {
-			mylog.Log.WithError(err).Error(util.Trace(""))
-			return nil, errors.New("lesson not found")
-		}
-		isPublished, err := lesson.IsPublished()
-		if err != nil {
-			mylog.Log.WithError(err).Error(util.Trace(""))
-			return nil, myerr.SomethingWentWrongError
-		}
-		studyID, err := lesson.StudyID()
-		if err != nil {
-			mylog.Log.WithError(err).Error(util.Trace(""))
-			return nil, myerr.SomethingWentWrongError
-		}
-
-		eventPayload, err := data.NewLessonUnlabeledPayload(
-			&labeled.LabelableID,
-			&labeled.LabelID,
-		)
-		if err != nil {
-			mylog.Log.WithError(err).Error(util.Trace(""))
-			return nil, err
-		}
-		isPublic := isPublished
-		event, err := data.NewLessonEvent(eventPayload, studyID, &viewer.ID, isPublic)
-		if err != nil {
-			mylog.Log.WithError(err).Error(util.Trace(""))
-			return nil, err
-		}
-		if _, err := r.Repos.Event().Create(ctx, event); err != nil {
-			mylog.Log.WithError(err).Error(util.Trace(""))
-			return nil, err
-		}
+	topic, err := r.Repos.Topic().Get(ctx, topicID)
+	if err != nil {
+		return nil, err
 	}
 
 	if newTx {
@@ -2042,11 +2753,10 @@ func (r *RootResolver) RemoveLabel(
 		}
 	}
 
-	return &removeLabelPayloadResolver{
-		Conf:        r.Conf,
-		LabelID:     &labeled.LabelID,
-		LabelableID: &labeled.LabelableID,
-		Repos:       r.Repos,
+	return &topicResolver{
+		Conf:  r.Conf,
+		Topic: topic,
+		Repos: r.Repos,
 	}, nil
 }
 
@@ -2073,23 +2783,23 @@ func (r *RootResolver) RequestEmailVerification(
 	emailPermit, err := r.Repos.Email().GetByValue(ctx, args.Input.Email)
 	if err != nil {
 		if err == data.ErrNotFound {
-			return false, errors.New("email not found")
+			return false, myerr.NotFoundError{Resource: "email"}
 		}
 		return false, err
 	}
 	email := emailPermit.Get()
 
 	if email.VerifiedAt.Status != pgtype.Null {
-		return false, errors.New("email already verified")
+		return false, myerr.ValidationError{Message: "email already verified"}
 	}
 
 	evt := &data.EVT{}
 	if err := evt.EmailID.Set(&email.ID); err != nil {
-		mylog.Log.WithError(err).Error("failed to set evt email_id")
+		mylog.Log.WithContext(ctx).WithError(err).Error("failed to set evt email_id")
 		return false, myerr.SomethingWentWrongError
 	}
 	if err := evt.UserID.Set(&email.UserID); err != nil {
-		mylog.Log.WithError(err).Error("failed to set evt user_id")
+		mylog.Log.WithContext(ctx).WithError(err).Error("failed to set evt user_id")
 		return false, myerr.SomethingWentWrongError
 	}
 
@@ -2129,7 +2839,7 @@ func (r *RootResolver) RequestPasswordReset(
 ) (*prtResolver, error) {
 	tx, err, newTx := myctx.TransactionFromContext(ctx)
 	if err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, myerr.SomethingWentWrongError
 	} else if newTx {
 		defer data.RollbackTransaction(tx)
@@ -2139,15 +2849,15 @@ func (r *RootResolver) RequestPasswordReset(
 	email, err := data.GetEmailByValue(tx, args.Input.Email)
 	if err != nil {
 		if err == data.ErrNotFound {
-			return nil, errors.New("email not found")
+			return nil, myerr.NotFoundError{Resource: "email"}
 		}
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, myerr.SomethingWentWrongError
 	}
 	user, err := data.GetUser(tx, email.UserID.String)
 	if err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
-		return nil, errors.New("no user with that email was found")
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
+		return nil, myerr.ValidationError{Message: "no user with that email was found"}
 	}
 
 	ctx = myctx.NewUserContext(ctx, user)
@@ -2155,33 +2865,33 @@ func (r *RootResolver) RequestPasswordReset(
 	requestIp, ok := myctx.RequesterIpFromContext(ctx)
 	if !ok {
 		err := errors.New("requester ip not found")
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 
 	prt := &data.PRT{}
 	if err := prt.EmailID.Set(&email.ID); err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, myerr.SomethingWentWrongError
 	}
 	if err := prt.UserID.Set(&email.UserID); err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, myerr.SomethingWentWrongError
 	}
 	if err := prt.RequestIP.Set(requestIp); err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, myerr.SomethingWentWrongError
 	}
 
 	prtPermit, err := r.Repos.PRT().Create(ctx, prt)
 	if err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 
 	token, err := prtPermit.Token()
 	if err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 
@@ -2204,7 +2914,7 @@ func (r *RootResolver) RequestPasswordReset(
 	if newTx {
 		err := data.CommitTransaction(tx)
 		if err != nil {
-			mylog.Log.WithError(err).Error(util.Trace(""))
+			mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 			return resolver, err
 		}
 	}
@@ -2212,6 +2922,88 @@ func (r *RootResolver) RequestPasswordReset(
 	return resolver, nil
 }
 
+type ReportContentInput struct {
+	Note      *string
+	Reason    string
+	SubjectID string
+}
+
+// ReportContent reports a node, which the viewer can read, to the site admins.
+func (r *RootResolver) ReportContent(
+	ctx context.Context,
+	args struct{ Input ReportContentInput },
+) (*contentReportResolver, error) {
+	viewer, ok := myctx.UserFromContext(ctx)
+	if !ok {
+		return nil, errors.New("viewer not found")
+	}
+	if viewer.Login.String == repo.Guest {
+		return nil, repo.ErrAccessDenied
+	}
+	db, ok := myctx.QueryerFromContext(ctx)
+	if !ok {
+		return nil, &myctx.ErrNotFound{Name: "queryer"}
+	}
+
+	subjectID, err := mytype.ParseOID(args.Input.SubjectID)
+	if err != nil {
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
+		return nil, myerr.ValidationError{Field: "subjectId", Message: "invalid value for subjectId"}
+	}
+	if _, err := r.Repos.GetNode(ctx, subjectID); err != nil {
+		return nil, err
+	}
+
+	contentReport := &data.ContentReport{
+		ReporterID: viewer.ID,
+		SubjectID:  *subjectID,
+	}
+	if err := contentReport.Note.Set(args.Input.Note); err != nil {
+		return nil, err
+	}
+	if err := contentReport.Reason.Set(args.Input.Reason); err != nil {
+		return nil, err
+	}
+	contentReport, err = data.CreateContentReport(db, contentReport)
+	if err != nil {
+		return nil, err
+	}
+
+	return &contentReportResolver{
+		Conf:          r.Conf,
+		ContentReport: contentReport,
+		Repos:         r.Repos,
+	}, nil
+}
+
+// RequestViewerDataExport requests an export of the viewer's data. The export
+// is built in the background, and the viewer is mailed a link to download it.
+func (r *RootResolver) RequestViewerDataExport(
+	ctx context.Context,
+) (bool, error) {
+	viewer, ok := myctx.UserFromContext(ctx)
+	if !ok {
+		err := errors.New("viewer not found")
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
+		return false, err
+	}
+	if viewer.Login.String == repo.Guest {
+		return false, repo.ErrAccessDenied
+	}
+	db, ok := myctx.QueryerFromContext(ctx)
+	if !ok {
+		err := &myctx.ErrNotFound{Name: "queryer"}
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
+		return false, err
+	}
+
+	if _, err := data.CreateDataExport(db, viewer.ID.String); err != nil {
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
+		return false, err
+	}
+	return true, nil
+}
+
 type ResetLessonDraftInput struct {
 	LessonID string
 }
@@ -2222,7 +3014,7 @@ func (r *RootResolver) ResetLessonDraft(
 ) (*lessonResolver, error) {
 	currentLessonPermit, err := r.Repos.Lesson().Get(ctx, args.Input.LessonID)
 	if err != nil {
-		return nil, errors.New("lesson not found")
+		return nil, myerr.NotFoundError{Resource: "lesson"}
 	}
 	body, err := currentLessonPermit.Body()
 	if err != nil {
@@ -2235,7 +3027,7 @@ func (r *RootResolver) ResetLessonDraft(
 
 	lesson := &data.Lesson{}
 	if err := lesson.ID.Set(args.Input.LessonID); err != nil {
-		return nil, errors.New("Invalid lessonId")
+		return nil, myerr.ValidationError{Field: "lessonId", Message: "Invalid lessonId"}
 	}
 
 	draft, err, _ := r.Repos.ReplaceMarkdownLinksWithRefs(
@@ -2244,11 +3036,11 @@ func (r *RootResolver) ResetLessonDraft(
 		studyID.String,
 	)
 	if err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 	if err := lesson.Draft.Set(draft); err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 
@@ -2279,7 +3071,7 @@ func (r *RootResolver) ResetCommentDraft(
 		args.Input.CommentID,
 	)
 	if err != nil {
-		return nil, errors.New("comment not found")
+		return nil, myerr.NotFoundError{Resource: "comment"}
 	}
 	body, err := currentCommentPermit.Body()
 	if err != nil {
@@ -2292,7 +3084,7 @@ func (r *RootResolver) ResetCommentDraft(
 
 	comment := &data.Comment{}
 	if err := comment.ID.Set(args.Input.CommentID); err != nil {
-		return nil, errors.New("Invalid commentId")
+		return nil, myerr.ValidationError{Field: "commentId", Message: "Invalid commentId"}
 	}
 
 	draft, err, _ := r.Repos.ReplaceMarkdownLinksWithRefs(
@@ -2301,11 +3093,11 @@ func (r *RootResolver) ResetCommentDraft(
 		studyID.String,
 	)
 	if err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 	if err := comment.Draft.Set(draft); err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	}
 
@@ -2321,6 +3113,33 @@ func (r *RootResolver) ResetCommentDraft(
 	}, nil
 }
 
+func (r *RootResolver) ResetFeedToken(
+	ctx context.Context,
+) (string, error) {
+	viewer, ok := myctx.UserFromContext(ctx)
+	if !ok {
+		err := errors.New("viewer not found")
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
+		return "", err
+	}
+	if viewer.Login.String == repo.Guest {
+		return "", repo.ErrAccessDenied
+	}
+	db, ok := myctx.QueryerFromContext(ctx)
+	if !ok {
+		err := &myctx.ErrNotFound{Name: "queryer"}
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
+		return "", err
+	}
+
+	feedToken, err := data.ResetFeedToken(db, viewer.ID.String)
+	if err != nil {
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
+		return "", err
+	}
+	return feedToken.Token.String, nil
+}
+
 type ResetPasswordInput struct {
 	Email    string
 	Token    string
@@ -2353,20 +3172,20 @@ func (r *RootResolver) ResetPassword(
 	prt := prtPermit.Get()
 
 	if prt.ExpiresAt.Time.Before(time.Now()) {
-		return false, errors.New("token has expired")
+		return false, myerr.ValidationError{Field: "token", Message: "token has expired"}
 	}
 
 	if prt.EndedAt.Status == pgtype.Present {
-		return false, errors.New("token has already ended")
+		return false, myerr.ValidationError{Field: "token", Message: "token has already ended"}
 	}
 
 	if err = user.Password.Set(args.Input.Password); err != nil {
-		mylog.Log.WithError(err).Error("failed to set password")
-		return false, err
+		mylog.Log.WithContext(ctx).WithError(err).Error("failed to set password")
+		return false, myerr.ValidationError{Field: "password", Message: err.Error()}
 	}
 	if err := user.Password.CheckStrength(mytype.Weak); err != nil {
-		mylog.Log.WithError(err).Error("password failed strength check")
-		return false, err
+		mylog.Log.WithContext(ctx).WithError(err).Error("password failed strength check")
+		return false, myerr.ValidationError{Field: "password", Message: err.Error()}
 	}
 
 	if _, err := r.Repos.User().UpdateAccount(ctx, user); err != nil {
@@ -2448,6 +3267,101 @@ func (r *RootResolver) ResetPassword(
 //   }, nil
 // }
 
+type RevokeStudyAccessInput struct {
+	StudyID string
+	UserID  string
+}
+
+func (r *RootResolver) RevokeStudyAccess(
+	ctx context.Context,
+	args struct{ Input RevokeStudyAccessInput },
+) (*studyResolver, error) {
+	tx, err, newTx := myctx.TransactionFromContext(ctx)
+	if err != nil {
+		return nil, err
+	} else if newTx {
+		defer data.RollbackTransaction(tx)
+	}
+	ctx = myctx.NewQueryerContext(ctx, tx)
+
+	studyID, err := mytype.ParseOID(args.Input.StudyID)
+	if err != nil || studyID.Type != "Study" {
+		return nil, myerr.ValidationError{Field: "studyId", Message: "invalid value for studyId"}
+	}
+	userID, err := mytype.ParseOID(args.Input.UserID)
+	if err != nil || userID.Type != "User" {
+		return nil, myerr.ValidationError{Field: "userId", Message: "invalid value for userId"}
+	}
+	study, err := r.getAdministeredStudy(ctx, studyID.String)
+	if err != nil {
+		return nil, err
+	}
+	if err := r.Repos.Study().RevokeAccess(ctx, studyID.String, userID.String); err != nil {
+		if err == data.ErrNotFound {
+			return nil, myerr.ValidationError{Field: "userId", Message: "user has no access to the study"}
+		}
+		return nil, err
+	}
+
+	if newTx {
+		err := data.CommitTransaction(tx)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &studyResolver{Study: study, Conf: r.Conf, Repos: r.Repos}, nil
+}
+
+type RevokeStudyInvitationInput struct {
+	InvitationID string
+}
+
+func (r *RootResolver) RevokeStudyInvitation(
+	ctx context.Context,
+	args struct{ Input RevokeStudyInvitationInput },
+) (*studyInvitationResolver, error) {
+	tx, err, newTx := myctx.TransactionFromContext(ctx)
+	if err != nil {
+		return nil, err
+	} else if newTx {
+		defer data.RollbackTransaction(tx)
+	}
+	ctx = myctx.NewQueryerContext(ctx, tx)
+
+	invitationID, err := mytype.ParseOID(args.Input.InvitationID)
+	if err != nil || invitationID.Type != "StudyInvitation" {
+		return nil, myerr.ValidationError{Field: "invitationId", Message: "invalid value for invitationId"}
+	}
+	invitation, err := data.GetStudyInvitation(tx, invitationID.String)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := r.getAdministeredStudy(ctx, invitation.StudyID.String); err != nil {
+		return nil, err
+	}
+	invitation, err = data.RevokeStudyInvitation(tx, invitationID.String)
+	if err != nil {
+		if err == data.ErrNotFound {
+			return nil, myerr.ValidationError{Field: "invitationId", Message: "invitation already revoked"}
+		}
+		return nil, err
+	}
+
+	if newTx {
+		err := data.CommitTransaction(tx)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &studyInvitationResolver{
+		Conf:            r.Conf,
+		Repos:           r.Repos,
+		StudyInvitation: invitation,
+	}, nil
+}
+
 type TakeAppleInput struct {
 	AppleableID string
 }
@@ -2470,7 +3384,7 @@ func (r *RootResolver) TakeApple(
 
 	appled := &data.Appled{}
 	if err := appled.AppleableID.Set(args.Input.AppleableID); err != nil {
-		return nil, errors.New("invalid appleable id")
+		return nil, myerr.ValidationError{Field: "appleableId", Message: "invalid appleable id"}
 	}
 	if err := appled.UserID.Set(&viewer.ID); err != nil {
 		return nil, errors.New("invalid appleable user_id")
@@ -2502,6 +3416,47 @@ func (r *RootResolver) TakeApple(
 	return &appleableResolver{appleable}, nil
 }
 
+type UnblockUserInput struct {
+	UserID string
+}
+
+func (r *RootResolver) UnblockUser(
+	ctx context.Context,
+	args struct{ Input UnblockUserInput },
+) (*userResolver, error) {
+	tx, err, newTx := myctx.TransactionFromContext(ctx)
+	if err != nil {
+		return nil, err
+	} else if newTx {
+		defer data.RollbackTransaction(tx)
+	}
+	ctx = myctx.NewQueryerContext(ctx, tx)
+
+	userID, err := mytype.ParseOID(args.Input.UserID)
+	if err != nil || userID.Type != "User" {
+		return nil, myerr.ValidationError{Field: "userId", Message: "invalid value for userId"}
+	}
+	user, err := r.Repos.User().Get(ctx, userID.String)
+	if err != nil {
+		return nil, err
+	}
+	if err := r.Repos.User().Unblock(ctx, userID.String); err != nil {
+		if err == data.ErrNotFound {
+			return nil, myerr.ValidationError{Field: "userId", Message: "user is not blocked"}
+		}
+		return nil, err
+	}
+
+	if newTx {
+		err := data.CommitTransaction(tx)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &userResolver{User: user, Conf: r.Conf, Repos: r.Repos}, nil
+}
+
 type UpdateEmailInput struct {
 	EmailID string
 	Type    *string
@@ -2526,12 +3481,12 @@ func (r *RootResolver) UpdateEmail(
 	email := emailPermit.Get()
 
 	if email.VerifiedAt.Status == pgtype.Null {
-		return nil, errors.New("cannot update unverified email")
+		return nil, myerr.ValidationError{Message: "cannot update unverified email"}
 	}
 
 	emailType, err := mytype.ParseEmailType(*args.Input.Type)
 	if err != nil {
-		return nil, errors.New("invalid email type")
+		return nil, myerr.ValidationError{Field: "type", Message: "invalid email type"}
 	}
 	viewer, ok := myctx.UserFromContext(ctx)
 	if !ok {
@@ -2632,13 +3587,13 @@ func (r *RootResolver) UpdateEnrollment(
 
 	enrolled := &data.Enrolled{}
 	if err := enrolled.EnrollableID.Set(args.Input.EnrollableID); err != nil {
-		return nil, errors.New("invalid enrollable id")
+		return nil, myerr.ValidationError{Field: "enrollableId", Message: "invalid enrollable id"}
 	}
 	if err := enrolled.UserID.Set(&viewer.ID); err != nil {
 		return nil, errors.New("invalid enrollable user_id")
 	}
 	if err := enrolled.Status.Set(args.Input.Status); err != nil {
-		return nil, errors.New("invalid enrolled status")
+		return nil, myerr.ValidationError{Field: "status", Message: "invalid enrolled status"}
 	}
 	if _, err := r.Repos.Enrolled().Pull(ctx, enrolled); err != nil {
 		if err != data.ErrNotFound {
@@ -2692,17 +3647,17 @@ func (r *RootResolver) UpdateLabel(
 ) (*labelResolver, error) {
 	label := &data.Label{}
 	if err := label.ID.Set(args.Input.LabelID); err != nil {
-		return nil, errors.New("Invalid labelId")
+		return nil, myerr.ValidationError{Field: "labelId", Message: "Invalid labelId"}
 	}
 
 	if args.Input.Color != nil {
 		if err := label.Color.Set(args.Input.Color); err != nil {
-			return nil, errors.New("Invalid color")
+			return nil, myerr.ValidationError{Field: "color", Message: "Invalid color"}
 		}
 	}
 	if args.Input.Description != nil {
 		if err := label.Description.Set(args.Input.Description); err != nil {
-			return nil, errors.New("Invalid description")
+			return nil, myerr.ValidationError{Field: "description", Message: "Invalid description"}
 		}
 	}
 
@@ -2729,19 +3684,19 @@ func (r *RootResolver) UpdateLesson(
 ) (*lessonResolver, error) {
 	lesson := &data.Lesson{}
 	if err := lesson.ID.Set(args.Input.LessonID); err != nil {
-		mylog.Log.WithError(err).Error("failed to set lesson id")
-		return nil, errors.New("Invalid lessonId")
+		mylog.Log.WithContext(ctx).WithError(err).Error("failed to set lesson id")
+		return nil, myerr.ValidationError{Field: "lessonId", Message: "Invalid lessonId"}
 	}
 	if args.Input.Draft != nil {
 		if err := lesson.Draft.Set(args.Input.Draft); err != nil {
-			mylog.Log.WithError(err).Error("failed to set lesson draft")
-			return nil, errors.New("Invalid draft")
+			mylog.Log.WithContext(ctx).WithError(err).Error("failed to set lesson draft")
+			return nil, myerr.ValidationError{Field: "draft", Message: "Invalid draft"}
 		}
 	}
 	if args.Input.Title != nil {
 		if err := lesson.Title.Set(args.Input.Title); err != nil {
-			mylog.Log.WithError(err).Error("failed to set lesson title")
-			return nil, errors.New("Invalid title")
+			mylog.Log.WithContext(ctx).WithError(err).Error("failed to set lesson title")
+			return nil, myerr.ValidationError{Field: "title", Message: "Invalid title"}
 		}
 	}
 
@@ -2768,13 +3723,13 @@ func (r *RootResolver) UpdateComment(
 ) (*commentResolver, error) {
 	comment := &data.Comment{}
 	if err := comment.ID.Set(args.Input.CommentID); err != nil {
-		mylog.Log.WithError(err).Error("failed to set comment id")
-		return nil, errors.New("Invalid commentId")
+		mylog.Log.WithContext(ctx).WithError(err).Error("failed to set comment id")
+		return nil, myerr.ValidationError{Field: "commentId", Message: "Invalid commentId"}
 	}
 	if args.Input.Draft != nil {
 		if err := comment.Draft.Set(args.Input.Draft); err != nil {
-			mylog.Log.WithError(err).Error("failed to set comment draft")
-			return nil, errors.New("Invalid draft")
+			mylog.Log.WithContext(ctx).WithError(err).Error("failed to set comment draft")
+			return nil, myerr.ValidationError{Field: "draft", Message: "Invalid draft"}
 		}
 	}
 
@@ -2803,37 +3758,37 @@ func (r *RootResolver) UpdateActivity(
 ) (*activityResolver, error) {
 	activity := &data.Activity{}
 	if err := activity.ID.Set(args.Input.ActivityID); err != nil {
-		return nil, errors.New("invalid activity id")
+		return nil, myerr.ValidationError{Field: "activityId", Message: "invalid activity id"}
 	}
 
 	if args.Input.Description != nil {
 		if err := activity.Description.Set(args.Input.Description); err != nil {
-			return nil, errors.New("invalid activity description")
+			return nil, myerr.ValidationError{Field: "description", Message: "invalid activity description"}
 		}
 	}
 	if args.Input.LessonID != nil {
 		lesson, err := r.Repos.Lesson().Pull(ctx, *args.Input.LessonID)
 		if err != nil {
-			mylog.Log.WithError(err).Error(util.Trace(""))
-			return nil, errors.New("lesson not found")
+			mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
+			return nil, myerr.NotFoundError{Resource: "lesson"}
 		}
 		lessonIsPublished, err := lesson.IsPublished()
 		if err != nil {
-			mylog.Log.WithError(err).Error(util.Trace(""))
+			mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 			return nil, myerr.SomethingWentWrongError
 		}
 
 		if !lessonIsPublished {
-			return nil, errors.New("lesson must be published")
+			return nil, myerr.ValidationError{Message: "lesson must be published"}
 		}
 
 		if err := activity.LessonID.Set(args.Input.LessonID); err != nil {
-			return nil, errors.New("invalid activity lesson_id")
+			return nil, myerr.ValidationError{Field: "lessonId", Message: "invalid activity lesson_id"}
 		}
 	}
 	if args.Input.Name != nil {
 		if err := activity.Name.Set(args.Input.Name); err != nil {
-			return nil, errors.New("invalid activity name")
+			return nil, myerr.ValidationError{Field: "name", Message: "invalid activity name"}
 		}
 	}
 
@@ -2860,17 +3815,17 @@ func (r *RootResolver) UpdateCourse(
 ) (*courseResolver, error) {
 	course := &data.Course{}
 	if err := course.ID.Set(args.Input.CourseID); err != nil {
-		return nil, errors.New("invalid course id")
+		return nil, myerr.ValidationError{Field: "courseId", Message: "invalid course id"}
 	}
 
 	if args.Input.Description != nil {
 		if err := course.Description.Set(args.Input.Description); err != nil {
-			return nil, errors.New("invalid course description")
+			return nil, myerr.ValidationError{Field: "description", Message: "invalid course description"}
 		}
 	}
 	if args.Input.Name != nil {
 		if err := course.Name.Set(args.Input.Name); err != nil {
-			return nil, errors.New("invalid course name")
+			return nil, myerr.ValidationError{Field: "name", Message: "invalid course name"}
 		}
 	}
 
@@ -2886,9 +3841,10 @@ func (r *RootResolver) UpdateCourse(
 }
 
 type UpdateStudyInput struct {
-	Description *string
-	Name        *string
-	StudyID     string
+	Description    *string
+	Name           *string
+	SearchLanguage *string
+	StudyID        string
 }
 
 func (r *RootResolver) UpdateStudy(
@@ -2910,6 +3866,12 @@ func (r *RootResolver) UpdateStudy(
 			return nil, myerr.UnexpectedError{"failed to set study name"}
 		}
 	}
+	if args.Input.SearchLanguage != nil {
+		language := strings.ToLower(*args.Input.SearchLanguage)
+		if err := study.SearchLanguage.Set(language); err != nil || !data.IsSearchLanguage(language) {
+			return nil, myerr.ValidationError{Field: "searchLanguage", Message: "Invalid search language"}
+		}
+	}
 
 	studyPermit, err := r.Repos.Study().Update(ctx, study)
 	if err != nil {
@@ -2923,8 +3885,12 @@ func (r *RootResolver) UpdateStudy(
 }
 
 type UpdateTopicInput struct {
-	Description string
-	TopicID     string
+	Description     *string
+	DisplayName     *string
+	IsFeatured      *bool
+	LogoID          *string
+	RelatedTopicIDs *[]string
+	TopicID         string
 }
 
 func (r *RootResolver) UpdateTopic(
@@ -2935,14 +3901,84 @@ func (r *RootResolver) UpdateTopic(
 	if err := topic.ID.Set(args.Input.TopicID); err != nil {
 		return nil, myerr.UnexpectedError{"failed to set topic id"}
 	}
-	if err := topic.Description.Set(args.Input.Description); err != nil {
-		return nil, myerr.UnexpectedError{"failed to set topic description"}
+	if args.Input.Description != nil {
+		if err := topic.Description.Set(args.Input.Description); err != nil {
+			return nil, myerr.UnexpectedError{"failed to set topic description"}
+		}
+	}
+	if args.Input.DisplayName != nil {
+		// An empty display name falls back to the name of the topic.
+		var displayName interface{}
+		if name := strings.TrimSpace(*args.Input.DisplayName); name != "" {
+			displayName = name
+		}
+		if err := topic.DisplayName.Set(displayName); err != nil {
+			return nil, myerr.UnexpectedError{Message: "failed to set topic display name"}
+		}
+	}
+	if args.Input.IsFeatured != nil {
+		var featuredAt interface{}
+		if *args.Input.IsFeatured {
+			featuredAt = time.Now()
+		}
+		if err := topic.FeaturedAt.Set(featuredAt); err != nil {
+			return nil, myerr.UnexpectedError{Message: "failed to set topic featured at"}
+		}
+	}
+	if args.Input.LogoID != nil {
+		var logoID interface{}
+		if *args.Input.LogoID != "" {
+			oid, err := mytype.ParseOID(*args.Input.LogoID)
+			if err != nil || oid.Type != "UserAsset" {
+				return nil, myerr.ValidationError{Field: "logoId", Message: "invalid value for logoId"}
+			}
+			logoID = oid
+		}
+		if err := topic.LogoID.Set(logoID); err != nil {
+			return nil, myerr.UnexpectedError{Message: "failed to set topic logo id"}
+		}
+	}
+
+	tx, err, newTx := myctx.TransactionFromContext(ctx)
+	if err != nil {
+		return nil, err
+	} else if newTx {
+		defer data.RollbackTransaction(tx)
 	}
+	ctx = myctx.NewQueryerContext(ctx, tx)
 
 	topicPermit, err := r.Repos.Topic().Update(ctx, topic)
 	if err != nil {
 		return nil, err
 	}
+
+	if args.Input.RelatedTopicIDs != nil {
+		if err := checkViewerIsSiteAdmin(ctx); err != nil {
+			return nil, err
+		}
+		relatedIDs := make([]string, 0, len(*args.Input.RelatedTopicIDs))
+		for _, id := range *args.Input.RelatedTopicIDs {
+			oid, err := mytype.ParseOID(id)
+			if err != nil || oid.Type != "Topic" {
+				return nil, myerr.ValidationError{Field: "relatedTopicIds", Message: "invalid value for relatedTopicIds"}
+			}
+			if oid.String == topic.ID.String {
+				return nil, myerr.ValidationError{Field: "relatedTopicIds", Message: "topic cannot be related to itself"}
+			}
+			relatedIDs = append(relatedIDs, oid.String)
+		}
+		if err := data.ReplaceTopicRelations(tx, topic.ID.String, relatedIDs); err != nil {
+			return nil, err
+		}
+	}
+
+	if newTx {
+		err := data.CommitTransaction(tx)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	return &topicResolver{
 		Conf:  r.Conf,
 		Topic: topicPermit,
@@ -2991,6 +4027,24 @@ func (r *RootResolver) UpdateTopics(
 		resolver.InvalidNames = invalidTopicNames
 		return resolver, nil
 	}
+	// replace aliases with the names of the topics they resolve to
+	canonicalNames := make([]string, 0, len(topicNames))
+	seenNames := make(map[string]struct{}, len(topicNames))
+	for _, name := range topicNames {
+		topic, err := r.Repos.Topic().GetByName(ctx, name)
+		if err == nil {
+			if name, err = topic.Name(); err != nil {
+				return nil, err
+			}
+		} else if err != data.ErrNotFound {
+			return nil, err
+		}
+		if _, prs := seenNames[name]; !prs {
+			seenNames[name] = struct{}{}
+			canonicalNames = append(canonicalNames, name)
+		}
+	}
+	topicNames = canonicalNames
 	topicPermits, err := r.Repos.Topic().GetByTopicable(
 		ctx,
 		args.Input.TopicableID,
@@ -3011,7 +4065,7 @@ func (r *RootResolver) UpdateTopics(
 			t := &data.Topic{}
 			t.Name.Set(name)
 			if err := t.Name.Set(name); err != nil {
-				return nil, errors.New("invalid topic name")
+				return nil, myerr.ValidationError{Field: "topicNames", Message: "invalid topic name"}
 			}
 			topic, err := r.Repos.Topic().Create(ctx, t)
 			if err != nil {
@@ -3023,10 +4077,10 @@ func (r *RootResolver) UpdateTopics(
 			}
 			topiced := &data.Topiced{}
 			if err := topiced.TopicID.Set(topicID); err != nil {
-				return nil, errors.New("invalid topic id")
+				return nil, myerr.UnexpectedError{Message: "failed to set topic id"}
 			}
 			if err := topiced.TopicableID.Set(args.Input.TopicableID); err != nil {
-				return nil, errors.New("invalid topicable id")
+				return nil, myerr.ValidationError{Field: "topicableId", Message: "invalid topicable id"}
 			}
 			_, err = r.Repos.Topiced().Connect(ctx, topiced)
 			if err != nil {
@@ -3039,10 +4093,10 @@ func (r *RootResolver) UpdateTopics(
 		if _, prs := newTopics[name]; !prs {
 			topiced := &data.Topiced{}
 			if err := topiced.TopicID.Set(&t.ID); err != nil {
-				return nil, errors.New("invalid topic id")
+				return nil, myerr.UnexpectedError{Message: "failed to set topic id"}
 			}
 			if err := topiced.TopicableID.Set(topicableID); err != nil {
-				return nil, errors.New("invalid topicable id")
+				return nil, myerr.UnexpectedError{Message: "failed to set topicable id"}
 			}
 			err := r.Repos.Topiced().Disconnect(ctx, topiced)
 			if err != nil {
@@ -3131,15 +4185,15 @@ func (r *RootResolver) UpdateViewerAccount(
 	}
 	if args.Input.NewPassword != nil && args.Input.OldPassword != nil {
 		if err := viewer.Password.CompareToPassword(*args.Input.OldPassword); err != nil {
-			return nil, errors.New("incorrect password")
+			return nil, myerr.ValidationError{Field: "oldPassword", Message: "incorrect password"}
 		}
 		if err := user.Password.Set(args.Input.NewPassword); err != nil {
-			mylog.Log.WithError(err).Error("failed to set password")
-			return nil, err
+			mylog.Log.WithContext(ctx).WithError(err).Error("failed to set password")
+			return nil, myerr.ValidationError{Field: "newPassword", Message: err.Error()}
 		}
 		if err := user.Password.CheckStrength(mytype.Weak); err != nil {
-			mylog.Log.WithError(err).Error("password failed strength check")
-			return nil, err
+			mylog.Log.WithContext(ctx).WithError(err).Error("password failed strength check")
+			return nil, myerr.ValidationError{Field: "newPassword", Message: err.Error()}
 		}
 	}
 
@@ -3150,11 +4204,11 @@ func (r *RootResolver) UpdateViewerAccount(
 	}
 	ok, err := user.Login.IsBlacklisted()
 	if err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return nil, err
 	} else if ok {
-		err := errors.New("username unavailable")
-		mylog.Log.WithError(err).Error(util.Trace("failed blacklist check"))
+		err := myerr.ValidationError{Field: "login", Message: "username unavailable"}
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace("failed blacklist check"))
 		return nil, err
 	}
 
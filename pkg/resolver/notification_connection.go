@@ -18,7 +18,7 @@ func NewNotificationConnectionResolver(
 ) (*notificationConnectionResolver, error) {
 	edges := make([]*notificationEdgeResolver, len(notifications))
 	for i := range edges {
-		edge, err := NewNotificationEdgeResolver(notifications[i], repos, conf)
+		edge, err := NewNotificationEdgeResolver(notifications[i], pageOptions, repos, conf)
 		if err != nil {
 			return nil, err
 		}
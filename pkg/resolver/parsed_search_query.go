@@ -0,0 +1,45 @@
+package resolver
+
+import (
+	"github.com/marksauter/markus-ninja-api/pkg/data"
+)
+
+type parsedSearchQueryResolver struct {
+	Query *data.SearchQuery
+}
+
+func (r *parsedSearchQueryResolver) ExcludedTerms() []string {
+	return r.Query.ExcludedTerms
+}
+
+func (r *parsedSearchQueryResolver) Qualifiers() []*searchQualifierResolver {
+	resolvers := make([]*searchQualifierResolver, len(r.Query.Qualifiers))
+	for i, q := range r.Query.Qualifiers {
+		resolvers[i] = &searchQualifierResolver{Qualifier: q}
+	}
+	return resolvers
+}
+
+func (r *parsedSearchQueryResolver) Terms() []string {
+	return r.Query.Terms
+}
+
+func (r *parsedSearchQueryResolver) Text() string {
+	return r.Query.Text
+}
+
+type searchQualifierResolver struct {
+	Qualifier *data.SearchQualifier
+}
+
+func (r *searchQualifierResolver) IsNegated() bool {
+	return r.Qualifier.Negated
+}
+
+func (r *searchQualifierResolver) Name() string {
+	return r.Qualifier.Name
+}
+
+func (r *searchQualifierResolver) Value() string {
+	return r.Qualifier.Value
+}
@@ -3,9 +3,13 @@ package resolver
 import (
 	"context"
 	"errors"
+	"fmt"
+	"sort"
+	"strings"
 
 	"github.com/marksauter/markus-ninja-api/pkg/data"
 	"github.com/marksauter/markus-ninja-api/pkg/myctx"
+	"github.com/marksauter/markus-ninja-api/pkg/myerr"
 	"github.com/marksauter/markus-ninja-api/pkg/mylog"
 	"github.com/marksauter/markus-ninja-api/pkg/mytype"
 	"github.com/marksauter/markus-ninja-api/pkg/repo"
@@ -32,6 +36,136 @@ func (r *RootResolver) Asset(
 	return &userAssetResolver{UserAsset: userAsset, Conf: r.Conf, Repos: r.Repos}, nil
 }
 
+func (r *RootResolver) FeaturedTopics(
+	ctx context.Context,
+	args struct {
+		After  *string
+		Before *string
+		First  *int32
+		Last   *int32
+	},
+) (*topicConnectionResolver, error) {
+	pageOptions, err := data.NewPageOptions(
+		args.After,
+		args.Before,
+		args.First,
+		args.Last,
+		&TopicOrder{direction: data.DESC, field: TopicFeaturedAt},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	topics, err := r.Repos.Topic().GetFeatured(ctx, pageOptions)
+	if err != nil {
+		return nil, err
+	}
+	resolver, err := NewTopicConnectionResolver(
+		topics,
+		pageOptions,
+		nil,
+		nil,
+		r.Repos,
+		r.Conf,
+	)
+	if err != nil {
+		return nil, err
+	}
+	resolver.count = func(ctx context.Context) (int32, error) {
+		return r.Repos.Topic().CountFeatured(ctx)
+	}
+	return resolver, nil
+}
+
+func (r *RootResolver) ModerationLog(
+	ctx context.Context,
+	args struct {
+		After     *string
+		Before    *string
+		First     *int32
+		Last      *int32
+		SubjectID *string
+		UserID    *string
+	},
+) (*moderationActionConnectionResolver, error) {
+	if err := checkViewerIsSiteAdmin(ctx); err != nil {
+		return nil, err
+	}
+	db, ok := myctx.QueryerFromContext(ctx)
+	if !ok {
+		return nil, &myctx.ErrNotFound{Name: "queryer"}
+	}
+
+	pageOptions, err := data.NewPageOptions(
+		args.After,
+		args.Before,
+		args.First,
+		args.Last,
+		moderationActionOrder{},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	filters := &data.ModerationActionFilterOptions{
+		SubjectID: args.SubjectID,
+		UserID:    args.UserID,
+	}
+	moderationActions, err := data.GetModerationAction(db, pageOptions, filters)
+	if err != nil {
+		return nil, err
+	}
+	return NewModerationActionConnectionResolver(
+		moderationActions,
+		pageOptions,
+		filters,
+		r.Repos,
+		r.Conf,
+	)
+}
+
+func (r *RootResolver) ModerationQueue(
+	ctx context.Context,
+	args struct {
+		After  *string
+		Before *string
+		First  *int32
+		Last   *int32
+		Status string
+	},
+) (*contentReportConnectionResolver, error) {
+	if err := checkViewerIsSiteAdmin(ctx); err != nil {
+		return nil, err
+	}
+	db, ok := myctx.QueryerFromContext(ctx)
+	if !ok {
+		return nil, &myctx.ErrNotFound{Name: "queryer"}
+	}
+
+	pageOptions, err := data.NewPageOptions(
+		args.After,
+		args.Before,
+		args.First,
+		args.Last,
+		contentReportOrder{},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	contentReports, err := data.GetContentReportByStatus(db, args.Status, pageOptions)
+	if err != nil {
+		return nil, err
+	}
+	return NewContentReportConnectionResolver(
+		contentReports,
+		pageOptions,
+		args.Status,
+		r.Repos,
+		r.Conf,
+	)
+}
+
 func (r *RootResolver) Node(
 	ctx context.Context,
 	args struct{ ID string },
@@ -92,6 +226,7 @@ func (r *RootResolver) Search(
 		Before  *string
 		First   *int32
 		Last    *int32
+		Mode    *string
 		OrderBy *OrderArg
 		Query   string
 		Type    string
@@ -102,10 +237,21 @@ func (r *RootResolver) Search(
 	if err != nil {
 		return &resolver, err
 	}
+	searchMode, err := ParseSearchMode(args.Mode)
+	if err != nil {
+		return &resolver, err
+	}
 	searchOrder, err := ParseSearchOrder(searchType, args.OrderBy)
 	if err != nil {
 		return &resolver, err
 	}
+	searchQuery, err := data.ParseSearchQuery(args.Query)
+	if err != nil {
+		return &resolver, myerr.ValidationError{Field: "query", Message: err.Error()}
+	}
+	if _, err := newSearchFilters(searchType, searchQuery, false); err != nil {
+		return &resolver, myerr.ValidationError{Field: "query", Message: err.Error()}
+	}
 
 	pageOptions, err := data.NewPageOptions(
 		args.After,
@@ -118,13 +264,29 @@ func (r *RootResolver) Search(
 		return &resolver, err
 	}
 
+	// Searches in auto mode fall back to fuzzy matching if few results match
+	// the words of the query.
+	fuzzy := searchMode == SearchModeFuzzy
+	var fullTextCount *int32
+	if searchMode == SearchModeAuto {
+		n, err := countSearch(ctx, r.Repos, searchType, searchQuery, false)
+		if err != nil {
+			mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
+			return &resolver, err
+		}
+		fullTextCount = &n
+		fuzzy = n < r.Conf.SearchFuzzyThreshold
+	}
+
+	filters, err := newSearchFilters(searchType, searchQuery, fuzzy)
+	if err != nil {
+		return &resolver, err
+	}
+
 	permits := []repo.NodePermit{}
 
-	switch searchType {
-	case SearchTypeActivity:
-		filters := &data.ActivityFilterOptions{
-			Search: &args.Query,
-		}
+	switch filters := filters.(type) {
+	case *data.ActivityFilterOptions:
 		activities, err := r.Repos.Activity().Search(ctx, pageOptions, filters)
 		if err != nil {
 			return &resolver, err
@@ -133,11 +295,16 @@ func (r *RootResolver) Search(
 		for i, l := range activities {
 			permits[i] = l
 		}
-	case SearchTypeCourse:
-		filters := &data.CourseFilterOptions{
-			IsPublished: util.NewBool(true),
-			Search:      &args.Query,
+	case *data.CommentFilterOptions:
+		comments, err := r.Repos.Comment().Search(ctx, pageOptions, filters)
+		if err != nil {
+			return &resolver, err
+		}
+		permits = make([]repo.NodePermit, len(comments))
+		for i, l := range comments {
+			permits[i] = l
 		}
+	case *data.CourseFilterOptions:
 		courses, err := r.Repos.Course().Search(ctx, pageOptions, filters)
 		if err != nil {
 			return &resolver, err
@@ -146,10 +313,7 @@ func (r *RootResolver) Search(
 		for i, l := range courses {
 			permits[i] = l
 		}
-	case SearchTypeLabel:
-		filters := &data.LabelFilterOptions{
-			Search: &args.Query,
-		}
+	case *data.LabelFilterOptions:
 		labels, err := r.Repos.Label().Search(ctx, pageOptions, filters)
 		if err != nil {
 			return &resolver, err
@@ -158,24 +322,17 @@ func (r *RootResolver) Search(
 		for i, l := range labels {
 			permits[i] = l
 		}
-	case SearchTypeLesson:
-		filters := &data.LessonFilterOptions{
-			IsPublished: util.NewBool(true),
-			Search:      &args.Query,
-		}
+	case *data.LessonFilterOptions:
 		lessons, err := r.Repos.Lesson().Search(ctx, pageOptions, filters)
 		if err != nil {
-			mylog.Log.WithError(err).Error(util.Trace(""))
+			mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 			return &resolver, err
 		}
 		permits = make([]repo.NodePermit, len(lessons))
 		for i, l := range lessons {
 			permits[i] = l
 		}
-	case SearchTypeStudy:
-		filters := &data.StudyFilterOptions{
-			Search: &args.Query,
-		}
+	case *data.StudyFilterOptions:
 		studies, err := r.Repos.Study().Search(ctx, pageOptions, filters)
 		if err != nil {
 			return &resolver, err
@@ -184,10 +341,7 @@ func (r *RootResolver) Search(
 		for i, l := range studies {
 			permits[i] = l
 		}
-	case SearchTypeTopic:
-		filters := &data.TopicFilterOptions{
-			Search: &args.Query,
-		}
+	case *data.TopicFilterOptions:
 		topics, err := r.Repos.Topic().Search(ctx, pageOptions, filters)
 		if err != nil {
 			return &resolver, err
@@ -196,10 +350,7 @@ func (r *RootResolver) Search(
 		for i, l := range topics {
 			permits[i] = l
 		}
-	case SearchTypeUser:
-		filters := &data.UserFilterOptions{
-			Search: &args.Query,
-		}
+	case *data.UserFilterOptions:
 		users, err := r.Repos.User().Search(ctx, pageOptions, filters)
 		if err != nil {
 			return &resolver, err
@@ -208,10 +359,7 @@ func (r *RootResolver) Search(
 		for i, l := range users {
 			permits[i] = l
 		}
-	case SearchTypeUserAsset:
-		filters := &data.UserAssetFilterOptions{
-			Search: &args.Query,
-		}
+	case *data.UserAssetFilterOptions:
 		userAssets, err := r.Repos.UserAsset().Search(ctx, pageOptions, filters)
 		if err != nil {
 			return &resolver, err
@@ -225,7 +373,11 @@ func (r *RootResolver) Search(
 	return NewSearchableConnectionResolver(
 		permits,
 		pageOptions,
-		args.Query,
+		searchQuery,
+		searchType,
+		fuzzy,
+		fullTextCount,
+		nil,
 		r.Repos,
 		r.Conf,
 	)
@@ -259,6 +411,158 @@ func (r *RootResolver) Topic(
 	return &topicResolver{Topic: topic, Conf: r.Conf, Repos: r.Repos}, nil
 }
 
+const (
+	defaultSuggestionCount = 5
+	maxSuggestionCount     = 20
+)
+
+func (r *RootResolver) Suggest(
+	ctx context.Context,
+	args struct {
+		First   *int32
+		Query   string
+		StudyID *string
+		Types   *[]string
+	},
+) ([]*suggestionResolver, error) {
+	viewer, ok := myctx.UserFromContext(ctx)
+	if !ok {
+		return nil, errors.New("viewer not found")
+	}
+
+	first := int32(defaultSuggestionCount)
+	if args.First != nil {
+		if *args.First < 0 || *args.First > maxSuggestionCount {
+			return nil, myerr.ValidationError{
+				Field:   "first",
+				Message: fmt.Sprintf("first must be between 0 and %d", maxSuggestionCount),
+			}
+		}
+		first = *args.First
+	}
+
+	opts := &data.SuggestionOptions{}
+	if args.StudyID != nil {
+		studyID, err := mytype.ParseOID(*args.StudyID)
+		if err != nil || studyID.Type != "Study" {
+			return nil, myerr.ValidationError{Field: "studyId", Message: "invalid value for studyId"}
+		}
+		opts.StudyID = &studyID.String
+	}
+	if args.Types != nil {
+		opts.Types = make([]string, len(*args.Types))
+		for i, t := range *args.Types {
+			suggestionType, err := ParseSuggestionType(t)
+			if err != nil {
+				return nil, myerr.ValidationError{Field: "types", Message: err.Error()}
+			}
+			opts.Types[i] = suggestionType
+		}
+		sort.Strings(opts.Types)
+	}
+
+	// Suggestions made to guests are the same for everyone, so they are
+	// cached.
+	isGuest := viewer.Login.String == repo.Guest
+	cacheKey := ""
+	if isGuest {
+		studyID := ""
+		if opts.StudyID != nil {
+			studyID = *opts.StudyID
+		}
+		cacheKey = fmt.Sprintf(
+			"%d:%s:%s:%s",
+			first,
+			studyID,
+			strings.Join(opts.Types, ","),
+			strings.ToLower(strings.TrimSpace(args.Query)),
+		)
+		if suggestions, ok := r.Suggestions.Get(cacheKey); ok {
+			return r.newSuggestionResolvers(suggestions.([]*data.Suggestion)), nil
+		}
+	} else {
+		opts.ViewerID = &viewer.ID.String
+		opts.ViewerIsAdmin = checkViewerIsSiteAdmin(ctx) == nil
+	}
+
+	db, ok := myctx.QueryerFromContext(ctx)
+	if !ok {
+		err := &myctx.ErrNotFound{Name: "queryer"}
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
+		return nil, err
+	}
+	suggestions, err := data.GetSuggestions(db, args.Query, first, opts)
+	if err != nil {
+		return nil, err
+	}
+	if isGuest {
+		r.Suggestions.Add(cacheKey, suggestions)
+	}
+	return r.newSuggestionResolvers(suggestions), nil
+}
+
+func (r *RootResolver) newSuggestionResolvers(
+	suggestions []*data.Suggestion,
+) []*suggestionResolver {
+	resolvers := make([]*suggestionResolver, len(suggestions))
+	for i, s := range suggestions {
+		resolvers[i] = &suggestionResolver{
+			Conf:       r.Conf,
+			Repos:      r.Repos,
+			Suggestion: s,
+		}
+	}
+	return resolvers
+}
+
+func (r *RootResolver) TrendingStudies(
+	ctx context.Context,
+	args struct {
+		After  *string
+		Before *string
+		First  *int32
+		Last   *int32
+		Window *string
+	},
+) (*studyConnectionResolver, error) {
+	window, err := ParseTrendingWindow(args.Window)
+	if err != nil {
+		return nil, err
+	}
+	period := window.period()
+
+	pageOptions, err := data.NewPageOptions(
+		args.After,
+		args.Before,
+		args.First,
+		args.Last,
+		&StudyOrder{direction: data.DESC, field: StudyTrendingScore},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	studies, err := r.Repos.Study().GetTrending(ctx, period, pageOptions, nil)
+	if err != nil {
+		return nil, err
+	}
+	resolver, err := NewStudyConnectionResolver(
+		studies,
+		pageOptions,
+		nil,
+		nil,
+		r.Repos,
+		r.Conf,
+	)
+	if err != nil {
+		return nil, err
+	}
+	resolver.count = func(ctx context.Context) (int32, error) {
+		return r.Repos.Study().CountTrending(ctx, period, nil)
+	}
+	return resolver, nil
+}
+
 func (r *RootResolver) User(ctx context.Context, args struct {
 	Login string
 }) (*userResolver, error) {
@@ -39,5 +39,5 @@ func (r *removeActivityAssetPayloadResolver) RemovedAssetEdge(
 		return nil, err
 	}
 
-	return NewUserAssetEdgeResolver(userAsset, r.Repos, r.Conf)
+	return NewUserAssetEdgeResolver(userAsset, nil, r.Repos, r.Conf)
 }
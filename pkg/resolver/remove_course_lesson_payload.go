@@ -39,5 +39,5 @@ func (r *removeCourseLessonPayloadResolver) RemovedLessonEdge(
 		return nil, err
 	}
 
-	return NewLessonEdgeResolver(lesson, r.Repos, r.Conf)
+	return NewLessonEdgeResolver(lesson, nil, r.Repos, r.Conf)
 }
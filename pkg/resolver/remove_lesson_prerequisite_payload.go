@@ -0,0 +1,32 @@
+package resolver
+
+import (
+	"context"
+
+	graphql "github.com/marksauter/graphql-go"
+	"github.com/marksauter/markus-ninja-api/pkg/myconf"
+	"github.com/marksauter/markus-ninja-api/pkg/mytype"
+	"github.com/marksauter/markus-ninja-api/pkg/repo"
+)
+
+type removeLessonPrerequisitePayloadResolver struct {
+	Conf           *myconf.Config
+	LessonID       *mytype.OID
+	PrerequisiteID *mytype.OID
+	Repos          *repo.Repos
+}
+
+func (r *removeLessonPrerequisitePayloadResolver) Lesson(
+	ctx context.Context,
+) (*lessonResolver, error) {
+	lesson, err := r.Repos.Lesson().Get(ctx, r.LessonID.String)
+	if err != nil {
+		return nil, err
+	}
+
+	return &lessonResolver{Lesson: lesson, Conf: r.Conf, Repos: r.Repos}, nil
+}
+
+func (r *removeLessonPrerequisitePayloadResolver) RemovedPrerequisiteID() graphql.ID {
+	return graphql.ID(r.PrerequisiteID.String)
+}
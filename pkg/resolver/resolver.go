@@ -8,6 +8,7 @@ import (
 	"github.com/marksauter/markus-ninja-api/pkg/myconf"
 	"github.com/marksauter/markus-ninja-api/pkg/repo"
 	"github.com/marksauter/markus-ninja-api/pkg/service"
+	"github.com/marksauter/markus-ninja-api/pkg/util"
 )
 
 var InternalServerError = errors.New("something went wrong")
@@ -16,6 +17,8 @@ type RootResolver struct {
 	Conf  *myconf.Config
 	Repos *repo.Repos
 	Svcs  *service.Services
+	// Suggestions caches the suggestions made to guests, by query.
+	Suggestions *util.LRUCache
 }
 
 func nodePermitToResolver(
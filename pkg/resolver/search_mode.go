@@ -0,0 +1,44 @@
+package resolver
+
+import (
+	"fmt"
+	"strings"
+)
+
+type SearchMode int
+
+const (
+	SearchModeAuto SearchMode = iota
+	SearchModeFullText
+	SearchModeFuzzy
+)
+
+func ParseSearchMode(s *string) (SearchMode, error) {
+	if s == nil {
+		return SearchModeAuto, nil
+	}
+	switch strings.ToUpper(*s) {
+	case "AUTO":
+		return SearchModeAuto, nil
+	case "FULL_TEXT":
+		return SearchModeFullText, nil
+	case "FUZZY":
+		return SearchModeFuzzy, nil
+	default:
+		var f SearchMode
+		return f, fmt.Errorf("invalid SearchMode: %q", *s)
+	}
+}
+
+func (f SearchMode) String() string {
+	switch f {
+	case SearchModeAuto:
+		return "auto"
+	case SearchModeFullText:
+		return "full_text"
+	case SearchModeFuzzy:
+		return "fuzzy"
+	default:
+		return "unknown"
+	}
+}
@@ -31,7 +31,7 @@ func ParseSearchOrderField(s string) (SearchOrderField, error) {
 func (f SearchOrderField) String() string {
 	switch f {
 	case SearchBestMatch:
-		return "best_match"
+		return data.BestMatch
 	default:
 		return "unknown"
 	}
@@ -75,6 +75,8 @@ func ParseSearchOrder(t SearchType, arg *OrderArg) (data.Order, error) {
 	switch t {
 	case SearchTypeActivity:
 		return ParseActivityOrder(arg)
+	case SearchTypeComment:
+		return ParseCommentOrder(arg)
 	case SearchTypeCourse:
 		return ParseCourseOrder(arg)
 	case SearchTypeLesson:
@@ -3,12 +3,15 @@ package resolver
 import (
 	"fmt"
 	"strings"
+
+	"github.com/marksauter/markus-ninja-api/pkg/data"
 )
 
 type SearchType int
 
 const (
 	SearchTypeActivity SearchType = iota
+	SearchTypeComment
 	SearchTypeCourse
 	SearchTypeLabel
 	SearchTypeLesson
@@ -22,6 +25,8 @@ func ParseSearchType(s string) (SearchType, error) {
 	switch strings.ToUpper(s) {
 	case "ACTIVITY":
 		return SearchTypeActivity, nil
+	case "COMMENT":
+		return SearchTypeComment, nil
 	case "COURSE":
 		return SearchTypeCourse, nil
 	case "LABEL":
@@ -46,6 +51,8 @@ func (f SearchType) String() string {
 	switch f {
 	case SearchTypeActivity:
 		return "activity"
+	case SearchTypeComment:
+		return "comment"
 	case SearchTypeCourse:
 		return "course"
 	case SearchTypeLabel:
@@ -64,3 +71,27 @@ func (f SearchType) String() string {
 		return "unknown"
 	}
 }
+
+// searchIndex returns the name of the search index of the search type.
+func (f SearchType) searchIndex() string {
+	return f.String() + "_search_index"
+}
+
+// studyContentType returns the type of the study content of the search type,
+// or false if results of the type are not the content of a study.
+func (f SearchType) studyContentType() (string, bool) {
+	switch f {
+	case SearchTypeActivity:
+		return data.StudyContentTypeActivity, true
+	case SearchTypeComment:
+		return data.StudyContentTypeComment, true
+	case SearchTypeCourse:
+		return data.StudyContentTypeCourse, true
+	case SearchTypeLesson:
+		return data.StudyContentTypeLesson, true
+	case SearchTypeUserAsset:
+		return data.StudyContentTypeUserAsset, true
+	default:
+		return "", false
+	}
+}
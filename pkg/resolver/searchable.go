@@ -17,6 +17,11 @@ func (r *searchableResolver) ToActivity() (*activityResolver, bool) {
 	return resolver, ok
 }
 
+func (r *searchableResolver) ToComment() (*commentResolver, bool) {
+	resolver, ok := r.searchable.(*commentResolver)
+	return resolver, ok
+}
+
 func (r *searchableResolver) ToCourse() (*courseResolver, bool) {
 	resolver, ok := r.searchable.(*courseResolver)
 	return resolver, ok
@@ -3,23 +3,34 @@ package resolver
 import (
 	"context"
 	"errors"
+	"strings"
 
 	"github.com/marksauter/markus-ninja-api/pkg/data"
 	"github.com/marksauter/markus-ninja-api/pkg/myconf"
+	"github.com/marksauter/markus-ninja-api/pkg/myctx"
+	"github.com/marksauter/markus-ninja-api/pkg/mylog"
 	"github.com/marksauter/markus-ninja-api/pkg/repo"
 	"github.com/marksauter/markus-ninja-api/pkg/util"
 )
 
+// didYouMeanLimit is the number of titles suggested in place of a search that
+// matched few results.
+const didYouMeanLimit = 3
+
 func NewSearchableConnectionResolver(
 	searchables []repo.NodePermit,
 	pageOptions *data.PageOptions,
-	query string,
+	query *data.SearchQuery,
+	searchType SearchType,
+	fuzzy bool,
+	fullTextCount *int32,
+	study *studySearch,
 	repos *repo.Repos,
 	conf *myconf.Config,
 ) (*searchableConnectionResolver, error) {
 	edges := make([]*searchableEdgeResolver, len(searchables))
 	for i := range edges {
-		edge, err := NewSearchableEdgeResolver(searchables[i], repos, conf)
+		edge, err := NewSearchableEdgeResolver(searchables[i], pageOptions, repos, conf)
 		if err != nil {
 			return nil, err
 		}
@@ -33,38 +44,248 @@ func NewSearchableConnectionResolver(
 	pageInfo := NewPageInfoResolver(edgeResolvers, pageOptions)
 
 	resolver := &searchableConnectionResolver{
-		conf:        conf,
-		edges:       edges,
-		searchables: searchables,
-		pageInfo:    pageInfo,
-		repos:       repos,
-		query:       query,
+		conf:          conf,
+		edges:         edges,
+		fullTextCount: fullTextCount,
+		fuzzy:         fuzzy,
+		searchables:   searchables,
+		searchType:    searchType,
+		pageInfo:      pageInfo,
+		repos:         repos,
+		query:         query,
+		study:         study,
 	}
 	return resolver, nil
 }
 
 type searchableConnectionResolver struct {
-	conf        *myconf.Config
-	edges       []*searchableEdgeResolver
-	searchables []repo.NodePermit
-	pageInfo    *pageInfoResolver
-	repos       *repo.Repos
-	query       string
+	conf  *myconf.Config
+	edges []*searchableEdgeResolver
+	// fullTextCount is the number of results matching the words of the query,
+	// if it was counted to decide whether to fall back to fuzzy matching.
+	fullTextCount *int32
+	fuzzy         bool
+	searchables   []repo.NodePermit
+	// searchType is the type of results searched for, unless the content of a
+	// study was searched.
+	searchType SearchType
+	pageInfo   *pageInfoResolver
+	repos      *repo.Repos
+	query      *data.SearchQuery
+	// study is the search of the content of a study, if the results are the
+	// content of a study ranked together.
+	study *studySearch
 }
 
-func (r *searchableConnectionResolver) ActivityCount(ctx context.Context) (int32, error) {
-	filters := &data.ActivityFilterOptions{
-		Search: &r.query,
+// newSearchFilters returns the filter options of the search type for the
+// qualifiers and text of query. Only published comments, courses and lessons
+// are searched.
+func newSearchFilters(
+	searchType SearchType,
+	query *data.SearchQuery,
+	fuzzy bool,
+) (data.FilterOptions, error) {
+	switch searchType {
+	case SearchTypeActivity:
+		filters, err := query.ActivityFilterOptions()
+		if err != nil {
+			return nil, err
+		}
+		filters.Fuzzy = fuzzy
+		return filters, nil
+	case SearchTypeComment:
+		// Comments have no titles to match fuzzily.
+		filters, err := query.CommentFilterOptions()
+		if err != nil {
+			return nil, err
+		}
+		if filters.IsPublished != nil && !*filters.IsPublished {
+			return nil, data.SearchQueryError{
+				Qualifier: "-is:published",
+				Message:   "only published comments may be searched",
+			}
+		}
+		filters.IsPublished = util.NewBool(true)
+		return filters, nil
+	case SearchTypeCourse:
+		filters, err := query.CourseFilterOptions()
+		if err != nil {
+			return nil, err
+		}
+		if filters.IsPublished != nil && !*filters.IsPublished {
+			return nil, data.SearchQueryError{
+				Qualifier: "-is:published",
+				Message:   "only published courses may be searched",
+			}
+		}
+		filters.Fuzzy = fuzzy
+		filters.IsPublished = util.NewBool(true)
+		return filters, nil
+	case SearchTypeLabel:
+		filters, err := query.LabelFilterOptions()
+		if err != nil {
+			return nil, err
+		}
+		filters.Fuzzy = fuzzy
+		return filters, nil
+	case SearchTypeLesson:
+		filters, err := query.LessonFilterOptions()
+		if err != nil {
+			return nil, err
+		}
+		if filters.IsPublished != nil && !*filters.IsPublished {
+			return nil, data.SearchQueryError{
+				Qualifier: "-is:published",
+				Message:   "only published lessons may be searched",
+			}
+		}
+		filters.Fuzzy = fuzzy
+		filters.IsPublished = util.NewBool(true)
+		return filters, nil
+	case SearchTypeStudy:
+		filters, err := query.StudyFilterOptions()
+		if err != nil {
+			return nil, err
+		}
+		filters.Fuzzy = fuzzy
+		return filters, nil
+	case SearchTypeTopic:
+		filters, err := query.TopicFilterOptions()
+		if err != nil {
+			return nil, err
+		}
+		filters.Fuzzy = fuzzy
+		return filters, nil
+	case SearchTypeUser:
+		filters, err := query.UserFilterOptions()
+		if err != nil {
+			return nil, err
+		}
+		filters.Fuzzy = fuzzy
+		return filters, nil
+	case SearchTypeUserAsset:
+		filters, err := query.UserAssetFilterOptions()
+		if err != nil {
+			return nil, err
+		}
+		filters.Fuzzy = fuzzy
+		return filters, nil
+	default:
+		return nil, errors.New("invalid search type")
 	}
-	return r.repos.Activity().CountBySearch(ctx, filters)
+}
+
+// countSearch returns the number of results of the search type matching query.
+func countSearch(
+	ctx context.Context,
+	repos *repo.Repos,
+	searchType SearchType,
+	query *data.SearchQuery,
+	fuzzy bool,
+) (int32, error) {
+	filters, err := newSearchFilters(searchType, query, fuzzy)
+	if err != nil {
+		return 0, err
+	}
+	switch filters := filters.(type) {
+	case *data.ActivityFilterOptions:
+		return repos.Activity().CountBySearch(ctx, filters)
+	case *data.CommentFilterOptions:
+		return repos.Comment().CountBySearch(ctx, filters)
+	case *data.CourseFilterOptions:
+		return repos.Course().CountBySearch(ctx, filters)
+	case *data.LabelFilterOptions:
+		return repos.Label().CountBySearch(ctx, filters)
+	case *data.LessonFilterOptions:
+		return repos.Lesson().CountBySearch(ctx, filters)
+	case *data.StudyFilterOptions:
+		return repos.Study().CountBySearch(ctx, filters)
+	case *data.TopicFilterOptions:
+		return repos.Topic().CountBySearch(ctx, filters)
+	case *data.UserFilterOptions:
+		return repos.User().CountBySearch(ctx, filters)
+	case *data.UserAssetFilterOptions:
+		return repos.UserAsset().CountBySearch(ctx, filters)
+	default:
+		return 0, errors.New("invalid search type")
+	}
+}
+
+// count returns the number of results of the search type matching the query,
+// which is 0 if its qualifiers do not apply to the type.
+func (r *searchableConnectionResolver) count(
+	ctx context.Context,
+	searchType SearchType,
+) (int32, error) {
+	var n int32
+	var err error
+	if r.study != nil {
+		contentType, ok := searchType.studyContentType()
+		if !ok {
+			return 0, nil
+		}
+		n, err = r.study.count(ctx, r.repos, r.query, r.fuzzy, []string{contentType})
+	} else {
+		n, err = countSearch(ctx, r.repos, searchType, r.query, r.fuzzy)
+	}
+	if _, ok := err.(data.SearchQueryError); ok {
+		return 0, nil
+	}
+	return n, err
+}
+
+func (r *searchableConnectionResolver) ActivityCount(ctx context.Context) (int32, error) {
+	return r.count(ctx, SearchTypeActivity)
+}
+
+func (r *searchableConnectionResolver) CommentCount(ctx context.Context) (int32, error) {
+	return r.count(ctx, SearchTypeComment)
 }
 
 func (r *searchableConnectionResolver) CourseCount(ctx context.Context) (int32, error) {
-	filters := &data.CourseFilterOptions{
-		IsPublished: util.NewBool(true),
-		Search:      &r.query,
+	return r.count(ctx, SearchTypeCourse)
+}
+
+// DidYouMean returns titles similar to the query, if few results match the
+// words of the query.
+func (r *searchableConnectionResolver) DidYouMean(ctx context.Context) ([]string, error) {
+	if r.fullTextCount == nil {
+		var n int32
+		var err error
+		if r.study != nil {
+			n, err = r.study.count(ctx, r.repos, r.query, false, r.study.types)
+		} else {
+			n, err = countSearch(ctx, r.repos, r.searchType, r.query, false)
+		}
+		if err != nil {
+			return nil, err
+		}
+		r.fullTextCount = &n
 	}
-	return r.repos.Course().CountBySearch(ctx, filters)
+	if *r.fullTextCount >= r.conf.SearchFuzzyThreshold {
+		return []string{}, nil
+	}
+
+	db, ok := myctx.QueryerFromContext(ctx)
+	if !ok {
+		err := &myctx.ErrNotFound{Name: "queryer"}
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
+		return nil, err
+	}
+	if r.study != nil {
+		return data.GetStudySearchSuggestions(
+			db,
+			r.study.id,
+			strings.Join(r.query.Terms, " "),
+			didYouMeanLimit,
+		)
+	}
+	return data.GetSearchSuggestions(
+		db,
+		r.searchType.searchIndex(),
+		strings.Join(r.query.Terms, " "),
+		didYouMeanLimit,
+	)
 }
 
 func (r *searchableConnectionResolver) Edges() *[]*searchableEdgeResolver {
@@ -75,19 +296,16 @@ func (r *searchableConnectionResolver) Edges() *[]*searchableEdgeResolver {
 	return &[]*searchableEdgeResolver{}
 }
 
+func (r *searchableConnectionResolver) IsFuzzy() bool {
+	return r.fuzzy
+}
+
 func (r *searchableConnectionResolver) LabelCount(ctx context.Context) (int32, error) {
-	filters := &data.LabelFilterOptions{
-		Search: &r.query,
-	}
-	return r.repos.Label().CountBySearch(ctx, filters)
+	return r.count(ctx, SearchTypeLabel)
 }
 
 func (r *searchableConnectionResolver) LessonCount(ctx context.Context) (int32, error) {
-	filters := &data.LessonFilterOptions{
-		IsPublished: util.NewBool(true),
-		Search:      &r.query,
-	}
-	return r.repos.Lesson().CountBySearch(ctx, filters)
+	return r.count(ctx, SearchTypeLesson)
 }
 
 func (r *searchableConnectionResolver) Nodes() (*[]*searchableResolver, error) {
@@ -110,34 +328,26 @@ func (r *searchableConnectionResolver) Nodes() (*[]*searchableResolver, error) {
 	return &nodes, nil
 }
 
+func (r *searchableConnectionResolver) ParsedQuery() *parsedSearchQueryResolver {
+	return &parsedSearchQueryResolver{Query: r.query}
+}
+
 func (r *searchableConnectionResolver) PageInfo() (*pageInfoResolver, error) {
 	return r.pageInfo, nil
 }
 
 func (r *searchableConnectionResolver) StudyCount(ctx context.Context) (int32, error) {
-	filters := &data.StudyFilterOptions{
-		Search: &r.query,
-	}
-	return r.repos.Study().CountBySearch(ctx, filters)
+	return r.count(ctx, SearchTypeStudy)
 }
 
 func (r *searchableConnectionResolver) TopicCount(ctx context.Context) (int32, error) {
-	filters := &data.TopicFilterOptions{
-		Search: &r.query,
-	}
-	return r.repos.Topic().CountBySearch(ctx, filters)
+	return r.count(ctx, SearchTypeTopic)
 }
 
 func (r *searchableConnectionResolver) UserCount(ctx context.Context) (int32, error) {
-	filters := &data.UserFilterOptions{
-		Search: &r.query,
-	}
-	return r.repos.User().CountBySearch(ctx, filters)
+	return r.count(ctx, SearchTypeUser)
 }
 
 func (r *searchableConnectionResolver) UserAssetCount(ctx context.Context) (int32, error) {
-	filters := &data.UserAssetFilterOptions{
-		Search: &r.query,
-	}
-	return r.repos.UserAsset().CountBySearch(ctx, filters)
+	return r.count(ctx, SearchTypeUserAsset)
 }
@@ -4,11 +4,13 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strings"
 
 	graphql "github.com/marksauter/graphql-go"
 	"github.com/marksauter/markus-ninja-api/pkg/data"
 	"github.com/marksauter/markus-ninja-api/pkg/myconf"
 	"github.com/marksauter/markus-ninja-api/pkg/myctx"
+	"github.com/marksauter/markus-ninja-api/pkg/myerr"
 	"github.com/marksauter/markus-ninja-api/pkg/mygql"
 	"github.com/marksauter/markus-ninja-api/pkg/mylog"
 	"github.com/marksauter/markus-ninja-api/pkg/mytype"
@@ -60,13 +62,13 @@ func (r *studyResolver) Activities(
 	studyID, err := r.Study.ID()
 	if err != nil {
 		if err != repo.ErrAccessDenied {
-			mylog.Log.WithError(err).Error(util.Trace(""))
+			mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		}
 		return &resolver, err
 	}
 	activityOrder, err := ParseActivityOrder(args.OrderBy)
 	if err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return &resolver, err
 	}
 
@@ -78,7 +80,7 @@ func (r *studyResolver) Activities(
 		activityOrder,
 	)
 	if err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return &resolver, err
 	}
 
@@ -86,6 +88,7 @@ func (r *studyResolver) Activities(
 	if args.FilterBy != nil {
 		filters = *args.FilterBy
 	}
+	filters.SearchLanguage = r.searchLanguage()
 
 	activities, err := r.Repos.Activity().GetByStudy(
 		ctx,
@@ -94,7 +97,7 @@ func (r *studyResolver) Activities(
 		&filters,
 	)
 	if err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return &resolver, err
 	}
 	activityConnectionResolver, err := NewActivityConnectionResolver(
@@ -106,7 +109,7 @@ func (r *studyResolver) Activities(
 		r.Conf,
 	)
 	if err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return &resolver, err
 	}
 	return activityConnectionResolver, nil
@@ -130,7 +133,7 @@ func (r *studyResolver) AppleGivers(
 	resolver := appleGiverConnectionResolver{}
 	appleGiverOrder, err := ParseAppleGiverOrder(args.OrderBy)
 	if err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return &resolver, err
 	}
 
@@ -142,14 +145,14 @@ func (r *studyResolver) AppleGivers(
 		appleGiverOrder,
 	)
 	if err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return &resolver, err
 	}
 
 	studyID, err := r.Study.ID()
 	if err != nil {
 		if err != repo.ErrAccessDenied {
-			mylog.Log.WithError(err).Error(util.Trace(""))
+			mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		}
 		return &resolver, err
 	}
@@ -160,7 +163,7 @@ func (r *studyResolver) AppleGivers(
 		args.FilterBy,
 	)
 	if err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return &resolver, err
 	}
 	appleGiverConnectionResolver, err := NewAppleGiverConnectionResolver(
@@ -172,7 +175,7 @@ func (r *studyResolver) AppleGivers(
 		r.Conf,
 	)
 	if err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return &resolver, err
 	}
 	return appleGiverConnectionResolver, nil
@@ -215,7 +218,7 @@ func (r *studyResolver) Assets(
 	resolver := userAssetConnectionResolver{}
 	userAssetOrder, err := ParseUserAssetOrder(args.OrderBy)
 	if err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return &resolver, err
 	}
 
@@ -227,37 +230,42 @@ func (r *studyResolver) Assets(
 		userAssetOrder,
 	)
 	if err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return &resolver, err
 	}
 
 	studyID, err := r.Study.ID()
 	if err != nil {
 		if err != repo.ErrAccessDenied {
-			mylog.Log.WithError(err).Error(util.Trace(""))
+			mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		}
 		return &resolver, err
 	}
+	filters := data.UserAssetFilterOptions{}
+	if args.FilterBy != nil {
+		filters = *args.FilterBy
+	}
+	filters.SearchLanguage = r.searchLanguage()
 	userAssets, err := r.Repos.UserAsset().GetByStudy(
 		ctx,
 		studyID.String,
 		pageOptions,
-		args.FilterBy,
+		&filters,
 	)
 	if err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return &resolver, err
 	}
 	userAssetConnectionResolver, err := NewUserAssetConnectionResolver(
 		userAssets,
 		pageOptions,
 		studyID,
-		args.FilterBy,
+		&filters,
 		r.Repos,
 		r.Conf,
 	)
 	if err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return &resolver, err
 	}
 	return userAssetConnectionResolver, nil
@@ -276,7 +284,7 @@ func (r *studyResolver) Comments(
 	studyID, err := r.Study.ID()
 	if err != nil {
 		if err != repo.ErrAccessDenied {
-			mylog.Log.WithError(err).Error(util.Trace(""))
+			mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		}
 		return &resolver, err
 	}
@@ -290,14 +298,14 @@ func (r *studyResolver) Comments(
 		commentOrder,
 	)
 	if err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return &resolver, err
 	}
 
 	filters := data.CommentFilterOptions{}
 	ok, err := r.ViewerCanAdmin(ctx)
 	if err != repo.ErrAccessDenied {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return &resolver, err
 	} else if !ok {
 		filters.IsPublished = util.NewBool(true)
@@ -309,7 +317,7 @@ func (r *studyResolver) Comments(
 		&filters,
 	)
 	if err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return &resolver, err
 	}
 	commentConnectionResolver, err := NewCommentConnectionResolver(
@@ -321,7 +329,7 @@ func (r *studyResolver) Comments(
 		r.Conf,
 	)
 	if err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return &resolver, err
 	}
 	return commentConnectionResolver, nil
@@ -365,13 +373,13 @@ func (r *studyResolver) Courses(
 	studyID, err := r.Study.ID()
 	if err != nil {
 		if err != repo.ErrAccessDenied {
-			mylog.Log.WithError(err).Error(util.Trace(""))
+			mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		}
 		return &resolver, err
 	}
 	courseOrder, err := ParseCourseOrder(args.OrderBy)
 	if err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return &resolver, err
 	}
 
@@ -383,7 +391,7 @@ func (r *studyResolver) Courses(
 		courseOrder,
 	)
 	if err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return &resolver, err
 	}
 
@@ -391,10 +399,11 @@ func (r *studyResolver) Courses(
 	if args.FilterBy != nil {
 		filters = *args.FilterBy
 	}
+	filters.SearchLanguage = r.searchLanguage()
 
 	ok, err := r.ViewerCanAdmin(ctx)
 	if err != nil && err != repo.ErrAccessDenied {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return &resolver, err
 	} else if !ok {
 		filters.IsPublished = util.NewBool(true)
@@ -406,7 +415,7 @@ func (r *studyResolver) Courses(
 		&filters,
 	)
 	if err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return &resolver, err
 	}
 	courseConnectionResolver, err := NewCourseConnectionResolver(
@@ -418,7 +427,7 @@ func (r *studyResolver) Courses(
 		r.Conf,
 	)
 	if err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return &resolver, err
 	}
 	return courseConnectionResolver, nil
@@ -450,7 +459,7 @@ func (r *studyResolver) Enrollees(
 	resolver := enrolleeConnectionResolver{}
 	enrolleeOrder, err := ParseEnrolleeOrder(args.OrderBy)
 	if err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return &resolver, err
 	}
 
@@ -462,13 +471,13 @@ func (r *studyResolver) Enrollees(
 		enrolleeOrder,
 	)
 	if err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return &resolver, err
 	}
 
 	studyID, err := r.Study.ID()
 	if err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return &resolver, err
 	}
 	users, err := r.Repos.User().GetByEnrollable(
@@ -478,7 +487,7 @@ func (r *studyResolver) Enrollees(
 		args.FilterBy,
 	)
 	if err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return &resolver, err
 	}
 	enrolleeConnectionResolver, err := NewEnrolleeConnectionResolver(
@@ -490,7 +499,7 @@ func (r *studyResolver) Enrollees(
 		r.Conf,
 	)
 	if err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return &resolver, err
 	}
 	return enrolleeConnectionResolver, nil
@@ -529,6 +538,36 @@ func (r *studyResolver) ID() (graphql.ID, error) {
 	return graphql.ID(id.String), err
 }
 
+func (r *studyResolver) Invitations(
+	ctx context.Context,
+) ([]*studyInvitationResolver, error) {
+	resolvers := []*studyInvitationResolver{}
+	ok, err := r.ViewerCanAdmin(ctx)
+	if err != nil || !ok {
+		return resolvers, err
+	}
+	db, ok := myctx.QueryerFromContext(ctx)
+	if !ok {
+		return resolvers, &myctx.ErrNotFound{Name: "queryer"}
+	}
+	id, err := r.Study.ID()
+	if err != nil {
+		return resolvers, err
+	}
+	invitations, err := data.GetStudyInvitationByStudy(db, id.String)
+	if err != nil {
+		return resolvers, err
+	}
+	for _, i := range invitations {
+		resolvers = append(resolvers, &studyInvitationResolver{
+			Conf:            r.Conf,
+			Repos:           r.Repos,
+			StudyInvitation: i,
+		})
+	}
+	return resolvers, nil
+}
+
 func (r *studyResolver) IsPrivate(ctx context.Context) (bool, error) {
 	return r.Study.Private()
 }
@@ -571,13 +610,13 @@ func (r *studyResolver) Labels(
 	studyID, err := r.Study.ID()
 	if err != nil {
 		if err != repo.ErrAccessDenied {
-			mylog.Log.WithError(err).Error(util.Trace(""))
+			mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		}
 		return &resolver, err
 	}
 	labelOrder, err := ParseLabelOrder(args.OrderBy)
 	if err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return &resolver, err
 	}
 
@@ -589,13 +628,13 @@ func (r *studyResolver) Labels(
 		labelOrder,
 	)
 	if err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return &resolver, err
 	}
 
 	labels, err := r.Repos.Label().GetByStudy(ctx, studyID.String, pageOptions, args.FilterBy)
 	if err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return &resolver, err
 	}
 	labelConnectionResolver, err := NewLabelConnectionResolver(
@@ -607,7 +646,7 @@ func (r *studyResolver) Labels(
 		r.Conf,
 	)
 	if err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return &resolver, err
 	}
 	return labelConnectionResolver, nil
@@ -651,13 +690,13 @@ func (r *studyResolver) Lessons(
 	studyID, err := r.Study.ID()
 	if err != nil {
 		if err != repo.ErrAccessDenied {
-			mylog.Log.WithError(err).Error(util.Trace(""))
+			mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		}
 		return &resolver, err
 	}
 	lessonOrder, err := ParseLessonOrder(args.OrderBy)
 	if err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return &resolver, err
 	}
 
@@ -669,7 +708,7 @@ func (r *studyResolver) Lessons(
 		lessonOrder,
 	)
 	if err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return &resolver, err
 	}
 
@@ -677,10 +716,11 @@ func (r *studyResolver) Lessons(
 	if args.FilterBy != nil {
 		filters = *args.FilterBy
 	}
+	filters.SearchLanguage = r.searchLanguage()
 
 	ok, err := r.ViewerCanAdmin(ctx)
 	if err != nil && err != repo.ErrAccessDenied {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return &resolver, err
 	} else if !ok {
 		filters.IsPublished = util.NewBool(true)
@@ -692,7 +732,7 @@ func (r *studyResolver) Lessons(
 		&filters,
 	)
 	if err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return &resolver, err
 	}
 	lessonConnectionResolver, err := NewLessonConnectionResolver(
@@ -704,12 +744,42 @@ func (r *studyResolver) Lessons(
 		r.Conf,
 	)
 	if err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return &resolver, err
 	}
 	return lessonConnectionResolver, nil
 }
 
+// LessonGraph returns the study's lessons, and their prerequisites, which may
+// be lessons of other studies.
+func (r *studyResolver) LessonGraph(ctx context.Context) (*lessonGraphResolver, error) {
+	studyID, err := r.Study.ID()
+	if err != nil {
+		return nil, err
+	}
+
+	filters := data.LessonFilterOptions{}
+	ok, err := r.ViewerCanAdmin(ctx)
+	if err != nil && err != repo.ErrAccessDenied {
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
+		return nil, err
+	} else if !ok {
+		filters.IsPublished = util.NewBool(true)
+	}
+	lessons, err := r.Repos.Lesson().GetByStudy(ctx, studyID.String, nil, &filters)
+	if err != nil {
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
+		return nil, err
+	}
+	lessonPrerequisites, err := r.Repos.LessonPrerequisite().GetByStudy(ctx, studyID.String)
+	if err != nil {
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
+		return nil, err
+	}
+
+	return NewLessonGraphResolver(ctx, lessons, lessonPrerequisites, r.Repos, r.Conf)
+}
+
 func (r *studyResolver) Name() (string, error) {
 	return r.Study.Name()
 }
@@ -768,6 +838,114 @@ func (r *studyResolver) ResourcePath(
 	return uri, nil
 }
 
+// Search returns the content of the study matching the query, i.e. its
+// activities, comments, courses, lessons and user assets, ranked together by
+// best match.
+func (r *studyResolver) Search(
+	ctx context.Context,
+	args struct {
+		After  *string
+		Before *string
+		First  *int32
+		Last   *int32
+		Mode   *string
+		Query  string
+		Types  *[]string
+	},
+) (*searchableConnectionResolver, error) {
+	resolver := searchableConnectionResolver{}
+	studyID, err := r.Study.ID()
+	if err != nil {
+		if err != repo.ErrAccessDenied {
+			mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
+		}
+		return &resolver, err
+	}
+	searchMode, err := ParseSearchMode(args.Mode)
+	if err != nil {
+		return &resolver, err
+	}
+	searchQuery, err := data.ParseSearchQuery(args.Query)
+	if err != nil {
+		return &resolver, myerr.ValidationError{Field: "query", Message: err.Error()}
+	}
+	search, err := newStudySearch(studyID.String, r.searchLanguage(), args.Types)
+	if err != nil {
+		return &resolver, err
+	}
+	if _, err := search.filters(searchQuery, false, search.types); err != nil {
+		return &resolver, myerr.ValidationError{Field: "query", Message: err.Error()}
+	}
+
+	pageOptions, err := data.NewPageOptions(
+		args.After,
+		args.Before,
+		args.First,
+		args.Last,
+		&SearchOrder{direction: data.DESC, field: SearchBestMatch},
+	)
+	if err != nil {
+		return &resolver, err
+	}
+
+	// Searches in auto mode fall back to fuzzy matching if few results match
+	// the words of the query.
+	fuzzy := searchMode == SearchModeFuzzy
+	var fullTextCount *int32
+	if searchMode == SearchModeAuto {
+		n, err := search.count(ctx, r.Repos, searchQuery, false, search.types)
+		if err != nil {
+			mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
+			return &resolver, err
+		}
+		fullTextCount = &n
+		fuzzy = n < r.Conf.SearchFuzzyThreshold
+	}
+
+	filters, err := search.filters(searchQuery, fuzzy, search.types)
+	if err != nil {
+		return &resolver, err
+	}
+	permits, err := r.Repos.SearchStudyContent(ctx, search.id, pageOptions, filters)
+	if err != nil {
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
+		return &resolver, err
+	}
+
+	// The results are of several types, so the connection counts and suggests
+	// the content of the study rather than results of a single search type.
+	var searchType SearchType
+	return NewSearchableConnectionResolver(
+		permits,
+		pageOptions,
+		searchQuery,
+		searchType,
+		fuzzy,
+		fullTextCount,
+		search,
+		r.Repos,
+		r.Conf,
+	)
+}
+
+// searchLanguage returns the language the study's content is searched in, or
+// nil if the viewer may not read it.
+func (r *studyResolver) searchLanguage() *string {
+	language, err := r.Study.SearchLanguage()
+	if err != nil {
+		return nil
+	}
+	return &language
+}
+
+func (r *studyResolver) SearchLanguage() (string, error) {
+	language, err := r.Study.SearchLanguage()
+	if err != nil {
+		return "", err
+	}
+	return strings.ToUpper(language), nil
+}
+
 func (r *studyResolver) Timeline(
 	ctx context.Context,
 	args struct {
@@ -783,7 +961,7 @@ func (r *studyResolver) Timeline(
 	filters := &data.EventFilterOptions{}
 	ok, err := r.ViewerCanAdmin(ctx)
 	if err != nil && err != repo.ErrAccessDenied {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return &resolver, err
 	} else if !ok {
 		filters.IsPublic = util.NewBool(true)
@@ -860,13 +1038,13 @@ func (r *studyResolver) Topics(
 	studyID, err := r.Study.ID()
 	if err != nil {
 		if err != repo.ErrAccessDenied {
-			mylog.Log.WithError(err).Error(util.Trace(""))
+			mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		}
 		return &resolver, err
 	}
 	topicOrder, err := ParseTopicOrder(args.OrderBy)
 	if err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return &resolver, err
 	}
 
@@ -878,7 +1056,7 @@ func (r *studyResolver) Topics(
 		topicOrder,
 	)
 	if err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return &resolver, err
 	}
 
@@ -889,7 +1067,7 @@ func (r *studyResolver) Topics(
 		args.FilterBy,
 	)
 	if err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return &resolver, err
 	}
 	topicConnectionResolver, err := NewTopicConnectionResolver(
@@ -901,7 +1079,7 @@ func (r *studyResolver) Topics(
 		r.Conf,
 	)
 	if err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return &resolver, err
 	}
 	return topicConnectionResolver, nil
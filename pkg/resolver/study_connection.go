@@ -20,7 +20,7 @@ func NewStudyConnectionResolver(
 ) (*studyConnectionResolver, error) {
 	edges := make([]*studyEdgeResolver, len(studies))
 	for i := range edges {
-		edge, err := NewStudyEdgeResolver(studies[i], repos, conf)
+		edge, err := NewStudyEdgeResolver(studies[i], pageOptions, repos, conf)
 		if err != nil {
 			return nil, err
 		}
@@ -46,7 +46,10 @@ func NewStudyConnectionResolver(
 }
 
 type studyConnectionResolver struct {
-	conf     *myconf.Config
+	conf *myconf.Config
+	// count counts the studies of connections not of a node, e.g. trending
+	// studies, in place of nodeID.
+	count    func(ctx context.Context) (int32, error)
 	edges    []*studyEdgeResolver
 	filters  *data.StudyFilterOptions
 	nodeID   *mytype.OID
@@ -81,6 +84,9 @@ func (r *studyConnectionResolver) PageInfo() (*pageInfoResolver, error) {
 
 func (r *studyConnectionResolver) TotalCount(ctx context.Context) (int32, error) {
 	var n int32
+	if r.count != nil {
+		return r.count(ctx)
+	}
 	if r.nodeID == nil {
 		return n, nil
 	}
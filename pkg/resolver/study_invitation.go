@@ -0,0 +1,136 @@
+package resolver
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/pgtype"
+	graphql "github.com/marksauter/graphql-go"
+	"github.com/marksauter/markus-ninja-api/pkg/data"
+	"github.com/marksauter/markus-ninja-api/pkg/myconf"
+	"github.com/marksauter/markus-ninja-api/pkg/mygql"
+	"github.com/marksauter/markus-ninja-api/pkg/repo"
+)
+
+// getAdministeredStudy returns the study, or ErrAccessDenied unless the viewer
+// can administer it.
+func (r *RootResolver) getAdministeredStudy(
+	ctx context.Context,
+	studyID string,
+) (*repo.StudyPermit, error) {
+	study, err := r.Repos.Study().Get(ctx, studyID)
+	if err != nil {
+		return nil, err
+	}
+	ok, err := r.Repos.Study().ViewerCanAdmin(ctx, study.Get())
+	if err != nil {
+		return nil, err
+	} else if !ok {
+		return nil, repo.ErrAccessDenied
+	}
+	return study, nil
+}
+
+type studyInvitationResolver struct {
+	Conf            *myconf.Config
+	Repos           *repo.Repos
+	StudyInvitation *data.StudyInvitation
+}
+
+func (r *studyInvitationResolver) Access() string {
+	return r.StudyInvitation.Access.String
+}
+
+func (r *studyInvitationResolver) CreatedAt() graphql.Time {
+	return graphql.Time{Time: r.StudyInvitation.CreatedAt.Time}
+}
+
+func (r *studyInvitationResolver) Email() *string {
+	if r.StudyInvitation.Email.Status != pgtype.Present {
+		return nil
+	}
+	return &r.StudyInvitation.Email.String
+}
+
+func (r *studyInvitationResolver) ExpiresAt() *graphql.Time {
+	if r.StudyInvitation.ExpiresAt.Status != pgtype.Present {
+		return nil
+	}
+	return &graphql.Time{Time: r.StudyInvitation.ExpiresAt.Time}
+}
+
+func (r *studyInvitationResolver) ID() graphql.ID {
+	return graphql.ID(r.StudyInvitation.ID.String)
+}
+
+func (r *studyInvitationResolver) Inviter(ctx context.Context) (*userResolver, error) {
+	user, err := r.Repos.User().Get(ctx, r.StudyInvitation.InviterID.String)
+	if err != nil {
+		return nil, err
+	}
+	return &userResolver{User: user, Conf: r.Conf, Repos: r.Repos}, nil
+}
+
+func (r *studyInvitationResolver) IsValid() bool {
+	i := r.StudyInvitation
+	if i.RevokedAt.Status == pgtype.Present {
+		return false
+	}
+	if i.ExpiresAt.Status == pgtype.Present && !i.ExpiresAt.Time.After(time.Now()) {
+		return false
+	}
+	if i.MaxUses.Status == pgtype.Present && i.UseCount.Int >= i.MaxUses.Int {
+		return false
+	}
+	return true
+}
+
+func (r *studyInvitationResolver) MaxUses() *int32 {
+	if r.StudyInvitation.MaxUses.Status != pgtype.Present {
+		return nil
+	}
+	return &r.StudyInvitation.MaxUses.Int
+}
+
+func (r *studyInvitationResolver) RevokedAt() *graphql.Time {
+	if r.StudyInvitation.RevokedAt.Status != pgtype.Present {
+		return nil
+	}
+	return &graphql.Time{Time: r.StudyInvitation.RevokedAt.Time}
+}
+
+func (r *studyInvitationResolver) Study(ctx context.Context) (*studyResolver, error) {
+	study, err := r.Repos.Study().Get(ctx, r.StudyInvitation.StudyID.String)
+	if err != nil {
+		return nil, err
+	}
+	return &studyResolver{Study: study, Conf: r.Conf, Repos: r.Repos}, nil
+}
+
+func (r *studyInvitationResolver) Token() string {
+	return r.StudyInvitation.Token.String
+}
+
+func (r *studyInvitationResolver) URL(ctx context.Context) (mygql.URI, error) {
+	var uri mygql.URI
+	study, err := r.Study(ctx)
+	if err != nil {
+		return uri, err
+	}
+	resourcePath, err := study.ResourcePath(ctx)
+	if err != nil {
+		return uri, err
+	}
+	uri = mygql.URI(fmt.Sprintf(
+		"%s%s/invitation/%s",
+		r.Conf.ClientURL,
+		resourcePath,
+		r.StudyInvitation.Token.String,
+	))
+	return uri, nil
+}
+
+func (r *studyInvitationResolver) UseCount() int32 {
+	return r.StudyInvitation.UseCount.Int
+}
@@ -16,6 +16,10 @@ const (
 	StudyCreatedAt
 	StudyEnrolledAt
 	StudyLessonCount
+	// StudyRecommendationScore and StudyTrendingScore order recommended and
+	// trending studies. They are not parsed from arguments.
+	StudyRecommendationScore
+	StudyTrendingScore
 )
 
 func ParseStudyOrderField(s string) (StudyOrderField, error) {
@@ -52,6 +56,10 @@ func (f StudyOrderField) String() string {
 		return "enrolled_at"
 	case StudyLessonCount:
 		return "lesson_count"
+	case StudyRecommendationScore:
+		return "recommendation_score"
+	case StudyTrendingScore:
+		return "trending_score"
 	default:
 		return "unknown"
 	}
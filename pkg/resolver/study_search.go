@@ -0,0 +1,81 @@
+package resolver
+
+import (
+	"context"
+
+	"github.com/marksauter/markus-ninja-api/pkg/data"
+	"github.com/marksauter/markus-ninja-api/pkg/myerr"
+	"github.com/marksauter/markus-ninja-api/pkg/repo"
+)
+
+// studySearch is a search of the content of a study, i.e. its activities,
+// comments, courses, lessons and user assets, ranked together.
+type studySearch struct {
+	id string
+	// language is the search language of the study.
+	language *string
+	// types are the types of content searched, or all of them if empty.
+	types []string
+}
+
+// newStudySearch returns the search of the content of the study with id, of
+// the search types, or of all of them if searchTypes is nil.
+func newStudySearch(
+	id string,
+	language *string,
+	searchTypes *[]string,
+) (*studySearch, error) {
+	search := &studySearch{id: id, language: language}
+	if searchTypes == nil {
+		return search, nil
+	}
+	search.types = make([]string, 0, len(*searchTypes))
+	for _, t := range *searchTypes {
+		searchType, err := ParseSearchType(t)
+		if err != nil {
+			return nil, err
+		}
+		contentType, ok := searchType.studyContentType()
+		if !ok {
+			return nil, myerr.ValidationError{
+				Field:   "types",
+				Message: "cannot search " + t + " results in a study",
+			}
+		}
+		search.types = append(search.types, contentType)
+	}
+	return search, nil
+}
+
+// filters returns the filter options of the search of the content of the types
+// for query.
+func (s *studySearch) filters(
+	query *data.SearchQuery,
+	fuzzy bool,
+	types []string,
+) (*data.StudyContentFilterOptions, error) {
+	filters, err := query.StudyContentFilterOptions()
+	if err != nil {
+		return nil, err
+	}
+	filters.Fuzzy = fuzzy
+	filters.SearchLanguage = s.language
+	filters.Types = types
+	return filters, nil
+}
+
+// count returns the number of results of the content of the types matching
+// query.
+func (s *studySearch) count(
+	ctx context.Context,
+	repos *repo.Repos,
+	query *data.SearchQuery,
+	fuzzy bool,
+	types []string,
+) (int32, error) {
+	filters, err := s.filters(query, fuzzy, types)
+	if err != nil {
+		return 0, err
+	}
+	return repos.CountStudyContentBySearch(ctx, s.id, filters)
+}
@@ -20,7 +20,7 @@ func NewStudyTimelineConnectionResolver(
 ) (*studyTimelineConnectionResolver, error) {
 	edges := make([]*studyTimelineEventEdgeResolver, len(events))
 	for i := range edges {
-		edge, err := NewStudyTimelineEventEdgeResolver(events[i], repos, conf)
+		edge, err := NewStudyTimelineEventEdgeResolver(events[i], pageOptions, repos, conf)
 		if err != nil {
 			return nil, err
 		}
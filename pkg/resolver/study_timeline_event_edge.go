@@ -11,14 +11,11 @@ import (
 
 func NewStudyTimelineEventEdgeResolver(
 	event *repo.EventPermit,
+	pageOptions *data.PageOptions,
 	repos *repo.Repos,
 	conf *myconf.Config,
 ) (*studyTimelineEventEdgeResolver, error) {
-	id, err := event.ID()
-	if err != nil {
-		return nil, err
-	}
-	cursor, err := data.EncodeCursor(id.String)
+	cursor, err := event.Cursor(pageOptions.OrderField())
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,69 @@
+package resolver
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	graphql "github.com/marksauter/graphql-go"
+	"github.com/marksauter/markus-ninja-api/pkg/data"
+	"github.com/marksauter/markus-ninja-api/pkg/myconf"
+	"github.com/marksauter/markus-ninja-api/pkg/mytype"
+	"github.com/marksauter/markus-ninja-api/pkg/repo"
+)
+
+func ParseSuggestionType(s string) (string, error) {
+	switch strings.ToUpper(s) {
+	case "LABEL":
+		return data.SuggestionTypeLabel, nil
+	case "LESSON":
+		return data.SuggestionTypeLesson, nil
+	case "STUDY":
+		return data.SuggestionTypeStudy, nil
+	case "TOPIC":
+		return data.SuggestionTypeTopic, nil
+	case "USER":
+		return data.SuggestionTypeUser, nil
+	default:
+		return "", fmt.Errorf("invalid SuggestionType: %q", s)
+	}
+}
+
+type suggestionResolver struct {
+	Conf       *myconf.Config
+	Repos      *repo.Repos
+	Suggestion *data.Suggestion
+}
+
+func (r *suggestionResolver) ID() graphql.ID {
+	return graphql.ID(r.Suggestion.ID.String)
+}
+
+func (r *suggestionResolver) Node(ctx context.Context) (*searchableResolver, error) {
+	id, err := mytype.ParseOID(r.Suggestion.ID.String)
+	if err != nil {
+		return nil, err
+	}
+	permit, err := r.Repos.GetNode(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	resolver, err := nodePermitToResolver(permit, r.Repos, r.Conf)
+	if err != nil {
+		return nil, err
+	}
+	searchable, ok := resolver.(searchable)
+	if !ok {
+		return nil, errors.New("cannot convert resolver to searchable")
+	}
+	return &searchableResolver{searchable}, nil
+}
+
+func (r *suggestionResolver) Text() string {
+	return r.Suggestion.Text.String
+}
+
+func (r *suggestionResolver) Type() string {
+	return strings.ToUpper(r.Suggestion.Type.String)
+}
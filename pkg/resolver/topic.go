@@ -20,6 +20,14 @@ type topicResolver struct {
 	Topic *repo.TopicPermit
 }
 
+func (r *topicResolver) Aliases(ctx context.Context) ([]string, error) {
+	id, err := r.Topic.ID()
+	if err != nil {
+		return nil, err
+	}
+	return r.Repos.Topic().GetAliasNames(ctx, id.String)
+}
+
 func (r *topicResolver) CreatedAt() (graphql.Time, error) {
 	t, err := r.Topic.CreatedAt()
 	return graphql.Time{t}, err
@@ -29,15 +37,67 @@ func (r *topicResolver) Description() (string, error) {
 	return r.Topic.Description()
 }
 
+func (r *topicResolver) DisplayName() (string, error) {
+	return r.Topic.DisplayName()
+}
+
+func (r *topicResolver) FeaturedAt() (*graphql.Time, error) {
+	t, err := r.Topic.FeaturedAt()
+	if err != nil {
+		return nil, err
+	}
+	if t != nil {
+		return &graphql.Time{Time: *t}, nil
+	}
+	return nil, nil
+}
+
 func (r *topicResolver) ID() (graphql.ID, error) {
 	id, err := r.Topic.ID()
 	return graphql.ID(id.String), err
 }
 
+func (r *topicResolver) IsFeatured() (bool, error) {
+	t, err := r.Topic.FeaturedAt()
+	return t != nil, err
+}
+
+// Logo returns the user asset shown as the logo of the topic, or nil if it has
+// none, or the viewer cannot read it.
+func (r *topicResolver) Logo(ctx context.Context) (*userAssetResolver, error) {
+	logoID, err := r.Topic.LogoID()
+	if err != nil || logoID == nil {
+		return nil, err
+	}
+	userAsset, err := r.Repos.UserAsset().Get(ctx, logoID.String)
+	if err == repo.ErrAccessDenied {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+	return &userAssetResolver{UserAsset: userAsset, Conf: r.Conf, Repos: r.Repos}, nil
+}
+
 func (r *topicResolver) Name() (string, error) {
 	return r.Topic.Name()
 }
 
+func (r *topicResolver) RelatedTopics(ctx context.Context) ([]*topicResolver, error) {
+	id, err := r.Topic.ID()
+	if err != nil {
+		return nil, err
+	}
+	topics, err := r.Repos.Topic().GetRelated(ctx, id.String)
+	if err != nil {
+		return nil, err
+	}
+	resolvers := make([]*topicResolver, len(topics))
+	for i, t := range topics {
+		resolvers[i] = &topicResolver{Topic: t, Conf: r.Conf, Repos: r.Repos}
+	}
+	return resolvers, nil
+}
+
 func (r *topicResolver) ResourcePath() (mygql.URI, error) {
 	var uri mygql.URI
 	name, err := r.Name()
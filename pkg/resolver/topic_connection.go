@@ -19,7 +19,7 @@ func NewTopicConnectionResolver(
 ) (*topicConnectionResolver, error) {
 	edges := make([]*topicEdgeResolver, len(topics))
 	for i := range edges {
-		edge, err := NewTopicEdgeResolver(topics[i], repos, conf)
+		edge, err := NewTopicEdgeResolver(topics[i], pageOptions, repos, conf)
 		if err != nil {
 			return nil, err
 		}
@@ -45,7 +45,10 @@ func NewTopicConnectionResolver(
 }
 
 type topicConnectionResolver struct {
-	conf        *myconf.Config
+	conf *myconf.Config
+	// count counts the topics of connections not of a topicable, e.g. featured
+	// topics, in place of topicableID.
+	count       func(ctx context.Context) (int32, error)
 	edges       []*topicEdgeResolver
 	filters     *data.TopicFilterOptions
 	pageInfo    *pageInfoResolver
@@ -79,5 +82,12 @@ func (r *topicConnectionResolver) PageInfo() (*pageInfoResolver, error) {
 }
 
 func (r *topicConnectionResolver) TotalCount(ctx context.Context) (int32, error) {
+	var n int32
+	if r.count != nil {
+		return r.count(ctx)
+	}
+	if r.topicableID == nil {
+		return n, nil
+	}
 	return r.repos.Topic().CountByTopicable(ctx, r.topicableID.String, r.filters)
 }
@@ -13,6 +13,8 @@ const (
 	TopicTopicedAt TopicOrderField = iota
 	TopicTopicedCount
 	TopicName
+	// TopicFeaturedAt orders featured topics. It is not parsed from arguments.
+	TopicFeaturedAt
 )
 
 func ParseTopicOrderField(s string) (TopicOrderField, error) {
@@ -37,6 +39,8 @@ func (f TopicOrderField) String() string {
 		return "topiced_count"
 	case TopicName:
 		return "name"
+	case TopicFeaturedAt:
+		return "featured_at"
 	default:
 		return "unknown"
 	}
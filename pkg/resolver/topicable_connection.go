@@ -21,7 +21,7 @@ func NewTopicableConnectionResolver(
 ) (*topicableConnectionResolver, error) {
 	edges := make([]*topicableEdgeResolver, len(topicables))
 	for i := range edges {
-		edge, err := NewTopicableEdgeResolver(topicables[i], repos, conf)
+		edge, err := NewTopicableEdgeResolver(topicables[i], pageOptions, repos, conf)
 		if err != nil {
 			return nil, err
 		}
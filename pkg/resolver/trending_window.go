@@ -0,0 +1,60 @@
+package resolver
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/marksauter/markus-ninja-api/pkg/data"
+)
+
+type TrendingWindow int
+
+const (
+	TrendingWindowDay TrendingWindow = iota
+	TrendingWindowWeek
+	TrendingWindowMonth
+)
+
+func ParseTrendingWindow(s *string) (TrendingWindow, error) {
+	if s == nil {
+		return TrendingWindowWeek, nil
+	}
+	switch strings.ToUpper(*s) {
+	case "DAY":
+		return TrendingWindowDay, nil
+	case "WEEK":
+		return TrendingWindowWeek, nil
+	case "MONTH":
+		return TrendingWindowMonth, nil
+	default:
+		var f TrendingWindow
+		return f, fmt.Errorf("invalid TrendingWindow: %q", *s)
+	}
+}
+
+// period returns the trending period of the window in the database.
+func (f TrendingWindow) period() string {
+	switch f {
+	case TrendingWindowDay:
+		return data.TrendingPeriodDay
+	case TrendingWindowWeek:
+		return data.TrendingPeriodWeek
+	case TrendingWindowMonth:
+		return data.TrendingPeriodMonth
+	default:
+		return "unknown"
+	}
+}
+
+func (f TrendingWindow) String() string {
+	switch f {
+	case TrendingWindowDay:
+		return "day"
+	case TrendingWindowWeek:
+		return "week"
+	case TrendingWindowMonth:
+		return "month"
+	default:
+		return "unknown"
+	}
+}
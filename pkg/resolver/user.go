@@ -229,6 +229,69 @@ func (r *userResolver) BioHTML() (mygql.HTML, error) {
 	return h, nil
 }
 
+// BlockedUsers returns the users the user has blocked, and is only visible to
+// the user.
+func (r *userResolver) BlockedUsers(
+	ctx context.Context,
+	args struct {
+		After    *string
+		Before   *string
+		FilterBy *data.UserFilterOptions
+		First    *int32
+		Last     *int32
+		OrderBy  *OrderArg
+	},
+) (*blockedUserConnectionResolver, error) {
+	isViewer, err := r.IsViewer(ctx)
+	if err != nil {
+		return nil, err
+	} else if !isViewer {
+		return nil, repo.ErrAccessDenied
+	}
+	id, err := r.User.ID()
+	if err != nil {
+		return nil, err
+	}
+
+	blockedUserOrder, err := ParseBlockedUserOrder(args.OrderBy)
+	if err != nil {
+		return nil, err
+	}
+
+	pageOptions, err := data.NewPageOptions(
+		args.After,
+		args.Before,
+		args.First,
+		args.Last,
+		blockedUserOrder,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	users, err := r.Repos.User().GetByBlocker(
+		ctx,
+		id.String,
+		pageOptions,
+		args.FilterBy,
+	)
+	if err != nil {
+		return nil, err
+	}
+	resolver, err := NewBlockedUserConnectionResolver(
+		users,
+		pageOptions,
+		id,
+		args.FilterBy,
+		r.Repos,
+		r.Conf,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return resolver, nil
+}
+
 func (r *userResolver) CreatedAt() (graphql.Time, error) {
 	t, err := r.User.CreatedAt()
 	return graphql.Time{t}, err
@@ -273,7 +336,7 @@ func (r *userResolver) Courses(
 
 	ok, err := r.IsViewer(ctx)
 	if err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return resolver, err
 	} else if !ok {
 		filters.IsPublished = util.NewBool(true)
@@ -590,6 +653,52 @@ func (r *userResolver) Notifications(
 	return notificationConnectionResolver, nil
 }
 
+func (r *userResolver) DeletionScheduledFor(ctx context.Context) (*graphql.Time, error) {
+	isViewer, err := r.IsViewer(ctx)
+	if err != nil || !isViewer {
+		return nil, err
+	}
+	db, ok := myctx.QueryerFromContext(ctx)
+	if !ok {
+		return nil, &myctx.ErrNotFound{Name: "queryer"}
+	}
+	id, err := r.User.ID()
+	if err != nil {
+		return nil, err
+	}
+	accountDeletion, err := data.GetAccountDeletionByUser(db, id.String)
+	if err != nil {
+		if err == data.ErrNotFound {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &graphql.Time{Time: accountDeletion.ScheduledFor.Time}, nil
+}
+
+func (r *userResolver) FeedToken(ctx context.Context) (*string, error) {
+	isViewer, err := r.IsViewer(ctx)
+	if err != nil || !isViewer {
+		return nil, err
+	}
+	db, ok := myctx.QueryerFromContext(ctx)
+	if !ok {
+		return nil, &myctx.ErrNotFound{Name: "queryer"}
+	}
+	id, err := r.User.ID()
+	if err != nil {
+		return nil, err
+	}
+	feedToken, err := data.GetFeedTokenByUser(db, id.String)
+	if err != nil {
+		if err == data.ErrNotFound {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &feedToken.Token.String, nil
+}
+
 func (r *userResolver) ID() (graphql.ID, error) {
 	id, err := r.User.ID()
 	return graphql.ID(id.String), err
@@ -635,13 +744,13 @@ func (r *userResolver) Lessons(
 	userID, err := r.User.ID()
 	if err != nil {
 		if err != repo.ErrAccessDenied {
-			mylog.Log.WithError(err).Error(util.Trace(""))
+			mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		}
 		return resolver, err
 	}
 	lessonOrder, err := ParseLessonOrder(args.OrderBy)
 	if err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return resolver, err
 	}
 
@@ -653,7 +762,7 @@ func (r *userResolver) Lessons(
 		lessonOrder,
 	)
 	if err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return resolver, err
 	}
 
@@ -664,7 +773,7 @@ func (r *userResolver) Lessons(
 
 	ok, err := r.IsViewer(ctx)
 	if err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return resolver, err
 	} else if !ok {
 		filters.IsPublished = util.NewBool(true)
@@ -676,7 +785,7 @@ func (r *userResolver) Lessons(
 		&filters,
 	)
 	if err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return resolver, err
 	}
 	resolver, err = NewLessonConnectionResolver(
@@ -688,7 +797,7 @@ func (r *userResolver) Lessons(
 		r.Conf,
 	)
 	if err != nil {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return resolver, err
 	}
 	return resolver, nil
@@ -756,6 +865,65 @@ func (r *userResolver) ReceivedTimeline(
 	)
 }
 
+// RecommendedStudies returns the studies recommended to the user, and is only
+// visible to the user.
+func (r *userResolver) RecommendedStudies(
+	ctx context.Context,
+	args struct {
+		After  *string
+		Before *string
+		First  *int32
+		Last   *int32
+	},
+) (*studyConnectionResolver, error) {
+	isViewer, err := r.IsViewer(ctx)
+	if err != nil {
+		return nil, err
+	} else if !isViewer {
+		return nil, repo.ErrAccessDenied
+	}
+	id, err := r.User.ID()
+	if err != nil {
+		return nil, err
+	}
+
+	pageOptions, err := data.NewPageOptions(
+		args.After,
+		args.Before,
+		args.First,
+		args.Last,
+		&StudyOrder{direction: data.DESC, field: StudyRecommendationScore},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	studies, err := r.Repos.Study().GetRecommended(
+		ctx,
+		id.String,
+		pageOptions,
+		nil,
+	)
+	if err != nil {
+		return nil, err
+	}
+	resolver, err := NewStudyConnectionResolver(
+		studies,
+		pageOptions,
+		id,
+		nil,
+		r.Repos,
+		r.Conf,
+	)
+	if err != nil {
+		return nil, err
+	}
+	resolver.count = func(ctx context.Context) (int32, error) {
+		return r.Repos.Study().CountRecommended(ctx, id.String, nil)
+	}
+	return resolver, nil
+}
+
 func (r *userResolver) ResourcePath() (mygql.URI, error) {
 	var uri mygql.URI
 	login, err := r.User.Login()
@@ -852,7 +1020,7 @@ func (r *userResolver) Timeline(
 	filters := &data.EventFilterOptions{}
 	ok, err := r.IsViewer(ctx)
 	if err != nil && err != repo.ErrAccessDenied {
-		mylog.Log.WithError(err).Error(util.Trace(""))
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
 		return &resolver, err
 	} else if !ok {
 		filters.IsPublic = util.NewBool(true)
@@ -940,3 +1108,11 @@ func (r *userResolver) ViewerCanEnroll(ctx context.Context) (bool, error) {
 	enrolled.UserID.Set(viewer.ID)
 	return r.Repos.Enrolled().ViewerCanEnroll(ctx, enrolled)
 }
+
+func (r *userResolver) ViewerHasBlocked(ctx context.Context) (bool, error) {
+	userID, err := r.User.ID()
+	if err != nil {
+		return false, err
+	}
+	return r.Repos.User().ViewerHasBlocked(ctx, userID.String)
+}
@@ -434,15 +434,15 @@ func (r *userAssetResolver) ViewerNewComment(ctx context.Context) (*commentResol
 		}
 		comment := &data.Comment{}
 		if err := comment.CommentableID.Set(userAssetID); err != nil {
-			mylog.Log.WithError(err).Error("failed to set comment commentable_id")
+			mylog.Log.WithContext(ctx).WithError(err).Error("failed to set comment commentable_id")
 			return nil, myerr.SomethingWentWrongError
 		}
 		if err := comment.StudyID.Set(studyID); err != nil {
-			mylog.Log.WithError(err).Error("failed to set comment user_id")
+			mylog.Log.WithContext(ctx).WithError(err).Error("failed to set comment user_id")
 			return nil, myerr.SomethingWentWrongError
 		}
 		if err := comment.UserID.Set(&viewer.ID); err != nil {
-			mylog.Log.WithError(err).Error("failed to set comment user_id")
+			mylog.Log.WithContext(ctx).WithError(err).Error("failed to set comment user_id")
 			return nil, myerr.SomethingWentWrongError
 		}
 		commentPermit, err = r.Repos.Comment().Create(ctx, comment)
@@ -20,7 +20,7 @@ func NewUserAssetConnectionResolver(
 ) (*userAssetConnectionResolver, error) {
 	edges := make([]*userAssetEdgeResolver, len(userAssets))
 	for i := range edges {
-		edge, err := NewUserAssetEdgeResolver(userAssets[i], repos, conf)
+		edge, err := NewUserAssetEdgeResolver(userAssets[i], pageOptions, repos, conf)
 		if err != nil {
 			return nil, err
 		}
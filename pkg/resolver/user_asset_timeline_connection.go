@@ -20,7 +20,7 @@ func NewUserAssetTimelineConnectionResolver(
 ) (*userAssetTimelineConnectionResolver, error) {
 	edges := make([]*userAssetTimelineEventEdgeResolver, len(events))
 	for i := range edges {
-		edge, err := NewUserAssetTimelineEventEdgeResolver(events[i], repos, conf)
+		edge, err := NewUserAssetTimelineEventEdgeResolver(events[i], pageOptions, repos, conf)
 		if err != nil {
 			return nil, err
 		}
@@ -19,7 +19,7 @@ func NewUserReceivedTimelineConnectionResolver(
 ) (*userReceivedTimelineConnectionResolver, error) {
 	edges := make([]*userTimelineEventEdgeResolver, len(events))
 	for i := range edges {
-		edge, err := NewUserTimelineEventEdgeResolver(events[i], repos, conf)
+		edge, err := NewUserTimelineEventEdgeResolver(events[i], pageOptions, repos, conf)
 		if err != nil {
 			return nil, err
 		}
@@ -20,7 +20,7 @@ func NewUserTimelineConnectionResolver(
 ) (*userTimelineConnectionResolver, error) {
 	edges := make([]*userTimelineEventEdgeResolver, len(events))
 	for i := range edges {
-		edge, err := NewUserTimelineEventEdgeResolver(events[i], repos, conf)
+		edge, err := NewUserTimelineEventEdgeResolver(events[i], pageOptions, repos, conf)
 		if err != nil {
 			return nil, err
 		}
@@ -0,0 +1,166 @@
+package scheduler
+
+import (
+	"context"
+
+	"github.com/marksauter/markus-ninja-api/pkg/data"
+	"github.com/marksauter/markus-ninja-api/pkg/myconf"
+	"github.com/marksauter/markus-ninja-api/pkg/mylog"
+	"github.com/marksauter/markus-ninja-api/pkg/repo"
+	"github.com/marksauter/markus-ninja-api/pkg/service"
+	"github.com/marksauter/markus-ninja-api/pkg/util"
+	"github.com/sirupsen/logrus"
+)
+
+// NewAccountDeletionJob returns a job that deletes the accounts whose grace
+// period has passed. The user's comments on other users' studies are
+// reassigned to the ghost user, their objects are purged from storage, and
+// what was deleted is recorded in the account deletion log. Each account is
+// deleted in its own transaction, which holds a lock on the deletion so that
+// another instance cannot delete it too.
+func NewAccountDeletionJob(
+	db data.Queryer,
+	conf *myconf.Config,
+	svcs *service.Services,
+) Job {
+	d := &accountDeleter{
+		conf: conf,
+		db:   db,
+		svcs: svcs,
+	}
+	return Job{
+		Name: "account_deletion",
+		Run:  d.run,
+	}
+}
+
+type accountDeleter struct {
+	conf *myconf.Config
+	db   data.Queryer
+	svcs *service.Services
+}
+
+// run deletes due accounts until none are left. Accounts that fail to delete
+// are skipped for the rest of the run, and retried on the next one.
+func (d *accountDeleter) run(ctx context.Context) error {
+	skip := []string{}
+	for ctx.Err() == nil {
+		userID, err := d.deleteNext(ctx, skip)
+		if err == data.ErrNotFound {
+			return nil
+		} else if err != nil {
+			if userID == "" {
+				return err
+			}
+			mylog.Log.WithContext(ctx).
+				WithError(err).
+				WithField("user_id", userID).
+				Error(util.Trace("failed to delete account"))
+			skip = append(skip, userID)
+		}
+	}
+	return nil
+}
+
+func (d *accountDeleter) deleteNext(
+	ctx context.Context,
+	skip []string,
+) (string, error) {
+	tx, err, newTx := data.BeginTransaction(data.WithContext(ctx, d.db))
+	if err != nil {
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
+		return "", err
+	} else if newTx {
+		defer data.RollbackTransaction(tx)
+	}
+
+	accountDeletion, err := data.ClaimAccountDeletion(tx, skip)
+	if err != nil {
+		return "", err
+	}
+	userID := accountDeletion.UserID.String
+
+	user, err := data.GetUser(tx, userID)
+	if err != nil {
+		return userID, err
+	}
+	ghost, err := data.GetUser(tx, repo.GhostID)
+	if err != nil {
+		return userID, err
+	}
+
+	record := &data.AccountDeletionLog{
+		RequestedAt: accountDeletion.RequestedAt,
+		UserID:      user.ID,
+	}
+	if err := record.Login.Set(user.Login.String); err != nil {
+		return userID, err
+	}
+
+	studies, err := data.CountStudyByUser(tx, userID, nil)
+	if err != nil {
+		return userID, err
+	}
+	courses, err := data.CountCourseByUser(tx, userID, nil)
+	if err != nil {
+		return userID, err
+	}
+	lessons, err := data.CountLessonByUser(tx, userID, nil)
+	if err != nil {
+		return userID, err
+	}
+	assets, err := data.CountUserAssetByUser(tx, userID, nil)
+	if err != nil {
+		return userID, err
+	}
+	comments, err := data.CountCommentByUser(tx, userID, nil)
+	if err != nil {
+		return userID, err
+	}
+	reassigned, err := data.ReassignComment(tx, userID, ghost.ID.String)
+	if err != nil {
+		return userID, err
+	}
+
+	// Objects are purged before the account is deleted, so that if purging
+	// fails the account is kept, and purged again on the next run.
+	objects, err := d.svcs.Storage.DeleteUser(&user.ID)
+	if err != nil {
+		return userID, err
+	}
+
+	if err := data.DeleteUser(tx, userID); err != nil {
+		return userID, err
+	}
+
+	record.AssetsDeleted.Set(assets)
+	record.CommentsDeleted.Set(comments - reassigned)
+	record.CommentsReassigned.Set(reassigned)
+	record.CoursesDeleted.Set(courses)
+	record.LessonsDeleted.Set(lessons)
+	record.ObjectsRemoved.Set(objects)
+	record.StudiesDeleted.Set(studies)
+	if err := data.CreateAccountDeletionLog(tx, record); err != nil {
+		return userID, err
+	}
+
+	if newTx {
+		if err := data.CommitTransaction(tx); err != nil {
+			mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
+			return userID, err
+		}
+	}
+
+	mylog.Log.WithContext(ctx).WithFields(logrus.Fields{
+		"user_id":             userID,
+		"login":               user.Login.String,
+		"assets_deleted":      assets,
+		"comments_deleted":    comments - reassigned,
+		"comments_reassigned": reassigned,
+		"courses_deleted":     courses,
+		"lessons_deleted":     lessons,
+		"objects_removed":     objects,
+		"studies_deleted":     studies,
+	}).Info(util.Trace("account deleted"))
+	return userID, nil
+}
@@ -0,0 +1,378 @@
+package scheduler
+
+import (
+	"archive/zip"
+	"context"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"os"
+	"path"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/pgtype"
+	"github.com/marksauter/markus-ninja-api/pkg/data"
+	"github.com/marksauter/markus-ninja-api/pkg/myconf"
+	"github.com/marksauter/markus-ninja-api/pkg/mylog"
+	"github.com/marksauter/markus-ninja-api/pkg/service"
+	"github.com/marksauter/markus-ninja-api/pkg/util"
+)
+
+// NewDataExportJob returns a job that builds the pending data exports. Each
+// export is archived as JSON files of the user's data, along with the original
+// files of their assets, uploaded to storage, and mailed to the user as a
+// signed download link. Archives are deleted once their links expire.
+func NewDataExportJob(
+	db data.Queryer,
+	conf *myconf.Config,
+	svcs *service.Services,
+) Job {
+	e := &exporter{
+		conf: conf,
+		db:   db,
+		svcs: svcs,
+	}
+	return Job{
+		Name: "data_export",
+		Run:  e.run,
+	}
+}
+
+type exporter struct {
+	conf *myconf.Config
+	db   data.Queryer
+	svcs *service.Services
+}
+
+func (e *exporter) run(ctx context.Context) error {
+	db := data.WithContext(ctx, e.db)
+	for ctx.Err() == nil {
+		dataExport, err := data.ClaimDataExport(db)
+		if err == data.ErrNotFound {
+			return nil
+		} else if err != nil {
+			return err
+		}
+
+		update := &data.DataExport{ID: dataExport.ID}
+		if err := e.export(ctx, db, dataExport, update); err != nil {
+			mylog.Log.WithContext(ctx).
+				WithError(err).
+				WithField("id", dataExport.ID.String).
+				Error(util.Trace("failed to export data"))
+			update.Status.Set(data.DataExportFailed)
+		} else {
+			update.Status.Set(data.DataExportSucceeded)
+		}
+		update.EndedAt.Set(time.Now())
+		if _, err := data.UpdateDataExport(db, update); err != nil {
+			return err
+		}
+	}
+	return e.deleteExpired(ctx, db)
+}
+
+// deleteExpired deletes the archives of the exports whose download links have
+// expired, as they can no longer be downloaded.
+func (e *exporter) deleteExpired(ctx context.Context, db data.Queryer) error {
+	dataExports, err := data.GetExpiredDataExport(db)
+	if err != nil {
+		return err
+	}
+	for _, dataExport := range dataExports {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		if err := e.svcs.Storage.DeleteDataExport(dataExport.ObjectPath.String); err != nil {
+			return err
+		}
+		update := &data.DataExport{ID: dataExport.ID}
+		update.ObjectPath.Set(nil)
+		if _, err := data.UpdateDataExport(db, update); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (e *exporter) export(
+	ctx context.Context,
+	db data.Queryer,
+	dataExport *data.DataExport,
+	update *data.DataExport,
+) error {
+	user, err := data.GetUserCredentials(db, dataExport.UserID.String)
+	if err != nil {
+		return err
+	}
+
+	f, err := ioutil.TempFile("", "data-export-")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(f.Name())
+	defer f.Close()
+
+	if err := e.archive(ctx, db, user, f); err != nil {
+		return err
+	}
+	size, err := f.Seek(0, io.SeekCurrent)
+	if err != nil {
+		return err
+	}
+	if _, err := f.Seek(0, io.SeekStart); err != nil {
+		return err
+	}
+
+	objectPath, err := e.svcs.Storage.UploadDataExport(&user.ID, &dataExport.ID, f, size)
+	if err != nil {
+		return err
+	}
+	link, err := e.svcs.Storage.PresignedURL(objectPath, e.conf.DataExportLinkExpiry)
+	if err != nil {
+		return err
+	}
+	expiresAt := time.Now().Add(e.conf.DataExportLinkExpiry)
+	update.ObjectPath.Set(objectPath)
+	update.ExpiresAt.Set(expiresAt)
+
+	return e.svcs.Mail.SendDataExportMail(&service.SendDataExportMailInput{
+		ExpiresAt: expiresAt,
+		Link:      link,
+		To:        user.PrimaryEmail.String,
+		UserLogin: user.Login.String,
+	})
+}
+
+// archive writes the zip archive of the user's data to w.
+func (e *exporter) archive(
+	ctx context.Context,
+	db data.Queryer,
+	user *data.User,
+	w io.Writer,
+) error {
+	userID := user.ID.String
+	zw := zip.NewWriter(w)
+
+	account, err := data.GetUser(db, userID)
+	if err != nil {
+		return err
+	}
+	accountRow := exportRow(account)
+	accountRow["primary_email"] = user.PrimaryEmail.String
+	profileRow := map[string]interface{}{}
+	for _, column := range []string{"bio", "name", "profile_email_id", "profile_updated_at"} {
+		if v, ok := accountRow[column]; ok {
+			profileRow[column] = v
+			delete(accountRow, column)
+		}
+	}
+	if err := writeJSON(zw, "account.json", accountRow); err != nil {
+		return err
+	}
+	if err := writeJSON(zw, "profile.json", profileRow); err != nil {
+		return err
+	}
+
+	emails, err := data.GetEmailByUser(db, userID, nil, nil)
+	if err != nil {
+		return err
+	}
+	if err := writeJSON(zw, "emails.json", exportRows(emails)); err != nil {
+		return err
+	}
+
+	studies, err := data.GetStudyByUser(db, userID, nil, nil)
+	if err != nil {
+		return err
+	}
+	if err := writeJSON(zw, "studies.json", exportRows(studies)); err != nil {
+		return err
+	}
+
+	courses, err := data.GetCourseByUser(db, userID, nil, nil)
+	if err != nil {
+		return err
+	}
+	if err := writeJSON(zw, "courses.json", exportRows(courses)); err != nil {
+		return err
+	}
+
+	lessons, err := data.GetLessonByUser(db, userID, nil, nil)
+	if err != nil {
+		return err
+	}
+	if err := writeJSON(zw, "lessons.json", exportRows(lessons)); err != nil {
+		return err
+	}
+	lessonDraftBackups := []map[string]interface{}{}
+	for _, l := range lessons {
+		backups, err := data.GetLessonDraftBackupByLesson(db, l.ID.String)
+		if err != nil {
+			return err
+		}
+		lessonDraftBackups = append(lessonDraftBackups, exportRows(backups)...)
+	}
+	if err := writeJSON(zw, "lesson_draft_backups.json", lessonDraftBackups); err != nil {
+		return err
+	}
+
+	comments, err := data.GetCommentByUser(db, userID, nil, nil)
+	if err != nil {
+		return err
+	}
+	if err := writeJSON(zw, "comments.json", exportRows(comments)); err != nil {
+		return err
+	}
+	commentDraftBackups := []map[string]interface{}{}
+	for _, c := range comments {
+		backups, err := data.GetCommentDraftBackupByComment(db, c.ID.String)
+		if err != nil {
+			return err
+		}
+		commentDraftBackups = append(commentDraftBackups, exportRows(backups)...)
+	}
+	if err := writeJSON(zw, "comment_draft_backups.json", commentDraftBackups); err != nil {
+		return err
+	}
+
+	activities, err := data.GetActivityByUser(db, userID, nil, nil)
+	if err != nil {
+		return err
+	}
+	if err := writeJSON(zw, "activities.json", exportRows(activities)); err != nil {
+		return err
+	}
+
+	apples, err := data.GetAppledByUser(db, userID, nil)
+	if err != nil {
+		return err
+	}
+	if err := writeJSON(zw, "apples.json", exportRows(apples)); err != nil {
+		return err
+	}
+
+	enrollments, err := data.GetEnrolledByUser(db, userID, nil, nil)
+	if err != nil {
+		return err
+	}
+	if err := writeJSON(zw, "enrollments.json", exportRows(enrollments)); err != nil {
+		return err
+	}
+
+	notifications, err := data.GetNotificationByUser(db, userID, nil)
+	if err != nil {
+		return err
+	}
+	if err := writeJSON(zw, "notifications.json", exportRows(notifications)); err != nil {
+		return err
+	}
+
+	events, err := data.GetEventByUser(db, userID, nil, nil)
+	if err != nil {
+		return err
+	}
+	if err := writeJSON(zw, "events.json", exportRows(events)); err != nil {
+		return err
+	}
+
+	assets, err := data.GetUserAssetByUser(db, userID, nil, nil)
+	if err != nil {
+		return err
+	}
+	if err := writeJSON(zw, "assets.json", exportRows(assets)); err != nil {
+		return err
+	}
+	for _, a := range assets {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		if err := e.writeAsset(zw, a); err != nil {
+			return err
+		}
+	}
+
+	return zw.Close()
+}
+
+// writeAsset copies the original file of the asset into the archive.
+func (e *exporter) writeAsset(zw *zip.Writer, asset *data.UserAsset) error {
+	object, err := e.svcs.Storage.Get(&asset.UserID, asset.Key.String)
+	if err != nil {
+		return err
+	}
+	defer object.Close()
+
+	fw, err := zw.Create("assets/" + asset.ID.Short + "/" + exportFileName(asset.OriginalName.String))
+	if err != nil {
+		return err
+	}
+	_, err = io.Copy(fw, object)
+	return err
+}
+
+// exportFileName returns the base of the uploaded file name name, with no path
+// separators or dot segments, so that it cannot escape the directory it is
+// unpacked into.
+func exportFileName(name string) string {
+	name = strings.Replace(name, "\\", "/", -1)
+	name = path.Base(name)
+	if name == "." || name == ".." || name == "/" {
+		return "file"
+	}
+	return name
+}
+
+func writeJSON(zw *zip.Writer, name string, v interface{}) error {
+	fw, err := zw.Create(name)
+	if err != nil {
+		return err
+	}
+	enc := json.NewEncoder(fw)
+	enc.SetIndent("", "  ")
+	return enc.Encode(v)
+}
+
+// exportRows maps exportRow over a slice of data rows.
+func exportRows(rows interface{}) []map[string]interface{} {
+	v := reflect.ValueOf(rows)
+	result := make([]map[string]interface{}, v.Len())
+	for i := 0; i < v.Len(); i++ {
+		result[i] = exportRow(v.Index(i).Interface())
+	}
+	return result
+}
+
+// exportRow returns the values of a data row by their column names. Undefined
+// values, i.e. columns not selected, are left out, as are passwords.
+func exportRow(row interface{}) map[string]interface{} {
+	v := reflect.Indirect(reflect.ValueOf(row))
+	t := v.Type()
+	result := make(map[string]interface{}, t.NumField())
+	for i := 0; i < t.NumField(); i++ {
+		column := t.Field(i).Tag.Get("db")
+		if column == "" || column == "password" {
+			continue
+		}
+		value, ok := v.Field(i).Addr().Interface().(pgtype.Value)
+		if !ok {
+			continue
+		}
+		switch value := value.(type) {
+		case *pgtype.TextArray:
+			if value.Status == pgtype.Present {
+				var elements []string
+				if err := value.AssignTo(&elements); err == nil {
+					result[column] = elements
+				}
+				continue
+			}
+		}
+		if got := value.Get(); got != pgtype.Undefined {
+			result[column] = got
+		}
+	}
+	return result
+}
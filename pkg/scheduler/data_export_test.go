@@ -0,0 +1,26 @@
+package scheduler
+
+import "testing"
+
+var exportFileNameTests = []struct {
+	name     string
+	expected string
+}{
+	{"photo.png", "photo.png"},
+	{"../../etc/passwd", "passwd"},
+	{"..\\..\\windows\\win.ini", "win.ini"},
+	{"/abs/path.txt", "path.txt"},
+	{"dir/", "dir"},
+	{"..", "file"},
+	{"/", "file"},
+	{"", "file"},
+}
+
+func TestExportFileName(t *testing.T) {
+	for _, tt := range exportFileNameTests {
+		actual := exportFileName(tt.name)
+		if actual != tt.expected {
+			t.Errorf("exportFileName(%q): expected %q, got %q", tt.name, tt.expected, actual)
+		}
+	}
+}
@@ -0,0 +1,191 @@
+package scheduler
+
+import (
+	"context"
+
+	"github.com/marksauter/markus-ninja-api/pkg/data"
+	"github.com/marksauter/markus-ninja-api/pkg/myconf"
+	"github.com/marksauter/markus-ninja-api/pkg/myctx"
+	"github.com/marksauter/markus-ninja-api/pkg/myerr"
+	"github.com/marksauter/markus-ninja-api/pkg/mylog"
+	"github.com/marksauter/markus-ninja-api/pkg/repo"
+	"github.com/marksauter/markus-ninja-api/pkg/util"
+)
+
+// NewPublishJob returns a job that publishes the lessons and courses whose
+// scheduled publish is due. Each is published as its owner, through the same
+// repo methods as the publish mutations, and in its own transaction, which
+// holds a lock on the lesson or course so that another instance cannot publish
+// it too.
+func NewPublishJob(db data.Queryer, conf *myconf.Config) Job {
+	p := &publisher{
+		conf:  conf,
+		db:    db,
+		repos: repo.NewRepos(db, conf),
+	}
+	return Job{
+		Name: "publish",
+		Run:  p.run,
+	}
+}
+
+type publisher struct {
+	conf  *myconf.Config
+	db    data.Queryer
+	repos *repo.Repos
+}
+
+// publishable is a kind of item with a scheduled publish.
+type publishable struct {
+	// claim locks a due item that is not in skip, and returns its ID and owner.
+	claim func(db data.Queryer, skip []string) (id, userID string, err error)
+	// publish publishes the item as the viewer in ctx.
+	publish func(ctx context.Context, id string) error
+	// unschedule drops the item's scheduled publish.
+	unschedule func(db data.Queryer, id string) error
+}
+
+func (p *publisher) lessons() *publishable {
+	return &publishable{
+		claim: func(db data.Queryer, skip []string) (string, string, error) {
+			lesson, err := data.ClaimScheduledLesson(db, skip)
+			if err != nil {
+				return "", "", err
+			}
+			return lesson.ID.String, lesson.UserID.String, nil
+		},
+		publish: func(ctx context.Context, id string) error {
+			_, err := p.repos.PublishLessonDraft(ctx, id)
+			return err
+		},
+		unschedule: func(db data.Queryer, id string) error {
+			lesson := &data.Lesson{}
+			if err := lesson.ID.Set(id); err != nil {
+				return err
+			}
+			if err := lesson.ScheduledPublishAt.Set(nil); err != nil {
+				return err
+			}
+			_, err := data.UpdateLesson(db, lesson)
+			return err
+		},
+	}
+}
+
+func (p *publisher) courses() *publishable {
+	return &publishable{
+		claim: func(db data.Queryer, skip []string) (string, string, error) {
+			course, err := data.ClaimScheduledCourse(db, skip)
+			if err != nil {
+				return "", "", err
+			}
+			return course.ID.String, course.UserID.String, nil
+		},
+		publish: func(ctx context.Context, id string) error {
+			_, err := p.repos.PublishCourse(ctx, id)
+			return err
+		},
+		unschedule: func(db data.Queryer, id string) error {
+			course := &data.Course{}
+			if err := course.ID.Set(id); err != nil {
+				return err
+			}
+			if err := course.ScheduledPublishAt.Set(nil); err != nil {
+				return err
+			}
+			_, err := data.UpdateCourse(db, course)
+			return err
+		},
+	}
+}
+
+func (p *publisher) run(ctx context.Context) error {
+	if err := p.publishAll(ctx, p.lessons()); err != nil {
+		return err
+	}
+	return p.publishAll(ctx, p.courses())
+}
+
+// publishAll publishes due items until none are left. Items that fail to
+// publish are skipped for the rest of the run, and retried on the next one.
+func (p *publisher) publishAll(
+	ctx context.Context,
+	kind *publishable,
+) error {
+	skip := []string{}
+	for ctx.Err() == nil {
+		id, err := p.publishNext(ctx, kind, skip)
+		if err == data.ErrNotFound {
+			return nil
+		} else if err != nil {
+			if id == "" {
+				return err
+			}
+			mylog.Log.WithContext(ctx).
+				WithError(err).
+				WithField("id", id).
+				Error(util.Trace("failed to publish"))
+			skip = append(skip, id)
+		}
+	}
+	return nil
+}
+
+func (p *publisher) publishNext(
+	ctx context.Context,
+	kind *publishable,
+	skip []string,
+) (string, error) {
+	tx, err, newTx := data.BeginTransaction(data.WithContext(ctx, p.db))
+	if err != nil {
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
+		return "", err
+	} else if newTx {
+		defer data.RollbackTransaction(tx)
+	}
+
+	id, userID, err := kind.claim(tx, skip)
+	if err != nil {
+		return "", err
+	}
+
+	owner, err := data.GetUserCredentials(tx, userID)
+	if err != nil {
+		mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
+		return id, err
+	}
+
+	permitter := repo.NewPermitter(p.repos, p.conf)
+	defer permitter.ClearCache()
+	p.repos.OpenAll(permitter)
+	defer p.repos.CloseAll()
+
+	ctx = myctx.NewUserContext(ctx, owner)
+	ctx = myctx.NewQueryerContext(ctx, tx)
+	msg := "published"
+	if err := kind.publish(ctx, id); err != nil {
+		if _, ok := err.(myerr.ValidationError); !ok {
+			return id, err
+		}
+		// The item can no longer be published, e.g. a course whose lessons were
+		// removed, so its schedule is dropped rather than retried forever.
+		mylog.Log.WithContext(ctx).
+			WithError(err).
+			WithField("id", id).
+			Warn(util.Trace("unable to publish"))
+		if err := kind.unschedule(tx, id); err != nil {
+			return id, err
+		}
+		msg = "unscheduled"
+	}
+
+	if newTx {
+		if err := data.CommitTransaction(tx); err != nil {
+			mylog.Log.WithContext(ctx).WithError(err).Error(util.Trace(""))
+			return id, err
+		}
+	}
+
+	mylog.Log.WithContext(ctx).WithField("id", id).Info(util.Trace(msg))
+	return id, nil
+}
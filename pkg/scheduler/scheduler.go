@@ -0,0 +1,81 @@
+package scheduler
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/marksauter/markus-ninja-api/pkg/mylog"
+	"github.com/marksauter/markus-ninja-api/pkg/mytrace"
+	"github.com/marksauter/markus-ninja-api/pkg/util"
+)
+
+// Job is a unit of background work run by a Scheduler on every tick.
+type Job struct {
+	Name string
+	Run  func(ctx context.Context) error
+}
+
+// Scheduler runs its jobs in the background, one after another, every
+// interval until it is stopped.
+type Scheduler struct {
+	interval time.Duration
+	jobs     []Job
+
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+func NewScheduler(interval time.Duration, jobs ...Job) *Scheduler {
+	return &Scheduler{
+		interval: interval,
+		jobs:     jobs,
+	}
+}
+
+// Start runs the jobs once immediately, and then on every tick.
+func (s *Scheduler) Start() {
+	ctx, cancel := context.WithCancel(context.Background())
+	s.cancel = cancel
+	s.wg.Add(1)
+	go func() {
+		defer s.wg.Done()
+		ticker := time.NewTicker(s.interval)
+		defer ticker.Stop()
+		for {
+			s.runJobs(ctx)
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+}
+
+// Stop signals the jobs to stop, and waits for a running job to return.
+func (s *Scheduler) Stop() {
+	if s.cancel == nil {
+		return
+	}
+	s.cancel()
+	s.wg.Wait()
+}
+
+func (s *Scheduler) runJobs(ctx context.Context) {
+	for _, job := range s.jobs {
+		if ctx.Err() != nil {
+			return
+		}
+		jobCtx, span := mytrace.StartSpan(ctx, "scheduler."+job.Name)
+		err := job.Run(jobCtx)
+		if err != nil {
+			span.SetError(err)
+			mylog.Log.WithContext(jobCtx).
+				WithError(err).
+				WithField("job", job.Name).
+				Error(util.Trace("scheduled job failed"))
+		}
+		span.Finish()
+	}
+}
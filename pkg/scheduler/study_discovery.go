@@ -0,0 +1,25 @@
+package scheduler
+
+import (
+	"context"
+
+	"github.com/marksauter/markus-ninja-api/pkg/data"
+	"github.com/marksauter/markus-ninja-api/pkg/myconf"
+)
+
+// NewStudyDiscoveryJob returns a job that recomputes the trending and
+// recommended studies once every discovery refresh interval. The refresh holds
+// a lock on its last run, so that only one instance refreshes them.
+func NewStudyDiscoveryJob(db data.Queryer, conf *myconf.Config) Job {
+	return Job{
+		Name: "study_discovery",
+		Run: func(ctx context.Context) error {
+			_, err := data.RefreshStudyDiscovery(
+				data.WithContext(ctx, db),
+				conf.DiscoveryRecommendationLimit,
+				conf.DiscoveryRefreshInterval,
+			)
+			return err
+		},
+	}
+}
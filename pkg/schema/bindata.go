@@ -4,7 +4,10 @@
 // enum/apple_giver_order_field.gql
 // enum/appleable_order_field.gql
 // enum/appleable_type.gql
+// enum/blocked_user_order_field.gql
 // enum/comment_order_field.gql
+// enum/content_report_reason.gql
+// enum/content_report_status.gql
 // enum/course_order_field.gql
 // enum/course_status.gql
 // enum/email_type.gql
@@ -17,17 +20,24 @@
 // enum/labelable_order_field.gql
 // enum/labelable_type.gql
 // enum/lesson_order_field.gql
+// enum/moderation_action_type.gql
 // enum/notification_order_field.gql
 // enum/order_direction.gql
 // enum/ref_order_field.gql
+// enum/search_language.gql
+// enum/search_mode.gql
 // enum/search_order_field.gql
 // enum/search_type.gql
+// enum/study_access_level.gql
 // enum/study_order_field.gql
+// enum/suggestion_type.gql
 // enum/topic_order_field.gql
 // enum/topicable_order_field.gql
 // enum/topicable_type.gql
+// enum/trending_window.gql
 // enum/user_asset_order_field.gql
 // enum/user_order_field.gql
+// input/accept_study_invitation.gql
 // input/activity_filters.gql
 // input/activity_order.gql
 // input/add_activity_asset.gql
@@ -35,8 +45,13 @@
 // input/add_course_lesson.gql
 // input/add_email.gql
 // input/add_label.gql
+// input/add_lesson_prerequisite.gql
+// input/add_topic_alias.gql
 // input/apple_giver_order.gql
 // input/appleable_order.gql
+// input/block_user.gql
+// input/blocked_user_order.gql
+// input/cancel_scheduled_publish.gql
 // input/comment_order.gql
 // input/course_filters.gql
 // input/course_order.gql
@@ -45,6 +60,7 @@
 // input/create_label.gql
 // input/create_lesson.gql
 // input/create_study.gql
+// input/create_study_invitation.gql
 // input/create_user.gql
 // input/create_user_asset.gql
 // input/delete_activity.gql
@@ -69,6 +85,8 @@
 // input/login_user.gql
 // input/mark_all_study_notification_as_read.gql
 // input/mark_notification_as_read.gql
+// input/merge_topics.gql
+// input/moderate_content.gql
 // input/move_activity_asset.gql
 // input/move_course_lesson.gql
 // input/notification_order.gql
@@ -79,11 +97,16 @@
 // input/remove_activity_asset.gql
 // input/remove_course_lesson.gql
 // input/remove_label.gql
+// input/remove_lesson_prerequisite.gql
+// input/remove_topic_alias.gql
+// input/report_content.gql
 // input/request_email_verification.gql
 // input/request_password_reset.gql
 // input/reset_comment_draft.gql
 // input/reset_lesson_draft.gql
 // input/reset_password.gql
+// input/revoke_study_access.gql
+// input/revoke_study_invitation.gql
 // input/search_order.gql
 // input/study_filters.gql
 // input/study_order.gql
@@ -91,6 +114,7 @@
 // input/topic_filters.gql
 // input/topic_order.gql
 // input/topicable_order.gql
+// input/unblock_user.gql
 // input/update_activity.gql
 // input/update_comment.gql
 // input/update_course.gql
@@ -142,13 +166,16 @@
 // type/add_course_lesson_payload.gql
 // type/add_email_payload.gql
 // type/add_label_payload.gql
+// type/add_lesson_prerequisite_payload.gql
 // type/added_to_activity_event.gql
 // type/added_to_course_event.gql
 // type/apple_giver_connection.gql
 // type/appleable_connection.gql
 // type/appled_event.gql
+// type/blocked_user_connection.gql
 // type/comment.gql
 // type/comment_draft_backup.gql
+// type/content_report.gql
 // type/course.gql
 // type/create_activity_payload.gql
 // type/create_course_payload.gql
@@ -176,25 +203,32 @@
 // type/labeled_event.gql
 // type/lesson.gql
 // type/lesson_draft_backup.gql
+// type/lesson_graph.gql
 // type/lesson_timeline_event.gql
+// type/lesson_toc_entry.gql
 // type/login_user_payload.gql
 // type/logout_user_payload.gql
+// type/moderation_action.gql
 // type/move_activity_asset_payload.gql
 // type/move_course_lesson_payload.gql
 // type/notification.gql
 // type/page_info.gql
+// type/parsed_search_query.gql
 // type/password_reset_token.gql
 // type/published_event.gql
 // type/referenced_event.gql
 // type/remove_activity_asset_payload.gql
 // type/remove_course_lesson_payload.gql
 // type/remove_label_payload.gql
+// type/remove_lesson_prerequisite_payload.gql
 // type/removed_from_activity_event.gql
 // type/removed_from_course_event.gql
 // type/renamed_event.gql
 // type/searchable_connection.gql
 // type/study.gql
+// type/study_invitation.gql
 // type/study_timeline_event.gql
+// type/suggestion.gql
 // type/text_match.gql
 // type/text_match_highlight.gql
 // type/topic.gql
@@ -353,6 +387,26 @@ func enumAppleable_typeGql() (*asset, error) {
 	return a, nil
 }
 
+var _enumBlocked_user_order_fieldGql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x54\xcd\x4d\x0a\xc2\x30\x14\x04\xe0\x7d\x4e\x31\xd0\x7d\xef\x60\xfd\xd9\x28\xd4\x85\xae\xc5\x24\x03\x09\xd6\xf7\xe4\x25\xa5\x14\xf1\xee\xe2\xdf\xc2\xed\x7c\xc3\x4c\x83\xbd\xe9\x8d\x56\x33\x0b\xfc\x8c\x29\xe5\x90\xe0\x07\x0d\x17\x46\x8c\x85\x86\xa0\x22\x0c\x35\xab\x14\x84\xb3\xc0\x13\x6a\x91\xc6\xd8\x3a\xca\x78\x45\xf7\x69\x1f\x0b\xad\x7f\xc1\x26\x73\x88\xb8\x3b\xa0\xc1\x3b\xf8\xdb\xfb\xde\x50\x50\x13\x67\x4c\x34\xfe\xbc\x75\x40\xb7\xeb\x97\xdb\xf5\xea\xb4\x38\xb8\x87\x7b\x0e\x00\xce\xba\x0b\xfd\x9e\x00\x00\x00")
+
+func enumBlocked_user_order_fieldGqlBytes() ([]byte, error) {
+	return bindataRead(
+		_enumBlocked_user_order_fieldGql,
+		"enum/blocked_user_order_field.gql",
+	)
+}
+
+func enumBlocked_user_order_fieldGql() (*asset, error) {
+	bytes, err := enumBlocked_user_order_fieldGqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "enum/blocked_user_order_field.gql", size: 158, mode: os.FileMode(420), modTime: time.Unix(1792346991, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
 var _enumComment_order_fieldGql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x74\xcc\xc1\x0a\x82\x40\x14\x85\xe1\xfd\x3c\xc5\x01\xf7\xbe\x83\xa8\x6d\x93\xb0\x75\xe8\x9d\x03\x0e\x34\x77\x64\xbc\x12\x11\xbd\x7b\x68\xd8\xae\xed\xe1\xfc\x5f\x81\x2e\xa7\x99\xd9\x02\x17\x8c\x4f\x3c\xa6\x20\x13\x24\xc5\x48\x35\x48\x52\xa5\x58\x48\xba\x40\x06\xc5\x48\xa4\xec\x99\xe9\x4b\x47\x5d\x23\xea\xef\xf1\xbc\x8d\xa7\xc0\xbb\xc7\xcb\x01\x05\xf6\xe1\x60\x76\x58\x32\x87\x0d\x82\x85\xc8\xd2\x01\xf5\xa5\xad\xfa\xb6\xb9\x55\xbd\xfb\x93\xac\xb3\x1f\x8c\xbf\xe0\xda\x35\x47\xf0\x76\x9f\x00\x00\x00\xff\xff\x9a\xed\x49\xf6\xb8\x00\x00\x00")
 
 func enumComment_order_fieldGqlBytes() ([]byte, error) {
@@ -373,6 +427,46 @@ func enumComment_order_fieldGql() (*asset, error) {
 	return a, nil
 }
 
+var _enumContent_report_reasonGql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x6c\xcc\xb1\x4e\x84\x40\x10\xc6\xf1\x7e\x9f\xe2\x4b\xae\xb0\xe3\x1d\xf0\x42\xc4\xe2\x3c\xb2\x9c\x85\xe5\x72\x19\xd8\x8d\xb0\x43\x66\x06\x0d\x31\xbe\xbb\x01\x63\x6c\x28\x27\xff\xf9\x7d\x27\x34\xac\x9a\xba\x91\x20\x14\x94\xb3\xa2\x67\x81\xd0\xcc\x62\x29\x0f\xb8\x73\x36\xca\x56\x38\xca\xcb\x84\xf3\xef\xe5\xf7\xec\x77\x80\x2f\x07\x9c\xfe\x0a\x2c\x06\x43\x52\x84\x6e\xd1\xf4\x41\x60\x41\x0c\x46\xfd\x32\x16\x0e\x28\x1f\x5f\xdb\xca\x1d\x80\xdc\x4b\xca\x03\x29\x94\x27\xe2\x4c\x0f\x8a\x3b\xcf\xab\xa4\x21\xda\x06\xcf\xd7\xe6\xcd\x3f\x3f\xd5\xb7\x03\x1c\x83\x04\xd5\x7f\xbb\xfd\xd7\xa5\x2f\xdb\xf6\x52\xbd\x1c\x81\x4e\x28\xbc\x2b\x2c\x12\x64\x19\x49\x91\xf2\x6e\xc1\x16\x49\xf0\x19\xd6\x6d\xe2\x7a\xab\x2b\x7f\xa0\x93\x42\xe7\x30\x15\x0e\x68\x9b\xf2\xe2\xbe\xdd\xcf\x00\x60\x5e\x35\xa5\x45\x01\x00\x00")
+
+func enumContent_report_reasonGqlBytes() ([]byte, error) {
+	return bindataRead(
+		_enumContent_report_reasonGql,
+		"enum/content_report_reason.gql",
+	)
+}
+
+func enumContent_report_reasonGql() (*asset, error) {
+	bytes, err := enumContent_report_reasonGqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "enum/content_report_reason.gql", size: 325, mode: os.FileMode(420), modTime: time.Unix(1792346543, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _enumContent_report_statusGql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x74\xcd\x41\x6b\x83\x40\x10\x05\xe0\xfb\xfe\x8a\x07\xde\xfd\x0f\xa5\xee\x41\x68\xab\xb8\xa5\xf7\xd5\x1d\xbb\x0b\x71\x26\xb8\x23\x21\x84\xfc\xf7\xa0\x49\x84\x1c\x72\x99\xc3\xe3\xcd\xf7\x0a\xb4\x92\x73\xea\x0f\x84\xac\x5e\x29\x43\x46\x78\x0c\xc2\x4a\xac\x98\xe9\x28\xb3\x96\x86\x78\x99\xf0\x79\x0f\xbb\x2d\x73\xea\x75\xc9\xb8\x18\xa0\xc0\x07\xc3\x87\x29\x31\x46\x59\x38\x80\x45\x63\xe2\x7f\x9c\x66\x59\x6f\xd2\x08\x8d\xf4\xc0\x28\x3c\xf5\xd2\x00\x55\xed\xbe\x6b\xe7\x6c\x65\x36\xe8\x77\xaf\x21\xfa\xbc\x42\xe8\x89\x18\x7e\x50\x0a\x10\xc6\x99\xb6\xb7\xa6\xb5\x3f\xe6\x75\x7a\xaf\xbc\x9b\xea\xac\x6b\xbe\xfe\x6c\x65\xae\xe6\x36\x00\x41\x5f\xf8\xe0\xf6\x00\x00\x00")
+
+func enumContent_report_statusGqlBytes() ([]byte, error) {
+	return bindataRead(
+		_enumContent_report_statusGql,
+		"enum/content_report_status.gql",
+	)
+}
+
+func enumContent_report_statusGql() (*asset, error) {
+	bytes, err := enumContent_report_statusGqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "enum/content_report_status.gql", size: 246, mode: os.FileMode(420), modTime: time.Unix(1792346543, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
 var _enumCourse_order_fieldGql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x74\x8f\x4d\x6a\xc3\x30\x10\x46\xf7\x3a\xc5\x07\xd9\xe7\x0e\xaa\xad\xae\x1a\xc7\x84\xb8\xdb\x20\x4b\x5f\x1b\x41\x3c\x32\xb2\x9c\x52\x4a\xef\x5e\xa2\xfe\xb9\x14\x6f\x87\xf7\xe6\xcd\x6c\xd0\xa6\x38\x32\xe5\xc0\x09\xfd\x2b\x5e\xce\xc1\x9d\xe1\xe2\x9c\x26\xc2\x45\x11\xba\x1c\xa2\x4c\x70\x56\xd0\x13\x31\x79\x26\xfa\xad\xa2\xcc\x03\xaa\xc2\xed\x6f\xb3\xfb\xc0\x8b\xc7\x9b\x02\x36\x28\x83\xaf\x25\x65\xab\xf5\x57\x2b\x8e\xc8\x61\xe0\x56\x01\xba\x7e\xd4\x4d\x65\xea\x93\x3e\xaa\x85\x11\x32\x87\xc2\xcb\x3c\xf4\x4c\x88\x4f\xb0\xe3\x78\xe1\x84\xe7\x70\xa5\x14\xb3\x6d\x1f\xcc\xa9\xda\x77\xcd\x1f\x73\xd1\x72\x89\xf6\x76\xf2\x4f\xac\x3a\x18\x7d\xfc\xd7\x5a\x18\x62\x3f\xc1\x46\xef\xcc\x1a\x52\x0e\x2a\x50\xb7\xbb\x33\x87\x15\x6c\x1e\xbd\xcd\xbf\x6f\x76\x6d\xfd\x5d\x7e\x57\x1f\x01\x00\x00\xff\xff\x40\xe0\xef\x64\x6a\x01\x00\x00")
 
 func enumCourse_order_fieldGqlBytes() ([]byte, error) {
@@ -613,6 +707,26 @@ func enumLesson_order_fieldGql() (*asset, error) {
 	return a, nil
 }
 
+var _enumModeration_action_typeGql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\x90\xc1\xca\xda\x50\x10\x85\xf7\x79\x8a\x03\xff\x56\x7c\x07\x21\x01\x85\x6a\xc5\x28\x5d\x5f\xbd\x13\x33\x34\x99\x09\x77\x26\xb5\x52\xfa\xee\xe5\xde\x08\xb6\x5d\xfd\xfb\x73\xbe\xf3\xcd\x7c\xe0\xa8\x66\x7c\x1d\x08\xe1\xe6\xac\x62\x08\x71\x64\x31\x8c\xe1\x09\x0f\xdf\x09\x2a\x48\x34\x69\x72\x8a\xb8\xa9\x38\x89\xaf\x2b\x92\x79\xc4\x5e\x23\xa5\x90\x5b\x9b\xd2\x3d\x3f\x27\xc2\xaf\x0a\xf8\x40\x4d\x03\x39\xc1\x7b\x7a\x77\x80\xba\xf9\xd2\x9c\x9b\x6a\x49\xb0\x8d\x6c\x56\x22\x0b\xdf\xa0\xdd\xdf\x8d\x15\x1e\xec\xbd\xce\x5e\xd4\xe4\x9e\x4d\x78\xe1\xec\xda\xfd\xae\x6d\x17\xd0\x96\xe3\x3f\x43\xe8\x92\x8e\xa0\x1f\x94\x9e\x2a\x04\xfa\x79\xa3\xc9\xc1\x6e\xd0\x87\x50\x42\x90\xf8\xba\x31\xa3\xb6\xbb\xfa\x25\x74\x91\x3e\x93\x7a\x8e\x91\xe4\xed\xa0\x09\x03\x77\x5e\x16\x6c\xb6\x89\xc4\x58\x25\xab\x06\xcc\x46\x29\x43\x4e\x4d\x7b\xfe\x7a\x7a\x71\xda\x12\x8a\xa5\x90\x03\x48\x64\x93\xca\xf2\xe4\x4e\xd3\x7f\x27\xf6\x5a\x5e\x6d\xce\xc3\x80\x44\x21\xae\x70\x9d\x1d\x51\x0b\x4c\xd4\x7b\x96\x3b\x68\x30\xca\x4b\xed\xa5\x3d\x36\x87\x7a\x59\xda\x07\x1e\x10\xf0\x08\x49\x72\xc6\xf5\x53\x9b\xeb\x0a\xf8\xb6\x39\x1d\xaa\xdf\xd5\x9f\x01\x00\x3b\xc6\x33\xc6\xfe\x01\x00\x00")
+
+func enumModeration_action_typeGqlBytes() ([]byte, error) {
+	return bindataRead(
+		_enumModeration_action_typeGql,
+		"enum/moderation_action_type.gql",
+	)
+}
+
+func enumModeration_action_typeGql() (*asset, error) {
+	bytes, err := enumModeration_action_typeGqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "enum/moderation_action_type.gql", size: 510, mode: os.FileMode(420), modTime: time.Unix(1792346543, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
 var _enumNotification_order_fieldGql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x54\x8d\x31\x0a\xc2\x50\x10\x44\xfb\x7f\x8a\x81\xf4\xb9\x43\xd0\x58\xaa\x48\x7a\x49\xf6\x8f\x64\xc1\xec\xca\xe6\x8b\x88\x78\x77\x49\x6c\xb4\x1b\x66\x86\xf7\x2a\x1c\xc3\x6f\x8c\xa2\x9c\x31\x3c\xf1\x18\x55\x46\x98\x17\xbd\xa8\xf4\x45\xdd\x20\x6e\x46\x59\xe2\x0c\xe9\x0d\x03\xe1\x91\x19\xcc\x75\xa2\xdd\x27\xec\x7f\xde\x87\x65\xd9\x29\xaf\x19\xaf\x04\x54\x58\x8b\x3f\xe0\xea\x91\xe0\x97\x5e\x74\x62\x9d\x80\xcd\xa9\x6d\xba\x76\x7b\x6e\xba\xf4\x4e\x9f\x00\x00\x00\xff\xff\xe5\x57\x9a\x5d\x96\x00\x00\x00")
 
 func enumNotification_order_fieldGqlBytes() ([]byte, error) {
@@ -673,7 +787,47 @@ func enumRef_order_fieldGql() (*asset, error) {
 	return a, nil
 }
 
-var _enumSearch_order_fieldGql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\xd1\x41\x6a\xf3\x30\x10\x05\xe0\xbd\x4f\xf1\x20\xfb\xdc\xc1\xbf\xed\x9f\x16\x12\xdb\xd4\x4a\xa1\xab\x20\x4b\xd3\x5a\x60\x49\xae\x34\x4e\x09\xa5\x77\x2f\x52\x69\xb3\x49\x4b\x76\x66\xf0\x37\x3c\xcd\xdb\xa0\x0f\x7e\xa1\xc0\x86\x22\xc6\x33\xde\x26\xa3\x26\x44\x92\x41\x4d\x08\x14\xd7\x99\x61\x98\x2c\x94\x77\x8e\x14\x1b\xef\x22\x94\x74\x18\x09\x3e\x68\x0a\xa4\xb7\x05\xb9\xd5\x62\xc8\xa6\x4b\xb3\xff\x86\x66\x8d\xf7\x02\xd8\x20\x0f\xf2\x8a\xbc\x5f\xea\x93\x74\x8a\xc0\xc6\xd2\xb6\x00\xca\xfa\xb1\x6c\xab\xa6\x3e\x96\xa2\xb8\xf6\xbf\x5b\xed\x48\x01\xfe\x19\x72\x59\x66\x8a\x78\x31\x27\x72\x59\xf6\xfd\xae\x39\x56\xdd\xa1\xbd\x2e\x47\x8a\x0c\x2b\x59\x4d\x60\x8f\xd7\x95\xc2\x39\xb1\x7f\xcd\x20\x8e\xfb\x52\x54\x77\x57\x95\x0a\x24\xd3\x23\x7f\x02\x56\x0f\x4d\x29\x6e\xc9\xa7\xbc\xb5\xe4\x38\xc2\x4a\xfd\x25\xbb\xfd\xbe\x69\xc5\x1f\x11\x2f\x78\xa6\x18\xbd\x8b\x89\xed\x9a\x61\xe8\xda\x9b\x54\xe4\x55\x1b\xca\x6a\x10\x87\xfa\xe9\x26\xc4\x7e\x31\x4a\x8e\xe9\x96\xf9\x33\x15\x08\x88\xae\xbf\x4f\x35\xfc\xbe\x61\x5d\xb4\xe4\x4b\x71\x87\xbe\xfe\xbe\xcb\x47\xf1\x19\x00\x00\xff\xff\x93\x46\x92\x44\x46\x02\x00\x00")
+var _enumSearch_languageGql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x4c\x8e\x41\x6a\xc3\x30\x10\x45\xf7\x3a\xc5\x87\x2c\xba\x29\xb9\x83\x69\x14\xc7\xd4\x51\x82\x64\x93\xb5\x62\x4d\x13\x81\x2d\x15\x8d\x4c\x09\xa5\x77\x2f\xb6\x1a\xe8\x6a\x1e\xff\xcf\x30\x6f\x83\xee\x4e\x18\x6d\xb8\xcd\xf6\x46\xb0\xe0\x3c\xbb\xc7\x0b\x63\x88\x21\x53\xc8\xf0\x0c\x26\x9b\x86\x3b\x39\xf8\xb0\xc5\x25\x26\xc7\xf0\x01\x8e\x78\x48\xfe\x33\xfb\x18\x18\x36\x38\xb1\xc1\x35\x3a\x4f\x0c\x9b\x08\x9c\x69\x9a\xc8\xe1\xfa\x40\xbe\x13\xd2\x3c\x12\x23\x7e\x20\xff\xfb\xf6\xba\x9c\xc1\x34\xc7\x73\x2b\x11\xc3\xf8\xc0\x18\xbf\x28\x0d\x96\x89\xc5\x66\x59\x9d\xb6\x82\xc2\x3c\xc1\xac\x06\xed\xd3\xf2\x5b\x00\xbb\x4a\x35\xe6\xb0\x40\xdf\xbd\x2d\x53\xaa\xba\x2d\xc9\xbe\x51\x7f\xdd\x5e\x4b\xb5\x96\xb5\xd4\xc7\x4a\x09\xe0\xd0\xab\xba\xd2\xcd\xca\x4d\x57\xb5\x85\xd4\x49\x5f\x64\x5d\xf8\x7c\xd2\x5d\x5f\xf7\xd2\x48\x01\xe8\xd3\xb1\x52\x25\xd7\xbd\x31\x85\x8a\xb1\x00\xcc\xf9\x29\x61\x2e\x72\x57\xa8\xeb\xf5\x7b\x63\x0e\xe2\x47\xfc\x0e\x00\x52\x5e\x26\xbd\x5b\x01\x00\x00")
+
+func enumSearch_languageGqlBytes() ([]byte, error) {
+	return bindataRead(
+		_enumSearch_languageGql,
+		"enum/search_language.gql",
+	)
+}
+
+func enumSearch_languageGql() (*asset, error) {
+	bytes, err := enumSearch_languageGqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "enum/search_language.gql", size: 347, mode: os.FileMode(420), modTime: time.Unix(1792348740, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _enumSearch_modeGql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\x8f\xb1\x4e\xc3\x40\x0c\x86\xf7\x7b\x8a\x5f\xea\x8a\xfa\x0e\x0c\x54\x0c\xad\x18\x48\x24\x9a\x05\x99\xc4\xe9\x59\x5c\xe2\x72\x76\x14\x22\xc4\xbb\xa3\x5e\x03\x88\x8d\xf1\xce\xfe\xbf\xff\xf3\x06\xf7\x3a\x83\x60\x4c\xb9\x8d\x18\xc8\xdb\xc8\x86\xcc\x36\x25\x37\xd0\x89\x64\x34\x87\xb8\xe1\x6d\xe2\xbc\x6c\x03\x8f\xd3\x80\xc7\xb2\x7e\xd0\x8e\xf1\x11\x80\x0d\x0e\x6b\xd0\x23\x63\xd6\xdc\x19\xb4\x2f\x8f\x92\xba\x01\x8d\x1d\x7a\x4a\xc9\xf0\x42\xed\x2b\x5c\xb1\xab\x9b\xe6\x08\xe9\xd1\xf3\xfc\xdd\x57\x50\xc5\x61\x1b\x80\xdb\xba\x7a\x08\xff\xa2\x6b\x5e\xbf\x3d\xf2\x82\x73\xe6\x5e\xde\x2f\x84\x5d\xbd\xdf\x3f\x57\x77\x4f\xd5\x5f\x0c\xd9\xef\x04\x9d\xb2\x5d\xfd\x28\x99\xfe\x5c\x3e\x8b\x47\x10\x5c\x3c\x31\x4c\x06\x49\x94\x2f\xda\x1e\xb9\xb0\xd6\x66\xd7\xc4\x99\x5c\xc6\x13\x7c\x39\xab\x5d\x5b\x9b\xe6\x18\x3e\xc3\xd7\x00\xaa\x4e\x9f\x47\x5c\x01\x00\x00")
+
+func enumSearch_modeGqlBytes() ([]byte, error) {
+	return bindataRead(
+		_enumSearch_modeGql,
+		"enum/search_mode.gql",
+	)
+}
+
+func enumSearch_modeGql() (*asset, error) {
+	bytes, err := enumSearch_modeGqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "enum/search_mode.gql", size: 348, mode: os.FileMode(420), modTime: time.Unix(1792348740, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _enumSearch_order_fieldGql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\x91\xcd\xaa\xd5\x30\x14\x85\xe7\x7d\x8a\x05\x77\x2a\xf7\x1d\x6a\x5b\x51\xb8\xa7\x2d\x36\x47\x70\x54\xd2\x64\x7b\x13\xc8\x4f\x4d\x76\x6f\x29\xe2\xbb\x4b\xa3\x78\x26\x47\x39\xb3\xb0\xd9\xdf\xda\xac\x7c\x4f\x18\x53\x5c\x29\xb1\xa5\x8c\xe5\xc0\x6e\xac\x32\xc8\x24\x93\x32\x48\x94\x37\xc7\xb0\x4c\x1e\x2a\x86\x40\x8a\x6d\x0c\x19\x4a\x06\x2c\x84\x98\x34\x25\xd2\xcf\x15\x85\xcd\x63\x2a\xcc\x70\xce\x3e\x58\x72\x1a\x3f\x2a\xe0\x09\x65\x50\x22\x4a\xbe\xd4\x6f\x32\x28\x02\x5b\x4f\xcf\x15\x50\xb7\x5f\xea\xbe\xe9\xda\xb9\x16\xd5\xbd\xfd\xb0\xf9\x85\x12\xe2\x37\xc8\x75\x75\x94\xf1\x6a\xdf\x28\x14\x72\x1c\x5f\xba\xb9\x19\xae\xfd\x7d\x72\xa1\xcc\xf0\x92\x95\x01\x47\x7c\xdf\x28\x1d\xef\xb0\x38\x0a\xda\x86\x57\x98\xb8\x63\x27\xe7\xc0\x86\x8e\x3f\x6b\xbb\x65\x53\x92\xd8\x90\x4d\x58\xe3\xba\x39\x99\x2c\x1f\xe7\xb9\xf7\xdd\x24\xe6\x4b\x2d\x9a\x8f\x77\xaf\xa9\x44\xf2\xfc\x9c\xbf\xc5\x9a\xcf\x5d\x2d\x1e\xe9\xa5\xa2\xf7\x14\x38\xc3\x4b\xfd\x9b\x1c\x2e\x97\xae\x17\xff\xa9\x76\x83\x1d\xe5\x1c\x43\x3e\xb1\x97\x6e\x9a\x86\xfe\x21\x2a\xf3\xa6\x2d\x15\x6a\x12\xd7\xf6\xeb\x43\x10\xc7\xd5\x2a\xb9\x9c\x0e\xca\xf3\x14\x0f\x88\x61\xfc\x74\xea\xfb\x77\xc2\xb6\x6a\xc9\x37\xe1\xd7\xb1\xad\x45\xd7\xce\xb5\xa8\x7e\x56\xbf\x06\x00\x2f\x59\x2b\x18\x7e\x02\x00\x00")
 
 func enumSearch_order_fieldGqlBytes() ([]byte, error) {
 	return bindataRead(
@@ -688,12 +842,12 @@ func enumSearch_order_fieldGql() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "enum/search_order_field.gql", size: 582, mode: os.FileMode(420), modTime: time.Unix(1542328582, 0)}
+	info := bindataFileInfo{name: "enum/search_order_field.gql", size: 638, mode: os.FileMode(420), modTime: time.Unix(1792347641, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _enumSearch_typeGql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\xcc\xc1\x4a\xc4\x30\x18\xc4\xf1\x7b\x9e\x62\xc0\x7b\xdf\x61\x5d\x73\x10\x16\x2b\x4d\x7b\xf0\x24\xb1\xfd\xb4\xd1\xee\x97\x92\x49\x04\x11\xdf\x5d\xb6\x07\x11\x45\xb3\xc7\x81\xff\x6f\x2e\xd0\xc9\x9a\x84\xa2\x99\xc8\xb3\x20\xe8\x14\x5e\xc3\x54\xfc\x82\x24\x2c\x4b\x26\xe2\x23\x3c\x28\x3e\x8d\x73\x63\x44\xcb\x11\x6e\x1b\xfd\xdb\x2a\x78\x37\xc0\xe9\x24\x97\xa4\xfc\x22\x47\x9f\xc7\x39\xe8\x13\xc6\x58\x12\x85\x08\x0a\xe6\x32\x05\x61\x63\x80\x7d\x3b\x74\xce\x9a\xff\xe9\xe2\x1f\x64\xf9\x29\x0f\xbb\x4b\x7b\xa8\x41\x21\xa3\xfe\x92\xd6\xb9\xf6\xa6\x42\xbf\xf5\xae\x1f\xae\xee\x2a\x79\x8e\x6b\x18\xb7\xba\x6f\x6f\xaf\xf7\x95\xba\x50\x12\x11\x15\xe9\xa5\xb0\xd1\xa0\xcf\xfe\x44\x07\x67\xbb\x33\x24\x3c\x29\xf9\x0f\x7f\xbf\x73\xce\xf6\xe6\xc3\x7c\x06\x00\x00\xff\xff\x67\x0d\x11\x63\xd0\x01\x00\x00")
+var _enumSearch_typeGql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\xd0\xc1\x4a\xc4\x30\x10\x80\xe1\x7b\x9e\x62\xc0\x7b\xdf\xa1\xd6\x1c\x0a\xbb\x5b\x69\x52\xc1\x93\xc4\x76\xb4\xa3\xed\xb4\x64\x26\x0b\x22\xbe\xbb\x6c\x0f\xa2\x2e\x9a\x3d\x06\xfe\x2f\x33\xcc\x15\xb4\xb8\x46\x14\x64\x15\xd0\x11\x81\x78\xa0\x23\x0d\x29\x4c\x10\x51\xd2\xa4\x02\xcb\x13\x04\x10\x0c\xb1\x1f\x0b\x83\x9c\x66\x70\xdb\xc3\xbf\xad\x08\xef\x06\xe0\xf4\x89\xa6\xc8\xf2\x45\xe6\xa0\xfd\x48\xfc\x0c\xa1\x57\x3a\x92\x12\x0a\x10\x83\x68\x1a\x08\xa5\x30\x00\x65\xe5\xeb\xbb\xda\xdf\x9b\xff\x7d\xbf\xcc\xf3\xb6\xdb\x4f\x5d\x35\xfb\xbd\x3d\xf8\x2c\x4e\x51\xce\x26\x57\x4d\xd7\x3a\x9b\xa1\x53\x78\xc4\xe9\xb7\xdc\x95\xd7\x76\x97\x83\x28\xb2\xf0\x99\xb4\xce\x35\x87\x0c\xfd\xd6\x3b\xdf\xdd\xe4\x4e\xa3\xcb\x4a\xfd\x56\xfb\xe6\xb6\xae\x32\x75\x12\x8c\x02\x0b\x43\x7c\x4d\x52\x30\xf1\x4b\x38\xd1\xce\xd9\xf6\x02\x09\x41\x04\xf5\x0f\xff\x50\x3a\x67\xbd\xf9\x30\x9f\x03\x00\x4b\x89\x68\x54\x4d\x02\x00\x00")
 
 func enumSearch_typeGqlBytes() ([]byte, error) {
 	return bindataRead(
@@ -708,7 +862,27 @@ func enumSearch_typeGql() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "enum/search_type.gql", size: 464, mode: os.FileMode(420), modTime: time.Unix(1542328582, 0)}
+	info := bindataFileInfo{name: "enum/search_type.gql", size: 589, mode: os.FileMode(420), modTime: time.Unix(1792349592, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _enumStudy_access_levelGql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x54\xcb\x41\xca\xc2\x30\x10\x40\xe1\x7d\x4e\xf1\xa0\xfb\xff\x0e\xe5\xb7\xbb\xa2\x50\xbd\xc0\xd0\x0e\x36\x10\x27\x92\x4c\x03\x22\xde\x5d\x74\x21\x74\xf7\x16\xef\xeb\xb8\xac\x8a\xcc\xb3\xd6\x8a\x18\xd1\x5a\x74\xf1\x98\x8d\x6b\x11\xf3\x8a\x67\x84\x7b\x89\x4d\x5c\xa9\xbe\x2d\x8f\xbf\xa0\xb6\xdd\x38\x7f\xba\xff\xca\x51\x9b\x26\x9e\x01\x3a\xfe\xc5\x28\x2a\x0b\x62\x0b\x6a\x25\xa7\x44\x34\x7c\xfd\x69\x18\x8e\xd3\x69\x1c\xf7\xfb\x6e\x98\x86\xfe\x10\x5e\xe1\x3d\x00\x05\xf3\x02\x26\x9e\x00\x00\x00")
+
+func enumStudy_access_levelGqlBytes() ([]byte, error) {
+	return bindataRead(
+		_enumStudy_access_levelGql,
+		"enum/study_access_level.gql",
+	)
+}
+
+func enumStudy_access_levelGql() (*asset, error) {
+	bytes, err := enumStudy_access_levelGqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "enum/study_access_level.gql", size: 158, mode: os.FileMode(420), modTime: time.Unix(1792347342, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
@@ -733,6 +907,26 @@ func enumStudy_order_fieldGql() (*asset, error) {
 	return a, nil
 }
 
+var _enumSuggestion_typeGql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x6c\xcc\xcd\x4a\xc4\x40\x10\xc4\xf1\xfb\x3c\x45\xc1\xde\xf3\x0e\x7e\xe4\x20\x2c\xae\x38\xd9\x83\xc7\x6c\x52\x26\x03\x49\x77\x4c\xf7\xb0\x0c\xe2\xbb\x4b\x14\x3d\x84\xbd\x16\xbf\x7f\x1d\xd0\x8c\x84\x97\x85\x06\x7d\x87\x68\x4f\x83\xe5\x61\xa0\x39\x7b\xb8\xa2\xd3\x79\x99\xe8\x44\x0b\x63\xbb\x76\x23\x3e\x32\xd7\x52\x05\x4a\x9e\x11\x7f\x69\x52\x69\xca\x42\x7c\x06\xe0\xf0\x37\x1a\xa6\xf6\xc2\xc9\x90\x04\x3e\x12\xe6\xb9\x2f\x55\x00\x8e\x77\xf7\xf5\x31\xec\x28\xcd\x54\x6e\xd8\x3a\xc6\xd3\xf3\x0e\x6f\x4f\x89\x86\x4b\x81\xb4\x33\x71\x4d\x3e\x42\xaf\xc2\x75\x4b\x62\x73\x7e\x7c\xdb\x15\xae\x4b\xea\xfe\x83\x4d\x35\xa7\x97\xa7\x87\x9d\xca\xc6\xf5\x07\x4d\x3a\x24\xa9\x02\x70\x8e\xf5\x6b\xf8\x0a\xdf\x03\x00\x3b\xea\x97\xce\x27\x01\x00\x00")
+
+func enumSuggestion_typeGqlBytes() ([]byte, error) {
+	return bindataRead(
+		_enumSuggestion_typeGql,
+		"enum/suggestion_type.gql",
+	)
+}
+
+func enumSuggestion_typeGql() (*asset, error) {
+	bytes, err := enumSuggestion_typeGqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "enum/suggestion_type.gql", size: 295, mode: os.FileMode(420), modTime: time.Unix(1792348933, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
 var _enumTopic_order_fieldGql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x6c\xcc\xc1\x0a\x82\x40\x10\x87\xf1\xfb\x3c\xc5\x1f\xbc\xfb\x0e\x52\x06\x1d\x4a\x0f\x7b\x0f\x9d\x1d\x70\x20\x67\x64\xdd\x88\x88\xde\x3d\x74\xaf\x5e\x3f\x3e\x7e\x15\xfa\xe4\x8b\xa4\xac\xb2\x62\xfc\xe0\x3d\x29\x4f\xc8\xbe\x28\x83\xdd\x4c\x38\xab\xdb\x0a\x1e\x0c\xa3\xc0\x53\x94\x24\xb1\x26\xb1\xd7\x8c\xb0\x6d\xdd\x96\x2e\x2a\xcf\x88\x2f\x01\x15\xf6\x50\x88\x9d\x2c\x58\xd6\x59\x6a\x02\x42\xd7\x5f\x4f\xed\xf9\xd1\x04\x3a\xbc\x6d\x28\xdf\xbd\xb9\xb5\xf4\xa3\x7f\x00\x00\x00\xff\xff\xb1\x60\x8f\xd7\xa0\x00\x00\x00")
 
 func enumTopic_order_fieldGqlBytes() ([]byte, error) {
@@ -793,6 +987,26 @@ func enumTopicable_typeGql() (*asset, error) {
 	return a, nil
 }
 
+var _enumTrending_windowGql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x52\x56\x08\xc9\x48\x55\x28\xcf\xcc\x4b\xc9\x2f\x2f\x56\xc8\x4f\x53\x28\xc9\xcc\x4d\x55\x28\x2e\x29\x4d\xc9\x4c\x2d\x56\x28\x29\x4a\xcd\x4b\x51\x48\x29\x2d\xca\xcc\x4b\xd7\xe3\x4a\xcd\x2b\xcd\x55\x08\x01\x09\x65\xe6\xa5\x87\x83\xb5\x28\x54\x73\x29\x28\x40\xcc\xc8\x49\x2c\x2e\x51\x48\x49\xac\xd4\xe3\x52\x50\x70\x71\x8c\xe4\x42\x95\x28\x4f\x4d\xcd\x06\xc9\x84\xbb\xba\x7a\xa3\x49\x19\x1b\x80\xb4\x15\x83\x64\x7d\xfd\xfd\x42\x3c\xb8\x6a\xb9\x00\x03\x00\x7a\xf8\x7c\x7e\x96\x00\x00\x00")
+
+func enumTrending_windowGqlBytes() ([]byte, error) {
+	return bindataRead(
+		_enumTrending_windowGql,
+		"enum/trending_window.gql",
+	)
+}
+
+func enumTrending_windowGql() (*asset, error) {
+	bytes, err := enumTrending_windowGqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "enum/trending_window.gql", size: 150, mode: os.FileMode(420), modTime: time.Unix(1792350052, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
 var _enumUser_asset_order_fieldGql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x6c\xce\xc1\x6a\xc3\x30\x0c\xc6\xf1\xbb\x9f\xe2\x83\xde\xfb\x0e\x5e\x9b\x41\x0f\xed\x4a\x49\x06\x3b\x15\xc7\x16\x58\xb0\xc8\x45\x56\x56\xca\xd8\xbb\x0f\x37\x6c\xa7\x5c\xc5\xff\xfb\xa1\x0d\xce\x5a\x6e\xa4\xc6\x54\x31\x3e\x70\xcf\x1c\x33\x42\xad\x64\x88\x45\x84\xa2\x71\x91\x8a\x18\x04\x23\xa1\x68\x22\xa5\xb4\x75\x24\xf3\x84\xa1\x92\xfa\x96\xbe\xb5\xf3\x2b\xd3\x67\xc2\xb7\x03\x36\x78\x1e\x16\xe6\xc9\x5a\x26\x56\xc8\x3c\x8d\xa4\xb8\xb3\x65\x16\x04\x41\x88\xc6\x5f\x6c\x8f\xad\x03\xfc\xae\x3f\xbc\x1f\xfa\x8f\xeb\x69\x38\xbe\x74\x17\xb7\xea\x44\xa5\xd0\x1e\x82\xf1\x44\x6d\xb5\xbb\x74\xbe\xef\xf6\x57\xdf\xaf\x0f\x24\x2c\xdd\xc9\x1f\xbb\xf5\x62\xbe\xa5\x60\xf4\x0f\x0e\xe7\xfd\x1f\xf8\xe3\x7e\x03\x00\x00\xff\xff\x2e\x00\x19\x12\x1e\x01\x00\x00")
 
 func enumUser_asset_order_fieldGqlBytes() ([]byte, error) {
@@ -833,6 +1047,26 @@ func enumUser_order_fieldGql() (*asset, error) {
 	return a, nil
 }
 
+var _inputAccept_study_invitationGql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x6c\xcc\x31\x0a\xc3\x30\x10\x44\xd1\x7e\x4f\x31\xc1\xbd\x0f\x90\x2e\xa5\x6b\xe7\x02\xc6\x59\x47\x4b\x60\x57\x88\x51\x40\x84\xdc\x3d\x20\x41\x2a\xd7\xf3\xe6\x4f\x58\x3c\x57\x82\x2d\x2b\x8e\x28\xb8\xed\xbb\x66\xae\xac\x8f\xb6\xf8\xdb\xb8\xd1\xc2\x67\xb1\xae\x4e\xc7\x11\xf8\x08\x30\xe1\x9e\x14\x8c\x97\x3a\xe2\x00\x93\xc2\xfe\x0c\x0c\x6c\xfd\x3f\x0b\x06\xba\x62\x65\x31\x7f\x5e\xe4\x2b\xbf\x01\x00\x55\x9b\x85\xd9\x89\x00\x00\x00")
+
+func inputAccept_study_invitationGqlBytes() ([]byte, error) {
+	return bindataRead(
+		_inputAccept_study_invitationGql,
+		"input/accept_study_invitation.gql",
+	)
+}
+
+func inputAccept_study_invitationGql() (*asset, error) {
+	bytes, err := inputAccept_study_invitationGqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "input/accept_study_invitation.gql", size: 137, mode: os.FileMode(420), modTime: time.Unix(1792347342, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
 var _inputActivity_filtersGql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x4c\xcc\xbd\x0a\xc2\x40\x10\xc4\xf1\x7e\x9f\x62\x20\x7d\x1e\xc0\xce\xc6\x5e\x2c\xac\xcf\x63\xe3\x0d\x84\x8b\xec\x6e\x94\x45\x7c\x77\xf1\xa3\x48\xfb\x63\xe6\x3f\xe0\x5c\xd2\xc1\x8e\x47\x63\x6d\x88\x05\x13\xe7\x50\xc3\x4c\x0f\xc7\x32\xa1\xd4\xe0\x9d\x41\xf5\x51\xd8\x6f\x6b\x60\xff\x93\x3c\x7c\x97\x8e\xa7\x00\x03\x8e\xab\x5a\x7e\x02\xae\xc5\x6a\xdb\xfc\x70\xc9\x51\xf0\xf7\x1d\x4e\x61\xec\x57\x79\xc9\x3b\x00\x00\xff\xff\x67\x3b\x5e\x0e\x7d\x00\x00\x00")
 
 func inputActivity_filtersGqlBytes() ([]byte, error) {
@@ -973,6 +1207,46 @@ func inputAdd_labelGql() (*asset, error) {
 	return a, nil
 }
 
+var _inputAdd_lesson_prerequisiteGql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x52\x56\xf0\xcc\x2b\x28\x2d\x51\x28\xa9\x2c\x48\x55\x48\xcb\x2f\x52\x70\x4c\x49\xf1\x49\x2d\x2e\xce\xcf\x0b\x28\x4a\x2d\x4a\x2d\x2c\xcd\x2c\xce\x2c\x49\xd5\xe3\xca\x04\xab\xc2\x2a\x09\x31\xa0\x9a\x4b\x41\x41\x59\x21\x24\x23\x55\xc1\x2f\x3f\x25\x55\xc1\xd3\x45\x21\x3f\x4d\xa1\x24\x23\x55\x21\x07\xac\x41\x8f\x4b\x01\xca\xf2\x4c\xb1\x52\xf0\x74\x51\xe4\xc2\xa5\xbe\x00\xc9\x68\x24\xcd\xc8\xc2\x9e\x29\x56\x0a\x9e\x2e\x8a\x5c\xb5\x5c\x80\x01\x00\x67\x12\x82\x3b\xbf\x00\x00\x00")
+
+func inputAdd_lesson_prerequisiteGqlBytes() ([]byte, error) {
+	return bindataRead(
+		_inputAdd_lesson_prerequisiteGql,
+		"input/add_lesson_prerequisite.gql",
+	)
+}
+
+func inputAdd_lesson_prerequisiteGql() (*asset, error) {
+	bytes, err := inputAdd_lesson_prerequisiteGqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "input/add_lesson_prerequisite.gql", size: 191, mode: os.FileMode(420), modTime: time.Unix(1792345549, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _inputAdd_topic_aliasGql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x54\x8c\x41\x0a\xc2\x30\x10\x45\xf7\x73\x8a\xdf\x76\x2b\x39\x40\x77\x85\x6e\xb2\xb6\x17\x08\x76\x9a\x06\x6a\x26\x24\xa3\x20\xe2\xdd\x25\x11\x41\xb7\xff\xbf\xf7\x06\xd8\x98\x6e\x0a\x7d\x24\xc6\x26\x19\xd3\xba\x2e\x92\xc2\x65\x3a\x82\x2b\x86\x42\x7b\xff\xc6\x8f\xf0\x24\x60\xc0\xb2\x33\x5c\x25\x4f\x60\xe3\x0d\x7a\x2f\x87\x8b\xbe\x6f\x29\xdd\x19\x5a\xb5\x3a\xf7\x86\x80\xe8\xae\x3c\xe2\xac\x39\x44\xdf\x51\x2b\xd8\x19\xb2\xfd\xa0\xfa\x2d\x22\x73\x91\xe3\xce\x05\x2a\xd5\x6d\xb7\x5d\x47\xd8\xb9\xa3\x17\xbd\x07\x00\xf1\x3e\x88\xf3\xb9\x00\x00\x00")
+
+func inputAdd_topic_aliasGqlBytes() ([]byte, error) {
+	return bindataRead(
+		_inputAdd_topic_aliasGql,
+		"input/add_topic_alias.gql",
+	)
+}
+
+func inputAdd_topic_aliasGql() (*asset, error) {
+	bytes, err := inputAdd_topic_aliasGqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "input/add_topic_alias.gql", size: 185, mode: os.FileMode(420), modTime: time.Unix(1792350759, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
 var _inputApple_giver_orderGql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x74\x8e\xc1\x0a\xc2\x30\x0c\x86\xef\x79\x8a\x7f\xec\xbe\x07\xd8\x4d\x10\x3d\x7a\x11\x3c\x77\x6d\xb4\x81\x91\x96\xb6\x2a\x43\x7c\x77\xe9\x86\x0e\x84\x1d\x93\x7c\xdf\x47\x5a\x5c\xcc\x94\x21\x8a\xa7\x17\xeb\x61\x62\x1c\x19\x37\x79\x70\x82\x0d\xaa\x6c\x8b\x04\xcd\xb0\x46\x31\x30\x42\x72\x9c\xd8\x75\x24\x1a\xef\x05\xbb\x4a\x1f\x2b\x7c\xaa\x07\xbc\x08\x68\x71\xf6\x0c\x27\x69\x51\xd7\x74\x09\x8b\x0e\x0d\x8e\x73\x47\x58\xa1\x1e\xb3\xbf\xff\xce\x0d\xfd\x42\x57\xe1\xd1\x6d\x45\x30\x4c\xb5\x33\x33\xfd\xff\x37\x87\xba\x6d\xe8\x4d\x9f\x00\x00\x00\xff\xff\x5f\x6e\x66\xf7\xe4\x00\x00\x00")
 
 func inputApple_giver_orderGqlBytes() ([]byte, error) {
@@ -1013,6 +1287,66 @@ func inputAppleable_orderGql() (*asset, error) {
 	return a, nil
 }
 
+var _inputBlock_userGql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x00\x64\x00\x9b\xff\x23\x20\x49\x6e\x70\x75\x74\x20\x74\x79\x70\x65\x20\x66\x6f\x72\x20\x42\x6c\x6f\x63\x6b\x55\x73\x65\x72\x2e\x0a\x69\x6e\x70\x75\x74\x20\x42\x6c\x6f\x63\x6b\x55\x73\x65\x72\x49\x6e\x70\x75\x74\x20\x7b\x0a\x20\x20\x23\x20\x54\x68\x65\x20\x49\x44\x20\x6f\x66\x20\x74\x68\x65\x20\x75\x73\x65\x72\x20\x74\x6f\x20\x62\x6c\x6f\x63\x6b\x2e\x0a\x20\x20\x75\x73\x65\x72\x49\x64\x3a\x20\x49\x44\x21\x0a\x7d\x0a\x03\x00\x24\x5d\x3d\xc7\x64\x00\x00\x00")
+
+func inputBlock_userGqlBytes() ([]byte, error) {
+	return bindataRead(
+		_inputBlock_userGql,
+		"input/block_user.gql",
+	)
+}
+
+func inputBlock_userGql() (*asset, error) {
+	bytes, err := inputBlock_userGqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "input/block_user.gql", size: 100, mode: os.FileMode(420), modTime: time.Unix(1792346991, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _inputBlocked_user_orderGql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x74\x8e\xb1\x0a\xc2\x40\x0c\x86\xf7\x3c\xc5\x5f\xba\xf7\x01\x3a\x8a\xb8\xba\x28\xce\xed\x25\xd2\x60\x49\xe4\xee\x8a\x14\xf1\xdd\xa5\x3d\xb4\x83\x38\x86\x7c\xdf\xc7\x5f\xe3\xd2\xcd\x09\x6a\x78\x0c\x1a\x06\xf4\xa3\x87\x9b\x30\xa6\x24\x11\xc1\xcd\x24\x64\x75\x4b\x08\x9d\xa1\x17\x78\x64\x89\xc2\x0d\xa9\xdd\xa7\x8c\x5d\xc1\xcf\x49\xe2\x71\xf9\xe0\x49\x40\x8d\xd3\x20\x60\x8d\xc5\xdd\xe2\xd9\x8b\x0f\x73\x96\xd4\x10\x36\xa8\xc5\xea\xef\x3f\x77\x45\xdf\xd0\x55\x65\xe4\x7f\x11\xf4\xf3\xd2\x59\x99\xf6\x67\xce\x41\x65\xe4\x8a\x5e\xf4\x1e\x00\x07\xea\x7e\x7d\xe7\x00\x00\x00")
+
+func inputBlocked_user_orderGqlBytes() ([]byte, error) {
+	return bindataRead(
+		_inputBlocked_user_orderGql,
+		"input/blocked_user_order.gql",
+	)
+}
+
+func inputBlocked_user_orderGql() (*asset, error) {
+	bytes, err := inputBlocked_user_orderGqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "input/blocked_user_order.gql", size: 231, mode: os.FileMode(420), modTime: time.Unix(1792346991, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _inputCancel_scheduled_publishGql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x6c\xcd\xb1\x0a\x02\x31\x10\x04\xd0\x3e\x5f\x31\x72\xbd\x1f\x60\xeb\x35\xe9\x04\xfd\x81\x5c\xb2\xc7\x06\x96\x6c\xc8\x26\x88\x88\xff\x2e\x28\xe9\xae\x1b\x98\xe1\xcd\x02\x5f\xea\xe8\xe8\xaf\x4a\xd8\xb5\xe1\x1a\x4a\x24\xb9\x47\xa6\x34\x84\xd2\x6d\x6c\x92\x8d\xcf\x2e\xff\x66\xc7\xed\x9f\x78\x3b\x60\xc1\x83\x09\x7e\x85\xee\xe8\x4c\x88\x3a\x9a\x11\xb4\x41\xc8\x4c\x0b\x9e\xb9\x33\x02\x6c\x0a\xa8\xf3\x00\x33\x86\x4d\xc8\xa7\x0b\xfc\x7a\x72\x1f\xf7\x1d\x00\x13\x59\x7e\x8e\xa1\x00\x00\x00")
+
+func inputCancel_scheduled_publishGqlBytes() ([]byte, error) {
+	return bindataRead(
+		_inputCancel_scheduled_publishGql,
+		"input/cancel_scheduled_publish.gql",
+	)
+}
+
+func inputCancel_scheduled_publishGql() (*asset, error) {
+	bytes, err := inputCancel_scheduled_publishGqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "input/cancel_scheduled_publish.gql", size: 161, mode: os.FileMode(420), modTime: time.Unix(1792345002, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
 var _inputComment_orderGql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x7c\xce\x31\xaa\xc3\x30\x0c\xc6\xf1\x5d\xa7\xf8\x42\xf6\x1c\x20\xeb\x7b\x74\xed\x52\xe8\x9c\xd8\x0a\x16\x34\x72\x70\x14\x4a\x28\xbd\x7b\xb1\x43\x6b\xba\x74\xb4\xf9\xeb\x27\xb5\xb8\x0e\xfb\x0a\x51\xdc\x83\xb8\x00\x17\xe7\x99\xd5\x56\xb8\x41\x31\x32\x62\xf2\x9c\xd8\x63\x5b\xa2\x22\xb1\x6d\x49\x3b\x12\x5d\x36\xc3\xdf\x91\x9e\x73\x81\x07\x01\x2d\x2e\x81\xe1\x25\xb1\x33\x89\x5a\x51\x8b\x87\x53\xf5\x71\x87\x05\xc6\xba\xb0\x93\x49\xd8\x63\x12\xbe\xf9\x8e\x50\xc7\x7b\x14\xf9\xff\xfd\x6e\xe8\xb3\xa2\xc4\xbf\xf9\x4c\x95\xac\xff\xba\xf3\x94\xbf\x1a\x7a\xd2\x2b\x00\x00\xff\xff\x63\xe8\x14\xd2\xf8\x00\x00\x00")
 
 func inputComment_orderGqlBytes() ([]byte, error) {
@@ -1153,7 +1487,7 @@ func inputCreate_lessonGql() (*asset, error) {
 	return a, nil
 }
 
-var _inputCreate_studyGql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x52\x56\xf0\xcc\x2b\x28\x2d\x51\x28\xa9\x2c\x48\x55\x48\xcb\x2f\x52\x70\x2e\x4a\x4d\x2c\x49\x0d\x2e\x29\x4d\xa9\xd4\xe3\xca\x04\xcb\x21\x09\x41\x14\x57\x73\x29\x28\xa4\xa4\x16\x27\x17\x65\x16\x94\x64\xe6\xe7\x59\x29\x28\x04\x97\x14\x65\xe6\xa5\x73\x29\x28\xe4\x25\xe6\xa6\x5a\x29\xc0\x00\x44\x58\x91\xab\x96\x0b\x10\x00\x00\xff\xff\x74\x2b\x3e\x91\x68\x00\x00\x00")
+var _inputCreate_studyGql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x54\x8a\x31\x0a\xc2\x40\x10\x45\xfb\x39\xc5\x97\xf4\x39\x40\x5a\x15\x0d\x04\x9b\x3d\xc1\x90\x4c\xe2\x82\xce\x2e\xb3\xb3\x45\x10\xef\x2e\x24\x16\xca\xaf\xfe\x7b\xaf\x41\xaf\xb9\x3a\x7c\xcd\x82\x39\x19\x8e\x26\xec\x12\xbc\x4e\x6b\x4b\x71\x73\x3f\x68\x8f\x5f\x04\x4c\x52\x46\x8b\xd9\x63\xd2\x0e\x00\x82\x5b\xd4\x85\x00\xe5\xa7\x6c\xe4\xbb\x5d\x1c\x08\x68\x70\x92\x99\xeb\xc3\x0b\x3c\xe1\x7c\xbb\x0c\x7d\xb8\xb6\x04\x14\x61\x1b\xef\x03\xeb\x52\x79\x91\x0e\xe1\xef\xd3\x9b\x3e\x03\x00\x87\x15\xb0\x4a\xa6\x00\x00\x00")
 
 func inputCreate_studyGqlBytes() ([]byte, error) {
 	return bindataRead(
@@ -1168,7 +1502,27 @@ func inputCreate_studyGql() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "input/create_study.gql", size: 104, mode: os.FileMode(420), modTime: time.Unix(1542328582, 0)}
+	info := bindataFileInfo{name: "input/create_study.gql", size: 166, mode: os.FileMode(420), modTime: time.Unix(1792348740, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _inputCreate_study_invitationGql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x6c\x90\x41\x6b\xc3\x30\x0c\x85\xef\xfe\x15\xaf\xf4\xde\x1f\x90\x5b\x59\x2f\x86\xc1\x0e\xeb\xd8\xd9\x4d\xd4\x5a\x10\xcb\xc6\x56\xba\x86\xb1\xff\x3e\xec\x84\x16\xba\xdd\x6c\x3d\xe9\x7b\xd2\xdb\xc2\x4a\x9a\x14\x3a\x27\xc2\x39\x66\xbc\x64\x72\x4a\xef\x3a\x0d\xb3\x95\x2b\xab\x53\x8e\xb2\x33\xdc\xba\xfe\x15\x17\xc0\xb7\x01\xb6\x38\x7a\x82\xeb\x7b\x2a\x05\xea\x09\x7c\x6f\xc2\x25\x3b\xd1\xb2\x33\x58\xf5\x0e\x0d\xb3\x6f\x9f\x57\xba\xd2\xb8\x31\x77\x04\x05\xc7\x23\x34\xa2\x90\x0c\xcf\x24\x8d\x3b\xbc\xc9\x38\xb7\xfa\x54\x28\xe3\x8b\xd5\x43\x3d\x97\x75\x30\xb8\xb9\xa1\xaa\x53\x52\xb0\x56\xdb\x26\x55\xd7\xcc\x72\x59\xac\x3e\x3d\xc9\x33\x9d\x6e\x89\x33\xb5\x45\xd7\xe7\x5e\x3b\x1c\x39\xd0\x63\x3d\x99\xc2\x89\x32\xe2\x19\xca\x81\xfe\x9c\xda\x3b\xc1\x69\xc9\x21\x29\x0d\x15\x15\xdc\xed\xa3\x50\xe9\x60\x45\x1f\x1c\x7b\x68\x0c\x4f\x28\x35\x8b\x7a\x70\xc3\x10\x34\xd6\xa9\x56\xb5\x43\x07\x7b\xd8\x98\x1f\xf3\x3b\x00\x66\x8a\x6b\x9f\xac\x01\x00\x00")
+
+func inputCreate_study_invitationGqlBytes() ([]byte, error) {
+	return bindataRead(
+		_inputCreate_study_invitationGql,
+		"input/create_study_invitation.gql",
+	)
+}
+
+func inputCreate_study_invitationGql() (*asset, error) {
+	bytes, err := inputCreate_study_invitationGqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "input/create_study_invitation.gql", size: 428, mode: os.FileMode(420), modTime: time.Unix(1792347342, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
@@ -1653,6 +2007,46 @@ func inputMark_notification_as_readGql() (*asset, error) {
 	return a, nil
 }
 
+var _inputMerge_topicsGql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x7c\x8c\x31\xae\xc2\x30\x10\x05\x7b\x9f\x62\xbe\xd2\x7e\xe5\x00\xd4\x69\x5c\xd0\x71\x01\x64\x6f\x62\x4b\x90\xb5\xec\x8d\x10\x42\xdc\x1d\x99\x34\xd0\xd0\xbe\x37\x33\x03\x7e\x2d\x9b\x61\xf7\x22\xcc\x5a\x39\x4a\x5d\xe4\xa4\x25\x87\x36\xba\xfc\xfe\x3e\xa6\x1d\x7e\x38\x18\xf0\x13\x3a\x63\x49\xb0\xfe\x61\xca\xb5\x93\xff\xdc\x52\x0e\x89\xdc\x88\x72\x11\x93\x38\x3a\x68\xba\xd5\xb0\x57\x7c\x3c\xe0\xa7\x3f\xf7\xb3\x42\x5e\x4d\xbb\x68\xe7\xba\x88\x7d\x89\x4f\xf7\x1a\x00\x20\xd6\xff\x82\xb7\x00\x00\x00")
+
+func inputMerge_topicsGqlBytes() ([]byte, error) {
+	return bindataRead(
+		_inputMerge_topicsGql,
+		"input/merge_topics.gql",
+	)
+}
+
+func inputMerge_topicsGql() (*asset, error) {
+	bytes, err := inputMerge_topicsGqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "input/merge_topics.gql", size: 183, mode: os.FileMode(420), modTime: time.Unix(1792350759, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _inputModerate_contentGql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x5c\x8f\xb1\x4e\x03\x31\x10\x44\x7b\x7f\xc5\x44\x69\x4f\xf9\x80\xeb\x22\xd2\x5c\x01\x0d\xf9\x01\x73\x9e\x04\xc3\xb1\x6b\xd9\x6b\x41\x84\xf8\x77\x64\x87\xbb\x82\xf6\x8d\xf6\xcd\xce\x1e\x93\xa4\x6a\xb0\x5b\x22\x2e\x9a\xf1\xa8\x81\xd9\x1b\x1f\x54\x8c\x62\x07\x17\x7b\xfe\x0f\xdf\x8f\xbe\x1d\xb0\xc7\xf9\x95\xf0\xb3\x45\x15\x98\xc2\xfc\x3b\x0f\x0e\x7f\x64\x5c\x7d\x51\xe5\xd8\xc9\xf9\x96\xb8\x73\xfd\xf0\x28\xe0\x57\x5a\xbc\xf4\x18\x7a\x81\x6d\xaa\x01\x51\xe6\xa5\x06\x06\x44\xc1\xa7\xcf\x12\xe5\x5a\xf0\xe1\xe3\xc2\xd0\x7a\x6a\x61\x2e\xad\x48\xd4\x38\xe2\xd9\x72\x94\xab\xdb\x1e\x7a\xd2\x40\x4c\xa7\x55\x3a\xdf\xd7\x0c\xd0\xbc\xa2\x26\x68\xa2\xe6\x1e\x50\x6a\x49\x94\xd0\xf2\xcc\x62\x9a\xfb\x88\x52\x5f\xde\x38\xdb\x14\x46\x4c\xa7\x9d\xfb\x71\xbf\x03\x00\x3d\x37\xf9\x57\x2f\x01\x00\x00")
+
+func inputModerate_contentGqlBytes() ([]byte, error) {
+	return bindataRead(
+		_inputModerate_contentGql,
+		"input/moderate_content.gql",
+	)
+}
+
+func inputModerate_contentGql() (*asset, error) {
+	bytes, err := inputModerate_contentGqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "input/moderate_content.gql", size: 303, mode: os.FileMode(420), modTime: time.Unix(1792346543, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
 var _inputMove_activity_assetGql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x74\x8e\x31\x0a\xc2\x40\x10\x45\xfb\x39\xc5\x93\xf4\x39\x80\x5d\x20\xcd\x16\x1e\x22\xe8\x2c\x6e\xa1\x13\x92\x71\x21\x88\x77\x17\x33\xb1\x51\xd2\x3d\xf8\xef\x0d\xd3\x90\xee\xe3\xc3\xf1\x65\x54\xb2\x4d\x9c\xac\x6a\x77\xf6\x52\x8b\x2f\xdd\x3c\xab\xb7\x52\x56\xe3\x6f\x88\xf0\x29\xd0\x90\x7a\x2c\xe3\x57\x65\xf8\x4c\xb8\x71\xb3\xaa\x0c\xd9\x75\x6a\x85\x80\xc8\x2e\x47\x52\x7f\x90\xdf\x6e\x3b\xbd\xca\x1b\xef\xa9\xf1\x16\x01\x5f\xe9\x25\xef\x00\x00\x00\xff\xff\x2b\xf2\x8e\xe7\xcc\x00\x00\x00")
 
 func inputMove_activity_assetGqlBytes() ([]byte, error) {
@@ -1733,7 +2127,7 @@ func inputPublish_comment_draftGql() (*asset, error) {
 	return a, nil
 }
 
-var _inputPublish_courseGql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x52\x56\xf0\xcc\x2b\x28\x2d\x51\x28\xa9\x2c\x48\x55\x48\xcb\x2f\x52\x08\x28\x4d\xca\xc9\x2c\xce\x70\xce\x2f\x2d\x2a\x4e\xd5\xe3\xca\x04\xcb\xa2\x08\x42\x34\x54\x73\x29\x28\x28\x2b\x78\xba\x28\xe4\xa7\x29\x94\x64\xa4\x2a\x24\x43\x35\x28\x40\x59\x9e\x29\x56\x0a\x9e\x2e\x8a\x5c\xb5\x5c\x80\x00\x00\x00\xff\xff\xa8\xf1\xe9\xb6\x63\x00\x00\x00")
+var _inputPublish_courseGql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x54\x8c\x3d\x0a\xc3\x30\x0c\x46\x77\x9f\xe2\x2b\x59\x4b\x0e\x90\xad\x34\x8b\xb6\x0e\x85\xce\x6d\x2d\x63\x41\x1c\x99\x58\x1e\x42\xe9\xdd\x0b\xf9\x81\x74\x13\xdf\x7b\x4f\x0d\x68\xcc\xd5\x60\x73\x66\x04\x9d\x70\xab\xaf\x41\x4a\xbc\x6a\x9d\x0a\xb7\x4e\x16\xfa\x37\xae\xc1\xc7\x01\x0d\xa8\x87\x06\x58\x64\xbc\xb7\x00\xdb\x45\xbe\x03\xf5\xa7\xc5\x7a\x44\x1e\x61\x8a\xbc\xbe\x39\xfa\xa0\x00\x4d\x62\xc6\xfe\x7c\xd8\x21\x65\xb7\xd9\x43\x52\x62\x2f\x4f\xe3\x61\x6e\x1d\x76\x70\xb1\x0e\x77\x49\xec\xbe\xee\x37\x00\x12\x87\xd4\x71\xc6\x00\x00\x00")
 
 func inputPublish_courseGqlBytes() ([]byte, error) {
 	return bindataRead(
@@ -1748,12 +2142,12 @@ func inputPublish_courseGql() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "input/publish_course.gql", size: 99, mode: os.FileMode(420), modTime: time.Unix(1543857213, 0)}
+	info := bindataFileInfo{name: "input/publish_course.gql", size: 198, mode: os.FileMode(420), modTime: time.Unix(1792345002, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _inputPublish_lesson_draftGql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x52\x56\xf0\xcc\x2b\x28\x2d\x51\x28\xa9\x2c\x48\x55\x48\xcb\x2f\x52\x08\x28\x4d\xca\xc9\x2c\xce\xf0\x49\x2d\x2e\xce\xcf\x73\x29\x4a\x4c\x2b\xd1\xe3\xca\x04\x2b\xc1\x94\x81\x68\xad\xe6\x52\x50\x50\x56\xf0\x74\x51\xc8\x4f\x53\x28\xc9\x48\x55\xc8\x01\x2b\xd0\xe3\x52\x80\xb2\x3c\x53\xac\x14\x3c\x5d\x14\xb9\x6a\xb9\x00\x01\x00\x00\xff\xff\x4d\x28\x35\xd0\x6d\x00\x00\x00")
+var _inputPublish_lesson_draftGql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x64\xcc\xbd\x0a\xc2\x30\x14\xc5\xf1\x3d\x4f\x71\xa4\xab\xe4\x01\xba\x09\x59\x02\x0e\x0e\x82\x73\x25\x37\xe4\x42\xbe\x68\x6e\x87\x22\xbe\xbb\x34\xad\x38\xb8\x85\x9c\xdf\xfd\x0f\xb0\xb9\x2e\x02\x59\x2b\xc1\x97\x19\xb7\xe5\x19\xb9\x85\x2b\xb5\x56\xb2\x99\x27\x2f\x5a\x71\x27\xff\xcb\x7e\xfa\x52\xc0\x00\x6b\x50\x3c\x24\x10\x62\x07\x5a\xe1\x78\x59\x37\xc2\x9a\x53\x57\x8f\x40\x19\x52\x50\xf7\x56\xf7\x6e\x4b\x69\x58\x8f\x92\x58\x84\xdc\xf9\xf7\x0d\x6e\x5f\x4b\x0e\x9c\x12\x39\x9e\x84\xe2\xba\xe5\x8f\xe1\x22\x23\xee\x9c\x48\xbd\xd5\x67\x00\x4f\xe1\xec\x61\xce\x00\x00\x00")
 
 func inputPublish_lesson_draftGqlBytes() ([]byte, error) {
 	return bindataRead(
@@ -1768,7 +2162,7 @@ func inputPublish_lesson_draftGql() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "input/publish_lesson_draft.gql", size: 109, mode: os.FileMode(420), modTime: time.Unix(1542329263, 0)}
+	info := bindataFileInfo{name: "input/publish_lesson_draft.gql", size: 206, mode: os.FileMode(420), modTime: time.Unix(1792345002, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
@@ -1853,6 +2247,66 @@ func inputRemove_labelGql() (*asset, error) {
 	return a, nil
 }
 
+var _inputRemove_lesson_prerequisiteGql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x74\x8c\x31\x0a\xc2\x40\x14\x44\xfb\x7f\x8a\x09\xe9\x73\x80\xd4\xdb\x7c\x10\x11\xf1\x08\x3b\x21\x0b\x9a\xbf\xee\xfe\x08\x22\xde\x5d\x88\x29\xb6\x49\x37\xcc\xbc\x79\x3d\x74\xc9\xab\xc3\xdf\x99\x98\xac\xe0\xca\x87\xbd\x78\x62\xad\xb6\x5c\x0a\x0b\x9f\x6b\xaa\xc9\x39\x48\xda\xc0\xa3\xfd\xaf\xf9\x08\xd0\xe3\x36\x13\x67\x8b\x84\x06\xd8\x04\x9f\x89\xfb\x26\x1c\x04\x7b\xd2\x38\x42\x43\x27\x47\x7c\x6e\xd4\xcd\xb9\xad\x35\x8e\xd0\xd0\xc9\x57\x7e\x03\x00\xce\xa6\x84\x09\xc5\x00\x00\x00")
+
+func inputRemove_lesson_prerequisiteGqlBytes() ([]byte, error) {
+	return bindataRead(
+		_inputRemove_lesson_prerequisiteGql,
+		"input/remove_lesson_prerequisite.gql",
+	)
+}
+
+func inputRemove_lesson_prerequisiteGql() (*asset, error) {
+	bytes, err := inputRemove_lesson_prerequisiteGqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "input/remove_lesson_prerequisite.gql", size: 197, mode: os.FileMode(420), modTime: time.Unix(1792345549, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _inputRemove_topic_aliasGql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x00\x6c\x00\x93\xff\x23\x20\x49\x6e\x70\x75\x74\x20\x74\x79\x70\x65\x20\x66\x6f\x72\x20\x52\x65\x6d\x6f\x76\x65\x54\x6f\x70\x69\x63\x41\x6c\x69\x61\x73\x2e\x0a\x69\x6e\x70\x75\x74\x20\x52\x65\x6d\x6f\x76\x65\x54\x6f\x70\x69\x63\x41\x6c\x69\x61\x73\x49\x6e\x70\x75\x74\x20\x7b\x0a\x20\x20\x23\x20\x54\x68\x65\x20\x61\x6c\x69\x61\x73\x20\x74\x6f\x20\x72\x65\x6d\x6f\x76\x65\x2e\x0a\x20\x20\x6e\x61\x6d\x65\x3a\x20\x53\x74\x72\x69\x6e\x67\x21\x0a\x7d\x0a\x03\x00\x75\x5c\xeb\x61\x6c\x00\x00\x00")
+
+func inputRemove_topic_aliasGqlBytes() ([]byte, error) {
+	return bindataRead(
+		_inputRemove_topic_aliasGql,
+		"input/remove_topic_alias.gql",
+	)
+}
+
+func inputRemove_topic_aliasGql() (*asset, error) {
+	bytes, err := inputRemove_topic_aliasGqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "input/remove_topic_alias.gql", size: 108, mode: os.FileMode(420), modTime: time.Unix(1792350759, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _inputReport_contentGql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x54\x8d\xb1\xae\x82\x40\x10\x45\xfb\xf9\x8a\x4b\xe8\xf9\x00\xba\x97\x47\xb3\x8d\x05\x9a\x58\x23\x0c\xb2\xc6\xcc\x6c\x96\x21\x91\x18\xff\xdd\xb8\x0b\x89\xb6\x33\xe7\xdc\x53\xc2\x49\x58\x0c\xb6\x06\xc6\xa8\x11\x2d\x07\x8d\xf6\xaf\x62\x2c\x56\x91\x4f\xdf\x9f\x63\x16\x9e\x04\x94\xf8\x13\xf0\x23\xdc\x3b\xe9\xcc\xab\x40\x47\xd8\xc4\x88\x09\xaf\x08\x10\x35\xae\x71\xb4\xe8\xe5\x4a\xc9\x38\x4f\x6b\x62\xfa\x5c\x80\x9f\x37\x9c\x87\x8f\x10\xb9\x9b\x55\x6a\x6c\xad\x1c\x6e\xd3\xb1\xc8\x03\xa7\x89\x71\xd0\x81\xe1\x9a\xbd\xb7\x6f\x99\x7e\xa5\xe7\xe5\x72\xe3\xde\xdc\x50\xc3\x35\x05\xbd\xe8\x3d\x00\x6a\x8d\x4a\x1e\xeb\x00\x00\x00")
+
+func inputReport_contentGqlBytes() ([]byte, error) {
+	return bindataRead(
+		_inputReport_contentGql,
+		"input/report_content.gql",
+	)
+}
+
+func inputReport_contentGql() (*asset, error) {
+	bytes, err := inputReport_contentGqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "input/report_content.gql", size: 235, mode: os.FileMode(420), modTime: time.Unix(1792346543, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
 var _inputRequest_email_verificationGql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x52\x56\xf0\xcc\x2b\x28\x2d\x51\x28\xa9\x2c\x48\x55\x48\xcb\x2f\x52\x08\x4a\x2d\x2c\x4d\x2d\x2e\x71\xcd\x4d\xcc\xcc\x09\x4b\x2d\xca\x4c\xcb\x4c\x4e\x2c\xc9\xcc\xcf\xe3\xca\x04\xab\xc3\x25\x0d\x31\xa5\x9a\x4b\x41\x41\x59\x01\x2c\xa9\x50\x92\xaf\x50\x06\x52\x50\xa9\xa7\xc0\xa5\xa0\x90\x0a\x12\xb3\x52\x08\x2e\x29\xca\xcc\x4b\x57\xe4\xaa\xe5\x02\x04\x00\x00\xff\xff\x73\xfb\xae\xee\x79\x00\x00\x00")
 
 func inputRequest_email_verificationGqlBytes() ([]byte, error) {
@@ -1953,6 +2407,46 @@ func inputReset_passwordGql() (*asset, error) {
 	return a, nil
 }
 
+var _inputRevoke_study_accessGql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x6c\x8d\x31\x0a\xc3\x30\x0c\x45\x77\x9d\xe2\x87\xec\x39\x40\xb6\x82\x17\xad\x6d\x2f\x50\x62\x05\x97\x42\x15\x2c\xb9\x25\x94\xde\xbd\xd8\x1e\x9b\x4d\xf0\xde\xd3\x1f\xc1\xcf\xad\x38\x7c\xdf\x04\xab\x66\x9c\xe5\xa5\x0f\xb9\x78\x89\xfb\x69\x59\xc4\x6c\xa2\x7b\x33\xfe\x40\x0f\x3f\x04\x8c\xb8\x26\x01\x07\xe8\x0a\x4f\x02\xab\xf5\x44\xe8\x07\xc7\x19\x1c\x86\x03\xaf\x98\x64\xbc\x93\x9a\xe0\xd6\x5e\xc2\x15\xb9\xed\xd4\xba\x62\x8e\x33\x38\x0c\xf4\xa5\xdf\x00\x0f\x6a\xad\x7a\xaa\x00\x00\x00")
+
+func inputRevoke_study_accessGqlBytes() ([]byte, error) {
+	return bindataRead(
+		_inputRevoke_study_accessGql,
+		"input/revoke_study_access.gql",
+	)
+}
+
+func inputRevoke_study_accessGql() (*asset, error) {
+	bytes, err := inputRevoke_study_accessGqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "input/revoke_study_access.gql", size: 170, mode: os.FileMode(420), modTime: time.Unix(1792353784, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _inputRevoke_study_invitationGql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x52\x56\xf0\xcc\x2b\x28\x2d\x51\x28\xa9\x2c\x48\x55\x48\xcb\x2f\x52\x08\x4a\x2d\xcb\xcf\x4e\x0d\x2e\x29\x4d\xa9\xf4\xcc\x2b\xcb\x2c\x49\x2c\xc9\xcc\xcf\xd3\xe3\xca\x04\xab\xc2\x2a\x09\x31\xa0\x9a\x4b\x41\x41\x59\x21\x24\x23\x55\xc1\xd3\x45\x21\x3f\x4d\xa1\x24\x23\x55\x21\x13\xae\x46\xa1\x24\x5f\xa1\x08\xac\x59\x8f\x4b\x01\x49\xdc\x33\xc5\x4a\xc1\xd3\x45\x91\xab\x96\x0b\x30\x00\xee\xb4\x1b\x77\x89\x00\x00\x00")
+
+func inputRevoke_study_invitationGqlBytes() ([]byte, error) {
+	return bindataRead(
+		_inputRevoke_study_invitationGql,
+		"input/revoke_study_invitation.gql",
+	)
+}
+
+func inputRevoke_study_invitationGql() (*asset, error) {
+	bytes, err := inputRevoke_study_invitationGqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "input/revoke_study_invitation.gql", size: 137, mode: os.FileMode(420), modTime: time.Unix(1792347342, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
 var _inputSearch_orderGql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x7c\xce\xb1\xaa\xc2\x40\x10\x85\xe1\x7e\x9f\xe2\x84\xf4\x79\x80\xd4\x97\xdb\x5a\x28\x58\x27\xbb\x27\xec\x80\xee\x86\xd9\x09\x12\xc4\x77\x97\xac\x68\xac\x2c\x67\xf8\xe7\x63\x5a\x9c\x87\xb5\x40\x12\x6e\x51\x7c\x44\xe1\xa0\x3e\xb2\xc0\x0f\x09\x23\x91\x35\x50\x19\xb0\xcc\x39\x41\x69\x8b\xa6\xce\x49\x9a\x17\xc3\xb1\xa6\x87\x2d\xc0\xdd\x01\x2d\x4e\x91\x08\xa2\xf4\x26\x39\xed\xa6\xe5\x17\x03\x31\x5e\x0b\xc6\x15\x16\x89\x32\xd3\xcb\x24\x0c\x98\x84\x97\xd0\x39\xec\xb7\x3d\x2a\xfb\xf7\x9e\x1b\xf7\xf1\x6b\xfc\xc3\xde\x9c\xda\xf4\xdf\x0f\xfe\x6f\x9b\xc6\x3d\xdc\x33\x00\x00\xff\xff\xf8\xc6\x4e\x6a\xf0\x00\x00\x00")
 
 func inputSearch_orderGqlBytes() ([]byte, error) {
@@ -2093,6 +2587,26 @@ func inputTopicable_orderGql() (*asset, error) {
 	return a, nil
 }
 
+var _inputUnblock_userGql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x00\x6a\x00\x95\xff\x23\x20\x49\x6e\x70\x75\x74\x20\x74\x79\x70\x65\x20\x66\x6f\x72\x20\x55\x6e\x62\x6c\x6f\x63\x6b\x55\x73\x65\x72\x2e\x0a\x69\x6e\x70\x75\x74\x20\x55\x6e\x62\x6c\x6f\x63\x6b\x55\x73\x65\x72\x49\x6e\x70\x75\x74\x20\x7b\x0a\x20\x20\x23\x20\x54\x68\x65\x20\x49\x44\x20\x6f\x66\x20\x74\x68\x65\x20\x75\x73\x65\x72\x20\x74\x6f\x20\x75\x6e\x62\x6c\x6f\x63\x6b\x2e\x0a\x20\x20\x75\x73\x65\x72\x49\x64\x3a\x20\x49\x44\x21\x0a\x7d\x0a\x03\x00\x19\x82\x7d\x04\x6a\x00\x00\x00")
+
+func inputUnblock_userGqlBytes() ([]byte, error) {
+	return bindataRead(
+		_inputUnblock_userGql,
+		"input/unblock_user.gql",
+	)
+}
+
+func inputUnblock_userGql() (*asset, error) {
+	bytes, err := inputUnblock_userGqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "input/unblock_user.gql", size: 106, mode: os.FileMode(420), modTime: time.Unix(1792346991, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
 var _inputUpdate_activityGql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x6c\x8e\xc1\xca\xc2\x30\x10\x84\xef\x79\x8a\xf9\xe9\xfd\x7f\x80\xde\x84\x5e\x72\x56\x1f\x20\x34\x5b\xb3\xa0\x49\xe8\xae\x4a\x11\xdf\x5d\x6c\x20\xb1\xd0\xdb\x32\x33\xdf\xcc\x76\xb0\x31\xdf\x15\xba\x64\xc2\x94\x66\x9c\xb3\x77\x4a\x87\x51\xf9\xc1\xba\xfc\x1b\x5e\xed\xad\x5a\x90\x97\x01\x3a\xd8\x01\x69\x82\x06\x82\xab\x0c\xea\x6d\x7d\x0f\x3b\xfc\x99\x35\x7a\x0a\x04\x4f\x32\xce\x9c\x95\x53\xdc\xe3\x7e\xec\x1e\x47\x9d\x39\x5e\x1a\xdb\xa6\xae\x24\x92\x22\x9c\x48\x1a\xd9\x29\x79\x3c\x59\x03\x34\xb0\x6c\xea\x4a\xae\x3c\xd1\x7a\xa2\xbb\xd1\xde\xf8\x57\xaf\xab\x6f\xf3\x09\x00\x00\xff\xff\x57\x31\xf0\xf2\x1b\x01\x00\x00")
 
 func inputUpdate_activityGqlBytes() ([]byte, error) {
@@ -2233,7 +2747,7 @@ func inputUpdate_lessonGql() (*asset, error) {
 	return a, nil
 }
 
-var _inputUpdate_studyGql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x64\x8b\x31\x0e\x83\x40\x0c\x04\x7b\xbf\x62\x23\xfa\x3c\x80\x9a\xe6\x6a\x92\x07\xa0\xd8\x04\x17\xf1\x59\x87\x29\x50\x94\xbf\x47\x01\x29\x02\x5d\xb7\xda\x99\x69\x90\xcc\x97\x40\xac\x2e\x18\x73\xc1\xdd\x79\x08\xe9\x63\xe1\xf5\x4a\xba\xb1\xc3\xb5\xcb\x6f\x02\x1a\xdc\x26\x01\xcb\xfc\x28\xea\xa1\xd9\x90\x47\xc4\x24\x98\xf7\x14\x47\xd6\xa2\x8f\xa2\xf6\xa4\x7f\x68\xc3\x4b\xaa\xe2\x77\x9e\xd5\xd4\x55\xd2\x36\x12\xb7\x48\xdd\x85\x3e\xf4\x0d\x00\x00\xff\xff\x49\xdd\x29\xd3\xc1\x00\x00\x00")
+var _inputUpdate_studyGql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x64\x8d\x3d\xae\xc2\x30\x10\x84\x7b\x9f\x62\x9e\x52\xbc\x8e\x03\xa4\x4e\x63\x89\x2e\x70\x00\x2b\xde\x24\x2b\xc1\xda\xb2\x37\x45\x84\xb8\x3b\x4a\x8c\x20\xc8\xdd\xce\xcf\xb7\xd3\xc0\x4a\x5c\x14\xba\x46\xc2\x18\x12\xae\xd1\x3b\xa5\x5e\x17\xbf\x9e\x0c\xef\xd9\xc1\x2a\xe5\x87\x01\x1a\x5c\x66\x82\xa7\x3c\x24\x8e\xca\x41\x10\x46\xe8\x4c\xc8\x05\xc5\x31\x6b\xd1\x6b\x62\x99\xcc\x07\x14\x77\xa7\x8a\xd8\xcc\xba\x7a\x73\x32\x2d\x6e\xa2\x6f\xf7\x3f\x63\x08\xa2\x24\x0a\xce\xc8\xe4\xd2\x30\x93\x07\xcb\x36\x5b\xe4\xf9\x0d\xb5\xe8\x7f\x74\x79\x6b\xbb\x6a\x7b\x3f\xac\x6f\x61\xbb\x3f\xf3\x34\xaf\x01\x00\x6d\x1b\xc0\xd6\x18\x01\x00\x00")
 
 func inputUpdate_studyGqlBytes() ([]byte, error) {
 	return bindataRead(
@@ -2248,12 +2762,12 @@ func inputUpdate_studyGql() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "input/update_study.gql", size: 193, mode: os.FileMode(420), modTime: time.Unix(1542328582, 0)}
+	info := bindataFileInfo{name: "input/update_study.gql", size: 280, mode: os.FileMode(420), modTime: time.Unix(1792348740, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _inputUpdate_topicGql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x64\x8b\x31\x0e\xc2\x30\x0c\x45\x77\x9f\xe2\x57\xdd\x39\x40\xe7\x2e\x9e\x29\x07\x40\x8d\x43\xbc\xc4\x56\x30\x03\x42\xdc\x1d\x29\x59\x22\xb1\x7d\xfd\xf7\xde\x0a\xae\xfe\x0a\xc4\xdb\x05\xd9\x1a\x6e\x9e\xee\x21\x87\xb9\x9e\x17\xd2\xce\xa6\x6b\xc8\x1f\x02\x56\x1c\x45\x90\xe4\x79\x36\xf5\x50\xab\xb0\x8c\x28\x82\x18\x29\x66\xb6\xe1\x1a\x4d\xeb\x63\xa1\x5e\xf2\xfe\x27\xf7\xc1\x69\x03\xef\x0b\x7d\xe9\x17\x00\x00\xff\xff\x47\x49\x0e\xc5\x97\x00\x00\x00")
+var _inputUpdate_topicGql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x6c\x91\x41\x4f\x84\x40\x0c\x85\xef\xfc\x8a\xb7\xd9\xab\xd9\x1f\xc0\x4d\x43\x4c\xe6\xe2\xc5\x35\x1e\x8c\x87\x09\xd3\x5d\x26\x81\xe9\xa4\x2d\x9a\x8d\xf1\xbf\x9b\x81\x5d\x04\xe3\x09\x68\xfb\xbd\xf7\x5a\xf6\x70\x29\x8f\x06\xbb\x64\xc2\x89\x05\x2f\x39\x78\xa3\x23\xe7\xd8\x1e\xaa\x38\xf5\x56\xa5\x79\xf8\xab\x02\xf6\x38\x76\x84\x40\xda\x4a\xcc\x16\x39\x81\x4f\xb0\x8e\x60\x33\x8a\x75\xaf\xc6\xb3\x49\x4c\xe7\x6a\x01\x93\x1f\x68\x43\x40\x3b\xfe\x4c\x30\xc6\xa8\x24\x7a\xc0\x7d\x02\x0d\xd9\x2e\x08\x51\x73\xef\x2f\x33\x22\xa4\x64\x8a\x68\x30\x9e\xc4\xec\x3f\xb1\xc9\x7e\xc6\x9e\xfc\x40\x5b\xfb\xd7\x8e\xac\x23\x59\x59\x47\xc5\x89\xbc\x8d\x42\xa1\x90\x51\x1f\xaf\x5f\x35\x1e\x98\x7b\xf2\x69\x26\x5d\x73\x73\x29\x19\xe1\x55\xc9\xae\xb9\xbd\x4e\x7a\x3d\x9f\x79\x9b\xe4\x77\x0f\xd7\x40\x68\xe0\x0f\xd2\x25\x78\x19\x2f\x8e\xe5\xe9\x42\x0d\xd7\xdc\x8c\x74\xa3\xa2\x10\xea\xbd\x51\x28\xf7\x59\xaa\x77\x10\xca\xbd\x6f\x63\x3a\x4f\x6a\xed\x28\x42\xc9\xc0\x89\xb4\xa8\x5e\x99\xf9\xc7\x05\xad\xf1\xe6\x9a\xdd\xfb\xdf\x55\x96\x83\x4d\x2f\x2e\xd4\x70\xcd\xae\xfa\xae\x7e\x06\x00\x49\x8d\xe5\xba\x1a\x02\x00\x00")
 
 func inputUpdate_topicGqlBytes() ([]byte, error) {
 	return bindataRead(
@@ -2268,7 +2782,7 @@ func inputUpdate_topicGql() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "input/update_topic.gql", size: 151, mode: os.FileMode(420), modTime: time.Unix(1542329263, 0)}
+	info := bindataFileInfo{name: "input/update_topic.gql", size: 538, mode: os.FileMode(420), modTime: time.Unix(1792350759, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
@@ -2953,7 +3467,7 @@ func scalarUriGql() (*asset, error) {
 	return a, nil
 }
 
-var _schemaGql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x94\x59\x4d\x6f\x1b\x39\x12\xbd\xeb\x57\x94\x31\x07\x27\x80\xe1\xbd\xeb\xe6\x71\x0f\x06\x02\xec\xac\x57\x6b\xe5\x12\xe4\x40\x75\x97\xac\x86\x5b\xcd\x0e\xc9\xb6\x21\x2c\xf2\xdf\x17\x2c\x7e\x16\xd9\xf2\x24\x27\x8b\xaf\xc8\xf7\xaa\xc9\xaa\xe2\x87\x75\x7b\xc4\x93\x80\xff\xad\x00\x7e\xcc\xa8\xce\x6b\xf8\x8f\xfd\xb3\x02\x38\xcd\x46\x98\x5e\x8e\x6b\x78\xf4\xbf\x56\x3f\x57\x2b\x73\x9e\xd0\x75\xa1\x31\x7f\xc0\x83\x94\xaf\xf3\x04\x02\x5e\xfa\x37\x1c\x41\x68\x8d\x06\xf6\x67\x30\x47\x04\xf9\x3e\xa2\xba\x01\x6d\xe6\xee\x0c\xa3\x38\xe1\x0d\x88\xb1\xf3\x7d\x6c\xfb\x76\x05\xae\xf5\x69\x05\x00\x04\xad\xe1\xbf\x46\xf5\xe3\xcb\x15\x21\xc4\xc0\x21\x62\xcb\xa1\xcf\x6b\xd8\x69\x54\x77\x96\x67\x95\xfb\x34\xca\x0e\xad\x2b\x9b\xc6\xea\xd8\x96\x93\xf9\x03\x9e\x8f\x08\x9b\x06\xe4\x81\xdc\xb4\x96\x5b\xb2\xf4\xdd\x1a\x36\x8d\x27\xfd\x22\x3b\xac\xf8\xb4\x25\x14\x30\xf4\xda\xd8\xe1\x9b\x46\x07\x6e\x9d\x93\x17\x76\xcb\xac\xd7\xf0\x6d\xd3\x5c\x7d\xf7\xec\xdf\x2c\xfd\xf7\x2b\x2b\xa0\x70\x10\x61\xe2\x09\xd0\x28\x54\x7b\x0c\x7c\x5b\x34\xb3\x1a\x35\xb9\x8a\x03\x9e\x70\x34\x1a\xfa\x91\xda\xa4\x63\x8e\xc2\x40\x2b\x4f\x08\xe2\x60\x50\x91\x41\x4f\xd8\xf6\x87\x1e\x3b\x78\x19\xe4\x5e\x0c\x7e\x12\xc0\x75\x09\xd3\xb7\xfa\x7d\x89\x3d\x1e\xa4\xc2\x8f\x35\x5c\x9f\x8f\x44\x0e\xbd\xd2\x06\xc6\x24\x76\x90\xea\x14\xe5\x1c\x0b\xf5\x59\xc3\x66\x34\x4b\x0c\x83\xf8\x47\x02\xdb\x85\x8d\xff\xb7\xea\xd0\x7a\x04\x72\xb2\xf1\x4c\x83\xa0\x37\x78\xd2\xa0\x88\x1a\x3b\x38\x28\xe9\x78\x5a\x39\x8e\xd8\xda\x7e\x8e\x4d\xda\xc1\x7f\xda\xc8\xa3\xd5\x21\xae\x55\xb6\xe4\x6e\xd1\x40\xd3\x37\x83\x91\x30\x48\xf9\x6a\x15\xdc\x70\x9f\x5d\x21\x6c\xb3\x81\x36\xa5\xb4\x0d\x16\xcf\xe0\x1c\x32\x32\xb4\x23\x85\xed\x18\xe4\x9f\xcf\x13\xfa\x48\x72\x80\xd8\x0f\x78\x1f\x5d\xbe\x5a\x2d\x25\xa7\x4b\xc4\x3c\x39\x29\x1f\x53\x7e\x5a\x1d\x6a\xe5\xb1\x6c\x0d\x21\x55\xc8\x78\x7b\x21\x59\x7d\xe8\xcb\x97\x7e\x84\x43\x8f\x43\x67\x47\x09\x98\x35\xfa\x0f\xa8\xb2\xd9\x7a\x6f\x19\x0b\x6f\x8d\x9c\xfa\xd6\xfa\x19\x7c\x22\x20\xf7\x89\x80\x6b\x1d\x3b\xd4\xee\x7c\x5e\xc3\xb3\xed\x54\x50\x5b\x67\x2c\x33\x79\x79\x0b\x2b\x20\xe4\x53\xe5\xbf\xff\xde\xe4\x3b\xc1\x0b\x65\xc7\xf1\xdb\x81\xed\xac\x14\x8e\x66\x38\x83\x98\xcd\x11\x47\xd3\xb7\xc2\x60\x17\x39\xde\x7a\x7c\xb7\x9f\x4f\xa3\x42\x29\x0d\xb5\xd5\x57\xd3\xbb\xae\xd3\x20\x42\x19\x35\x92\x7e\xb7\xa6\x7f\xeb\x0d\x4d\xbb\xe8\xba\x3b\xdf\xa4\x7a\xf7\xa9\x1f\xa7\xd9\xac\xed\x38\x86\x6f\x2c\x7c\xf5\xb9\x36\x3c\x89\xf3\x20\x45\x97\x89\xc1\x80\x5a\xcb\x91\xc4\xa0\x95\xb3\xd2\xe8\x95\xee\xa9\xf1\x40\xe6\x4c\x28\x87\x73\x9d\x1c\xaf\x65\x46\xc0\x93\xe8\x07\x2b\x63\x27\xd6\x4d\xc6\xb5\x06\xd1\xb6\x72\x1e\x8d\x97\xfc\xcb\xf6\xc9\xb4\xa8\x9d\x8b\x10\xb0\xf4\x11\x62\x8f\x83\xfb\x06\xfa\x69\xd3\xc1\x73\x3e\xd8\x76\xc6\x49\xed\x9c\x93\x80\x05\xce\x56\x9e\x6c\x6d\xf1\xac\xf4\x5d\x71\x66\xc8\xc2\x26\x85\x10\x3e\x1f\x04\x05\x62\x62\xbe\x57\x28\x0c\x5a\xf2\x11\xdf\xd9\xca\xb6\x64\x09\x6b\x15\x98\xef\x19\x1a\xd9\x39\x9c\xbb\xce\x05\xd2\x72\x3a\x7a\xb7\x44\x9c\xdc\x61\x05\xb5\x03\x2f\x13\xd3\x1c\x27\x5e\x36\xc5\xf7\x09\x2a\x58\xab\x89\x2e\x48\xe3\x14\x7b\x56\x16\x79\xf7\x19\x56\xf2\x56\x31\xc7\x89\x63\xd5\x72\xbc\x54\x72\x38\x2d\x41\x05\x2b\x61\x97\x49\x43\x5a\x3b\x4e\x9b\xd4\x9c\xd2\x22\x91\x91\x72\x7e\x99\xc3\xe5\x3a\x67\x62\xb9\x7d\xcf\xe1\xc2\xcb\x88\xb3\x38\x6b\x70\x40\x92\xe1\xe5\xa3\x23\xb8\x0c\xb2\x86\xa1\x91\x9f\xc3\xf9\x44\x44\xf6\x2c\xc0\x1c\x35\x0f\xb0\x26\xc3\x0a\xda\x3a\xc0\x32\x97\x5d\xa5\x88\x3b\xf1\x52\xad\x70\x72\xac\x5c\x34\x09\x2a\xc4\xaa\xa2\x91\x3e\xc0\xd5\x0d\x92\x12\x29\x4e\x1c\x3b\x8b\xea\x26\x41\x05\x7b\x15\xd5\x19\xbb\x2b\xad\x17\xe8\x59\x78\x37\x19\x56\x0a\x54\xe1\x9d\x2f\x80\xab\x51\x5e\x22\xa5\x50\x58\x0f\x56\xa8\x9a\x1c\xac\x56\x84\x95\x2b\x2e\x53\xb8\xce\x32\xa8\x49\x50\x41\x59\x65\x50\x22\xe4\x91\xef\x58\xab\xc8\x6f\x38\x5c\xb0\x57\x91\x9f\x2b\x7c\x1c\x37\x5f\xc9\x72\xe7\x60\xae\xc6\x4c\x85\x22\xb3\xb1\x7c\xfb\xbb\x7f\xf3\xd9\x36\x4d\x03\xfa\x8d\xfb\xce\xfe\x0e\x1b\x91\x3d\x81\x11\x10\xe4\xfe\x0e\x40\xda\x33\x42\x7f\xc7\x19\x4e\xbb\xf6\x3c\xf4\x8a\x23\x1d\x56\x67\x8d\xf6\x5c\x9e\x1d\x30\xfc\xf9\x94\x8e\x27\x79\x11\x7a\x08\x40\xa4\x8f\x48\x3e\x5f\xf9\x91\x3a\xdd\x88\x68\x75\xe8\xcc\x3f\xc8\x97\x17\xec\x40\xce\xc6\xab\xc8\xd9\x58\x0e\xa2\xf3\xbf\xd9\x4c\x3c\x0a\xf5\x0a\xa3\x34\xfd\xc1\x7b\x07\xc2\x9e\xae\x45\x67\xc7\x9f\x84\x7a\xfd\x92\xd9\xee\xf4\x16\x45\x17\x5c\x7e\x5c\xb4\x46\xff\x37\x4d\x12\x10\xc3\x90\x96\x37\x57\xd3\xa5\xdc\xdd\x30\xe4\x9c\xda\x91\xae\xe1\x4f\x29\x07\x14\xe3\xd5\x2f\x71\xe6\x09\xbc\x20\x40\x71\xbe\xa0\x92\x7f\xd8\x52\xb7\xe2\x03\xb9\x4b\xf2\x0d\x63\xed\xce\xcf\x83\xd2\x1c\x51\xc1\x24\x75\x1f\xd6\xfe\x24\xdf\x70\xf1\x54\xf8\x58\x1a\xa2\x54\x65\xc9\x63\x82\xa4\x5d\x65\xcf\x0f\x87\x17\x94\x97\x4e\x89\x8f\x05\xce\x74\x97\xce\x89\xa4\xfb\x34\xef\x87\x5e\x1f\x8b\x8d\x65\x72\x28\xdf\x59\x9e\x72\x30\xed\x88\xd4\x2c\xb8\x6c\x3c\xef\x65\x77\x86\xf6\x28\xc6\x17\xd4\x30\x29\x39\x49\x8d\x1d\x25\x92\xff\xc0\x6b\x0d\x9d\x12\x07\x93\x09\x3a\x07\x1b\x8b\x16\xaa\x99\x25\x25\x17\x61\xbf\x27\xed\x6b\xf7\x82\xb6\xaf\xc5\x4b\xe2\xb9\x29\xfb\x70\x02\x43\xdd\xb0\xcb\x92\x5d\x23\x5c\xf4\xf2\x93\x80\xc2\x8b\x51\xb3\xad\x4d\x51\x68\xc1\xc6\xab\x89\x97\x2e\x76\xbe\xb4\x9a\x4e\x77\x29\x66\xb6\x95\xa5\x50\xbd\x74\xbf\xc8\x44\xf3\xcd\x9c\x5d\x03\x9c\x2c\xdb\xd0\xb7\x09\x2a\x84\xaa\x0d\x7d\x8b\x3f\x66\xd4\x26\x3b\x9b\xbc\xa1\x4a\x05\x2e\xdc\x6b\xf6\x08\x1a\xdd\x36\xa3\xdc\x08\x3a\x78\x7c\xcd\xfa\x26\xf1\x65\xfb\x72\x2d\x48\xfa\x30\x09\xad\xdf\xa5\xea\x40\xa1\x5d\xda\xcb\xd2\x4f\xbe\xe3\x16\xd9\xd2\xd6\xb6\x28\xf9\xb4\x7d\xf6\x6a\x1a\x8d\xae\x52\xc3\xca\x9c\x84\xa1\x07\x0a\x4d\x61\xed\xd4\x34\x9a\x85\x54\xd9\x16\xf8\x52\xa2\x44\xa1\x22\x11\x3e\x50\x5a\x4a\x8c\x6d\x69\xa8\xd2\x82\x89\xd9\xbd\xed\x5a\xc7\x89\x8c\xd4\x61\x52\x18\x6d\x00\xeb\x75\x71\xd7\x7e\xf1\x9a\x6f\xfb\x21\xd1\xd8\xc6\x6f\xc4\x2b\xdf\xf8\x9f\x03\x70\x69\xe3\xdf\x4d\x9d\x08\x67\x98\x0e\x75\xab\xfa\xc9\x6d\xa4\x63\xf7\x2f\xa9\xe2\x7b\x8c\x60\x09\x3d\xd3\xa0\xf2\x68\xbf\x63\x68\x12\xf4\xc0\xef\xc9\xa5\x2c\x76\x62\xbc\x24\xef\x32\x6c\xa9\x22\x07\x99\x90\x43\xd7\x9a\x1e\xb4\x12\x1d\x3b\xcc\xef\x12\x14\xc9\xa8\x55\xb9\x1c\xb7\x6c\x1c\x95\x1c\x06\x3a\x0d\x6b\x23\xcc\x4c\x2f\x98\x62\x84\xbf\x08\x0f\xcb\xe1\xb5\x62\xdf\x42\x30\xe2\x49\x35\x0e\xaf\xa4\x5b\x39\x48\x15\xe6\x29\x9f\x3a\x9a\xae\x78\x47\x76\x92\xac\xf8\xec\x12\x94\x32\xc3\xb6\x2a\x0d\xda\x3f\xbc\x84\xe9\xcd\xe0\xd7\x22\x1d\xf4\x3d\x3b\xab\xa5\xbb\x0c\x5b\xca\xbc\x4a\xc0\xaf\x2f\xa5\x4b\xbe\xc0\xa7\x7a\x8a\xca\xdb\x43\x9e\x64\xbf\x1c\x4a\xf1\x3e\xe1\x84\xd8\x7d\x62\x97\xa0\x28\xe2\x5e\x09\x3f\x92\x20\x5a\x7a\x16\x4c\xb4\xf4\x00\xc8\x69\x09\x8a\xb4\xee\x85\xd0\x95\x87\x69\x10\xad\xe7\x25\x1a\xbb\xe2\x36\x46\xed\x6f\x0d\xef\xbd\x39\x92\xcd\xbd\xa4\x3a\xb4\x50\xd2\x0b\x52\x3a\x3d\x01\x64\x60\xbe\xb9\xe4\x1f\x94\x26\x88\xdf\x8f\x9c\x48\x75\x3f\xda\x71\x98\xbd\x36\xb8\x7f\x87\x94\x02\xee\x71\xd3\x2f\x47\xdc\x4b\xfc\xa9\xdf\x65\x52\xd2\x5b\xbc\x25\xed\x6a\x53\xfd\xca\xc1\xe2\xab\x97\x37\x2e\xe3\x6f\xca\x38\xb8\x2c\xfa\xa4\xe4\xa1\x1f\x70\x49\xd4\x9b\xb8\xe8\xcf\xd5\xff\x03\x00\x00\xff\xff\xcd\x31\x5c\x71\xc9\x1a\x00\x00")
+var _schemaGql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x5a\x51\x6f\x1b\xb9\xf1\x7f\xf7\xa7\x60\x90\x07\xe7\x0f\x28\xba\x3f\x0a\xf4\x45\x40\x0f\x50\x2c\xf7\x6a\x34\x76\x7c\x8e\x7d\x69\x70\x08\x60\x6a\x77\x24\xb1\x5e\x91\x1b\x92\x6b\x47\x28\xee\xbb\x17\x33\x1c\x72\xc9\xdd\x95\xdd\x00\xed\x5b\x9e\xa4\x1d\x72\x7f\xbf\x21\x39\x9c\x19\x0e\xd7\x55\x3b\xd8\x4b\xf1\xaf\x13\x21\xbe\x76\x60\x0f\x0b\xf1\x2b\xfe\x9c\x08\xb1\xef\xbc\xf4\xca\xe8\x85\xb8\xe4\x7f\x27\x7f\x9c\x9c\xf8\x43\x0b\xa1\x0b\xbd\xf3\x5a\xbc\x37\xe6\xa1\x6b\x85\x14\x5b\xf5\x08\x5a\x48\xe7\xc0\x8b\xf5\x41\xf8\x1d\x08\xf3\xa4\xc1\xce\x84\xf3\x5d\x7d\x10\x5a\xee\x61\x26\xa4\xae\xb9\x0f\x3e\xcf\x4f\x44\x78\x7a\x73\x22\x84\xa0\x2e\x0b\xf1\xd1\x5b\xa5\xb7\xaf\x48\x42\x08\xa5\x88\xd0\x72\xd1\xff\x2d\xc4\x9d\x03\xbb\x44\x9c\x13\xd2\x69\x29\x1a\xe5\xbc\x30\x1b\xd2\xc2\x9b\x56\x55\x4e\x6c\x40\xfa\xce\x42\x1d\x95\x73\xca\x83\x90\xf5\x5e\x69\x37\x13\x7b\xe3\xbc\xb0\x50\x81\xf6\xcd\x21\x75\x25\xb0\x8d\xb2\xce\xa3\xa2\x51\x7a\x4b\x78\x41\xe3\xd7\xe2\x06\x7c\x67\xb5\x23\x26\x68\x60\x0f\xda\x3b\xa1\x34\x3d\x93\x16\x7e\x27\xbd\xa8\xcc\x1e\x84\xdc\x78\xb0\xd4\xe0\x5a\xa8\xd4\x46\x41\x2d\xb6\x8d\x59\xcb\x46\x5c\xac\x90\x41\x84\x2e\x71\x70\x27\xdf\x4f\xb1\x86\x8d\xb1\xf0\x3c\x47\xe8\xf3\x1c\x09\x0d\x59\xe8\x9e\x6c\x63\xec\x3e\xd1\x05\x14\xea\xb3\x10\x17\xda\x4f\x21\x34\xf2\x45\x00\xec\x12\xde\xa7\x25\xa4\x59\x3d\x33\x5a\x43\x85\x56\xf7\x0a\x51\x5f\x8b\xdb\x1d\x08\xd9\xd5\xca\x0b\x6f\xa5\x6a\xe2\x92\x4a\xea\xe3\x84\x97\x0f\xa0\x71\x41\xe3\x3a\x6a\x78\x02\xe7\x83\x72\x73\xf1\x41\x37\x07\xf1\xa8\x9c\x5a\x37\x40\x70\xde\xe4\xcb\x8e\x03\xd9\x9b\x1a\x2c\x59\xf7\x7b\xb3\xfd\xb1\xa6\xff\xb5\x35\xe5\xf7\x69\x05\x2c\x8d\x75\xb0\x68\x26\x4c\xa7\xeb\xd6\xff\x84\xca\x8b\x27\xe5\x77\xc2\xef\x94\x4b\x43\xe2\x96\x8b\x7a\x21\x2e\x56\x2f\xe3\xc9\xad\x54\x9a\x96\x06\x44\xe7\xc0\x4e\x20\xa2\x98\xe1\xc8\xe4\x2e\xd3\xda\x2f\x49\xb5\x91\xf5\xdd\x40\x6b\xac\x77\x68\x75\x95\xd1\x1e\xb4\x9f\x09\xd3\xd4\x47\x2c\xec\x59\xeb\xfa\xb5\x83\x0e\x7e\xd8\xd7\xff\xd2\xbe\x2c\xaf\x16\x8d\x52\x39\xe1\xbc\xf4\xc0\xb6\xe4\xa5\xef\xdc\x42\x9c\x85\x55\x0c\xeb\xfa\x91\x84\xe2\x2f\xe2\xc3\xf5\xf9\x55\xb0\x88\xa2\x7d\x64\x0e\x1c\xea\xb4\xa9\x01\x7d\x4e\x98\x1b\x7c\x8a\xeb\x8a\xde\xea\x62\x15\x9d\x14\xb6\xcc\xa9\x45\x91\xd1\x71\xac\xba\x32\x35\x8c\xf0\x1c\x02\xca\x14\xb6\x2e\x56\x2e\x62\xbb\x1c\x7c\xd0\x8e\xc8\x6e\x21\x7e\xbf\x58\xbd\xfa\xc2\xe8\xbf\x23\xfc\x17\x52\xd8\x42\x23\x63\x3c\x27\x81\x03\x69\xab\xdd\x9b\x89\xa9\x7f\xc1\x42\x7e\x18\xe1\xb3\x46\xf8\x37\xf3\x84\x7b\x7f\x2f\x7d\xb5\x13\x16\x5c\xd7\x78\x57\xf8\x23\xca\xac\xe6\x62\x05\x1b\x49\x6d\xde\x88\xe5\xdd\xed\x87\x00\x87\x3e\x62\x21\x3e\xd2\xda\x5c\xb2\x6d\xe0\xe4\x7d\xb0\x35\xe0\x64\x0a\xd3\xa2\x0d\xd2\x58\x84\xf2\xb0\x77\x6c\xf0\x50\x8b\x8d\x35\x41\xbb\x2a\xd9\x6a\x00\x35\xf8\xf2\xbb\x43\xc4\x25\xac\x08\x8c\x86\x14\x4c\x41\x38\x9a\x49\x54\xbe\x31\xe6\x01\x19\xe6\xe2\x93\xb1\xb5\x13\xd2\x42\x18\x10\xd4\x42\x3a\xd1\x5a\xd8\xa8\x6f\xe0\x66\xe2\x6b\x67\x3c\xd4\x0c\xd5\xee\xac\x74\xe0\xb0\x87\x14\x4f\x3b\xd3\x70\x86\xf7\x14\x30\x74\x9d\x7a\xec\xe5\x41\xac\x41\x68\xd8\x4a\x0f\x75\x70\xce\x52\x34\x20\x6b\x5c\xca\x80\x76\xfa\xf6\x74\x2e\x6e\x78\xfe\xf8\x85\x46\xed\x55\x7a\xe1\x6b\x27\x1b\xb4\x0c\xeb\x66\x42\xcd\x61\x2e\xee\xd1\xa5\x2f\xde\x7f\xf8\xe5\xe2\xea\x7e\xc6\x28\xf7\x21\x41\xfc\xf0\xe9\xea\xfc\xe6\xa7\xab\xe5\xe5\xf9\xfd\x4c\xdc\x37\x72\x0d\xcd\x22\x3e\x51\x46\xd8\x3f\x1d\x5a\x58\xdc\x7e\xbe\x3e\xef\x21\x94\x5b\xb4\xdd\xba\x51\x6e\x07\x35\xbe\xa0\xdc\xa2\x32\x9d\x75\xf0\xb6\x01\xe7\x8c\x66\x19\x46\xa0\x47\xe5\x0f\x6f\x25\xe6\x9e\x2c\xac\xc3\x2a\xf7\x60\x95\x05\x1c\xf3\xe2\xe7\xcf\x9f\x3f\x7f\x7e\x7b\x79\xf9\x76\xb5\xba\x0f\xd3\x74\x2f\xdb\xb6\x01\xb7\xf8\xf9\xea\x7e\x26\x9e\x76\x60\x41\x58\xa9\xb7\x3c\x5d\xb2\x71\x06\xe7\x2c\x26\xd6\x11\xef\xf2\xe2\x6a\x3e\xbf\x5c\xfe\xe3\x3e\x2c\x35\xa7\xed\x31\x1f\xe6\x5e\xb8\xc8\x98\xab\x53\xf0\xe2\xd5\x0e\xc6\x83\x51\x2a\x3c\xe3\x72\x53\x77\xec\x18\x4d\xe5\xf6\xd0\x02\xfb\x92\x20\x90\xeb\x06\x8e\xb9\xc2\x98\xf5\xd3\x94\x17\x59\x3f\x8d\xaf\x4f\xfc\x51\x55\x7a\xca\xbd\x19\x36\x44\x67\x49\x8d\xf3\x23\xa7\x00\x76\x7e\x66\xab\xb4\xd8\x28\x68\x6a\x7c\x4b\x52\x98\x9f\x4f\x1f\x13\x50\x7b\x44\x44\x9b\x7f\x2d\x3e\x76\xdb\x2d\x38\xef\xe8\x0d\x17\x0e\x24\x0a\x8d\x19\x95\x24\x63\xe0\xff\x61\x75\x5d\xf8\x8f\x26\x43\x31\x53\xd2\x0b\x07\x5c\xd0\xd7\x68\xe5\x0e\x84\x57\xbe\x41\xa5\x25\xa6\x07\x9c\x69\xf0\x3e\xe7\xc3\x44\x6b\xda\xae\x91\x96\x13\x05\x1c\x7e\x50\xe2\xcd\x33\x6e\x8b\xbb\xe0\x6e\x2f\xbd\xc5\x9f\x83\x7a\xbc\x27\xa4\x0f\x14\x7f\xfa\xff\x63\x1e\x0d\xa7\x8b\x94\x8b\xd3\x4b\xfa\x86\xb5\x0f\x1c\x2f\xd9\x4e\x1f\xc6\x68\xec\xd9\x9b\x53\x93\x84\x7e\x68\x8e\x8b\x74\x20\xc7\xa1\x8d\x67\x28\x7e\x87\x37\xb0\xe9\xbc\x50\x4c\x4d\xb0\x65\x72\x57\xd8\x2c\xc5\xbe\x5c\xe1\x62\x42\x64\x13\xcf\x02\xfb\xde\x84\x31\x14\xf2\x52\x2b\xa3\xc9\x90\xbf\x70\x54\xec\xc5\xaf\xbe\x0c\x4d\x98\x0c\x00\x8d\x17\x0d\x6f\x26\x8c\xc5\xff\x52\x0b\xd9\x28\x49\xaa\x28\xef\x92\x11\x53\xe7\xdc\x88\x49\x70\xda\x77\x18\xdb\x6f\x3c\xdc\x0c\xcf\xa6\xe4\x61\xaa\x68\x8c\xc2\x5b\xd0\xe8\x0c\x45\xdd\xe1\x9b\x38\x36\xf1\xa4\x74\x6d\x9e\x66\xbc\xb3\x94\x15\x5e\xed\x41\xd4\x50\xc9\x03\x9f\x4e\x83\x07\x99\x09\xd0\xd6\x34\x4d\x08\x7a\xbd\x25\x8b\xe8\xc5\x1c\xad\x4e\xe0\x08\xce\xdd\xc2\xc6\x02\xfa\x37\x82\x69\xc1\x2a\x53\xab\x4a\x36\xcd\x01\x47\x11\x95\xf9\x18\x94\x9b\x32\xda\x17\x02\xfa\x8f\x9c\xe1\xd9\x9c\xe1\x36\xad\x6e\xda\x64\x0a\x03\xa9\x85\x34\xf7\x6c\x08\xa5\xe1\x7f\x3a\x3f\xff\x7b\x80\x0c\x6f\x2f\xc4\x2d\x77\xff\x44\xcf\x99\xef\x3b\xee\xb4\xd1\x0d\xa2\x4d\x91\x47\x9d\x8b\x13\x41\x8e\x31\xb7\x6a\x6a\x89\x1e\xa0\xf7\xb3\x24\x1e\xd8\x36\xd6\x5e\xfa\xc3\x7a\xd5\x59\x1b\x8a\x28\xb2\xf3\x3b\xd0\x5e\x55\x18\xfc\x12\xc6\xa3\x82\x27\x5c\x74\x7a\x2b\xd6\x93\x62\x81\x89\x4b\x4a\xcb\xaa\x82\x16\x53\x28\x2d\x94\x7e\x54\xdc\x86\xbb\x5e\xb4\x56\x3d\x4a\xcf\x4e\x69\x26\xb6\x56\x6a\x1f\x37\x4b\x80\x16\xb2\xaa\xc0\x91\x93\x08\xae\x06\x9f\x5b\x4f\x33\x72\x91\xd0\xde\x28\xdd\x76\x7e\x21\x96\x53\x8d\x17\xd8\xf6\xaa\x0c\x21\xcb\x9a\x12\x1a\xae\x5d\xa1\x2e\xe1\x00\x8a\xe1\x9f\x58\xea\x7a\xc9\x8f\x54\x88\x4a\x04\x03\x79\xc2\x1e\x36\x5c\xcb\x43\x63\x64\x9d\x91\xc5\x3d\x8c\x64\x22\xa4\x1f\xcc\x74\x46\x0f\xef\xa9\x39\x23\xca\xc5\x39\x4f\x2e\x1f\xd3\x68\x01\x7b\x2c\xad\x78\x93\xcd\xe2\xa9\xc3\x79\x34\x9d\xf6\x4c\x79\x8e\x7d\x32\x2e\x7a\xce\x49\x48\x30\x35\x08\x0c\xa3\xb8\x1a\xfc\x17\xd3\x09\xc6\x7c\x8f\x4d\x19\x26\x3d\xe7\x98\x24\x98\xc0\x6c\x2d\x58\xf8\xda\x29\xaa\xe5\x04\x68\x9a\x8b\x88\x4b\x0f\xd7\x59\xa7\x9c\x64\xd4\x58\x30\x8e\x5a\x27\xe8\x2b\xb3\x47\x4f\x31\xc1\x7c\x16\x5a\x32\x3a\x96\xe4\x1c\x2c\x1a\x03\xc7\x90\x43\x47\x73\x0b\xce\x34\x8f\x18\x17\x4c\x8c\x51\x5c\x6e\xc8\x6a\x0c\x21\x4f\xac\xeb\xf0\x22\x38\x56\x83\x6a\x69\x4b\x14\x65\x9a\xf4\xc2\xa4\x4c\x16\x96\xde\x35\xa6\x7a\x70\xd1\x37\x60\x3c\xe7\x90\x82\xbb\x4b\xe9\x19\x05\x1a\xa5\xb7\x21\x11\xe1\x19\xc0\x36\xa3\x0b\xb3\x21\xb0\x54\x2c\xc1\xbe\x84\xa5\x8d\x57\x9b\xc3\x60\xa7\x52\xde\x84\xf3\x15\x12\x9e\x1b\xd8\x1b\x1a\x30\xbb\x1c\x06\x83\x6f\xca\xd1\x1e\xcf\x43\x9c\x2a\x59\x63\x79\x06\xc7\xbf\xc6\x91\xa0\x7b\x89\x63\x7f\x17\x05\x69\xdc\xbd\xcb\x3a\x93\xba\xc2\x2c\x0f\xb5\xc2\x52\x78\xdd\x35\x50\xc7\xa8\x89\xfe\x2f\xee\x3c\x4c\x0b\xfa\xa5\xae\xe8\xb5\x8f\xf1\x85\xeb\xd0\x3f\x12\x9e\x4d\xb6\x26\x76\x7e\x96\xb1\x28\x39\xad\x43\x0d\x0d\x90\xdf\x33\x9b\x72\xa8\x71\x5f\xa6\x10\xb4\x91\x8d\x03\xa1\x36\x42\x1b\xc2\x4b\x6f\x3e\x49\xd7\x03\xf6\x6a\xff\x46\x48\xcb\x00\xb3\xe2\xce\x0b\xf1\xce\x98\x06\x64\x8c\x15\x67\x74\x68\x41\x83\xd0\xf0\x54\x78\xba\x70\x9c\x89\xbe\x2b\x8d\xb9\x90\xa6\xb1\x96\xe2\xdc\xe4\x4b\x82\xde\xbd\x05\xf8\xe0\xb2\x4a\xf0\x20\x1b\x40\x07\xe1\x71\x60\xf2\x39\x3d\x6e\xe1\x72\xce\x7a\xd1\x00\x75\xe4\x78\x06\xa0\xbd\x21\x90\xbc\xf4\xc4\x0c\x51\xba\xe1\x5c\x78\x1c\x38\x9d\x82\x82\xb6\x14\x97\x4a\x58\x0e\x55\x05\x2a\xc9\x26\x41\x5f\x0c\xa0\xe4\xfa\x71\x73\x29\x8f\x16\x94\x82\x81\x0a\x7b\x8f\x4e\x77\x03\x7d\xc6\x41\xf4\x6c\xaa\x31\xe9\x38\x90\x4f\x8c\x3a\xe6\x06\x81\x24\xdf\xba\x67\x49\x92\xe0\xf0\xe1\x08\x46\x08\xce\x25\x52\x11\x8c\x7b\xb8\x32\x16\x0f\xe4\x71\x2a\x89\x86\x36\x08\xb8\x61\xbc\xa7\x4d\x96\x0c\x3b\x12\xac\x0a\x69\xc2\x2f\xc5\xf9\x4a\x25\xf4\x6c\x07\x04\xe8\x72\x07\xac\x32\xd9\x00\x76\xbc\x03\x32\x95\x69\x7d\xfb\x32\xd1\xc8\x89\x24\xba\x22\xbe\xaf\x7a\xd1\x80\x6c\x14\xe5\xfb\x01\xd0\x4e\x0b\x54\xb2\x37\xe4\x30\x98\x62\xdb\xad\x7a\xd1\x00\x7d\xb4\xed\x32\x74\xda\x4e\xc7\xe0\x8b\xfd\xc7\x58\xe5\xfe\xcb\x85\xd3\x0c\x31\xaa\x33\x45\xef\xec\xe3\x7a\x14\xa1\x3d\xce\x7d\x19\xdd\x0b\xe9\x34\xcd\x40\xf5\x62\x8b\xaf\x7a\xd1\x00\x72\xb4\xc5\x7b\xc0\xd2\xf2\x83\xb2\xc9\x94\x4b\xe4\x24\x1e\xa0\x27\x79\xce\x10\xe3\x57\x08\x4c\x2f\x85\xa3\x19\xdf\x54\x48\xcc\xc9\x2b\xe0\x03\x65\xaf\x51\x11\x74\x4a\xad\x8a\xa6\x81\x66\x45\x5b\xd4\x8e\x26\xe0\x17\xf5\xc8\xbb\x12\x4f\xc0\xe4\xdc\xb4\x58\xe2\xff\x98\x61\xa2\xf3\x22\x41\xa4\xfb\x25\x0a\x12\x49\xea\x1f\xef\x7b\xc2\xd9\x13\xf3\x2d\xbc\x53\xc2\x8a\x6b\x87\xb1\x55\xe7\xa7\x19\x2e\xb2\xd2\x59\x28\x77\x56\xef\xa3\x20\xc1\x27\x49\x3e\xaf\x91\xc4\x17\x55\x16\x5a\x45\x4a\xfc\x1a\xb3\xdd\x42\x2d\x4c\xe7\x99\xc5\x74\x1e\x51\x89\x80\xff\x17\x33\x71\x29\xed\x43\x48\xb0\x58\x3b\x2c\xc7\x5a\x90\x35\xbe\xbf\x97\xf6\xe1\x2a\x6b\x5b\xba\x1b\x90\x75\x54\xf9\x72\xb2\x35\xe9\x7f\xb1\xea\x09\xb0\xe2\x92\x16\x3d\x67\x73\x43\xba\x65\xd3\xe4\x98\x2e\x80\x66\x09\xc6\x7f\x82\x99\x6f\xf4\x09\x02\xda\x0f\x13\x2c\xf9\xc0\xa6\xba\x0d\x06\x58\xa8\x74\x2b\x1f\xd8\xa2\x30\xb7\xe6\xeb\x45\x61\xe2\xc5\x12\xd4\xd9\x25\xa0\xa5\xa2\x10\x95\x1d\xc7\x59\x39\xa1\x61\x66\xce\xd7\x7f\x90\xa7\xa7\x51\xc6\x57\x4d\x49\xe1\x52\x9c\x34\x1c\xde\x51\x12\xf6\x25\x58\x2c\x12\xc7\xd2\x95\xd2\x64\xfd\xc6\xef\xf0\x6b\x8b\x06\xe4\x63\x88\xe8\xa1\x28\x25\x64\x76\xb4\x60\x6b\xa3\xae\xf3\x93\x74\x83\x36\x3c\x52\xec\x91\x80\x2b\xa3\xd8\x8d\x9e\xf9\xbb\x87\xa8\x70\x2f\x4a\xca\xd2\x23\xa1\x5e\x9a\x47\x48\xf1\x32\x3f\x34\x13\xb3\x68\x8d\x53\x71\x1f\x61\xd2\x1f\x43\x63\xe1\xb3\x2e\x87\x0d\xd9\xa4\x0c\x5a\xf2\xfd\x85\xaf\x71\x34\x8d\x51\xe3\x19\xe6\xfc\x6c\x9c\x13\xe7\xf2\x82\x37\x6f\x28\xb6\x21\xa7\xf5\x83\x60\xce\x47\x89\x32\x9a\x73\xd7\x61\x42\x4b\x8f\x03\x2c\xf4\x0d\x6b\x53\x1f\x44\xb5\x0b\x37\x03\xad\x35\xad\x71\x50\x93\x53\xe2\x01\x9e\x3a\x51\x5b\xb9\xf1\x19\x61\x50\x70\x85\xd2\x01\x6b\xd6\x92\xa8\x83\xec\xfb\xa8\x39\x5e\x4e\x70\x73\xfc\x9b\x22\xcf\x9b\xb2\x81\x93\x30\xfa\x60\x34\x88\xac\xd6\x12\x3c\x41\x99\x7d\x59\x38\x6a\x35\x37\xe3\xa6\x44\x34\xd1\x96\x5b\x4e\xa2\x8e\x76\xc3\x4e\xa8\x5f\xcd\xc0\x9b\x9b\x40\x49\x9b\xb7\x0c\x58\xa7\xec\x66\x40\x9a\x27\x50\x45\xad\x24\xd0\x16\x49\xd4\x4d\x2f\x1a\x10\x8d\x92\xa8\x9e\xa1\xa8\x9b\x8c\xf2\x1c\x66\x19\x55\x40\x06\x94\xa3\xf6\x21\xff\xa8\xc3\xa4\x32\x99\x47\x7a\xbe\xbc\x11\xd4\xca\x2b\x1c\x41\x32\x2e\x72\xdc\x0c\xe4\x13\x7e\x29\x7e\xd1\xc1\x0e\x39\x14\x93\xd7\x16\xe4\x43\x08\xc8\xb6\xe3\x6b\x92\xc1\x17\x62\x81\x96\xbf\x0a\xc8\xfd\x76\xfa\x54\xa0\xf0\xda\xfc\x1c\x1a\x99\xf8\x6b\x47\x57\x50\x29\x29\x7f\x04\x9b\xc2\x92\x88\x15\xb8\x35\x5e\xcd\x86\xbc\x1c\xa7\x0f\x9c\xa7\x8c\xfb\xb7\xac\x6f\xcf\x3c\xdd\x3e\x1d\xdc\x7a\x7e\xd1\x4a\xe7\xf0\x82\x16\xaf\xaa\xc1\x3f\x43\x7d\xcd\x1d\x6f\xa0\xd8\x5f\xe3\xb6\x44\x79\x7d\x73\x3b\x1e\xed\x37\x9c\x84\x51\xde\x58\x4b\x2f\xc3\xa5\x04\x57\x83\x14\x2e\xb7\xc2\xd2\x07\x7e\x07\xa1\x1f\x84\xe7\x72\x86\x79\xd2\x68\xcb\xb4\x3e\x11\x4c\x57\x40\x47\xd6\x90\x18\x1c\xd8\x74\x8c\x4e\x3d\xf8\xaa\x4c\x69\xd1\x5a\xb3\xb5\xe0\xc2\x81\x56\x7a\x2c\xa6\xa9\x3d\x6f\x2b\x1a\x4a\xc8\x31\x57\xd2\xcb\x73\x42\x1f\xcd\x9c\x03\xef\x46\xbe\xb6\xbf\xf1\xc7\x40\x8b\x2e\x3a\x40\x3a\xf0\x13\xbe\xf7\x66\x20\x9f\xf2\xbc\x89\x68\xe0\x59\x9f\x61\xca\xdd\x69\x41\x95\x37\x24\x2e\x16\xe6\x64\xc5\x92\x38\xa8\x2c\x3a\x5c\x00\xbc\x15\x7d\x00\xac\xfa\xe9\x9a\xbf\x36\x08\x7d\xb1\x48\x41\x4d\x49\x85\xbf\x02\x7e\x11\xf9\x00\xc5\xb5\x40\x36\x18\x4c\x6c\x4f\x5d\x32\xba\xf4\x5e\x34\xa0\x42\xed\x28\x3c\x66\xc3\x8f\xe6\x21\x9d\x79\x4e\x5d\x56\xea\x1f\x14\x36\x02\x0b\xf6\xa6\x44\x11\xab\xfc\x2e\x73\x13\x83\x86\xc4\x46\xb2\x92\x6a\x5c\x41\x09\x04\x74\x73\xe0\x9c\x90\x0d\x19\x20\x9e\x7a\x34\xde\x73\xac\x0f\x6c\x97\x0f\xd0\xfa\x70\x63\xdc\x69\xaf\x9a\x64\xad\xc8\xcd\x9f\x33\x8c\x34\x1c\xa8\xdd\x17\x4d\x26\x54\xef\x1b\x4b\xf5\x7b\xf9\xc9\x20\xab\xc5\xb3\x0e\x87\x97\xc1\x49\x09\xbf\xa0\x2b\x4e\x4a\xb7\x51\x70\xec\xa4\x74\xa7\xd7\x45\xc1\x98\x9e\xfa\x6f\x6b\x83\x49\xe1\x70\x3a\x3d\xaa\xc7\xf2\xbb\xe3\xaa\x0e\xe9\x7b\xd7\xd6\x54\xd6\x41\x98\x1a\x5c\x65\x15\x7d\xfe\x82\x96\xf8\x93\xb1\xe9\x9b\x01\x59\xe4\x03\x1d\xbd\x14\x03\x7b\x22\x2a\xa4\xfd\x50\x58\xf0\x7d\x74\x7d\x12\x10\xc8\xca\x8c\xee\x2e\x93\x4d\x25\x74\x91\x26\x7a\xff\x53\x47\x37\xd6\x3d\x5c\x51\x7f\xb9\xeb\x45\x09\x8c\x9e\x46\x2a\xa7\xbd\xdb\x17\xc7\xf9\x63\x37\x4a\xd2\xb4\x38\x27\x79\x5c\x68\xe6\x4a\x7d\x07\x84\x49\xde\xb3\xa6\xd7\x47\xd4\x95\x69\x8c\x8d\xf3\x94\x4f\x1d\xad\x4e\xaa\xbb\x06\xca\x22\x77\xb9\xeb\x45\x89\x88\x9e\x46\x1c\xe8\xec\x22\x05\x7d\xc2\xc0\xe0\x29\x67\x61\xf4\x22\x15\x63\xf8\x32\x09\xcb\xfc\xec\x88\x80\xd7\x97\x9c\x63\x0f\xca\xde\xb2\x44\x65\x61\x82\xe5\xe7\xef\x33\xa5\xe4\xa2\x02\x51\x51\x02\xba\xeb\x45\x13\x6e\xe9\x08\xc5\x4c\xd4\xca\xb5\x8d\x8c\x9f\x30\x34\x66\x6b\x66\xf4\xfd\x9f\x87\xf2\x03\x97\xf0\x0d\x3b\xde\x84\xa3\xc6\x2f\xe6\x5e\x41\xc1\xec\x20\x18\x04\x94\x62\x95\x1a\x93\x68\x3a\xe1\x6a\x64\xc5\x2a\x13\x0e\xda\x22\x9a\x3f\xfe\xcf\xbe\x9d\xc1\x6a\x8d\x9e\x66\x72\x13\x54\x7d\x72\x97\x0b\xf3\x4c\x33\x9f\xab\x7e\xee\xcb\x6a\x59\x20\x49\xe5\xaf\x92\x27\x89\x7b\xaa\x28\x19\x11\x50\x31\x28\xae\x74\x4a\xb0\x8a\x9c\xa7\xe7\x2b\x8a\x5a\x25\x67\xd1\x54\xf0\x8e\x28\xd7\xca\x70\xfd\x7e\x36\x34\xb1\xe3\xa4\xd7\xd6\x6c\x54\x03\x53\xa4\xdc\x54\x92\xfe\x71\xf2\xef\x01\x00\x32\x51\x5a\xdc\x21\x32\x00\x00")
 
 func schemaGqlBytes() ([]byte, error) {
 	return bindataRead(
@@ -2968,7 +3482,7 @@ func schemaGql() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "schema.gql", size: 6857, mode: os.FileMode(420), modTime: time.Unix(1546279982, 0)}
+	info := bindataFileInfo{name: "schema.gql", size: 12833, mode: os.FileMode(420), modTime: time.Unix(1792353784, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
@@ -3113,6 +3627,26 @@ func typeAdd_label_payloadGql() (*asset, error) {
 	return a, nil
 }
 
+var _typeAdd_lesson_prerequisite_payloadGql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x6c\xcd\xb1\x0a\xc2\x60\x0c\x04\xe0\x3d\x4f\x71\xd2\xbd\x0f\xe0\xe6\xee\x50\xc4\x17\xf8\x21\x27\x2d\x94\xa6\x26\x29\x52\xc4\x77\x17\x5b\xd1\x0a\xae\xb9\xef\x72\x15\x4e\xcc\xc9\x07\xe4\x3c\x12\x17\x73\x1c\x54\x8f\x8c\xb0\xa1\x71\x3a\xaf\x53\x17\x5d\xb2\x96\x25\xff\x9b\x35\x65\xee\xad\x28\xee\x02\x54\x38\xb7\x44\xbf\x18\x64\x4b\x8c\x1b\x88\x5b\x09\x14\x55\x2a\xd2\x6a\xc1\xdb\xed\xb1\xee\xed\xe4\xf3\xe0\xa7\xb5\xaa\x97\xdf\x9e\xbf\xad\x87\x3c\x07\x00\x0e\x5b\x0c\xdf\xc5\x00\x00\x00")
+
+func typeAdd_lesson_prerequisite_payloadGqlBytes() ([]byte, error) {
+	return bindataRead(
+		_typeAdd_lesson_prerequisite_payloadGql,
+		"type/add_lesson_prerequisite_payload.gql",
+	)
+}
+
+func typeAdd_lesson_prerequisite_payloadGql() (*asset, error) {
+	bytes, err := typeAdd_lesson_prerequisite_payloadGqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "type/add_lesson_prerequisite_payload.gql", size: 197, mode: os.FileMode(420), modTime: time.Unix(1792345549, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
 var _typeAdded_to_activity_eventGql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x54\x91\xb1\x6e\xf4\x20\x10\x84\x7b\x9e\x62\x4e\xd7\xfe\xba\x07\x70\x67\xe9\x4f\x71\x4d\x8a\xe4\xf2\x00\x04\xc6\x31\xd1\x01\x16\xac\x6d\x59\x51\xde\x3d\x02\xfb\x62\xa7\x02\xed\xce\x37\xbb\x03\x67\xbc\x70\x48\xcc\x0c\x92\xa1\xa1\xad\xa5\x85\x44\x68\x23\x6e\x72\xb2\x80\x13\x83\x20\x06\x68\x7c\xb8\x89\x01\x79\x7c\xff\xa4\x91\x8b\x92\x65\x20\xda\x02\xdc\x62\xbb\xc9\x9f\xaa\xda\xf9\xe1\x4e\x5f\x2d\x15\xf0\x96\x99\xda\x9c\x29\x37\xe7\x79\x77\x81\x55\xf4\x4f\x01\xcf\xd1\x52\x7d\x29\xe0\x8c\x5b\xcf\x7d\xa6\x44\xcc\xbd\x33\x3d\xa4\x54\x0b\x8a\x59\xe7\x75\xb9\x8b\xc2\xaf\xb0\xc1\x63\xee\x49\xed\x2e\xab\xbe\xf2\x7f\xa9\xd2\x68\xf6\x75\x36\xe6\x6a\x19\xc4\x75\x8e\xb9\x8e\xb3\x5a\x08\x1d\x2c\xc4\x79\x62\xee\x19\x6a\x39\xd6\xd0\xd5\xd0\x24\x6a\x59\x2d\xb7\x6b\x2b\x0d\x4a\xb8\xea\xe8\x6c\x83\xeb\xff\xc3\x42\x59\x46\xbb\xa0\x4b\xd1\x1f\x52\x6d\xcf\x6a\xcc\x98\xd2\xea\x55\x65\x0d\x5e\xcb\x71\xa0\xc7\xcc\x84\xb9\x8f\x18\x98\xba\x98\x7c\xf9\x9e\x07\x5f\xb0\xd2\x5f\x43\x9d\xd4\xb7\xfa\x09\x00\x00\xff\xff\xf9\x05\x5c\x77\xcf\x01\x00\x00")
 
 func typeAdded_to_activity_eventGqlBytes() ([]byte, error) {
@@ -3213,7 +3747,27 @@ func typeAppled_eventGql() (*asset, error) {
 	return a, nil
 }
 
-var _typeCommentGql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xc4\x56\xc1\x8e\xdb\x36\x10\xbd\xeb\x2b\x26\xd8\x43\x13\xa0\x5d\xa0\x87\x5c\x74\x29\xbc\xf6\x16\x31\xb0\x69\x17\x8e\x7d\x2a\xf6\x40\x8b\x23\x8b\x8d\x44\x0a\xe4\x68\x1d\xa3\x28\x90\x8f\xc8\x17\xe6\x4b\x8a\x19\x8a\x92\xac\x75\x76\xd1\xf6\x90\x83\x01\x53\x7c\xf3\x38\x6f\x38\x7c\xe4\x15\x6c\xb0\xf5\x18\xd0\x52\x00\x05\x85\x6b\x1a\xb4\x74\x9d\xd1\xa9\x45\x58\xc6\x11\x98\xa6\xad\xb1\x11\x48\x06\xb0\xc2\x1a\x09\xd5\xbe\xc6\x1f\x33\x80\x3b\xb5\xc7\x7a\x18\x60\x08\xce\x6e\x4d\x83\xb5\xb1\x78\xfb\x88\x96\xf8\xf3\x6f\x4e\xcb\xf4\x7d\xb7\xaf\x4d\xa8\x12\xfa\x03\x75\xfa\x94\xe6\x76\xd6\x94\xce\x37\x1b\x0c\xae\xf3\x05\xde\xb9\x42\x51\x02\xee\x5a\xad\xc6\x15\x77\x01\xfd\x22\x04\xa4\xb3\x75\xb2\xbf\x32\x80\x2b\xd8\x56\x08\xaa\xa3\xca\x79\x70\x25\x50\x85\xa3\x24\xe8\x27\x72\x61\xc8\x06\xf8\xde\xe9\x13\xa8\x00\xef\x95\xff\xa8\xdd\xd1\x32\x92\xbf\xe5\xf0\x81\xbc\xb1\x87\x57\x33\xa8\x47\xab\xd1\xa3\x06\x72\xf0\x6e\xfb\xfe\x2e\xe1\xf9\x7f\x2e\x5f\x9e\x8b\x20\xfc\x44\x29\x62\x8b\x9f\xe8\xc2\x2a\x7d\xc6\xac\x17\x54\x08\xae\x30\x8a\x50\xc3\xd1\x50\x05\x54\x99\x30\x95\x34\xc1\xe6\x69\xbf\x78\xd0\xd3\xad\x35\x5a\x32\xa5\xc1\x20\xb5\xe0\x32\x82\xb2\x1a\xc8\x34\x08\xc7\x0a\xad\x7c\x76\xfb\x3f\xb1\x20\x38\xaa\x00\x85\x47\x5e\x4c\xa8\xe3\xdf\x05\xe5\xc0\x95\x9e\x26\xd8\x79\xcf\x7d\xa1\xbd\x2a\x89\xeb\x5c\x54\xca\x1e\x30\x40\xe9\xfc\xb4\xe6\x17\x4b\x2b\x41\x33\xd5\x1b\xa4\xce\x5b\x6e\xc0\x60\xec\xa1\xc6\x9e\x79\xaf\x8a\x8f\x5d\x3b\xb2\xf6\xcb\x0e\xec\x27\x30\x1a\x5e\xff\xfc\xd3\xdb\x37\xd7\xf3\xe2\xfd\x10\x7a\x0e\x13\x84\x06\x35\x74\xed\xd7\xcf\x5f\x54\x49\xe8\x41\x59\xe8\xa4\xa7\xbe\x7e\xfe\x82\x8f\xe8\x4f\x40\x47\x07\x8d\xb1\x1d\x61\x18\xb8\x3c\x82\xe2\x1f\x41\xe3\x02\xc1\xdb\x3e\x9f\x30\xa8\xb8\x91\xf1\xeb\x0c\x20\xad\xbe\x5e\xa5\xb6\x8b\xd8\x6b\x99\x33\x3a\x87\xf5\xea\x55\x06\xf0\x66\xd8\xa4\xd5\x48\x30\xaf\x41\x6d\x82\x54\x75\x5a\x84\xf0\xad\x2a\x7c\x4f\xe5\x21\x87\x3f\x9e\xca\x79\x90\x4d\x4d\x9a\x63\x1b\x86\xb3\xc6\x85\x36\x1a\x01\xea\x5f\x18\x19\xee\xd3\x30\x87\x1b\xe7\x6a\x54\xf6\x49\x5f\xa4\x9a\xd4\x6c\x37\xcf\x16\x23\x22\xd2\xa6\x24\x06\x06\x63\x32\x31\x13\xdb\x5e\x38\xa9\x52\x12\x8e\x10\x0b\xc4\x13\xa1\xc5\x82\x0f\x8d\x86\x43\xed\xf6\xaa\x86\xf5\x2a\x6e\xa4\x40\x52\xef\x66\xff\x7e\x89\x3d\x96\xce\xe3\xf3\x6b\x44\xcc\x7c\x91\x5f\x4d\x4d\xc8\x1f\xc0\xb5\x64\x9c\x8d\x25\xe8\xab\xe1\x25\x03\xd4\x50\x7a\xd7\xf4\x07\xd0\x5a\x2c\x18\x18\x49\x4b\x09\xbf\x39\xe5\xd1\xaf\x23\x5b\xb8\xa4\xa0\x34\x3e\x10\xd8\x51\x09\xbb\xf2\xa0\x25\xb1\xf9\x40\x39\xac\x2d\x5d\x62\xa8\xd5\x8b\x04\x0c\x39\x8b\xff\xdd\xeb\xff\xa1\xce\x71\xf4\x20\x4e\xb8\xe2\x59\x93\xf1\x72\x40\x3f\x35\xc5\xc1\x02\x53\x67\xc6\xa3\xc3\x4e\x28\x32\x50\x9b\xde\x0d\x79\x78\x2b\xa3\x99\x21\x3e\xc7\xc6\x3c\x43\xaf\x83\x12\xf5\xc3\x38\xf1\x8c\xbe\xfa\x6e\xbb\xbd\x87\x56\x51\xd5\xf7\xf7\xb9\xd5\xfb\xfe\x6a\xbc\x57\x54\xe5\xb0\xdb\xac\x27\x8e\x1c\xf8\x2a\x7d\xf1\xb2\x10\x54\x1e\xef\xdd\xff\x7a\x41\x48\x59\xa2\x85\x48\x5d\xfa\xbf\x17\x6e\x09\x51\xb3\xdb\xdc\x5d\x14\xd3\xf9\x7a\xaa\x61\xa9\xe2\x42\x8f\x06\x8f\xe8\x41\xcb\x13\x23\x06\xc5\xb5\x39\x26\x4e\x2e\x95\x8d\x2f\x90\xb9\x59\xcc\x38\x62\x62\xdf\xe4\x88\x6f\x8a\x39\xc7\xca\xe8\x29\x47\xff\x92\x98\x27\x1f\x67\x57\x46\x2f\xfa\x07\xc5\x40\xf2\x77\x96\x5d\xc1\xc2\x02\xea\x03\x82\xbc\xa1\x58\xfc\xf2\xd2\xab\xea\x96\x21\x93\x97\x95\x8c\xe3\x23\x66\xc1\xbe\x16\x9c\x97\xe0\x2e\x20\xbb\x49\xab\x0e\xc6\xaa\xd4\xf3\x71\xfe\xc2\xdb\xc1\x10\x36\xec\xdb\x62\x46\x56\xa7\xdb\x88\xf3\xe1\x38\xeb\xf4\xf8\x4e\xe8\xb3\x9d\x1c\xa7\x17\x52\x1e\x4f\xd2\x34\xf1\xc9\xd7\x98\xfe\xda\xf2\x91\x57\x91\xd0\x81\x32\xfa\xa9\x80\x56\x1d\x90\x71\x39\xdc\xf7\xff\x7a\x11\x8b\xc1\xea\x39\x67\xb9\x71\xe4\xcf\x78\xd5\x70\xa1\x1e\xe6\x60\x16\x16\x92\xc2\x09\xf8\x61\x2c\x0d\x39\x52\x35\x14\xae\xb3\x12\xc1\x95\x1a\x7c\xfa\xdc\x51\x04\xb9\x64\xa0\x98\x14\x6f\xeb\x3f\x01\x00\x00\xff\xff\x45\x58\x7f\xcb\x28\x0b\x00\x00")
+var _typeBlocked_user_connectionGql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x84\x90\x31\x4f\xc3\x30\x14\x84\x77\xff\x8a\xab\xba\xf7\x07\x78\x41\xa1\x62\xe8\x86\x44\x11\x43\xc5\x60\xe2\xd7\xd4\x22\x79\x8e\xec\x17\x21\x84\xfa\xdf\xd1\x7b\x49\xa1\x2a\x03\xdb\xd9\xbe\xb3\xee\xbb\x35\x1a\x06\xc5\x8e\x20\x9f\x23\xe1\x98\x0b\x9e\x2b\x95\x8d\xb3\xe3\x7d\x9f\xdb\x77\x8a\x7a\xf3\xa0\x9e\x34\x8c\x3d\x0d\xc4\x52\x61\xe7\x2f\x07\xac\xd1\xa0\x9d\x4a\xcd\xc5\xd2\x53\x25\x24\xc6\x18\xba\xc4\x41\x52\xe6\x8d\xc3\xf2\xee\xf1\x24\x25\x71\xb7\x72\x16\xdb\x9f\x08\x49\x68\x40\x10\xc8\x89\x40\x1c\x91\x8f\xb3\x8c\x1d\x69\x8e\x73\x24\x6f\x85\x96\xcc\xcb\x89\x18\x1f\xa1\x9a\x6b\xaa\x54\xf0\x36\x57\xbc\x73\xb8\xc8\x46\x3c\xf6\x69\xa0\x95\x3b\x3b\x67\xed\x32\x33\xb5\xda\xe5\x3f\xc8\xed\xaf\xf3\x0a\xf5\xea\x76\x06\xde\xf1\x31\x97\xc1\xe8\x20\x19\x21\xc5\xbf\xc8\x63\xe8\x48\x7d\x1e\x8f\x8b\x5a\x10\x1a\xf4\xa9\x8a\x92\xea\xec\x55\xbd\x26\x3c\x0e\x37\x73\xbf\xde\x06\x74\x8e\x7a\xd9\xa5\x7a\x1c\xb4\xf3\xe2\xd2\x35\x25\x4b\xe8\xd1\xe6\x89\xed\x7f\x1d\xb7\x6a\x33\x1d\xab\xfd\x81\xd0\x0f\xcc\xb9\x55\xa3\xc7\x8e\x65\xe5\xce\xee\x7b\x00\x1d\x62\xed\x32\x0a\x02\x00\x00")
+
+func typeBlocked_user_connectionGqlBytes() ([]byte, error) {
+	return bindataRead(
+		_typeBlocked_user_connectionGql,
+		"type/blocked_user_connection.gql",
+	)
+}
+
+func typeBlocked_user_connectionGql() (*asset, error) {
+	bytes, err := typeBlocked_user_connectionGqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "type/blocked_user_connection.gql", size: 522, mode: os.FileMode(420), modTime: time.Unix(1792346991, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _typeCommentGql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xc4\x56\xcf\x8e\xdb\xb6\x13\xbe\xeb\x29\x66\xb1\x87\x5f\x02\xe4\x17\xa0\x87\x5c\x74\x29\xbc\xf6\x16\x31\xb0\x69\x17\x8e\x7d\x2a\x72\xa0\xc4\xb1\xc5\x86\x22\x05\x72\xb4\x8e\x51\x04\xc8\x43\xe4\x09\xf3\x24\xc5\xf0\x8f\xa4\x95\xbd\xbb\x68\x7b\xe8\xc1\x80\x48\x7f\xf3\xcd\x7c\x43\xce\x0c\xaf\x61\x83\x9d\x43\x8f\x86\x3c\x08\xa8\x6d\xdb\xa2\xa1\xb7\x05\x9d\x3a\x84\x65\x5c\x81\x6a\x3b\x8d\xfc\xe5\xa1\x00\x58\xa1\x46\x42\x51\x69\x7c\x53\x00\xdc\x89\x0a\xf5\xb0\x40\xef\xad\xd9\xaa\x16\xb5\x32\x78\xfb\x80\x86\x78\xfb\x57\x2b\x03\xf6\xbe\xaf\xb4\xf2\x4d\x46\x7f\x44\xe1\xea\x71\x45\xbd\x3c\x65\xe4\xce\xa8\xbd\x75\xed\x06\xbd\xed\x5d\x8d\x77\xb6\x16\x94\x81\xbb\x4e\x8a\xd1\xff\xce\xa3\x5b\x78\x8f\xf4\xc8\x6b\xf1\x67\x01\x70\x0d\xdb\x06\x41\xf4\xd4\x58\x07\x76\x0f\xd4\xe0\x28\x10\xd2\x1f\x65\x60\x28\x06\x78\x65\xe5\x09\x84\x87\x0f\xc2\x7d\x96\xf6\x68\x18\xc9\x7b\x25\x7c\x24\xa7\xcc\xe1\x6a\x06\x75\x68\x24\x3a\x94\x40\x16\xde\x6f\x3f\xdc\x65\x3c\x7f\x97\x61\xe7\x39\x0b\xc2\x2f\x94\x2d\xb6\xf8\x85\x2e\x78\x49\x11\xb3\x5e\x10\xde\xdb\x5a\x09\x42\x09\x47\x45\x0d\x50\xa3\xfc\x54\xd2\x04\x5b\xe6\xd3\x63\xc3\x44\xb7\x96\x68\x48\xed\x15\xfa\x90\x0b\x4e\x23\x08\x23\x81\x54\x8b\x70\x6c\xd0\x84\x6d\x5b\xfd\x81\x35\xc1\x51\x78\xa8\x1d\xb2\xb3\x40\x1d\x3f\x17\x54\x02\x67\x7a\x1a\x60\xef\x1c\x1a\x02\xe9\xc4\x9e\x38\xcf\x75\x23\xcc\x01\x3d\xec\xad\x9b\xe6\xfc\x62\x6a\x83\xd1\x4c\xf5\x06\xa9\x77\x86\xaf\xa3\x57\xe6\xa0\x31\x31\x57\xa2\xfe\xdc\x77\x23\x6b\x72\x3b\xb0\x9f\x40\x49\x78\xf5\xd3\xff\xdf\xbd\x7e\x3b\x4f\xde\xff\x7c\xe2\x50\x3e\xd0\xa0\x84\xbe\xfb\xf1\xed\xbb\xd8\x13\x3a\x10\x06\xfa\x70\xa7\x7e\x7c\xfb\x8e\x0f\xe8\x4e\x40\x47\x0b\xad\x32\x3d\xa1\x1f\xb8\x1c\x82\xe0\x1f\x41\x6b\x3d\xc1\xbb\x40\xd4\x77\x7e\x50\x71\x13\xd6\xaf\x0a\x80\xec\x7d\xbd\xca\xd7\x2e\x62\x19\x0a\xa0\x64\x09\xeb\xd5\x55\x01\xf0\x7a\x38\xa4\xd5\x48\x30\xcf\x81\x56\x3e\x64\x75\x9a\x04\xff\x54\x16\xfe\x4b\xe5\xbe\x84\xdf\xcf\xe5\x7c\x0a\x87\x9a\x35\x87\xf0\xd6\xfe\xd1\xc5\x85\xda\x6a\x2d\x3a\x8f\x72\x50\xf5\xa0\xf0\x88\xee\x0d\x54\x58\x8b\xde\xe3\x64\x0f\x1a\xe1\xa1\xd2\x96\xcf\x30\x90\x29\xee\x5b\xa1\x92\x7f\x66\x3f\x7e\x99\xc9\x4a\xb8\xb1\x56\xa3\x30\x4f\x78\xed\x62\x33\x42\x19\xed\x52\x6f\x3a\xb7\x3b\x3f\x09\xcd\x2d\xef\xd9\x23\x88\x88\x7c\x15\x32\x03\x83\x31\x37\x52\x15\x8b\x2d\x70\x52\x23\x82\x39\x42\xbc\x90\xfc\x87\xef\xb0\xe6\x52\x95\x70\xd0\xb6\x12\x1a\xd6\x2b\x3e\x10\x88\x90\x5c\x31\xc5\xdf\x77\x51\xe1\xde\x3a\x7c\xde\x47\xc4\xcc\x9d\xfc\xa2\x34\x21\x6f\x80\xed\x48\x59\x13\x53\x90\xb2\xe1\x82\x48\x3e\x43\x67\xdb\x54\xf6\xc6\x60\xcd\xc0\x18\xf8\x3e\x98\xdf\x9c\xca\x38\x33\x22\x9b\xbf\xa4\x60\xaf\x9c\x27\x30\xa3\x12\x9e\x05\x83\x96\xcc\xe6\x3c\x95\xb0\x36\x74\x89\x41\x8b\x17\x09\xb4\x98\xd9\xff\xe6\xe4\xbf\x50\x67\x9d\x9c\x88\x0b\x5c\xb1\xc2\xc3\x7a\x39\xa0\xcf\x5b\xf1\xd0\x78\xf3\xcd\x8c\x05\xcb\xfd\x97\x63\x04\x94\x2a\xf5\x60\x5e\xde\x4a\x75\xde\x86\x9f\x63\x63\x9e\xe1\xae\x83\x08\xea\x87\x75\xe6\x19\xbb\xf9\xfb\xed\xf6\x1e\x3a\x41\x4d\xba\xdf\x63\xc5\xb0\xa1\x4b\x03\xf9\x5e\x50\x53\xc2\x6e\xb3\x4e\x01\xb0\xa5\xe7\x01\xfe\xe2\x88\x0a\x28\xbe\x59\xbd\x3c\xfd\xd3\xb1\xc4\x79\x48\x8d\x2b\xe4\x25\x7d\xce\x92\x32\xa8\xd9\x6d\xee\x2e\x8a\xe9\x9d\x9e\x6a\x58\x0a\x33\x6d\x34\x32\x3c\x73\xa2\x51\x1c\x89\xec\x2a\xfe\xb9\x14\x26\xbe\x82\xe6\xcd\x62\xc6\x11\x03\x7b\x92\x23\xbe\x64\xe6\x1c\x2b\x25\xa7\x1c\xe9\xfd\x32\x0f\x3e\x92\xac\x94\x5c\xa4\x67\xcc\x40\xf2\xb5\x28\xae\x61\x61\x00\xe5\x01\x21\xbc\xe3\x58\xfc\xf2\xd2\xcb\xee\x96\x21\x93\xd7\x5d\x58\xc7\xa7\xd3\x82\x07\xac\xb7\x2e\x64\x8e\x7b\xb0\x32\xd0\x89\x83\x32\x22\xdf\xf9\xf8\x7f\x6e\x12\x29\x78\x4e\xba\x22\x6c\x79\x4e\xb2\x08\x34\x32\xcf\x40\x8e\x87\xed\x8c\x95\x38\x0c\xbe\x14\xed\xa4\x9c\x5e\x08\x79\xac\xa4\x69\xe0\x93\xdd\x18\xfe\xda\x70\xc9\x87\x58\xf9\x9d\x25\x94\x3c\x17\xd0\x89\x03\x32\xae\x84\xfb\xf4\x95\x44\x2c\x86\x56\xcf\x31\x87\x39\x17\x3e\xc6\x01\xc7\x89\xfa\x34\x07\xb3\x30\x9f\x15\x4e\xa6\x61\x02\x72\x6a\xc8\x92\xd0\x50\xdb\xde\x04\x7a\xce\xd4\xd0\xa7\x1f\x77\x94\x80\x5c\x32\xb0\x84\xb5\xa1\xab\xe2\x6b\xf1\xd7\x00\x34\x41\x17\xed\xac\x0b\x00\x00")
 
 func typeCommentGqlBytes() ([]byte, error) {
 	return bindataRead(
@@ -3228,7 +3782,7 @@ func typeCommentGql() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "type/comment.gql", size: 2856, mode: os.FileMode(420), modTime: time.Unix(1546256427, 0)}
+	info := bindataFileInfo{name: "type/comment.gql", size: 2988, mode: os.FileMode(420), modTime: time.Unix(1792349592, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
@@ -3253,7 +3807,27 @@ func typeComment_draft_backupGql() (*asset, error) {
 	return a, nil
 }
 
-var _typeCourseGql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xe4\x57\xcd\x8e\xe3\x36\x0c\xbe\xfb\x29\xb8\x98\x4b\x0b\x14\xf3\x00\xbe\x14\xd9\x6c\xdb\x09\x30\x6d\x83\x4c\xe6\x54\xec\x41\xb1\xe8\x58\x85\x2d\x19\x12\x9d\x20\x28\xf6\xdd\x0b\x52\xf2\x4f\xec\x34\x9d\xe9\xa9\xed\x9e\x6c\x49\xd4\xc7\x1f\xf1\xa3\xa8\x07\xd8\x61\xeb\x31\xa0\xa5\x00\x0a\x0a\xd7\xf9\x80\x8f\x19\x5d\x5a\x84\xb5\x0c\xc0\x34\x6d\x8d\x0d\x0b\x64\x00\xab\xb6\xad\x51\x1d\x6a\xfc\x2e\x03\x58\x7b\x54\x34\x8c\x7e\x71\x5a\xbe\xdb\xee\x50\x9b\x50\xf5\xd3\x2f\xa8\x7c\x31\x8e\xa8\xd3\x97\x5e\x72\xef\x5a\x53\xf4\x2b\xaf\xd6\x94\xce\x37\x3b\x0c\xae\xf3\x05\x3e\xbb\x42\x11\xaf\x65\x7f\x64\x00\x0f\xb0\xd1\x68\xc9\x94\x06\x03\x9c\x2b\xb4\x40\x15\x26\x6b\xe1\xac\x02\x28\x7d\x52\xb6\x40\x0d\x8a\x1e\x33\x18\x86\x2b\xca\x61\x6f\x1a\xcc\xde\x82\x51\x38\xf6\x94\x06\x90\x61\x7c\x8d\xb2\x43\xea\xbc\xe5\x68\xd5\x26\x10\x74\x01\x3d\xe3\x39\xa8\xd4\x09\x41\x71\x80\x34\x50\x65\x42\xfc\x67\x1f\xc4\x24\x1e\xfc\x64\x4e\xe8\xc3\x37\x19\xc0\x14\x89\xed\xc0\x14\x63\x30\xd1\x2e\xc1\xa6\x4a\x11\x9b\x81\xa0\x4a\x42\x2f\x0b\xa1\xc5\x82\x7d\xd0\x70\xac\xdd\x41\xd5\xb0\xf9\xf4\x28\x78\x22\x92\xc3\x0b\x79\x63\x8f\xd9\xfb\x55\x1c\xb0\x74\x1e\xef\xeb\x88\x32\xf7\x94\x94\xc6\x07\x02\x3b\x2a\xe3\x43\x1d\xd4\x45\x14\x91\xc9\x61\x63\xe9\x16\x42\xad\xfe\x16\x80\x45\xae\xf6\xff\xea\x35\x7a\x96\x84\xc2\x59\x8b\x05\x19\x67\xa3\xa8\xe3\x95\x8f\x97\x3c\x66\xae\xc4\x5f\x84\x33\x80\x6f\xa7\x93\xeb\x61\xdf\x87\x45\xb2\xb0\x72\xad\x08\x41\x59\x0d\x64\x1a\x1c\xd3\xc7\x1d\x7e\xc7\x82\x62\xfa\x08\x1b\xb4\x64\x4e\xfc\xed\xf3\x26\x21\xee\x19\x06\x43\xe1\x4d\xcb\x7a\xc0\x95\x93\x0c\xe4\x6d\x93\xc5\x3e\xc4\x6f\xd9\x0a\x1e\xad\x46\xcf\x59\xe7\xe0\x69\xff\xf3\xf3\x0c\x8b\xa7\x72\x59\x10\x34\xa3\x73\xd8\x7c\xea\xbd\x0c\x31\x55\x13\x52\x1b\xb9\x8b\xfa\x7b\x16\x0c\xdb\x7e\x98\xc3\x47\xe7\x6a\x54\xf6\xee\x36\x4e\xf5\xab\x8d\x3c\x31\xdf\x3a\xd2\x27\x18\x7b\xac\x11\x6a\x0c\xc1\x59\x28\xbd\x8b\xc7\x5c\x74\xde\xa3\xa5\x1e\xfb\x70\x01\xdb\x35\x07\xf4\xec\x55\x94\xed\xf9\xc3\x51\x89\x6b\x72\xf4\x92\x23\x11\x8c\x1c\x1c\x38\x2e\xac\x2a\x1e\x09\x24\x49\x49\x9b\x0f\x00\xf1\xfc\x9f\x45\xfc\x26\xaf\x5d\x99\xc0\xc2\x5f\x99\x36\x1a\xf4\xbf\x63\xf4\x8f\xa6\x26\xe4\x09\x70\x92\x42\xc2\xc2\x21\x1e\x7d\x5c\x27\x81\x99\xb1\xae\x94\xfd\x4c\xbb\x18\xe1\x88\x17\xfe\x65\x05\xe3\x9f\xfb\x37\x54\x95\xe8\xde\xa4\xa2\xc4\x89\x45\x35\x91\x54\x55\x0d\x2e\x49\xcf\xb3\x33\xb6\xcf\x4a\x4f\x22\xc2\xc8\x82\x69\x26\x8f\xf0\xee\x6c\xd1\x2f\xf1\x65\x3a\x87\xd7\x80\x7e\x09\x7f\xeb\x1a\x1c\x6a\x40\xba\x06\x87\xf1\xf5\x35\xc8\x2a\x9f\xf6\xfb\x2d\xb4\x8a\xaa\xc4\xbf\xa1\x22\xf0\x3e\x9f\x6e\xf2\xad\xa2\x2a\x87\xd7\xdd\x66\x62\x6b\x20\x45\x5d\x58\x1a\x1b\xe7\xf3\xd4\x7a\xbc\xc8\xe8\x6a\x5b\xa7\x2f\xa0\x42\x70\x85\xe1\x02\x0b\x67\x43\xd5\x5c\xaf\x08\xe5\xb1\xd3\x58\xd4\x9c\x9e\xda\xc4\xad\xc7\x5d\x66\x47\x89\xaf\x83\xd8\x29\x1a\xef\xe1\xb5\xf4\x6e\xff\x0d\x5a\xbf\xd9\xbb\x81\xd5\xe2\xdc\x84\xd4\x32\xbe\xd7\x21\xdc\xe2\x91\xd8\xdf\xb5\xba\x6f\x0a\xd2\xef\x8d\xa6\x40\x68\xf4\xba\x7b\xbe\xc5\xa2\xce\xd7\x53\xf2\xac\x55\xd4\x73\x32\x78\x46\x0f\x4a\x37\x92\x21\x26\xa4\x4e\x84\xaf\xdf\xb8\xb6\x56\x76\xc5\xab\xf3\xfb\x77\x8e\xc0\x1d\xd0\x8d\x76\x75\x04\xe1\xd9\x39\xc8\x93\x0a\x0b\x90\x79\xd3\x3b\x9a\xf2\xa4\x82\xa0\x4c\xdb\x88\x2f\x59\xf6\x00\x2b\x0b\xa8\x8f\x08\xf2\xdc\x60\xe7\xd7\xcb\xf7\xc7\x0f\x2c\x30\xbe\x41\x40\xc6\xf1\x4d\xb0\x62\xda\x06\x17\x6f\xff\x8e\x9f\x2a\x16\x5a\x75\x34\x56\xf5\x67\x1a\xd7\x6f\x34\x53\x86\xb0\x01\x45\x91\x6c\x56\xf7\x95\x88\xad\x91\x0a\xeb\x34\xf6\x55\x28\x99\x3a\xc9\x96\xbb\xf6\x8e\x69\x32\xb5\x7a\x32\x9b\xde\x33\x96\xf3\x59\x45\x38\x07\xca\xe8\xa5\xf5\xad\x3a\x22\xcb\xe5\xb0\x4d\x7f\xc9\x83\xd5\x50\xc7\xd8\xe0\xc0\xb2\xf2\x93\xc3\x6f\x63\xd0\x3e\xcf\x65\xd9\xa9\xd0\x7b\x37\xca\x7e\x1e\xa3\x42\x8e\x54\xcd\xc9\x67\x65\x03\x07\x69\x28\x41\xd7\x64\x11\xc9\x35\x0b\xa6\x6b\xe8\x4b\xf6\x67\x00\x00\x00\xff\xff\x75\xf0\x9e\xc3\x4b\x0e\x00\x00")
+var _typeContent_reportGql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x9c\x53\xb1\x6e\xdb\x30\x10\xdd\xf9\x15\x2f\xf0\xd0\xa5\xc8\x07\x68\x33\xdc\x0e\x5e\x8a\x22\x49\xd1\xa1\xe8\x40\x8b\x27\x8b\xad\x74\x14\x78\xa7\xba\x41\x91\x7f\x2f\x8e\x92\x62\xd9\xce\x94\x8d\x3a\xdd\x7b\xf7\xde\xe3\x71\x83\x2d\x32\x0d\x29\x2b\x52\x83\x3a\xb1\x12\x2b\xb4\xf5\x8a\x43\x26\xff\x5b\xa0\x2d\x21\x8f\x1d\xc9\xbd\xd3\xe7\x81\xb0\x9b\x7a\x1e\x26\xd0\x3f\x07\x6c\xb0\x0f\xc4\x1a\x9b\x48\x53\x7b\xf0\x4a\xf0\x1c\xa0\xb1\x27\x9c\x5a\xe2\x52\x9e\xe7\x9c\xbc\xa0\xce\xe4\x95\xc2\xbd\xc3\x72\xdc\x6a\x85\xa7\xd8\xd3\x9d\x73\x40\x0c\x15\xf6\x9f\xca\x71\x83\xa7\x57\x28\xe5\x0f\x02\xfa\x3b\x74\x9e\xbd\xc6\xc4\x26\xf9\x4c\x6c\x64\x9c\x94\x2a\x3c\x6a\x8e\x7c\x9c\xd0\xdf\xdb\xe7\x32\x7c\xb1\x66\xd3\x67\xb6\x32\x3e\x93\x97\xc4\xd5\xa5\xad\x87\x52\x5c\xcd\x1f\x85\x32\x4e\x6d\x7a\x85\xae\x39\x27\x1a\xc3\x51\xae\xf0\x4d\x28\xbb\xf7\xa5\x92\x49\x52\xf7\x87\x02\x52\x46\x88\xd2\x47\x91\x45\xe4\xf4\x63\x09\xe9\x2c\x4c\xd4\x58\x6f\x72\xb0\xf2\x28\x57\xae\x1e\x4b\xf1\x36\xd5\xb0\x18\xf9\x68\x83\x79\xec\x3a\xc4\x06\x51\xd1\x7a\xc1\x81\x88\x11\xa8\xa3\x39\x2f\x19\x0f\xbf\xa8\xd6\x0a\x5f\x52\x20\xf7\xe2\xdc\x06\x5b\x06\x85\x23\x21\x32\xbc\x51\x31\xd5\x76\x3b\x6f\xed\xcb\xe7\xd2\xd7\x0f\x1d\xf5\xc4\x2a\x28\xdf\xd3\x0e\x6d\x51\x8f\x59\x52\x46\x93\x32\x46\x29\x74\x83\x3f\xc6\xe9\xaa\x2d\x85\xe9\xff\x72\xbd\x2b\x1f\x51\xa9\x87\xb7\xb5\x25\x10\x87\x25\x0e\x13\x65\x38\x4e\x81\xae\xa2\x98\x85\xaf\xd4\xa2\x88\xb5\xd9\x17\x8d\x6f\x99\xd8\x9d\x41\x2b\x2b\xab\xea\xfc\x28\xb8\x49\xb9\x2f\xea\xa1\x09\x3e\x86\x5b\x4b\x83\x3f\xd2\x9e\x9b\x54\xe1\xeb\x7c\x9a\x6d\x6d\xd1\x45\x29\x6f\xd2\x5c\x88\xf5\x96\x43\x85\x1f\x17\x5a\x2c\xc0\x9f\xd7\x10\x33\x2c\x8b\xf3\x1b\xc8\xdc\x6e\xdb\xa3\x49\x7d\x87\x3a\x8d\x5c\x46\x59\x8e\x62\x22\xe7\xe5\x9e\xfd\x18\x53\xe9\xdc\x59\x63\x85\x3d\xeb\x9d\x7b\x71\xff\x07\x00\xc3\xce\x3b\x9a\x3b\x04\x00\x00")
+
+func typeContent_reportGqlBytes() ([]byte, error) {
+	return bindataRead(
+		_typeContent_reportGql,
+		"type/content_report.gql",
+	)
+}
+
+func typeContent_reportGql() (*asset, error) {
+	bytes, err := typeContent_reportGqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "type/content_report.gql", size: 1083, mode: os.FileMode(420), modTime: time.Unix(1792346543, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _typeCourseGql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xe4\x57\xcd\x6e\xe3\x36\x10\xbe\xeb\x29\x26\xc8\xa5\x05\x82\x7d\x00\x5d\x0a\xaf\xb7\x6d\x0c\x64\xdb\xc0\x71\x4e\x45\x0f\x63\x71\x64\xb1\x90\x48\x81\x1c\x25\x30\x8a\x7d\xf7\x62\x48\x4a\x62\x24\x37\xc9\xf6\xd4\x76\x4f\x36\xc9\x99\x6f\x7e\x38\xf3\x71\x74\x0d\x7b\xea\x1d\x79\x32\xec\x01\xa1\xb2\x83\xf3\xf4\xa1\xe0\x73\x4f\xb0\x0d\x0b\xd0\x5d\xdf\x52\x27\x02\x05\xc0\xa6\xef\x5b\xc2\x63\x4b\x37\x05\xc0\xd6\x11\xf2\xb4\xfa\xc5\xaa\xf0\x7b\x3f\x1c\x5b\xed\x9b\x71\xfb\x81\xd0\x55\xf3\x8a\x07\x75\x1e\x25\x0f\xb6\xd7\xd5\x78\xf2\x68\x74\x6d\x5d\xb7\x27\x6f\x07\x57\xd1\x9d\xad\x90\xe5\xac\xf8\xb3\x00\xb8\x86\x9d\x22\xc3\xba\xd6\xe4\xe1\xb9\x21\x03\xdc\x50\xf2\x16\x9e\xd1\x03\xaa\x27\x34\x15\x29\x40\xfe\x50\xc0\xb4\xdc\x70\x09\x07\xdd\x51\xf1\x1e\x8c\xca\x4a\xa4\x3c\x81\x4c\xeb\x97\x28\x7b\xe2\xc1\x19\xc9\x56\xab\x3d\xc3\xe0\xc9\x09\x9e\x85\x06\x9f\x08\x50\x12\xa4\x80\x1b\xed\xe3\x7f\x89\x21\xb8\x24\x8b\x9f\xf5\x13\x39\xff\x5d\x01\x90\x23\x49\x2c\x94\x72\x0c\x3a\xc6\x16\xb0\xb9\x41\x86\xca\x76\x04\x58\x33\xb9\x70\xe0\x7b\xaa\x24\x06\x05\xa7\xd6\x1e\xb1\x85\xdd\x27\x81\x87\x28\x52\xc2\x03\x3b\x6d\x4e\xc5\xd7\x9b\x38\x52\x6d\x1d\xbd\x6e\x23\xca\xbc\x66\xa4\xd6\xce\x33\x98\xd9\x98\x5c\xea\x64\x2e\xa2\x04\x99\x12\x76\x86\x2f\x21\xb4\xf8\x26\x40\x8b\x0b\xfd\x5f\x9d\x22\x07\xb5\x75\x50\x59\x63\xa8\x62\x6d\x4d\xb4\x65\xe5\xe4\xe3\xb9\x8c\x95\x1b\xf2\x1f\x84\x0b\x80\xef\xf3\xcd\xed\xa4\x77\xb5\x2a\x16\xf1\x4a\x21\x13\xa0\x51\xc0\xba\xa3\xb9\x7c\xec\xf1\x0f\xaa\x38\x94\x60\x15\xba\x41\x89\xd9\xf4\x77\xac\x9b\x84\x78\x10\x18\xf2\x95\xd3\xbd\xf8\x07\xb6\xce\x2a\x50\xd4\xb2\xc3\x31\xc5\xef\x51\x05\x47\x46\x91\x23\x05\x6c\xe1\xf6\xf0\xf9\x6e\x81\x25\x5b\x65\x38\x08\x68\x5a\x95\xb0\xfb\x94\x80\x77\x92\x73\xed\x47\xa4\x3e\xf6\x2e\xa9\x1f\x44\xd0\xa7\x56\x26\x55\xc2\x47\x6b\x5b\x42\xf3\xaa\x9a\x94\xfa\x0b\x45\xd9\x58\xaa\x8e\x57\x8d\xe0\xb5\x39\xb5\x04\x2d\x79\x6f\x0d\xd4\xce\x76\x31\x1f\x83\x73\x64\x78\xc4\x3e\x9e\xc1\x0c\xdd\x91\x9c\x44\x15\x65\xc7\xfe\x91\x84\xc6\xb3\x70\xf5\xdc\x4c\x60\x6c\xe1\x48\xe0\x82\xa9\x78\x25\x90\x24\x43\xd9\x5c\x01\xc4\xfb\xbf\x0b\x78\x17\xfb\xda\xd6\x09\xcc\xff\x9d\x6b\xb3\x43\xff\xbb\x8e\xfe\x49\xb7\x4c\x62\x15\x6c\x28\xa1\xd0\x85\x53\x3e\xc6\xbc\x66\x89\x59\x74\x5d\x1d\xf4\xa5\xed\x62\x86\x23\x9e\xff\x97\x11\xc6\x3f\x8f\x6f\x62\x95\x18\x5e\xc6\x28\x71\x63\xc5\x26\x59\xa9\x66\x85\xa5\xcd\x82\x01\xda\xa4\x3d\x98\xe8\x6e\xae\x8d\x1d\xad\x29\xc3\x60\x37\x5d\xde\x65\xe2\x4a\x6d\x34\xf7\x50\xde\x07\x33\xbc\x7d\x36\xe4\xd6\xf8\x61\xbb\x84\x47\x4f\xee\xea\x5d\x8f\xe8\xc4\x20\xe9\x11\x9d\xd6\x2f\x1f\x51\x31\x79\x7b\x38\xdc\x43\x8f\xdc\xa4\xee\x9d\xf8\x44\x0c\xbb\x34\x07\xdc\x23\x37\x25\x3c\xee\x77\x6f\x9b\xd7\x1e\x7c\xd5\x90\x1a\xc2\x03\x1c\x18\x60\xb2\x7e\x03\xba\x06\x7a\x8a\x19\x98\xa4\x12\x4d\xad\x5d\xf3\x8c\x3c\xf8\x75\x3a\xe2\x7e\x99\x46\xa3\x87\xb0\x4a\x8e\x45\xb5\x41\x9d\x01\xbd\xb7\x95\x96\xb7\x00\x9e\x35\x37\xcb\xc8\x82\x90\xdc\xd9\xa0\xce\x49\x77\x4d\x3d\x2c\xa3\xd1\xab\xcc\x13\x25\xbe\x0d\xe2\x49\xd9\xf8\x1a\xde\x09\xb3\xe5\x7f\x83\x76\xde\x1d\xdd\xc4\x3a\x21\xb8\x8c\x74\xc2\xfa\x22\xe7\x90\x67\xdd\x85\x52\x0c\x73\x0b\x5b\x70\x84\x0a\xb0\x6d\xb3\xe7\x72\xba\xc6\x58\xa4\x37\xb2\xec\xb4\x19\x98\xbc\xc4\xc1\x96\xb1\xdd\x13\x2a\x6d\x4e\xd2\x26\x9f\xe3\x51\xce\x20\x6f\x90\x82\xe4\x01\x86\x5e\x8d\xf3\x51\xfa\x3b\xf6\x5d\xe6\x6f\xe0\x84\xc7\xfd\xdd\x25\x4a\x18\x5c\x9b\x33\xc1\x16\xa3\x9d\x27\x4d\xcf\xe4\x00\x55\x17\xa2\xd0\x3e\x0d\x65\x32\x89\xc4\xb3\x2d\x9a\x8d\x9c\x2e\x47\x91\x25\x82\x4c\x88\x17\x26\xf7\x19\xa4\xef\xd7\xf3\xcc\x2d\xfa\x15\xc8\x72\xfe\x9f\x5d\xb9\x45\x1f\x66\xce\x7c\xa2\xfa\x52\x14\xd7\xb0\x31\x40\xea\x44\x10\xbe\xbc\x24\xf8\xed\xfa\x53\xec\x47\x75\xca\x3f\xc7\x20\xac\xe3\xe7\xd1\x46\x18\xc2\xdb\x38\x03\x0f\x9e\xe4\x0a\x7b\x3c\x69\x83\x63\xf9\xc4\xf3\xb1\xdf\x92\xf3\x42\x5a\x9a\xa9\x03\x94\xf6\x25\x20\xa3\x46\xd2\x13\x6f\x44\xcf\x58\x45\x23\xe1\x25\x57\xb3\xc2\x7c\xd5\xdf\xb9\x22\x73\xaf\xb3\xdd\xf4\x69\x67\xa4\xf7\x82\xa3\x32\xc1\xa2\x56\x6b\xef\x7b\x3c\xd1\xce\xd4\xb6\x84\xfb\xf4\x2f\x45\xb0\x99\x28\x53\x1c\x0e\xf5\x1a\xfe\x94\xf0\xdb\x9c\xb4\xdf\x97\xb2\x12\x94\x1f\xa3\x9b\x65\x93\x9c\x64\x25\x14\xbd\xd0\xad\x09\xe0\x92\xa4\xac\x4d\xc6\x08\xa6\xf6\xc8\x9f\xec\x2f\xc5\x5f\x03\x00\x3b\xf4\xc6\x1b\x56\x0f\x00\x00")
 
 func typeCourseGqlBytes() ([]byte, error) {
 	return bindataRead(
@@ -3268,7 +3842,7 @@ func typeCourseGql() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "type/course.gql", size: 3659, mode: os.FileMode(420), modTime: time.Unix(1543865804, 0)}
+	info := bindataFileInfo{name: "type/course.gql", size: 3926, mode: os.FileMode(420), modTime: time.Unix(1792345002, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
@@ -3573,7 +4147,7 @@ func typeDelete_user_asset_payloadGql() (*asset, error) {
 	return a, nil
 }
 
-var _typeDelete_viewer_account_payloadGql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x52\x56\x08\x4a\x2d\x29\x2d\xca\x53\x28\xa9\x2c\x48\x55\x48\xcb\x2f\x52\x70\x49\xcd\x49\x2d\x49\x0d\xcb\x4c\x2d\x4f\x2d\x72\x4c\x4e\xce\x2f\xcd\x2b\xd1\xe3\x02\xcb\x62\x91\x09\x48\xac\xcc\xc9\x4f\x4c\x51\xa8\xe6\x52\x50\x50\x56\x08\xc9\x48\x55\x48\x01\x2b\x4a\x51\x28\x03\x2b\x53\xc8\x4c\xd1\xe3\x52\x80\x09\x42\xb4\x7a\xa6\x58\x29\x78\xba\x28\x72\xd5\x72\x01\x02\x00\x00\xff\xff\x3f\x6d\x3b\xb5\x7d\x00\x00\x00")
+var _typeDelete_viewer_account_payloadGql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x6c\x8e\xcd\x4a\xc5\x30\x10\x85\xf7\x79\x8a\x73\xb9\xfb\x3e\xc0\xdd\x09\x45\xe8\x4e\xb4\xea\x3a\xcd\x1c\xc9\xc0\x34\x91\x90\x5a\x8a\xf8\xee\x62\x5a\x0b\x82\xcb\x39\x3f\xdf\x9c\x2b\x1e\x59\x97\x92\x50\xb7\x77\xe2\x2d\x17\xf4\x34\x56\xbe\x28\x57\x96\xbb\x10\xf2\x92\x6a\xe7\x9a\xfb\x8f\xf3\xe0\x37\xcb\x5e\xf0\xe9\x80\x2b\xc6\x48\x48\x0b\x09\x3e\x1a\x00\x2a\x9d\xc3\xaf\xb8\x57\x07\xb9\x61\xe8\x2f\xad\xf1\x1a\x99\x50\x23\xe1\xf7\x4f\x58\xd5\x0c\xd3\x89\xe9\xf0\x9c\xaa\xda\x4f\x64\xcf\x35\x5d\x73\xc2\xec\x37\x4c\x6c\x90\xe0\x53\xa0\x19\x05\xab\xd6\x78\x9c\x7f\x66\xf6\x47\xeb\xdc\xa2\x39\x3d\x85\x48\x59\x8c\x72\x9f\xcb\x0d\xa3\xce\xbc\xb8\x2f\xf7\x3d\x00\xf3\x8f\xf5\x7a\x10\x01\x00\x00")
 
 func typeDelete_viewer_account_payloadGqlBytes() ([]byte, error) {
 	return bindataRead(
@@ -3588,7 +4162,7 @@ func typeDelete_viewer_account_payloadGql() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "type/delete_viewer_account_payload.gql", size: 125, mode: os.FileMode(420), modTime: time.Unix(1543011322, 0)}
+	info := bindataFileInfo{name: "type/delete_viewer_account_payload.gql", size: 272, mode: os.FileMode(420), modTime: time.Unix(1792346171, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
@@ -3753,7 +4327,7 @@ func typeLabeled_eventGql() (*asset, error) {
 	return a, nil
 }
 
-var _typeLessonGql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xe4\x58\xcd\x6e\xdb\x38\x10\xbe\xeb\x29\x26\xe8\xa1\x2d\x90\x2d\xb0\x87\x5e\x74\x59\xa4\x71\x16\x35\xe0\x76\x83\xc4\x39\x2d\x7a\xa0\xc5\x91\xc5\xad\x44\x0a\x24\x65\xd7\x58\x14\xe8\x43\xf4\x09\xfb\x24\x8b\xe1\x9f\x64\x59\x76\xd6\xdd\xc3\x06\xed\x21\x88\x48\xcf\x7c\x9c\x19\xce\x37\x1c\xf2\x19\xdc\x61\xab\xd1\xa0\xb4\x06\x18\xd4\x68\x8c\x92\xaf\x32\xbb\x6b\x11\x16\x6e\x00\xa2\x69\x6b\x6c\x9c\x40\x06\x70\xad\x1a\xfa\x66\xab\x1a\x2f\x69\xa8\x91\x59\x8c\xa3\x1b\xa9\x55\x5d\xc7\xd1\x82\xad\x30\x0d\xde\x2b\x1e\xfe\x5b\x51\x8a\x82\x59\xa1\xe4\x7d\xb7\xfa\x0b\x0b\x4b\xd3\xb7\xdd\xaa\x16\xa6\x8a\xd2\x77\x58\xa2\x46\x59\x60\x3f\x21\x59\x93\x46\xf7\xc8\x74\x91\x84\xef\x6d\xc7\x77\x11\xff\x41\x8a\x52\xe9\xe6\x0e\x8d\xea\x74\x81\x0b\x55\xb0\x64\xec\x43\xcb\x83\xb1\xd9\xdf\x19\x00\xf9\x6e\x3b\x2d\x9d\xe3\xc2\x58\x50\x25\xb0\xc2\x8a\x8d\xb0\x02\x0d\x30\x63\x54\x21\x98\x45\x0e\x5b\x61\x2b\xb0\x95\x30\x29\x40\x30\x90\x7c\x91\x01\x0c\xd1\x6c\x85\x80\x31\x64\x42\xba\xb1\xc3\xb7\x15\xb3\x50\xa8\x06\x81\x95\x16\xb5\xfb\xc1\xb4\x58\x88\x52\x20\x87\x75\xad\x56\xac\x86\xf9\xec\x95\xc3\x73\x22\x39\xdc\x5b\x2d\xe4\x3a\x3b\x7f\x89\x15\x96\x4a\xe3\xe9\x35\xbc\xcc\x78\x91\xdf\x45\x6d\x91\x26\x40\xb5\xb4\x4d\x06\x4a\xa5\x87\x91\xd1\xce\x0a\xe4\x50\x6a\xd5\xb8\x15\x0a\x25\x25\x16\x24\xec\x81\x4b\x07\xf1\x66\x97\xc3\x95\x57\xdb\x79\x50\x33\xe5\x48\x29\xb4\xb1\x20\x7b\x87\x68\x03\x93\x4b\x11\x50\x1b\x9b\xc3\x5c\xda\x29\x84\x9a\x3d\x0a\x40\x22\x7b\xfa\x7f\x68\xfe\x1f\x9d\x54\x84\x30\xf4\xd1\x41\x66\x00\x2f\xfb\xa9\xeb\xa4\x73\x91\xb9\x94\x5b\x56\x08\x9d\x41\x0d\xdb\x4a\x01\xeb\x6c\xa5\x34\xf2\xbd\xdc\x22\x96\xf9\x1f\x72\x78\x30\xa8\x7b\x3d\x2f\x00\x2b\xc5\x77\xc0\x0c\xbc\x63\xfa\x23\x57\x5b\x67\x0d\xcd\xc5\x7d\xbc\x98\xd6\xd0\x28\x39\xba\xc5\x14\xbc\x5d\xbe\x5b\x44\x35\xfa\xce\xdd\xcc\xbf\x50\xb4\xf8\xc9\x46\xc5\x25\x7e\xb2\xa3\x35\xe7\x1c\x25\xf1\x1b\xfd\xbe\x10\xdd\x80\x49\x0e\x56\x34\x08\xdb\x0a\x7d\xa6\x2a\x47\x7b\xd8\x32\x03\x85\xab\x1f\x9c\x20\xc3\xe7\x95\xcd\x61\x29\x1a\x0c\x88\x87\x14\x2d\x7c\x05\xf2\x1b\xe6\xf6\xa5\xd3\x1a\xa5\x1d\x70\x33\x8a\xfc\x68\xcc\x7c\xa2\xac\x49\x3b\x72\x06\x67\xc2\x39\x32\xa0\x4c\x98\x99\x64\x4c\xa1\x3a\x6d\x70\xa2\x24\xc7\x4c\xbd\x04\x51\x02\x93\x3b\xbf\xfb\x24\x4c\x80\xf4\x7f\x32\x31\xbd\xd2\x73\x03\xb2\x6b\x56\x44\x46\x61\x2b\x21\x41\xd8\xbd\xb2\xef\x81\x0e\xa1\xdf\x3b\xa5\x14\x97\x60\x62\xc8\x42\xae\x59\xe9\xf3\xb4\x62\x72\x8d\x7d\x9a\x9e\x60\xaf\xd3\x19\x51\xa9\x4f\x7c\x23\xe4\xba\xc6\x00\xbc\x62\xc5\xc7\xae\x3d\x92\xfb\xb0\xda\x81\xe0\xf0\xe2\xd7\x5f\x5e\xbf\x7c\x35\xe2\xf2\x73\x13\x10\x84\x71\x20\xc8\xa1\x6b\xbf\x7d\xf9\xea\x13\x9e\x49\xe8\xdc\xe9\xf8\xed\xcb\x57\xdc\xa0\xde\x81\xdd\x2a\x68\x84\xec\x2c\x9a\x04\xa5\x11\x18\xfd\x59\x68\x94\xb1\xf0\x3a\x58\x63\x92\x0f\x6f\xdc\x38\xd2\x8e\x16\x9f\xcf\x28\x16\x64\xaa\x97\xf5\x69\x20\x78\x0e\xf3\xd9\x85\xdf\x78\xdf\x67\xcc\x7a\xfd\x63\xcc\x1f\x46\xe0\x14\xfd\xff\x27\xb7\x4d\x0e\x7f\x1e\xf8\xf2\x61\xba\x8e\xd1\x11\x60\xdc\x19\x50\xb1\x0d\x02\xba\xc6\x09\xb9\xaf\x18\xc2\x84\x09\x6a\x55\x68\x91\xf0\xf3\xcf\xd2\x6a\xf8\xe0\x9c\xd3\x65\xd0\x39\xf9\x34\x3b\x8c\x50\x20\x8f\x54\xc1\x9b\xb0\xb1\x83\x32\x18\xa7\x0e\xea\xe0\xdc\x5b\xb2\x11\xb8\x45\x0d\x5c\x98\x46\x18\x83\xfc\x32\x25\xcf\x25\x28\x0d\x62\x2d\x95\x0b\xe8\xd1\x34\x22\x07\xee\x2d\xb3\x9d\x89\x8b\xf5\x33\x6e\xa9\x48\xce\x7e\xd5\xd4\x9c\x40\xcb\xb4\xef\x93\x43\x29\xfc\x8d\xe4\x8d\xaf\xb4\x3e\xf7\x73\x78\xa3\x54\x8d\x4c\x1e\x01\xf0\x8d\x3e\x72\xaf\x79\x1b\x87\x63\xb5\x43\xf6\xd7\x74\x9d\x38\x45\x7b\x2f\xf0\x73\x50\x24\x04\xe3\x1c\x8e\xb8\xeb\xd8\xd3\x24\xc9\x77\x7b\x97\x88\xe4\x9c\x1b\xb0\xc8\x8d\x0f\x29\xd4\x77\x01\xa9\x19\x0d\x79\xe9\x0f\x09\x6a\x49\x9d\x17\xc8\x45\x68\x4b\x69\x78\xe3\x46\xa3\xce\x94\xce\x18\x89\x9f\xd2\xd9\x1b\x7a\x88\x41\xb2\x3f\x37\x13\x2d\x04\xa9\x44\xa6\xf8\xff\x27\x3a\x94\xd0\x9f\x38\xbd\xbe\xe9\x18\x18\xd0\x6a\xdc\x08\xd5\x99\x33\x8d\x88\x6a\x8f\x18\x32\x0e\x52\x0a\x4f\x62\xb1\x03\x8b\x83\x18\xa0\xde\x3c\x43\x57\xf3\xc7\x6e\xd2\x4e\x28\xf7\xd7\xf8\xa3\xec\xc7\x8d\x7f\xa2\x38\xda\x01\x12\x12\x5d\x33\x6a\x21\xf1\x47\xab\x01\x4f\x94\xa6\x61\x4f\xce\xa0\xe9\xcd\x66\xbf\xe7\xf7\x79\xb7\x0c\xdb\x36\xd9\xfa\x5b\x61\x6b\x8c\x3d\xe4\x70\xab\x6d\x8d\x13\x37\xde\xb7\xcb\xe5\x2d\xb4\xcc\x56\xe1\xa0\xd8\xcb\x34\x1d\x5e\x85\x6e\x99\xad\x72\x78\xb8\x9b\x7f\xef\xb5\xd5\x85\xd0\x77\x8e\x8e\x02\xe1\x73\xa2\x42\x38\x7b\x1e\xee\x16\x53\xe6\x74\xba\x1e\x5a\x71\xcd\xe4\xf0\x84\xf7\xa7\xf5\x91\x8e\xd0\xcb\x5c\x33\xe9\x4f\xf0\xf1\xe9\x39\x82\xf2\xe6\x8d\x97\x4f\x18\xfe\x5d\x6c\x8c\x31\x13\x7c\x88\xe1\x9f\x24\xa6\x31\x66\x82\x5f\x85\x17\x8b\x7d\x8c\x65\xd2\xa7\x32\x14\x4e\xec\xad\xd2\x1f\x29\x95\x7c\xc9\x0d\x97\xc7\x1e\xec\x3d\x6e\xc3\x55\x30\xdd\x09\x2f\xb2\xcf\x59\xf6\x0c\xae\x24\x20\x5f\x23\xb8\xd7\x49\x8a\xe7\xe2\xf0\xb9\xf2\x86\x04\x06\x4f\x96\x6e\xec\x5f\xfb\xae\xc8\x02\xa3\x74\xec\x31\x29\xb4\x2d\x5b\x0b\xc9\x62\xa6\xfa\xdf\x27\xb2\x4a\x58\x6c\xa8\xff\x77\x2c\x97\x3c\xa6\x23\x59\xe3\x6a\xb3\xe2\x98\x8a\xa8\x37\x75\xc0\x81\x93\xf6\xf6\x39\x3f\xb4\x7a\x30\xeb\x6d\x9f\x4b\x62\x29\xf3\x70\x0a\x98\xe0\x87\xd6\xb7\x6c\x8d\x24\x97\xc3\x6d\xf8\x0a\x1e\x5c\xf5\x55\x94\xaf\xfd\xbd\xc6\x7d\xa4\xfb\x0a\x05\xe9\xc3\x58\x96\x9c\x32\xd1\xbb\x5e\xf6\xc3\x80\x9a\xca\xb2\x9a\x4e\x17\xe9\x14\x28\x48\xa9\xf6\xed\x97\x00\x27\x79\x4d\x82\xe1\x00\xfb\x9c\xfd\x13\x00\x00\xff\xff\xa9\x17\x00\x25\x7a\x16\x00\x00")
+var _typeLessonGql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x59\xcd\x6e\x1b\x39\x12\xbe\xeb\x29\xca\xc8\x21\x09\xe0\x0d\xb0\x87\x5c\x74\x59\x38\xb2\x17\x11\xe0\x24\x86\x2d\x9f\x16\x39\x50\xcd\x6a\x35\x37\xdd\x64\x0f\x59\x2d\x45\x18\x0c\x90\x87\xc8\x13\xe6\x49\x06\xc5\xbf\x6e\x49\x2d\xc7\xc2\x1c\x26\x89\xe7\x60\x58\xa4\x8a\x1f\xab\x8a\xf5\x55\x15\xa9\x67\x70\x8b\xad\x45\x87\x9a\x1c\x08\xa8\xd1\x39\xa3\x5f\x4d\x68\xdb\x22\x5c\xfb\x01\xa8\xa6\xad\xb1\xf1\x02\x13\x80\x99\x69\xf8\xb3\x58\xd6\x78\xce\x43\x8b\x82\x30\x8d\xae\xb4\x35\x75\x9d\x46\xd7\x62\x89\x79\xf0\xde\xc8\xf8\x9f\x54\xa9\x0a\x41\xca\xe8\xbb\x6e\xf9\x7f\x2c\x88\xa7\x6f\xba\x65\xad\x5c\x95\xa4\x6f\xb1\x44\x8b\xba\xc8\xc8\xb7\xa8\x45\x93\x47\x77\x28\x6c\x91\x85\xef\xa8\x93\xdb\x84\x7f\xaf\x55\x69\x6c\x73\x8b\xce\x74\xb6\xc0\x6b\x53\x88\xac\xec\x7d\x2b\xa3\xb2\x93\xdf\x27\x00\x6c\x3b\x75\x56\x7b\xc3\x95\x23\x30\x25\x88\x82\xd4\x5a\x91\x42\x07\xc2\x39\x53\x28\x41\x28\x61\xa3\xa8\x02\xaa\x94\xcb\x0e\x82\x81\xe4\x8b\x09\xc0\x10\x8d\x2a\x04\x4c\x2e\x53\xda\x8f\x3d\x3e\x55\x82\xa0\x30\x0d\x82\x28\x09\xad\xff\xc2\xb5\x58\xa8\x52\xa1\x84\x55\x6d\x96\xa2\x86\xf9\xe5\x2b\x8f\xe7\x45\xa6\x70\x47\x56\xe9\xd5\xe4\xf4\x2d\x96\x58\x1a\x8b\x0f\xef\x11\x64\xf6\x37\xf9\xaf\xaa\x09\x79\x02\x4c\xcb\xc7\xe4\xa0\x34\x76\xe8\x19\xeb\xb5\x40\x09\xa5\x35\x8d\xdf\xa1\x30\x5a\x63\xc1\xc2\x01\xb8\xf4\x10\x6f\xb6\x53\xb8\x08\xcb\xb6\x01\xd4\x8d\x19\x52\x2a\xeb\x08\x74\x6f\x10\x1f\x60\x36\x29\x01\x5a\x47\x53\x98\x6b\x1a\x43\xa8\xc5\x77\x01\x6a\xb1\xb7\xfe\x83\x95\x7f\xd1\x48\x63\xe5\xae\x8d\x1e\x72\x02\xf0\xb2\x9f\x9a\xe5\x35\x67\xac\xf8\x33\x58\x54\x08\x9d\x43\x0b\x9b\xca\x80\xe8\xa8\x32\x16\xe5\x4e\x6c\xc1\x04\xe2\x17\x53\xb8\x77\x68\xfb\x75\x41\x00\x96\x46\x6e\x41\x38\x78\x27\xec\x27\x69\x36\x5e\x1b\x9e\x4b\xe7\x78\x36\xbe\xc2\xa2\x96\xe8\x37\x33\xf0\x76\xf1\xee\x3a\x2d\xe3\xcf\x53\x3f\xf3\x88\x85\x84\x9f\x29\x2d\x5c\xe0\x67\xda\xdb\x73\x2e\x51\x33\xbf\x31\x9c\x0b\xd3\x0d\x84\x96\x40\xaa\x41\xd8\x54\x18\xc8\x60\x3c\xed\x61\x23\x1c\x14\x3e\x7f\x48\x86\x8c\x1f\x2f\x68\x0a\x0b\xd5\x60\x44\x3c\xa4\x68\x11\x32\x50\x38\x30\x86\x2b\x3a\x6b\x51\x53\xd4\xd9\x43\x45\x91\x5f\x8d\x99\x3f\x28\x6b\xf2\x89\x9c\xc0\x99\x58\x47\x06\x94\x89\x33\xa3\x8c\x29\x4c\x67\x1d\x8e\xa4\xe4\x14\xa9\xe7\xa0\x4a\x10\x7a\xcb\xbb\x04\xe1\x29\xcc\xfc\xff\xd1\xc0\x0c\x8b\x9e\x3b\xd0\x5d\xb3\x64\x32\x2a\xaa\x94\x06\x45\x3b\x69\x3f\x00\x1d\x42\xbf\xf7\x8b\xb2\x5f\xc6\x82\x34\x6c\xc0\x7e\x16\x04\x16\x7f\xeb\x94\xc5\x91\x60\x65\x1a\x0b\x68\x2d\x7a\x11\xa7\x08\x79\x17\x89\x2d\x53\x4e\xd3\x13\x29\x2d\xc9\x59\xa7\xd4\x95\xd0\x9c\xfc\x1c\x55\xe5\xf1\xf6\x65\x7a\x04\xf3\x06\xec\x08\x13\xe3\xe4\x88\x21\x25\xad\x28\x7d\x13\x53\x54\x42\xaf\xb0\x4f\x90\x0f\xd4\x0d\xbf\x26\x9d\x52\xc4\x4c\x3e\x10\xe0\x94\x5e\xd5\x18\x84\x60\x29\x8a\x4f\x5d\x7b\x24\xeb\xc2\x72\x0b\x4a\xc2\x8b\x7f\xff\xeb\xf5\xcb\x57\x59\xb3\x4c\xb3\x80\xa0\x9c\x07\x41\x09\x5d\xfb\xed\xcb\xd7\x10\xa9\x42\x43\xe7\xfb\xb2\x6f\x5f\xbe\xe2\x1a\xed\x16\x68\x63\xa0\x51\xba\x23\x74\x19\xca\x22\x08\xfe\x23\x68\x8c\x23\x78\x1d\xb5\x71\xd9\x86\x37\x7e\x9c\xf8\xc2\x9b\xcf\x2f\xd9\x17\x6c\x7f\x90\x65\x51\x00\x25\xa7\x30\xbf\x3c\x1b\x3a\xf5\xb2\x5f\x7f\x8c\xce\x43\x0f\x3c\x54\x78\xfe\x26\xb3\xdd\x14\xfe\x77\x60\xcb\xc7\x83\xe3\xf4\xd6\x70\xf3\xe1\x7c\xf7\x51\x89\x35\x02\xfa\x96\x1d\x65\xa0\xba\x72\x71\x82\x5b\x66\xde\x24\x7e\xfd\x54\x9a\xdc\xe0\x9c\x53\xf2\x10\x77\x68\x3f\x66\x16\x8a\xa5\xf9\x48\x82\xb9\x8a\x07\x3b\x48\x31\x69\xea\x20\xc9\xcc\xc3\x71\xaf\x15\x6e\xd0\x82\x54\xae\x51\xce\xa1\x3c\x4f\xd1\x21\xcf\xc1\x58\x50\x2b\x6d\xd8\xc3\xc7\xc3\x88\x3d\x70\x47\x82\x3a\x97\x36\xeb\x67\xfc\x56\x89\x9c\xfd\xae\xb9\x2d\x86\x56\x58\x9f\xdc\x44\x2c\xc2\xff\x61\x79\x17\x6a\x7c\x88\xfd\x29\xbc\x31\xa6\x46\xa1\x8f\x00\x84\x2b\x26\xca\xb0\x32\xde\x38\x51\xee\x2f\xdb\xe3\x8b\x29\xa1\xe6\x8b\xec\x43\xb4\x0f\x02\x4f\x83\x22\xd1\x19\xa7\x70\xc4\x3f\x04\xfc\x24\xa5\xfa\xb1\xd6\xf5\x95\x9a\x57\x0c\x0b\x35\x8f\x0f\x29\xd4\xf7\x9f\xf9\x1a\x14\xe3\xd2\xe7\x71\x7f\x19\x62\x15\x01\xa5\x8a\x17\x22\x1e\x5e\x49\x75\x78\x27\xe2\x1a\xa3\xf1\x73\x8a\xc0\xd4\xbd\x0e\x82\xfd\xb9\x1b\x69\x5e\x79\x49\x62\x4a\xf8\x7f\xa0\xdb\x40\xad\xd0\x19\xb3\xb1\xba\x6f\x77\xa3\x02\x23\x14\x89\x2d\xce\x21\x3f\x52\xf7\xcb\x9d\xf5\x4e\xa7\xeb\xab\xdc\xce\xc4\x13\x21\xd0\xa3\xbb\xc1\x7f\xba\xdd\x91\x6e\xb7\xb5\xb8\x56\xa6\x73\x27\x06\x7f\x5a\xf6\x1d\x02\xec\x93\x33\xd3\x32\x57\x0f\x0f\x96\x06\x89\x98\xbd\x7a\xe8\x48\x35\xfc\xa4\x11\xde\x3c\xc8\x80\x45\x21\x87\x90\xfc\xa6\x72\xce\xe1\x3c\x68\xf6\x58\x46\xe9\x15\x43\xbd\x0b\xb3\x43\xba\x3d\xa0\x5f\xee\x30\x5d\x51\xa1\xec\x6a\xde\xd7\xc0\x12\x7b\x15\xbd\x13\xb8\xc3\x64\xc5\xb3\x54\xac\x7e\x87\xfa\x3b\x7e\x4c\x1d\xb9\x68\x67\xef\x7a\x18\x16\xe2\x68\xef\xe4\x76\x70\x34\x7b\xc4\xe7\x87\x1f\x51\xd7\xfe\x56\x4d\x56\x68\xa7\x48\xad\x71\x37\x07\x78\x47\x08\x1d\x42\xc1\xf3\xda\xa3\x59\xe4\x8b\x25\x39\xaf\xf8\x76\x67\x89\xdf\xbf\x5b\xad\xd0\x11\x4a\x9f\x77\x73\xf3\x7b\x76\xa4\xe5\x35\x25\xe3\x68\x72\x23\x76\x25\x4f\x32\x2c\x9f\x58\xad\x34\xfe\x6a\x79\xe8\x07\x4d\x14\xf1\x4c\x4e\xc8\x13\x57\xeb\xdd\x27\xa3\x40\xe2\x45\x3c\xb6\xd1\x74\x41\x8a\x6a\x4c\x17\xc1\xe1\x51\x53\x9d\xfd\x34\x14\xe7\x8e\x95\xc5\x0b\xa3\xc9\xab\xb7\xb3\xd4\xdf\xa1\xcf\xb9\x92\x09\x20\x8b\x1e\x98\xc3\xbb\x0a\xfc\xf5\x5c\x26\x53\xe4\x88\x5c\x7c\x98\x5d\x69\xb2\xdb\x1c\x99\x4c\xb1\xb7\x8b\xc5\x0d\xb4\x82\xaa\xd8\x51\xee\x50\xcb\xc6\x1f\x2e\x6e\x04\x55\x53\xb8\xbf\x9d\x1f\xe6\x80\xc7\xbd\xac\xfa\x63\x0a\x57\x4c\xdf\x4d\xc4\x8f\x89\xf1\xfb\xfa\xdc\xdf\x5e\x8f\xa9\xd3\xd9\x7a\xa8\xc5\x4c\xe8\xe1\x55\x20\xb4\xf5\x47\xae\x8e\x41\x66\x26\x74\x68\xf5\xf7\xdb\xec\x3d\xa8\xa0\xde\xfe\xf6\x19\x23\xfc\x74\xb3\x8f\x71\xa9\xe4\x10\x23\xbc\x9a\x8f\x63\x5c\x2a\x79\x11\x1f\xd5\x77\x31\x16\x79\xfd\x73\x97\x33\xd8\xc6\xd8\x4f\x5c\xd7\x42\x7a\x8d\xef\x9b\x3d\xd8\x7b\xdc\xc4\xd7\xca\xfc\x6c\x39\x40\x8b\x6f\x8a\xa6\x84\x8d\xb1\xb2\xa7\x73\x1f\x41\x8c\xc4\xdf\xcd\x4c\xa7\x29\x66\xfa\x3f\x26\x93\x67\x70\xa1\x01\xe5\x0a\xc1\xff\xfe\xc6\xc7\x71\x7d\xf8\x83\xdc\x15\x0b\x0c\x7e\x94\xf3\xe3\xf0\x7b\xd6\x05\x1b\xe0\x8c\x4d\x77\x59\xde\xba\x15\x2b\xa5\x45\x22\x53\xf8\x7e\x24\xf0\x15\x61\xc3\xef\x0c\xec\x4e\xd4\x32\x31\x86\xb5\x61\x6d\xb5\x91\x98\x8b\x66\x50\x75\x40\xd3\x07\xf5\xed\x69\x39\xd4\x7a\x30\x1b\x74\x9f\x6b\x4e\x24\x5e\x51\x2e\x61\x42\xc9\x43\xed\x5b\xb1\x42\x96\x9b\xc2\x4d\xfc\x14\x2d\xb8\xe8\x13\xbd\x5c\x85\x67\x23\xd6\xbc\x7f\x17\x61\x27\x7d\xdc\x97\x65\xa3\x5c\xb2\xae\x97\x8d\x72\x1c\x18\x64\x48\xd4\xdc\x4a\x6b\x0f\xce\x4e\xca\xe7\xb9\x9b\xa5\xbc\xe4\xee\x79\xfe\x39\x00\x49\xb0\x3c\xb6\x5c\x1d\x00\x00")
 
 func typeLessonGqlBytes() ([]byte, error) {
 	return bindataRead(
@@ -3768,7 +4342,7 @@ func typeLessonGql() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "type/lesson.gql", size: 5754, mode: os.FileMode(420), modTime: time.Unix(1546447639, 0)}
+	info := bindataFileInfo{name: "type/lesson.gql", size: 7516, mode: os.FileMode(420), modTime: time.Unix(1792345549, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
@@ -3793,6 +4367,26 @@ func typeLesson_draft_backupGql() (*asset, error) {
 	return a, nil
 }
 
+var _typeLesson_graphGql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x64\x90\xb1\x6a\xc3\x30\x18\x84\x77\x3d\xc5\x85\x0c\x5d\x4a\x1e\x20\x5b\xa1\x50\x0a\x9d\x4a\xb6\xd2\x41\xf8\x3f\xdb\x82\x58\x76\xf5\xcb\x04\x53\xf2\xee\x45\xb6\x1a\x5b\xc9\x68\xf9\xf4\x7d\xa7\xdb\xe3\x93\x43\xa0\xd2\x47\x45\x6c\x89\x21\x30\xf0\x67\x74\xea\x22\xd1\x04\x3b\xb4\xe8\x6b\x58\x68\x1c\x65\x7a\x52\x9c\xa9\xda\x7b\x3d\x98\x38\x0d\xc4\xc7\xfc\xf5\x36\xc7\x7e\x0d\xb0\xc7\xe9\x9e\x41\x69\xa8\x89\x91\xe8\x33\xf0\x60\x00\xe1\x40\x2f\xf4\x95\xa3\x1e\xf1\xb5\x70\x5e\xff\x0f\xa7\xdd\xf7\xce\xdc\x70\x59\x59\x30\x9e\xe1\x7c\x75\x1e\xc5\xf9\xa6\xd0\x29\xea\xd0\x77\xe8\x63\xcb\x30\x77\x76\xd4\xe4\xcb\x8c\x9b\x2a\x09\xae\xc6\x14\xcf\xb7\x39\x85\x99\x16\x12\xda\xfa\x85\x94\x7f\x58\x85\x2d\x74\xc5\x0c\x6b\xfd\xbc\xc5\xbb\xd0\x47\x57\x3b\x2e\xd3\x8a\x8d\x84\xf5\x82\xe8\x3a\xe2\xd2\xd2\x3f\x2e\x7e\x49\x0a\x11\x4a\x2a\x5d\x05\xda\x48\x79\x89\x47\x9c\x5c\xc7\x87\x49\x36\x45\xef\x41\xeb\x9b\x8f\xb9\xdd\xe6\x76\x61\x5c\x52\x29\xbf\x3d\x5e\x6f\x5d\xcd\xdf\x00\xaf\x97\x36\x52\x24\x02\x00\x00")
+
+func typeLesson_graphGqlBytes() ([]byte, error) {
+	return bindataRead(
+		_typeLesson_graphGql,
+		"type/lesson_graph.gql",
+	)
+}
+
+func typeLesson_graphGql() (*asset, error) {
+	bytes, err := typeLesson_graphGqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "type/lesson_graph.gql", size: 548, mode: os.FileMode(420), modTime: time.Unix(1792345549, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
 var _typeLesson_timeline_eventGql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x94\x90\x31\x6b\xc3\x30\x10\x85\x77\xfd\x8a\x17\xb2\xe7\x07\x78\x33\x21\x43\xa0\x43\xa1\xd9\x4a\x06\x61\x9d\x55\x81\x7d\x67\xa4\x73\xa1\x94\xfc\xf7\x72\xb2\x8b\xa1\x4d\x86\x6c\x27\xee\xbd\x77\xfa\xde\x1e\x2d\x83\x42\x24\xe8\xd7\x44\xe8\x25\xe3\x85\x4a\x11\xbe\xa4\x91\x86\xc4\x74\xfa\x24\xd6\x83\xab\xdb\x3b\x9b\x93\x59\xbf\x1d\xb0\x47\x8b\x6e\xce\x45\x72\x0d\x99\x0b\x21\x31\x26\x1f\x13\x7b\x4d\xc2\x07\x87\x75\xdf\xe0\x4d\x73\xe2\xb8\x73\xd5\x76\xf9\x20\x90\x45\xc1\x2b\xd4\x1e\x1c\x20\xfd\x32\x86\x48\x66\x64\x09\xd4\xdc\x3b\xef\x6e\xce\xd5\xcb\xc2\x4c\x9d\xdd\x79\x9e\xe3\xb8\x79\x17\x90\x33\xf7\x92\x47\xbf\xa4\x09\x7c\x0a\xff\x51\x26\x1f\xc9\x74\x0d\x5e\xd7\x69\xc5\x69\x31\xa4\xa2\x06\x60\x9f\x2f\xa6\xad\x43\x83\xf7\x07\xf5\x5d\xff\x1a\x8d\xb6\xfc\x62\x3f\x30\x5e\xb7\xf2\x54\xd4\x0f\xe8\x64\xe6\xea\x4e\x4a\x63\xb1\x0f\x5b\x81\x5b\x2f\x96\x57\x95\x47\x13\x36\x38\xb3\xee\xdc\xcd\xfd\x04\x00\x00\xff\xff\x73\xce\x3a\xc4\x00\x02\x00\x00")
 
 func typeLesson_timeline_eventGqlBytes() ([]byte, error) {
@@ -3813,6 +4407,26 @@ func typeLesson_timeline_eventGql() (*asset, error) {
 	return a, nil
 }
 
+var _typeLesson_toc_entryGql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x5c\xcf\xc1\x4a\xc4\x30\x10\xc6\xf1\x7b\x9e\xe2\x5b\xf6\x2a\x0b\x5e\x3c\xf4\x2a\x82\xc2\x8a\xa0\xbd\x89\x87\x6c\xf3\xb5\x09\xc4\xc9\x92\xcc\x8a\x45\x7c\x77\x49\xa5\xb6\xf6\x98\xe4\x3f\x3f\x32\x7b\x3c\xf3\x9c\x59\x28\x5a\x60\xe1\x69\x5d\x90\x01\x41\xa0\x9e\x50\x7b\x8a\x44\xea\xd1\x25\xd1\x29\x49\x3d\x2c\x22\x4b\x49\x72\x30\x3a\x9e\x89\xe3\x74\x68\x9f\x6e\xef\x44\xf3\x88\x2f\x03\xec\xd1\x7a\xce\x56\x81\xb0\x28\x1d\x2e\xe2\x98\xa1\x3e\x94\xf9\xe9\x60\x80\xce\x87\xe8\x32\xa5\xc1\xeb\x7f\x69\xf7\xb6\x33\x7f\x56\xe4\x07\x63\xfd\x88\x2e\xf0\x15\xfa\x9c\xde\x71\x0d\x4d\xb8\xa9\xd4\x14\x35\x78\x10\x5d\x4d\x5a\xe9\x7c\xca\x9b\xd1\x79\xbf\xdf\x45\x70\x4a\x6e\xc4\x7d\xfb\x78\xac\x4a\x89\x97\xa1\xc1\x8b\xe6\x20\xc3\xca\x51\x7e\xea\x46\xa9\x75\xbd\x5e\xea\x6f\xf3\x33\x00\xbb\x62\xa9\xce\x50\x01\x00\x00")
+
+func typeLesson_toc_entryGqlBytes() ([]byte, error) {
+	return bindataRead(
+		_typeLesson_toc_entryGql,
+		"type/lesson_toc_entry.gql",
+	)
+}
+
+func typeLesson_toc_entryGql() (*asset, error) {
+	bytes, err := typeLesson_toc_entryGqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "type/lesson_toc_entry.gql", size: 336, mode: os.FileMode(420), modTime: time.Unix(1792344474, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
 var _typeLogin_user_payloadGql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x3c\xcc\x31\x0a\x02\x31\x10\x85\xe1\x7e\x4e\xf1\x60\xfb\x3d\x80\x9d\xbd\x85\xc8\x7a\x80\x98\x7d\x9a\xe0\x32\x23\xc9\xa4\x08\xe2\xdd\xc5\x08\x5b\x7e\x8f\xc7\x3f\xe1\x42\x6f\x45\xe1\xfd\x45\xdc\xad\xe0\x64\x8f\xac\xd7\xca\x32\xcb\xd8\x76\x9f\x43\xdf\x2c\xac\x78\x0b\x30\x61\x49\x84\xdb\x93\x8a\x56\xb9\xe2\xd6\xe1\x89\x88\x5b\xa6\xfa\xe8\x84\xe6\x89\xea\x39\x06\xcf\xa6\xb3\xe0\x7f\x3f\xe0\x18\x23\x6b\x5d\x7e\x90\x8f\x7c\x03\x00\x00\xff\xff\xec\x9b\xd1\x71\x81\x00\x00\x00")
 
 func typeLogin_user_payloadGqlBytes() ([]byte, error) {
@@ -3853,6 +4467,26 @@ func typeLogout_user_payloadGql() (*asset, error) {
 	return a, nil
 }
 
+var _typeModeration_actionGql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x84\x52\xcd\x8e\xd3\x30\x10\xbe\xe7\x29\xbe\xd5\x1e\xb8\xa0\x7d\x80\xdc\xaa\x85\x43\x0f\x20\x24\x96\x13\xe2\xe0\xc6\x93\xd6\x6c\x32\x13\xd9\x13\x95\x15\xda\x77\x47\x63\xbb\x9b\x90\x16\x71\xb3\xdb\xef\x3f\xbe\xc7\xd3\x89\x10\xa9\x93\xe8\x21\x3d\x1c\xc3\x75\x1a\x84\xf3\xc9\x8f\x81\xa1\x22\xcf\x10\x46\x27\xac\xc4\xfa\xd0\xe8\xcb\x44\xf8\x24\x9e\xa2\x33\xe4\xae\xe0\x7f\x37\x40\x51\xab\x02\xea\x9e\x89\x1f\x1a\xd4\x7b\x7b\x45\x79\x7a\x99\xe8\xae\x59\x68\xd9\xed\x7c\x92\xe2\xa8\x6f\x4a\x55\x43\x62\x8b\x6f\x89\x62\x61\xec\x3d\xb1\x86\x3e\x50\xca\x48\xef\x94\xe0\xd8\x43\xc3\x48\x38\x9f\x88\x57\x02\x38\xbb\xb4\xc4\xe9\x22\x39\x25\xbf\xd3\x16\x4f\x61\x2c\x09\x82\x6f\xb1\xff\xb0\x0d\xf3\x2e\x81\x7e\x4d\x83\xe3\x1c\xda\xe6\xf9\x3b\x14\x8b\x52\x8b\xaf\x1a\x03\x1f\x17\x6a\xdd\xe9\xa6\x3f\x84\xdf\x43\x22\x78\x1e\x06\x84\x1e\x41\x71\x72\x09\x07\x22\x86\xa7\x81\x94\xbc\x09\xa7\xf9\xf0\x93\x3a\x6d\xf1\x59\x3c\x2d\xca\xc1\x5f\x32\xfc\xc7\x63\xa5\xb1\xdf\x36\x9b\x13\x45\x44\x4a\x93\x70\x0a\x87\x81\xd0\x4b\x5c\x6b\x1a\xd7\x30\x75\xec\xd7\xa6\xb9\xc7\x8e\x41\xfe\x48\x08\x0c\x67\x38\xa6\xba\xc1\xcd\xa7\xf0\x31\x43\xc7\x69\xa0\x91\x58\x13\xf2\xbd\x3c\x8f\x1d\xba\x39\x26\x89\xd9\x74\x4e\x59\x71\x72\xc7\x50\x16\x36\xeb\xf2\xff\x65\xd5\x55\xee\xa0\x34\xc2\x95\xca\xc4\x6f\x4b\x58\x2e\xe3\xb1\x78\xba\x7e\x62\x35\xfe\x2a\x33\x72\x64\xb3\xdf\x62\xff\xd1\xe6\x71\xa1\xae\x3a\xad\x7e\x2d\xcd\xf6\xdc\x4b\x1c\x33\x0f\x2a\x70\xc1\x5f\x77\x9b\xdc\x91\x0c\xd7\xe2\x4b\x3d\xd5\x7e\x3b\x0c\x21\xa9\x55\xb2\x3a\xc9\xb0\xf9\xd0\xe2\xfb\x36\x8e\x8d\xf9\x63\xcb\xb2\xf2\xe9\xb2\xc2\x2d\x56\x65\xd8\xf7\x57\x51\x37\xa0\x93\x99\xb3\xa1\xcd\x9a\x2c\x6a\x7d\x02\xb5\x95\x89\x65\xe4\xa3\x01\x5b\xec\x59\xef\x9a\xd7\xe6\xcf\x00\x30\x61\x41\xf0\x2f\x04\x00\x00")
+
+func typeModeration_actionGqlBytes() ([]byte, error) {
+	return bindataRead(
+		_typeModeration_actionGql,
+		"type/moderation_action.gql",
+	)
+}
+
+func typeModeration_actionGql() (*asset, error) {
+	bytes, err := typeModeration_actionGqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "type/moderation_action.gql", size: 1071, mode: os.FileMode(420), modTime: time.Unix(1792346543, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
 var _typeMove_activity_asset_payloadGql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x64\x8c\x31\xae\x83\x30\x10\x05\xfb\x3d\xc5\x43\x14\xbf\xe3\x00\x74\x14\xbf\x8c\x14\x45\xc9\x01\x2c\x7b\x01\x4b\xc1\x1b\xd9\x1b\x10\x8a\x72\xf7\xc8\xc4\xd0\xa4\xdc\x79\xb3\x53\xe3\xc2\xfa\x8c\x01\xba\x3e\x18\xbd\x44\x9c\x64\xe6\xce\xaa\x9f\xbd\xae\x5d\x4a\xac\x0d\x6d\xdb\x0f\x3f\x9b\xf5\x2e\xc6\xe1\x45\x40\x8d\xeb\xc8\x30\x65\x86\x0f\x58\x46\x6f\x47\x68\xa6\x59\xc6\x62\x12\x26\x99\xd9\x35\x84\x43\x6c\xb1\x17\x2b\x3a\x2a\xec\x06\x46\x1f\x65\xfa\x7e\x17\xe1\x2f\x95\x90\x95\x10\xd8\xaa\x97\xb0\x95\x32\xfb\x77\x03\xb7\xb8\x25\x8e\xdd\x7e\x56\xf4\xa6\x4f\x00\x00\x00\xff\xff\x22\x81\xfe\x7f\xdc\x00\x00\x00")
 
 func typeMove_activity_asset_payloadGqlBytes() ([]byte, error) {
@@ -3933,6 +4567,26 @@ func typePage_infoGql() (*asset, error) {
 	return a, nil
 }
 
+var _typeParsed_search_queryGql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x84\x91\x4f\x6b\xdb\x40\x10\xc5\xef\xfa\x14\xcf\xf8\x5a\x0b\xbb\xa7\xa2\x43\xa1\x3d\xf5\x54\x5a\x6c\xe8\xa1\x04\xbc\xd1\x8e\xb5\x4b\xa4\x95\x3c\x33\x1b\xd9\x84\x7c\xf7\xb0\xb2\x6c\xd9\x86\x24\xd7\xe5\xcd\x6f\xdf\x9f\x39\x7e\xb5\x3d\x0c\x84\x0c\x97\x0e\xfb\x48\x7c\x44\x6f\x04\x3e\x28\x71\xc7\xa4\x64\xf3\x4c\x8f\x1d\xe1\x8f\x61\x21\xbb\x1e\x84\x7f\x07\xdd\x4b\x06\xcc\xb1\x71\x84\x40\x95\x51\xb2\xe8\x5b\xb6\x02\x13\x2c\x3a\xc7\x46\x48\xc0\x24\xb1\x56\x41\x13\x45\x11\x5a\x45\x63\xb4\x74\x79\x06\xd0\xa1\xac\xa3\x25\xbb\x21\x6e\xa4\xc0\xff\xb5\xb2\x0f\xd5\xec\x61\x96\x5d\xb0\xfb\x68\x6a\xbf\xf3\xc4\x82\xda\x37\x5e\x7d\xa8\xa0\x8e\xce\xd0\x2f\xa0\xbc\xca\xb1\x8d\x42\x5c\x34\x86\x9f\xc4\x44\x25\xde\x26\xfa\x74\x9a\xd0\xa3\xe9\xf1\xe9\xe6\x8f\x4f\x2c\x5f\xec\xea\x47\x36\x53\x1b\xbd\x57\xd7\x46\x85\x57\xb9\xfa\x3d\x79\x51\x3a\x68\x81\xf1\x30\x7b\xcd\xb2\x39\x7e\x4c\x12\xb4\xbb\xbb\x01\xce\xb9\x4a\xa6\xd4\x6a\xf1\xfd\xeb\x72\xf5\x6d\xb1\x5c\x2d\x96\xab\xed\x38\xc6\x5d\xa2\x71\x8a\x7f\x8e\xd4\x11\x0f\x1d\x4d\xfc\x34\xe7\x38\xd0\x99\xbc\xf0\x52\x74\xf1\xb1\xf6\xe2\xc8\x0e\x7d\x79\xf9\x7d\x92\x14\xf8\xd9\xb6\x35\x99\x70\x15\x30\x98\x86\x92\xcd\x1b\xee\x9d\xcb\x81\x92\x84\x53\xd2\xcb\xfd\xb3\xa9\xe3\xfb\x80\xdb\x78\x38\xa9\xaf\xfb\x7a\x1b\x00\x13\x12\xca\xc1\xa5\x02\x00\x00")
+
+func typeParsed_search_queryGqlBytes() ([]byte, error) {
+	return bindataRead(
+		_typeParsed_search_queryGql,
+		"type/parsed_search_query.gql",
+	)
+}
+
+func typeParsed_search_queryGql() (*asset, error) {
+	bytes, err := typeParsed_search_queryGqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "type/parsed_search_query.gql", size: 677, mode: os.FileMode(420), modTime: time.Unix(1792349186, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
 var _typePassword_reset_tokenGql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x94\xcc\x31\x0a\x02\x31\x10\x85\xe1\x3e\xa7\x78\xb2\xfd\x1e\xc0\xce\xd2\x4e\x96\x5c\x20\x90\x27\x3b\x68\xb2\x21\x33\x12\x45\xbc\xbb\x24\x2c\x58\xdb\x3d\xfe\x19\xbe\x09\x0b\x4b\xa5\x32\x9b\x22\xa0\x04\xd5\xb6\xd5\x88\x9e\x0c\xb6\xdd\x98\x67\x67\xaf\x42\x5c\x16\x0f\x49\xe5\xce\x34\x7e\x7d\x3f\xe1\xed\x80\x09\xe7\xc8\x6c\x72\x15\x2a\x6c\x25\x62\x30\x22\xe4\x08\x93\x44\xb4\x95\x79\xe4\x81\xa1\x05\x85\xa8\x3e\x18\x67\x87\x7d\x9d\xec\x08\x2f\x89\x07\xf7\x37\xc7\x67\x91\x4a\xed\xd6\x3e\x7f\xd8\xc7\x7d\x03\x00\x00\xff\xff\x3a\x69\x68\xc8\xde\x00\x00\x00")
 
 func typePassword_reset_tokenGqlBytes() ([]byte, error) {
@@ -4053,6 +4707,26 @@ func typeRemove_label_payloadGql() (*asset, error) {
 	return a, nil
 }
 
+var _typeRemove_lesson_prerequisite_payloadGql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x74\x8e\x41\xca\x83\x30\x10\x46\xf7\x73\x8a\x4f\xdc\x7b\x00\xd7\x6e\x84\x7f\x21\xf2\x5f\x40\x9a\x09\x09\xa8\x63\x27\xb1\x22\xa5\x77\x2f\x8d\x41\x84\xd2\xf5\x37\xef\xbd\x29\xd1\x73\x5c\x75\x46\xdc\x17\x86\x15\x45\xcf\x93\x3c\xf8\x8f\x43\x90\xb9\x53\x56\xbe\xaf\x3e\xf8\xc8\x15\xa5\x93\x5f\x73\x37\xec\xa3\x0c\x06\x4f\x02\x4a\xfc\x3b\xc6\x98\x14\xb0\x2a\x13\x36\xe7\x6f\x0e\xd1\x31\x96\x0b\x83\x6d\x08\xd0\x24\x34\x15\x21\x13\x35\x0e\x7b\x41\xa7\xaa\x6d\x20\xf6\x1b\xcf\xe8\x91\x88\x67\xf2\xa3\xca\xd3\xf5\xc3\xd6\xd4\x68\x9b\x82\x5e\xf4\x1e\x00\x9e\x18\xef\x3f\xf5\x00\x00\x00")
+
+func typeRemove_lesson_prerequisite_payloadGqlBytes() ([]byte, error) {
+	return bindataRead(
+		_typeRemove_lesson_prerequisite_payloadGql,
+		"type/remove_lesson_prerequisite_payload.gql",
+	)
+}
+
+func typeRemove_lesson_prerequisite_payloadGql() (*asset, error) {
+	bytes, err := typeRemove_lesson_prerequisite_payloadGqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "type/remove_lesson_prerequisite_payload.gql", size: 245, mode: os.FileMode(420), modTime: time.Unix(1792345549, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
 var _typeRemoved_from_activity_eventGql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x64\x90\x41\x4e\xc3\x30\x14\x44\xf7\x3e\xc5\x54\xdd\xa2\x1e\x20\xbb\x48\x80\xd4\x0d\x8b\x52\x0e\x60\xec\x09\x31\xaa\xed\xc8\xfe\x49\x54\x21\xee\x8e\xec\xa4\xa4\x82\x55\x22\xfb\xbf\xf7\x67\xbc\xc7\x89\x43\x62\x66\x90\x0c\x8d\x44\x1f\x27\x5a\x74\x29\x7a\x68\x23\x6e\x72\x72\x05\x27\x06\x41\x0c\xd0\xf8\x70\x13\x03\xf2\xf8\xfe\x49\x23\x07\x25\xd7\x81\x38\x2d\xd0\x73\x8a\xbe\x5d\x91\xa7\x4a\x38\x3f\x5c\xe8\xab\x5a\x01\x6f\x99\xa9\xcd\x99\x72\x76\x9e\x17\x17\x58\x87\x1e\x14\xf0\x12\x2d\xd5\x97\x02\xf6\x38\xf7\xdc\xf6\xd6\x14\x73\xef\x4c\x0f\x29\xe7\x05\xc6\xac\xf3\x2d\xe6\x41\xe1\x77\xb8\xc1\x6d\xf7\x4e\x6d\xa6\x85\xa8\x86\xbf\x5c\xb9\x6a\xb6\x50\x2b\x75\xb4\x0c\xe2\x3a\xc7\x5c\x57\x5a\x2d\x84\x0e\x16\xe2\x3c\x31\xf7\x0c\xf5\x38\xd6\xfa\x55\x69\x12\xb5\x2c\xca\xf5\xb7\x95\x06\xa5\x62\x35\x3a\xdb\xe0\xf8\x78\x17\x29\xcb\x68\xff\x35\x5b\x1f\xd8\x98\x31\xa5\xc5\x55\xc7\x1a\xbc\x96\xcf\x1d\x3d\x66\x26\xcc\x7d\xc4\xc0\xd4\xc5\xe4\x69\x37\xbe\x60\xe5\x7e\x29\xb5\x53\xdf\xea\x27\x00\x00\xff\xff\x55\x9d\xb5\x9f\xdd\x01\x00\x00")
 
 func typeRemoved_from_activity_eventGqlBytes() ([]byte, error) {
@@ -4113,7 +4787,7 @@ func typeRenamed_eventGql() (*asset, error) {
 	return a, nil
 }
 
-var _typeSearchable_connectionGql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\x93\xbd\x8e\xdb\x30\x10\x84\x7b\x3d\xc5\x04\xd7\xe7\x01\xd4\x19\x87\x14\x57\x04\x08\x70\xd7\x1d\x5c\xd0\xe4\x4a\x22\x20\x2d\x15\xee\x32\xb0\x11\xf8\xdd\x03\x52\x92\xff\x73\x56\x47\x81\x33\xdf\x72\x67\x57\x2f\xd8\x30\xc8\xb5\x04\xcf\x30\x10\x32\xd1\x76\x66\xd7\x13\x6c\x60\x26\xab\x3e\xf0\xf7\x4a\x0f\x23\xe1\xfd\x74\xf7\x23\xeb\xff\x56\xc0\x0b\x36\xb0\x29\x4a\x88\x68\x42\x44\x92\x82\x19\x4d\xeb\xd9\x4c\x4e\xcc\xf7\x35\xde\x35\x7a\x6e\xbf\x55\xc5\xf6\xd1\x11\xbc\xd2\x00\xa3\xd0\x8e\x40\xec\x10\x9a\xe9\xe8\x5a\xca\x3e\x0e\x8e\xea\x8b\xa2\xb3\x91\xf6\x8a\xc1\xa8\xed\x48\x10\xb8\x38\x22\x49\xea\x15\x4d\x48\xec\xb2\x53\x69\xaf\x3f\x27\x49\x8d\xcf\x8f\xe5\x6b\x5b\x1d\xab\x2a\xbf\xb8\xf7\xa2\xb9\xda\xe4\x13\x68\x67\x16\xa6\x83\x69\x8d\x67\xd1\x53\x16\xf8\x9d\x28\x1e\xee\x22\x78\x3d\xa5\x33\x07\x91\x3b\xe2\x34\xec\x28\x66\xb4\xb1\xea\xff\x78\xf5\x74\x43\xcf\xcf\xbd\xe6\x62\xd1\x1e\x5e\x43\x62\xad\xf1\xc6\x7a\x11\xd2\x19\x69\x43\x8a\xb2\x86\x37\x09\xef\x68\xe7\xbe\x73\xc2\x92\x95\xe5\x50\xe3\xf3\x7a\xb2\xdb\x47\xd5\x7b\xb3\xa3\x7e\x45\xf1\xa2\x7b\xd2\x49\x4f\x22\x81\xd7\xc0\x8a\xf0\x8b\x4e\xf2\x8e\xc8\xb2\x2c\xd7\x9d\xcc\x5d\xbc\x71\x13\xe2\x50\x96\x11\x1a\x60\xbc\xbb\xdf\xd0\xd1\xb4\x94\x75\x35\x7e\xcd\xa7\x87\xcf\x16\x4d\x6e\xd5\x40\xb3\xf0\xd9\x34\x35\x8c\xde\xae\x60\x15\xdd\x13\x56\x12\x8a\x2b\x50\x59\xb6\x82\x04\x23\x42\xb7\x7f\xc5\xff\x78\x9b\xac\xbd\x84\x1e\xab\x7f\x01\x00\x00\xff\xff\x66\x76\xc8\x42\x52\x04\x00\x00")
+var _typeSearchable_connectionGql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\x93\x4f\x6b\xdc\x30\x10\xc5\xef\xfe\x14\x2f\xe4\x5a\xfa\x01\x7c\xdb\x86\x96\xe6\x10\x68\x49\xa0\x94\xb0\x07\xad\x35\xb6\x05\xb6\xe4\x6a\x46\xdd\x6c\x4a\xbe\x7b\x19\xf9\x4f\x92\x7a\xe9\xfa\x26\x5b\x6f\x7e\x33\x7a\xd2\xbb\xc6\xce\x83\x6c\x43\x70\x1e\x06\x4c\x26\x56\xad\x39\x74\x84\x2a\x78\x4f\x95\xb8\xe0\x3f\x16\x72\x1a\x08\xf7\xcb\xde\x67\xd5\xff\x29\x80\x6b\xec\x50\xa5\xc8\x21\xa2\x0e\x11\x89\x33\x66\x30\x8d\xf3\x66\xac\xc4\xb4\x5f\xe2\x5e\xa2\xf3\xcd\x55\x91\xcb\x1e\x5a\x82\x13\xea\x61\x04\xd2\x12\xc8\x5b\x84\x7a\x5c\xda\x86\xb4\xce\x07\x4b\xe5\x9b\xa6\x53\x21\x3d\x09\x7a\x23\x55\x4b\x8c\xe0\x73\x45\x24\x4e\x9d\xa0\x0e\xc9\x5b\xad\x14\x7a\x92\xbb\x51\x52\xe2\xf1\x61\xfe\xda\x17\x2f\x45\xa1\x13\x77\x8e\x45\xbb\x8d\x75\x0c\x69\xcd\xcc\xb4\x30\x8d\x71\x9e\x65\xf1\x02\xbf\x12\xc5\xd3\xca\x82\x9b\xc5\x9d\xc9\x08\x3d\x91\x4f\xfd\x81\xa2\xa2\x4d\x25\xee\xb7\x13\x47\xff\xd0\x75\xdc\xf7\x5c\xcc\xda\xd3\x4d\x48\x5e\x4a\xdc\x7a\xb9\x2a\xce\x20\xab\xd0\xf7\xe4\x65\x03\x70\x52\x5e\xe4\xa5\xc8\x5b\xe6\x1b\x85\x6b\x9a\x93\x8e\x18\xec\x7a\xd7\x99\x08\x09\xab\xe2\x0f\x70\x35\x6a\x3a\x2e\x46\xcf\x5d\x9c\x30\x8e\x21\x5a\x56\xbc\x75\xf6\x67\x48\x77\x64\x7c\x89\xc7\xe9\x91\xec\xa7\x1e\xaf\x77\xa5\x4f\x34\xcb\xf3\x42\x95\xcb\x55\xe8\x6b\xdc\x8f\xfa\x1f\x2d\x49\x4b\x71\x69\x78\x74\xd2\xc2\x40\x74\xd4\xff\x4d\x3a\x9f\x5f\x1b\x38\xfe\x92\x9e\x9f\x4f\x25\x3e\x85\xd0\x91\xf1\x67\xcd\xeb\xcc\x81\xba\x0d\xde\x65\xdd\x85\x8b\xe8\x88\x39\xf8\x2d\xb0\x2c\x5c\xd1\x5e\x4d\xd2\xc8\xf0\x9c\x9d\xf7\x26\x4d\x06\xdd\xfa\x3a\xc4\x3e\x67\x53\x7d\x30\xce\xae\x03\x3b\x98\x86\x54\x57\xe2\xdb\xb4\x9a\x1a\x7d\x0d\xc7\xd5\x5c\x38\x1a\x86\xf3\x42\x71\x88\x24\xa3\x83\x83\x89\x4c\xf6\xbb\x1a\xab\x0c\xfd\x18\x63\x9c\x7f\x9d\xf5\x80\x25\xd9\x4d\x61\x51\xe1\xa5\xa4\x48\x18\x5c\xb5\x81\x95\x75\x17\x58\x89\x29\x6e\x40\xa9\x6c\x03\x09\x86\x99\xb6\x44\x58\x79\x3b\xd5\xbe\x85\xbe\x14\x7f\x07\x00\x14\x41\x50\xe1\xae\x05\x00\x00")
 
 func typeSearchable_connectionGqlBytes() ([]byte, error) {
 	return bindataRead(
@@ -4128,12 +4802,12 @@ func typeSearchable_connectionGql() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "type/searchable_connection.gql", size: 1106, mode: os.FileMode(420), modTime: time.Unix(1546291900, 0)}
+	info := bindataFileInfo{name: "type/searchable_connection.gql", size: 1454, mode: os.FileMode(420), modTime: time.Unix(1792349592, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _typeStudyGql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x59\xcd\x8e\xe3\x36\x0c\xbe\xfb\x29\xb8\xd8\x43\x5b\x60\xb1\x0f\x90\x4b\x91\xcd\x4e\x3b\x01\xa6\xdd\xc1\x4c\x06\x3d\x14\x7b\x50\x2c\x26\x51\x61\x4b\x86\xa4\x24\x18\x14\xfb\xee\x05\xa9\x1f\x3b\x8e\xe3\x49\x5a\xa0\x9d\x9f\x9e\x12\x49\xd4\x27\x92\xfa\x44\x52\xd6\x7b\xb8\xc3\xc6\xa2\x43\xed\x1d\x08\x70\x7e\x2b\x1f\x3f\x16\xfe\xb1\x41\xb8\xa7\xff\xa0\xea\xa6\xc2\x9a\x86\x0b\x80\x69\xd3\x54\x28\x96\x15\x7e\x28\x00\x66\x16\x85\xcf\xad\x2b\x6d\x4d\x55\xa5\xd6\xaf\x46\xf2\xef\x3d\x0a\x5b\x6e\x52\xef\xc2\x34\xaa\x4c\x8d\x07\xad\x56\xc6\xd6\x77\xe8\xcc\xd6\x96\x78\x63\x4a\xe1\x69\xac\xf8\xb3\x00\x20\xb5\xfc\xd6\x6a\xd6\x49\xe9\x75\x85\x20\x4a\xaf\x76\xca\x3f\xc2\xca\x9a\x1a\xfc\x06\xa1\xdc\x5a\x8b\xda\x07\xa5\x61\xf9\x08\x7a\x5b\x2f\xd1\x7e\x2c\x20\x0b\x7f\x5f\x00\x10\xda\x62\x83\x71\x14\x56\xc6\xf2\xec\x8c\xe7\x0d\x2c\x11\x2c\xaf\x87\xf2\x23\xcf\x08\xb2\x13\x98\x6b\xff\x0e\xa0\x00\xf8\x61\x02\xd3\x38\xa1\xe8\xe9\x57\x29\xe7\xc1\xac\x12\xa0\x42\x77\x42\xc5\x8e\x62\x0a\x5d\x52\x2d\x01\x91\x38\x46\x57\x83\xd2\xdc\x66\x68\xbf\x11\x1e\x4a\x53\x23\x88\x95\xc7\xa0\xbc\x6b\xb0\x54\x2b\x85\x12\xd6\x95\x59\x8a\x0a\xe6\x9f\x83\xe2\x2c\x32\x81\x7b\x6f\x95\x5e\x17\x97\x2f\xb1\xc4\x95\xb1\x38\xbe\x46\x90\xe9\x2f\xf2\x93\xaa\x3c\x52\x07\x98\xc6\x2b\xa3\x1d\x7b\xba\xe3\x94\xe4\xe1\x8e\x77\x8c\xd6\x58\x92\x70\x00\x5e\x31\xc4\xa7\xc7\xd6\xd7\x01\xd4\x0d\x19\xb2\x52\xd6\x79\xd0\xad\x41\xc4\xa6\x6c\x52\x02\xb4\xce\xf3\x2e\x0e\x21\x54\xe2\x49\x00\x12\x39\x98\xff\xc5\xca\x7f\x68\xa4\x21\x84\xae\x8d\x0c\x79\x48\xb1\x59\x9e\xf3\x2e\xb0\x6d\x2e\x51\x7b\xda\x0c\x07\xfb\x0d\x86\x9d\x0b\xb4\xdf\x0b\x07\x42\xee\x84\x2e\x51\x82\x60\xb5\x53\x73\xea\x27\xb0\x50\x35\x0e\x12\x76\xeb\xd0\x12\x98\x81\x8d\xd8\x21\x08\x3a\xd9\x12\xfc\x46\xb9\xf0\x9f\x8e\x22\x83\x51\xe3\x67\xb5\x43\xfb\xea\x18\xfb\x4c\xd8\xc4\x14\x3a\xcd\x92\xec\xff\x2e\x4f\x72\xe7\x11\x53\x8e\xe3\xa6\x73\xe8\xc7\x82\xa6\xa8\xc3\x46\x93\x5c\xd8\x62\xea\x4a\xce\x7a\x17\x56\x7c\x70\x68\xa7\x24\x71\x32\xfa\xd1\xa0\x0b\xfb\xd2\xb2\xd3\xec\xb5\xcb\xe0\xaf\x8e\x40\xc3\x21\x8f\x4e\x56\x72\xc7\x25\x31\x2f\xbb\xf8\x85\x04\xbd\x73\x2d\xcc\x54\xce\x06\x76\x98\x9c\xfb\x9e\x26\x72\x69\xb6\xd6\xe1\x59\xe9\x3f\x88\x8e\x24\xff\x88\x75\x6e\xea\x9f\xb1\xf8\x29\xea\x07\xb0\xb1\xac\x1f\x25\xde\x06\xff\x93\x3b\x2e\xe1\x7e\x70\xf0\xcb\x20\xfe\xf9\xf6\x65\xe6\x07\xf3\x3a\xb4\x0f\x1d\x63\x69\x9e\xf0\xa4\xf0\x08\x42\x4b\xf0\xaa\xc6\x36\xf1\x9b\xe5\x1f\x58\x7a\xce\xfc\x25\xd7\xe0\xcc\xdc\xf8\x37\x25\xfd\x88\x48\xdc\x97\xe8\x4a\xab\xd8\x02\xa2\x6b\x8e\xce\x34\xab\x33\xd6\x46\xfc\x27\x67\x82\x45\x2d\xd1\x52\xc1\x60\xe0\x7a\xf1\xcb\x4d\x0f\x8a\xba\x26\x3c\x70\x74\x98\x87\x8a\x0f\xe4\xbb\x03\xca\x40\x50\xe5\x62\x47\x2a\x41\xe2\xf0\x5b\x39\x3f\xc1\x39\x97\x66\x8e\xe7\x79\x76\xc6\x6b\x9b\xab\xb8\xb1\x9d\x83\x91\xba\x8e\x8f\x46\xd0\x64\xa7\x70\x8f\x16\xa4\x72\xb5\x72\x0e\xe5\x87\x4c\x9e\x0f\x60\x2c\xa8\xb5\x36\xec\xd0\x93\x34\x22\x03\xee\xbd\xf0\x5b\x97\x16\x6b\x7b\x78\x29\x25\x27\x30\xff\xdc\x5d\x55\xb9\xc8\xfa\xc6\xaa\x9d\xf0\xf8\x23\x49\xb9\xdb\xd0\x98\xc0\x27\x63\x2a\x14\x27\xd3\x56\x25\x96\x58\x9d\x51\x7f\xb1\xdc\x41\xca\x12\x35\xe6\x84\x15\x50\x06\xf3\x55\xb7\x58\x4b\x09\xeb\x86\xc4\x4f\xe5\x2b\xc6\x1a\x4b\x57\x41\xe0\x6d\x9c\xb6\xe8\x8c\x4b\x8e\x1b\x3b\xf7\x79\x9e\xb7\xbf\x6d\x5d\x3e\x93\x6c\x5c\xe7\x40\x72\xfb\xe9\xe2\xac\x42\xe7\x8c\x3e\xab\x38\x0b\xa2\x23\xc5\x59\xc4\x3a\xb7\x38\xbb\x61\xf1\x93\x64\xe7\xd1\x51\xb6\x07\x89\x37\x42\xf7\xe8\x8e\x8b\xf8\xce\x73\x5e\x08\xe1\xcf\xb6\xaf\x65\x3c\x4f\xe9\x52\x9e\x3b\x7a\x9c\x2f\x4d\xcd\xaa\xbd\x36\x9a\xfc\x87\xfb\x18\x2b\x61\xf6\xeb\x51\x84\xc9\x19\xb0\x5f\xb1\x1e\x7e\x9c\xc8\xb2\x3c\xfe\x9d\x0b\x73\xf6\xca\x6f\xc0\xec\x75\x08\x38\xd4\xf5\x9b\xf2\x9b\x2f\xd4\x31\x30\x95\x05\x8f\xd6\x31\x41\x9a\x6a\xab\x8e\xec\xf5\x62\x71\x0b\x8d\xf0\x9b\x18\xad\x52\x6d\x40\x33\x6c\xfc\x94\x7d\x2b\xfc\x66\x02\x0f\x77\xf3\xe1\xe2\xd7\xac\x00\x77\xe1\x7b\xbb\x73\xa6\x54\x54\xb5\x07\x8d\x0f\xd6\xa7\x9a\xbf\x52\x1a\xff\x27\xdc\xbf\x12\x38\xe2\x96\x5c\x10\x37\xae\x68\x46\x27\x6c\xf0\x7b\xc9\x22\xee\xda\x48\xc6\x4c\x24\xf0\xa6\x51\xe5\x58\x62\x0a\x02\xaf\x6d\xff\x87\xf3\x52\x74\xc6\x25\x69\x89\x5f\x93\x5e\x46\x56\x3a\xdb\xba\x4c\x2e\x36\xae\x43\x2e\x6e\x5f\xf8\x2c\xc0\xea\x6f\x1b\x99\xbe\x10\xc4\xbf\x03\x5f\x08\x38\xaa\x3d\xdc\xdd\x0c\x04\xb5\xad\xad\xba\xb1\x6c\x26\x74\xf7\x1e\x26\x64\x9d\xee\xec\xe1\xab\x04\xdd\x8d\xc2\xd8\x4c\xe8\x29\x8d\xf6\x2f\x48\x7d\x84\xa6\xa9\x70\xe0\xdd\xa1\x05\xa1\xde\x27\x40\xc2\xd5\xee\xc4\xe7\x83\x8c\x14\xae\x7b\x7d\xa8\x6b\xe1\x8e\xf4\xe9\x3f\x84\xb4\x56\x5d\x0b\xc7\x0a\xc9\x0e\xcc\xb7\xa2\x78\x0f\x53\x0d\x28\xd7\x08\xfc\x74\x4a\x6e\xbc\xef\x3f\xa5\x5e\xd1\x70\xfb\x9c\x0a\xdc\x0e\x8f\x9d\x53\x3a\xfc\xce\xd8\x74\xff\x27\x4b\x1a\xb1\x56\x5a\x24\x6a\x84\xf1\x81\xe4\xa5\x3c\xd6\x10\xbf\xb9\xa3\x96\x29\x8d\x91\x2e\x9c\xfb\x8c\xc4\x18\x9a\xa2\x9e\x1d\xce\x8d\x28\xdb\x52\xad\xab\x72\xa7\x37\x28\x3e\xd7\x74\x26\x44\x00\x33\x20\x94\x3c\x56\xbd\x11\x6b\x24\xb9\x09\xdc\xc6\x7f\x51\xfd\x69\x9b\x0f\xe5\x1a\xf9\x9d\x80\xff\x4c\xe0\xf7\xec\xb0\xaf\x7d\x51\x32\xc8\x25\xcb\xb2\xe8\xd7\xd6\x21\xde\x78\x51\x41\x69\xb6\x9a\xe5\xc9\x3f\x39\x88\x1d\x1e\x37\x96\x9c\x91\x60\xb8\x50\x14\xdf\x8a\xbf\x02\x00\x00\xff\xff\xc9\xa3\x57\x4a\x0f\x1f\x00\x00")
+var _typeStudyGql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x5a\xdd\x6e\xdb\xb8\x12\xbe\xf7\x53\x8c\xd1\x8b\xd3\x03\xb8\x79\x00\x5f\x9c\x03\xd7\xf1\x69\x0c\x38\x3f\x88\x9d\xb3\x08\x16\xc5\x86\x96\xc6\x16\xb7\x12\xa9\x92\x94\x03\x63\xd1\x77\x5f\xcc\x90\x94\xe4\xdf\xd8\xd8\x05\xb6\x69\x7a\x67\x92\x33\xc3\x99\xe1\x37\x3f\xa4\xfc\x0e\xee\xb1\x34\x68\x51\x39\x0b\x02\xac\xab\xd2\xf5\x45\xc7\xad\x4b\x84\x29\xfd\x06\x59\x94\x39\x16\xb4\xdc\x01\x18\x94\x65\x8e\x62\x9e\x63\xaf\x03\x30\x34\x28\x5c\x3d\x1a\x29\xa3\xf3\x3c\x8e\x6e\x74\xca\xb3\x53\x14\x26\xc9\xe2\xec\x4c\x97\x32\x89\x83\x07\x25\x17\xda\x14\xf7\x68\x75\x65\x12\x9c\xe8\x44\x38\x5a\xeb\xfc\xd1\x01\x20\xb5\x5c\x65\x14\xeb\x24\xd5\x32\x47\x10\x89\x93\x2b\xe9\xd6\xb0\x30\xba\x00\x97\x21\x24\x95\x31\xa8\x9c\x57\x1a\xe6\x6b\x50\x55\x31\x47\x73\xd1\x81\x9a\xf8\x7d\x07\x80\xa4\xcd\x32\x0c\xab\xb0\xd0\x86\xb9\x6b\x79\x4e\xc3\x1c\xc1\xf0\x7e\x98\x12\x37\x04\xda\x3e\x8c\x95\xeb\x02\x74\x00\xfe\xdd\x87\x41\x60\xe8\x6c\xe9\x97\x4b\xeb\x40\x2f\xe2\x9e\x12\xed\x01\x15\x5b\x8a\x49\xb4\x51\xb5\x28\x88\xc8\x31\xb8\x1a\xa4\x62\x1d\x59\xb4\xcb\x84\x83\x44\x17\x08\x62\xe1\xd0\x2b\x6f\x4b\x4c\xe4\x42\x62\x0a\xcb\x5c\xcf\x45\x0e\xe3\x4b\x92\x0e\x9e\xa4\x0f\x53\x67\xa4\x5a\x76\xce\xdf\x62\x8e\x0b\x6d\xf0\xf8\x1e\x9e\x66\x7b\x93\xff\xc9\xdc\x21\x4d\x80\x2e\x9d\xd4\xca\xb2\xa7\x5b\x4e\x89\x1e\x6e\x79\x47\x2b\x85\x09\x11\x7b\xc1\x0b\x16\xf1\x71\xdd\xf8\xda\x0b\xb5\xfb\x0c\x59\x48\x63\x1d\xa8\xc6\x20\x42\x53\x6d\x52\x14\x68\xac\xe3\x53\xdc\x27\x21\x17\x2f\x0a\xc8\xc5\x16\xff\xad\x49\xff\xa2\x91\xda\xa4\x9b\x36\xb2\xc8\x4d\x88\x0d\x6b\x9e\x2e\x29\xfe\x0e\xc6\x29\x2a\x47\x07\x6e\xe1\x39\x43\x7f\x72\x8c\x29\x78\x16\x16\x44\xba\x12\x2a\xc1\x14\x04\xab\x1d\x87\x03\xd7\x87\x99\x2c\x70\x2f\x60\x2b\x8b\x86\x84\x69\xc8\xc4\x0a\x41\x50\x64\xa7\xe0\x32\x69\xfd\x6f\x0a\x45\x16\x46\x83\x4f\x72\x85\xe6\x87\x43\xec\x77\x82\x26\xda\xea\x08\x4a\x6a\xff\xb7\x71\x52\x4f\xee\x20\x65\x37\x6f\x5a\x8b\xee\x40\x46\xe2\xa4\x29\x0a\x24\xfb\x98\xce\x1f\xb1\x12\x45\xed\xac\xae\x47\xe6\x83\x45\x33\x20\x8a\xbd\x60\xa2\xec\x47\x8b\xe4\x09\xe1\x5a\xe8\xd4\xcf\xca\xd6\xc2\xdf\x48\xca\xa3\xc8\x8a\xee\x38\x27\xe7\xd5\x2e\x7e\x25\x49\xef\x54\x0b\x6b\x28\xd7\x06\xb6\x90\x5c\xcf\xbd\x0c\xe4\x44\x57\xc6\xe2\x31\x24\xd7\xe5\xdf\x93\x1e\x29\xfe\x41\xd6\xa9\xa5\x7f\xc8\xe4\x87\xa0\xef\x85\x1d\xab\xfa\x81\xe2\x6d\xe0\x3f\xba\xe3\x1c\xec\x7b\x07\xbf\x0e\xe0\x9f\x6e\x5f\x8d\x7c\x6f\x5e\x0b\xf6\x7e\xe2\x58\x99\x27\x79\xa9\x70\x08\x42\xa5\xe0\x64\x81\x4d\xe1\xd7\xf3\xdf\x31\x71\x5c\xf9\x13\xee\xc1\xb9\x69\x0d\x3f\x63\xd1\x0f\x12\x09\xfb\x29\xda\xc4\x48\xce\x50\x04\xd7\x3a\x3b\x13\x57\x6b\x2d\x9e\xee\x09\x9c\x60\x50\xa5\x68\x30\x05\xa7\xe1\x6a\x76\x3d\xd9\x12\x45\x53\x7d\x5e\x08\xc2\xb6\x82\x66\xab\xf9\x40\xbe\x3b\x60\xea\x63\x40\x5a\xc0\xfa\x32\x41\x82\xc3\xf2\x5b\x89\x1f\xef\x9c\x73\xa2\x87\x92\xe8\xf7\x19\x3b\x21\x60\x0e\x84\xc5\x28\x1c\x6c\x2b\x30\xe2\xd4\x6e\x68\xf8\xe3\x5e\x49\x7c\x46\x03\xa9\xb4\x85\xb4\x16\xd3\x5e\x44\x47\xda\x03\x6d\x40\x2e\x95\x26\x0f\x1f\x86\x11\x79\x60\xea\x84\xab\x6c\xdc\xac\x99\xe1\xad\x64\xda\x87\xf1\xe5\x16\x6e\x69\x6b\xa9\x56\xd2\x09\x52\xc9\x12\xec\x9b\x30\x82\x5b\x95\xaf\x61\x25\xad\x9c\xe7\x48\x4b\x92\xee\xd2\x69\x21\x95\xb4\xce\x08\xa7\x0d\xf7\x40\x2d\xfe\x3e\xfc\xca\xb7\xeb\x71\x3d\xd5\xfd\xdc\xb6\x53\xda\x10\x67\xa5\x91\x2b\xe1\xf0\xbf\xc4\x6e\xef\xfc\xa0\x0f\x1f\xb5\xce\x51\xa8\x2d\x1d\xeb\x42\x99\x8b\x39\xe6\x07\xaa\x51\xbb\xe3\x63\xba\x18\x53\x94\x28\x68\xa1\x2e\x91\xbc\xba\xbf\x42\xb6\xdb\xc3\x58\x22\x27\x44\x7e\xa8\x42\xb2\xac\x63\x05\xd2\x13\xbc\x8d\xf8\x0e\xce\x38\x27\xc0\xd9\xb9\xaf\xa3\x3a\x9e\x6c\x5d\x9d\x05\xd8\xb8\x56\x0a\xe0\xf1\x4e\xfc\xef\xa2\x1c\xad\xd5\xea\x00\xa6\x36\xdb\x41\x4f\x7a\xa4\x1d\x0c\xb2\x4e\x6d\x07\x27\x4c\xde\x94\xc9\xd2\xa0\xc1\xaf\x95\xb4\xd2\x21\x2c\x8d\x28\xb3\x58\x2d\x37\x94\xfa\x97\x0d\x9a\xd8\x46\xa9\x4f\x44\x1d\x25\xf2\xa0\x7b\x30\x88\x98\xe1\x68\x14\x79\x8a\x37\x12\x46\xc1\x1d\x67\xc5\x11\xf3\xbc\x92\x40\x3a\xd9\xbe\x26\x92\x98\xa5\x1d\x4a\x3c\xb1\x15\x4b\x89\x2e\xd8\xb6\x1f\x0d\x26\xff\xe0\x39\x86\x9e\x9e\xfd\xba\x93\xb9\xea\xca\xda\xee\xa0\x2f\x3a\x5b\x75\xb4\xa1\x8d\xb9\x82\x96\xe1\x59\xba\x0c\xf4\xb3\xf2\x89\x8c\xa6\x7e\x91\x2e\xbb\xa5\x89\x3d\xac\x4c\xb8\xd1\xa9\x13\x17\xcf\xfa\x2e\xb1\x45\x7b\x35\x9b\xdd\x41\x29\x5c\x16\xb2\x60\xec\x39\x88\xc3\x84\x47\xf9\x3b\xe1\xb2\x3e\x3c\xdc\x8f\x03\x9f\x7f\xce\x67\x2b\xca\x6a\x9e\x4b\x9b\x61\xda\x7a\xfd\xec\xd5\xd8\xea\xc5\x5b\x52\xaf\xc6\x31\xdd\x63\x5a\x4f\x23\x2c\xaf\xad\x69\x0f\x8c\x50\x5f\xf8\x4a\xb1\x44\x97\xa1\xa1\x1c\x3e\x47\xeb\xa0\x10\x2e\xc9\x48\x2d\xcb\xdb\xff\xc4\xed\xdf\x86\xdb\xc0\x7f\xa5\x9f\xa9\xd1\x63\x3f\x83\x41\x5b\xe5\xd4\xc0\x2e\x85\x54\xec\x45\x84\xaf\x15\x9a\xf5\x05\x5c\xe2\x42\xf0\x9a\xd3\x30\x78\x98\xdd\x7a\x7d\x0a\x9d\x52\x3b\xc8\x67\x73\xad\x53\x8c\x5a\x31\x96\x79\x16\x2c\x7b\x95\xf6\xc8\xb5\xfe\x42\x46\x5d\xc0\x7d\xd8\xa7\x10\x74\xcc\x90\xcb\x42\x3a\x4c\x3d\xe0\x5d\x86\x41\xc8\xd7\x4a\xe4\x74\x21\x36\x16\x9e\x08\x3d\xfd\xc9\xed\xa7\xf1\xcd\x13\xdf\x8a\x9f\xc2\x95\xb7\xff\x9f\xc7\xc7\xc7\xc7\x0f\xd7\xd7\x1f\x2e\x2f\x9f\xbc\x4e\xac\x70\x74\x74\xb7\xad\x11\x7d\xda\xb2\x14\x22\x89\x56\x8e\x4a\xb3\xd3\x51\xcb\x85\x36\x3d\xd0\x0a\x69\x75\x30\x9c\x8d\xff\x3f\x9e\x3d\xf6\x60\x78\x7b\x7d\x3d\xba\x99\xd1\x8f\x87\xfb\xe9\xa8\x17\x64\x4d\x46\xd3\xe9\xed\x0d\xeb\xf1\x30\x1d\xdd\xff\x36\x98\x4e\x47\xb3\x4d\x17\x89\x3c\x0f\xb1\x58\x78\xad\x78\x6f\xea\xfd\x79\xbf\xd9\xba\xc4\xee\x67\xdf\x51\x34\xdf\xc9\xf6\xa6\x8f\x5c\xa8\x65\x25\x96\x01\x5e\x21\x3f\x44\x03\x28\x70\x99\x9d\x2f\xd0\x4d\x9c\x4c\x02\x53\x94\x1e\xc7\x41\xee\x6e\x83\x81\x2b\x86\x9c\xb0\x56\x27\x52\xb4\x0f\xa3\xc9\x0c\xf4\x12\x91\x4b\x85\xef\xf7\x40\xef\x85\x08\xf9\x19\x84\x47\x83\x70\x6f\x13\x10\x8e\xe4\x8c\x1e\x60\x44\x1c\xad\x16\x80\xef\x99\xb3\x70\x6a\x3b\xe0\xda\x05\x81\xa3\x6f\xb4\xc7\x9a\x4c\x4f\xf0\x36\x7a\xcc\xe0\x8c\x73\x5a\x4c\xfe\xc6\xfd\x3a\x3a\xcc\x93\xad\xab\xc1\xc5\xc6\xb5\xc0\xc5\xe3\x63\xaf\x98\x7b\x3e\x56\x92\x6e\x50\x95\x69\x7c\xb7\x0c\x3f\xf7\xbc\x5b\x72\x87\xf2\x70\x3f\xd9\xd3\xa0\x54\x26\x6f\xf7\x25\x43\xa1\xda\xaf\x43\xfc\xf6\xe2\x39\xfc\x5b\x29\xbd\x9f\xf8\xb5\xa1\x50\x03\x5a\xdd\x7e\x44\xd9\x96\x40\x1f\xd8\xf6\x7c\x0d\x6d\x84\x10\xc1\x0b\x42\xfc\x83\xd3\x81\x47\xcd\x5a\x92\x7f\x84\xda\x16\x75\x25\x36\xde\xbb\xf6\x7e\x9e\x6d\xac\xba\x12\x96\xff\x9a\x91\xb6\xc4\x7c\xeb\x74\xde\xc1\x40\x01\xa6\x54\x37\xe8\x0f\x1d\xe4\xc6\xe9\xf6\x1f\x3c\x46\xb4\xdc\xfc\xc9\x03\x78\xec\xff\x82\x31\xa0\xe0\xb7\xda\xc4\x57\x49\xb2\xa4\x14\x4b\xa9\x44\x84\x86\x5f\x8f\xb1\x14\x54\xa7\xba\x2f\x1d\x16\x10\xbe\x04\xa2\x4a\x63\x4b\x4a\xba\x10\x42\x95\x6f\x19\x48\x97\xa0\x67\x0b\x73\x47\x94\x6d\xa0\xd6\x56\xb9\x35\xeb\x15\x1f\x2b\x0a\x2a\xd6\x92\xeb\xb0\x4c\x77\x55\x2f\xc5\x12\x89\xae\x0f\x77\xe1\x57\x50\x7f\xd0\xd4\xc3\x74\x89\x7c\x55\x27\xb5\xeb\x37\x3b\x72\xd0\xe7\x6d\x52\x32\xc8\x46\xcb\x6a\xd2\x40\x46\x0e\x71\xda\x89\x9c\x9a\x62\xc5\xa2\xc9\x3f\x75\x12\xdb\x0c\x37\xa6\x1c\x12\x61\x1f\xc6\xca\x75\x3b\xdf\x3a\x7f\x0e\x00\xd8\xbc\x29\xc5\xa5\x23\x00\x00")
 
 func typeStudyGqlBytes() ([]byte, error) {
 	return bindataRead(
@@ -4148,7 +4822,27 @@ func typeStudyGql() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "type/study.gql", size: 7951, mode: os.FileMode(420), modTime: time.Unix(1546281321, 0)}
+	info := bindataFileInfo{name: "type/study.gql", size: 9125, mode: os.FileMode(420), modTime: time.Unix(1792349592, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _typeStudy_invitationGql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x9c\x92\x41\x8f\xd3\x40\x0c\x85\xef\xf9\x15\x6f\xb5\xd7\x6a\x7f\x40\x2e\xa8\x2c\x07\x22\xed\x01\x2d\x2d\x77\x27\x71\x37\xa6\x89\xa7\x1a\x3b\xe9\x56\x88\xff\x8e\x66\x92\xa2\xa8\x95\x10\x70\x9b\x64\xe6\x7d\x7e\xcf\xf6\x23\xb6\x0a\xd1\x49\x9c\x5c\x82\x6e\x60\x1d\x45\x6e\x41\x06\x42\x2f\x7a\x44\x88\x30\x56\x47\x7d\x01\x0f\x24\xfd\x06\x1e\x40\x38\x45\x99\xc8\x19\xe6\x63\x7b\x79\x2a\xfc\x72\x62\x7c\x4d\xe7\xea\x37\x0c\x3f\x0a\xe0\x11\xbb\x8e\x41\x4d\xc3\x66\xf0\x8e\x57\xc5\xf0\x16\x49\xdd\x9e\x0a\x2c\xf7\xe5\x4c\xd8\xe6\x8f\x17\x9e\xb8\x7f\x28\x32\xa2\x6a\x59\x5d\x0e\xc2\x33\xa2\x4d\x85\x49\x5b\xb8\x0c\x8c\x73\xc7\x9a\x7f\x87\xfa\x3b\x37\x8e\x33\x19\x9a\xc8\xe4\xdc\x26\xf4\x72\xdc\x7a\x89\x9d\x0c\xbc\x10\x93\xa9\x1c\xe7\xd6\x53\x52\xe7\xbc\x1e\x36\x90\x03\x48\x2f\x89\x92\xdf\x26\x7f\x51\xf4\xed\x5f\x4c\xad\xd0\xfc\x7e\x92\xc8\x96\xb1\x3c\x71\xcc\xdc\xf9\xdf\xd5\x5d\x22\x4b\x5b\xa2\xfa\xb4\xf2\x39\x1a\x47\x9c\xbb\x70\x8d\x72\xc3\x4d\x98\x5c\x85\x63\x89\xbd\x71\x5c\xa4\xcf\x74\xe7\xc0\x5c\xfa\x1e\xf5\x3c\x8f\x93\x73\xfb\x21\x69\xed\x1b\xf5\xa9\xe8\xc7\x10\x7a\x26\x5d\x55\xd6\x71\xa8\x39\x22\x1c\x72\xa7\xef\xe6\xd7\x90\xae\x61\x39\x58\x2f\x83\x2c\x9d\x1f\xe8\x7d\x6f\x6c\x25\x2a\xf5\xff\x6c\x59\x9a\x46\xe4\x29\x1c\x67\xe2\x72\x5c\x77\x6b\xde\xaf\xbc\x85\xb7\x62\x31\x78\x48\xb2\x7c\xbb\x2c\xd7\x2a\x9d\x87\x23\x2b\x46\x4b\x1d\x0d\x4b\x8a\x1b\x46\x52\xe7\x67\xd7\xd1\xaf\xe4\x9f\x77\xbb\x2f\xd8\xbf\xbe\xfc\x51\x3c\xc6\xbe\xc4\xfe\xb5\xfa\xfb\xa6\x76\x64\xa8\x99\x75\x61\xce\xc1\x47\xe3\xe7\x30\xaa\x97\xa8\xd4\x1f\x8a\x9f\xc5\xaf\x01\x00\x33\x5f\xda\x87\xb8\x03\x00\x00")
+
+func typeStudy_invitationGqlBytes() ([]byte, error) {
+	return bindataRead(
+		_typeStudy_invitationGql,
+		"type/study_invitation.gql",
+	)
+}
+
+func typeStudy_invitationGql() (*asset, error) {
+	bytes, err := typeStudy_invitationGqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "type/study_invitation.gql", size: 952, mode: os.FileMode(420), modTime: time.Unix(1792347368, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
@@ -4173,6 +4867,26 @@ func typeStudy_timeline_eventGql() (*asset, error) {
 	return a, nil
 }
 
+var _typeSuggestionGql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x74\x90\x41\x6a\xc3\x30\x10\x45\xf7\x3a\xc5\x0f\xd9\x1a\x1f\xc0\xbb\x42\x36\x59\x3b\x17\x50\xac\x5f\x5b\xa0\x68\x5c\x69\x4c\x6a\x4a\xef\x5e\x24\xd3\xd8\x50\xba\x92\x18\x3d\xbe\xde\xfc\x33\xde\x90\x97\x71\x64\x56\x2f\x11\x2a\x18\xe4\x31\x07\x2a\x61\x91\x69\xd3\x30\xe1\x63\x61\x5a\x5b\xa3\xeb\x4c\xf4\x3b\xfb\x65\x80\x33\x6e\x13\x71\xbd\x40\xde\xa1\x13\x7f\xa3\xe8\x10\xc5\xb1\x35\x80\x77\x1d\xae\x97\x93\x79\xc1\x7f\x91\x72\x76\xe8\xeb\x67\xf6\x1e\xb8\xb3\xca\x4f\xad\xb9\x55\x01\x3e\xbf\xec\x1c\x9e\x5e\xa7\x06\xbe\x65\x5b\x89\x20\xa3\x8f\x45\xc3\x62\xc9\x4c\x4d\x1d\x46\xfb\x60\x0d\x2b\x30\xe4\x19\x99\x36\x24\xeb\xe2\xd6\x9d\xd9\x86\x2a\xb3\x1f\x20\x09\xc1\xde\x19\x9a\x72\x2b\x80\x7a\x0d\x1b\x51\xa3\x02\x73\x96\x58\xbc\x8b\x5d\x87\x5e\x93\x8f\xe3\x61\xc1\xda\xd3\xbf\x7d\x94\xd7\xee\x50\xe3\x6d\x9d\x79\x32\xdf\xe6\x67\x00\xa1\xd3\xca\x65\x89\x01\x00\x00")
+
+func typeSuggestionGqlBytes() ([]byte, error) {
+	return bindataRead(
+		_typeSuggestionGql,
+		"type/suggestion.gql",
+	)
+}
+
+func typeSuggestionGql() (*asset, error) {
+	bytes, err := typeSuggestionGqlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "type/suggestion.gql", size: 393, mode: os.FileMode(420), modTime: time.Unix(1792348933, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
 var _typeText_matchGql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x74\xce\xb1\x8e\x83\x30\x10\x04\xd0\xde\x5f\x31\x88\x9e\x0f\xa0\xbb\xee\x9a\xab\x8e\xee\x74\x85\xe5\x2c\xde\x95\xc0\x58\xf6\x46\x01\x45\xf9\xf7\x28\x10\x3b\x69\xd2\xae\x66\xde\x4e\x8b\x2f\x28\xad\x8a\xd9\xaa\x63\x5c\x44\x59\x02\x2c\x32\xd9\xe4\x18\x89\xf2\x79\xd2\xce\xe8\x16\x09\x03\xad\xfa\xb3\xc7\xae\x06\x68\x31\x30\x21\x47\x72\x32\x8a\x3b\x90\x31\x59\x3f\x53\xd0\xe2\x28\x13\x62\x5a\x22\x25\xdd\x8e\x0f\x74\xc2\x12\x3a\x83\x1a\xed\xf1\xab\x49\x82\x6f\xcc\x6e\x7e\x8b\xe7\x49\x3c\x6b\x7e\x37\x4a\xb5\x94\x1e\x00\xd7\x64\x8f\xbf\x3a\xad\xf6\x9b\xff\xa7\x38\x7c\xde\x50\xce\xaf\x0d\x37\x73\x0f\x00\x00\xff\xff\x85\x02\x5a\x83\x11\x01\x00\x00")
 
 func typeText_matchGqlBytes() ([]byte, error) {
@@ -4213,7 +4927,7 @@ func typeText_match_highlightGql() (*asset, error) {
 	return a, nil
 }
 
-var _typeTopicGql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x94\x54\xc1\x6e\xdb\x3a\x10\xbc\xeb\x2b\x26\xc8\xe5\x3d\xe0\x21\x1f\xa0\xcb\x83\xe3\x14\xa8\x81\xa0\x4d\x1d\xfb\x54\xe4\x40\x8b\x2b\x8b\x85\x44\x0a\xe4\x2a\x86\x51\xf4\xdf\x8b\x5d\x4a\xb6\x9c\x18\x29\x7a\xa3\xb4\xb3\xb3\xc3\xe5\xce\xde\x62\x4d\x7d\xa4\x44\x9e\x13\x0c\x38\xf4\xae\xba\x2b\xf8\xd8\x13\x36\x72\x86\xeb\xfa\x96\x3a\x0d\x17\xc0\x97\x60\xe9\xbf\x02\x78\x26\x13\xab\xc6\xec\x5a\xfd\xda\x7a\x57\x87\xd8\xad\x29\x85\x21\x56\xf4\x18\x2a\xc3\x12\x43\xf1\xb3\x00\x6e\xb1\xb2\xe4\xd9\xd5\x8e\x12\xb8\x21\x58\xc3\x04\xe3\x2d\xd8\x75\x84\x43\x43\x5e\x7f\x87\xdd\x0f\xaa\x18\x07\x93\x50\x45\x32\x4c\xf6\xae\xc0\x74\x5c\x70\x89\x8d\xeb\xe8\xa6\x50\xc6\x8d\xd0\x50\xaa\xa2\xeb\xd9\x05\x8f\x50\x2b\xc5\x28\x1f\xf3\x58\x89\x67\x8e\xce\xef\x35\xd3\xd9\x12\xab\x87\x19\x89\x37\x1d\xbd\xcb\x96\x9f\x17\x69\x19\xfb\x79\xb3\x79\x42\x6f\xb8\x41\x1d\x22\xb8\x71\xe9\x9c\x12\xc7\xbb\x3f\x19\x6e\x4a\x6c\xd7\xab\x31\x6f\x4d\x3c\x44\x2f\xad\x6d\x5d\x62\xad\x24\x29\xd2\x9d\x04\x93\x52\xa8\x9c\x5c\x0f\x07\xc7\xcd\xa5\x88\x33\xee\x9f\x02\x98\x73\x09\x8c\xa6\x47\x71\xb9\x79\xca\xce\x8d\x61\x54\xa1\x23\x98\x9a\x29\x6a\x20\xf5\x54\x49\xeb\x2d\xf6\x6d\xd8\x99\x16\xab\x87\x3b\xe5\x53\xc8\x74\xcb\xe2\xef\x4b\xec\xa8\x0e\x91\x3e\xae\x91\x31\x1f\x15\xa9\x5d\x4c\x0c\x7f\x2e\x26\x83\x74\x2a\x97\x59\x14\x53\x62\xe5\xf9\x1a\x43\x6b\xfe\x48\x20\x90\x8b\xfc\xaf\xd1\x92\x28\x42\xd0\x11\x49\xf9\x41\xcf\x0f\x13\x95\x9f\x2c\xea\x18\x32\x59\x15\xbc\xa7\x4a\xc0\x99\x32\x08\xc3\xfd\xb1\xcc\x2e\x91\x2c\xe5\x9c\x0a\x7c\x1b\x28\x1e\xc1\x01\x49\x8d\x32\xe7\xde\x1d\x33\x43\x8e\xbc\xed\x8d\xcc\x99\xb8\x2f\x5d\x8c\xca\x3c\x9f\x83\xc8\x8b\x8e\x5e\x29\x13\x09\x7c\xa6\x63\x73\xec\xe9\xa6\x00\xfe\x9d\xfd\x5b\x9e\xd4\x8f\x63\x39\x73\xe4\xc9\x7f\x5a\x43\xed\xa7\x2d\x1d\x7a\x3b\x79\x70\x3c\x5e\xf1\xa0\x5a\x62\xbb\x7e\xbc\xe2\x88\x21\xb6\x73\x23\x2c\x4d\xae\xf2\xea\xe8\x40\x71\xa4\xcc\x29\xd9\xf8\xff\x17\x18\x83\x4b\xe3\xb7\x1a\x2e\x71\x1f\x42\x4b\xc6\xdf\x14\xbf\x8a\xe2\x16\x0b\x0f\xb2\xfb\xdc\x20\xad\xb8\x79\xbb\xae\x3e\x49\x78\xb6\xb2\xf4\x3b\x2f\xa1\x05\xaa\x21\xa6\x10\x35\x71\x48\x24\x63\xdd\x9b\xbd\xf3\x66\x7a\xd5\x1c\xbf\x62\x7c\xc7\xd4\xc1\x70\x76\x85\xb7\xd3\xbe\x10\x2d\xba\x2e\x82\x9d\x1e\x60\xd4\x39\x1b\x97\x0f\xc4\x9e\x5f\x65\x2e\x79\xf6\x77\xdc\x9e\x5e\x46\xda\x64\xb2\x00\xe3\xec\x7b\xe9\xbd\xd9\x93\xe0\x4a\x3c\x8d\xa7\x51\xfe\xe2\xb4\x77\x44\x6d\x12\xac\x1e\x4a\x7c\x3f\x35\xec\xe5\x2d\x54\x2e\x94\xa6\x9b\x9d\xa0\x2f\xe7\x86\x70\x60\xd3\xa2\x0a\x83\x57\xbc\xf4\xe7\xb4\x26\x2e\x9d\xa2\xc8\xa5\x00\xd5\x80\xf2\x90\xbf\x03\x00\x00\xff\xff\xd5\x43\xfb\x33\x73\x06\x00\x00")
+var _typeTopicGql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x94\x55\xc1\x8e\xe3\x36\x0c\xbd\xfb\x2b\xde\x60\x2e\x2d\xb0\x98\x0f\xf0\xa5\xc8\xce\xb4\x68\x80\xc5\x76\x9a\x4d\x4e\xc5\x1e\x14\x8b\x8e\x55\xd8\x92\x21\xd2\x13\x04\xc5\xfc\x7b\x41\xc9\xca\x38\xc9\x60\xda\xbd\xc9\x22\xf9\xf8\x48\x91\xcf\xf7\xd8\xd0\x18\x89\xc9\x0b\xc3\x40\xc2\xe8\x9a\x87\x4a\x4e\x23\x61\xab\x67\xb8\x61\xec\x69\x48\xe6\x0a\xf8\x1a\x2c\x7d\xaa\x80\x6f\x64\x62\xd3\x99\x7d\x9f\xbe\x76\xde\xb5\x21\x0e\x1b\xe2\x30\xc5\x86\xbe\x84\xc6\x88\xda\x50\xfd\x53\x01\xf7\xd8\x76\x84\x20\x1d\x45\x78\x33\x10\x43\x3a\x23\x88\xc4\xa1\x7f\x21\x48\x80\x74\x54\x32\x03\xa6\x77\x86\x89\x6b\xfc\xf5\x4d\xa2\xf3\x87\xbb\xef\x77\x55\x42\x59\x5b\xf2\xe2\x5a\x97\x00\x08\xd6\x08\xc1\x78\x0b\x71\x03\xe1\xd8\x91\x4f\xd7\x61\xff\x37\x35\x82\xa3\x61\x34\x91\x8c\x90\x7d\xa8\x50\x8e\x2b\xa9\xb1\x75\x03\xcd\x88\xca\xcb\x12\x37\xd1\x8d\xe2\x82\x47\x68\x2f\xa9\x2c\x6c\x35\x66\x36\x6f\x91\x5a\xcb\x45\x08\xb8\x0b\x47\xaf\x05\x4d\x4c\x91\x1f\xf0\x44\xad\x99\x7a\xe1\x52\xe3\x4d\x44\x4a\xe2\x78\xec\xcd\xe9\xab\x19\xe8\x2a\xc9\xff\x2b\x38\x01\xa5\x7a\x5b\x32\x32\x45\xb2\x9f\xe0\x5a\x38\x81\x63\xc5\x2f\xb7\xa5\x76\x2d\xc0\xd9\x1a\xeb\xa7\x92\x86\x17\x38\xc5\x1b\xfb\x53\xba\x65\xa7\x5d\xb6\x83\xf3\xfc\x8b\x06\xf2\x6f\xb3\x43\x8d\xcf\x21\xf4\x64\xfc\x8c\xa2\x1d\xe9\xc3\x21\xdc\xd4\xa7\x97\x35\x76\x4c\x71\xc5\x4c\xf2\x41\xff\x94\xad\xbf\x6d\xc3\xb6\xd8\xf9\x9a\x11\x3a\xf3\x42\x88\xd4\xeb\xdb\xde\x0c\xd2\x7c\x9f\xc6\x58\xc7\x29\x1d\xce\xd3\xa4\xa8\xbf\x6f\xb7\xcf\x18\x8d\x74\x68\x43\x84\x74\x8e\x97\xd1\x79\x98\x9f\x8d\x74\x35\x76\x9b\xf5\x1c\xb7\x21\x99\xa2\xd7\x5d\xe9\x1d\x4b\xe2\xaf\xb8\x3a\xee\x0c\xc3\x1c\x1a\x97\xd8\x1c\x9d\x74\x97\x7c\xde\xfc\x7e\xaa\x80\x25\x96\xba\x51\xd9\x32\x97\x9f\x35\xa1\xa7\x45\x69\xc2\x40\x30\xad\x50\x4c\x8b\xc2\x23\x35\xba\x05\x16\x87\x3e\xec\x4d\x8f\xf5\x93\xa2\x23\xbb\x94\xde\x55\x3f\x9e\x62\x4f\x6d\x88\xf4\x71\x8e\xec\xf3\x51\x92\xd6\x45\x16\xf8\xb7\x64\xaa\x0c\xe7\x74\x19\x25\xf9\xd4\x58\x7b\x79\x0f\xa1\x37\xff\x09\xd0\x9b\xab\xf8\x3f\xa2\x25\x65\x84\x90\xb6\x35\x05\x2d\x1f\x26\x26\x7c\xb2\x68\x63\xc8\x60\x4d\xf0\x9e\x1a\x75\xce\x9c\x82\x22\x7c\x3e\xd5\x59\xf6\x34\x2a\x61\x96\x04\x7f\x4e\x14\x4f\x3a\x62\x9c\x94\x6f\x89\xbd\x3f\x65\x84\x6c\xb9\xee\x8d\xce\x99\xca\x29\x5f\x8c\xca\x32\x5e\x02\x22\x49\x74\xf4\x42\x19\x48\xdd\x17\x3c\xb6\xa7\x91\xee\x2a\xe0\xe7\xc5\xdd\xe3\x99\xfd\xad\x56\xbc\xa3\x0c\xda\x2f\x4c\xa3\x2d\x72\x38\x1f\xdf\x91\xc3\xb4\x12\xbb\xcd\x97\x77\x36\x62\x8a\xfd\x72\x11\x1e\x4d\x9e\xa2\x17\x47\x47\x8a\x33\x7a\x0e\xc9\x1a\xac\x7a\x91\x8d\x8f\xc6\xef\x92\x79\x21\x1a\xaf\x55\x75\x8f\x95\x07\xd9\x43\x6e\x50\xca\xb8\xbd\xfe\xff\xfc\xaa\xe6\xc5\x3f\x28\x7d\xe7\xbf\xca\x0a\xcd\x14\x39\xc4\x14\x38\x31\xe9\x58\x8f\xe6\xe0\xbc\x29\xaf\x9a\xed\xe5\x45\x16\x55\x3a\xa1\x01\x46\x12\x7f\xf2\xb6\xa8\x90\x72\xd1\x38\x1f\x6c\x79\x80\x99\xe7\x62\x5c\x3e\x20\xfb\xf6\x2a\x4b\xca\x8b\xdb\x4c\x7c\xed\x75\xa4\x13\x4b\x9d\x28\xe3\xec\x2d\xf5\xd1\x1c\x48\xfd\x6a\x3c\xcf\xa7\x99\xfe\xea\xac\x3b\xca\x36\x29\x7c\x3a\x14\x81\xd3\x06\x7d\xbf\x76\xd5\x82\xb8\x54\x76\xd6\xc2\xd9\x2d\x4d\x68\x10\xd3\xa3\x09\x93\x4f\xd0\xda\x9f\xb3\x4c\x5c\x6e\x4a\xf2\x7c\x54\xc7\x1a\x6b\x2f\x77\xd5\x6b\xf5\xef\x00\xf4\xd6\x53\x29\x44\x08\x00\x00")
 
 func typeTopicGqlBytes() ([]byte, error) {
 	return bindataRead(
@@ -4228,7 +4942,7 @@ func typeTopicGql() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "type/topic.gql", size: 1651, mode: os.FileMode(420), modTime: time.Unix(1542329263, 0)}
+	info := bindataFileInfo{name: "type/topic.gql", size: 2116, mode: os.FileMode(420), modTime: time.Unix(1792350759, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
@@ -4313,7 +5027,7 @@ func typeUpdate_topics_payloadGql() (*asset, error) {
 	return a, nil
 }
 
-var _typeUserGql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x5a\x4b\x8f\xe3\xb6\x0f\xbf\xfb\x53\x70\xb1\x87\xff\xbf\xc0\x60\x3e\x40\x6e\xd9\xd9\x59\x6c\x80\xe9\x74\x9a\x49\xda\x43\xb1\x07\xc5\xa6\x63\x15\xb6\x64\x48\x74\x82\x60\xd1\xef\x5e\x50\x0f\x5b\x79\x36\x46\x81\x62\x1e\x7b\x8a\x42\x91\x14\x1f\x3f\x51\x94\x92\x8f\x30\xc7\xd6\xa0\x45\x45\x16\x04\x74\x16\xcd\x6d\x46\xbb\x16\x61\x69\xd1\x80\x6c\xda\x1a\x1b\x37\x99\x01\xdc\x2b\xa3\xeb\x5a\xac\x6a\xbc\xc9\x00\x1e\x75\xe1\x3e\x9f\x51\x98\xbc\x8a\xd4\xa5\x92\xa5\x36\xcd\x1c\xad\xee\x4c\x8e\x0f\x3a\x17\xc4\x73\x90\x7d\xcf\x00\x3e\xc2\xac\x40\x45\xb2\x94\x68\x81\x2a\x84\x42\x10\x82\x50\x05\x90\x6c\x10\xb6\x15\x2a\x47\x66\x3b\xfe\x67\x41\xe4\xb9\xee\x14\xc1\x56\x58\xa8\x85\x25\xe8\x5a\x16\x28\x6e\x33\x88\x73\x4b\x4f\x99\xd2\x04\x16\xb2\xc1\x0f\x99\x5b\x65\x0a\xb5\xb4\x04\xba\x04\x91\x93\xdc\x48\xf2\xeb\x09\xea\xb5\x83\xde\x2a\xeb\xf5\x44\x86\xff\x67\x00\x2c\x3c\x47\xea\x8c\xf2\xf6\x61\x74\x5f\x7a\xc3\x9c\x5a\xa7\x28\xd7\x0d\x82\x28\x09\x8d\x9b\xb0\x2d\xe6\xec\x56\x01\xeb\x5a\xaf\x44\x0d\xb3\xcf\xb7\x4e\x9f\x63\x99\xc0\x33\x19\xa9\xd6\xd9\xf8\x25\x56\x58\x6a\x83\x97\xd7\xf0\x3c\x87\x8b\x7c\x91\x35\x21\x13\x40\xb7\x24\xb5\xb2\x50\x6a\x93\x06\xc4\x38\x2b\xb0\x80\xd2\xe8\xc6\xad\x90\x6b\xa5\x30\x67\x66\xaf\xb8\x74\x2a\x3e\xed\x26\x30\xf5\x62\x3b\xaf\xd4\x9e\x72\xa4\x94\xc6\x12\xa8\xc1\x21\x06\x42\xef\x52\x54\x68\x2c\x4d\x60\xa6\xe8\x94\x06\x97\xe3\xcb\x0a\x98\x65\x4f\xfe\x17\x53\xfc\x4b\x27\x35\x6b\x48\x7d\x74\x2a\x33\x80\x9f\x06\xd2\x5d\x2f\x73\x8c\xb0\xb6\xad\x91\x21\x6e\x07\x70\x55\xc2\x7a\xba\x87\xaa\x1b\xbd\x35\x78\xbd\xd4\xd4\x0f\xe9\x18\x93\xfa\x28\xe5\x73\x1f\x16\xf8\xb5\x43\xb3\x03\xd2\x60\x5d\x8d\x0b\x89\xb4\xb0\xda\x79\x71\x4f\x3e\x0c\xcc\xa2\x42\xe0\x12\x6a\xf7\xd0\x01\x92\xb0\xb1\xac\xcc\x20\x19\x89\x1b\xf4\x3a\x98\x33\x59\x7f\xb1\x6b\xf1\x43\xc0\x5e\xa4\x1d\x81\x2f\x06\x4e\x0c\x20\xb4\x16\xe9\x6c\x89\x73\x93\x6f\x0d\x7f\xa7\xcb\x9b\x73\x3c\x44\x63\x4c\x7d\xe3\xe3\x6e\xca\x62\xaf\xa4\xc0\x5d\xeb\x61\x8f\xf0\xde\xc1\xa4\xba\xf5\xb4\x23\x84\x2d\x86\x43\xb8\xed\x56\xb5\xcc\xa1\x35\xba\x94\x35\xc2\x4a\x6a\xd6\xbc\x92\x3a\x26\xe4\x1a\x11\x10\x16\xbe\x2e\x7e\x7e\x08\xa2\x3c\x9c\x38\x42\x10\xbe\xae\x2d\xd0\xab\x3f\x31\xf7\xed\x40\x6e\x30\x76\x02\x61\x78\xb6\x05\xc8\x75\x67\xec\xd9\xf3\x3f\xcc\xbe\x8f\xdd\x11\x43\x31\x66\x67\xdc\x39\x99\xd7\xb1\x2d\xae\xf7\xaf\xdf\x17\xde\xbd\x64\x53\x78\xc2\x88\x1d\x81\x8d\x90\x35\x6b\x75\x83\x09\xdc\xf3\xc7\xb9\x42\x9d\x74\xb7\x8e\xdd\xf6\x82\xef\x04\x82\xde\xd7\x51\x08\x74\x01\x7d\x39\x00\x74\x28\x71\x36\x5d\xe8\x0a\xb1\xbf\x2b\x25\x6d\xa1\xb4\x81\x8e\x05\x48\xe7\x66\xfc\xfa\xd6\x72\xff\x42\xeb\x43\x9a\x95\x11\x35\x62\xb8\xf8\x5e\x6c\x0f\x63\x32\x47\x35\x88\x7b\x40\x19\x9a\xc3\xa4\x37\x1c\x56\x4f\x9a\xc3\x81\x78\x01\x84\x0c\x3a\x0b\xdb\x4a\x43\x25\x36\x98\x62\x0f\xa8\xea\xc1\xc8\x4a\x12\x2c\xbe\x97\xb3\xd0\x07\x67\x6c\x8f\xf8\x72\xca\xd0\x25\x9c\x5f\xeb\xdb\x01\xc2\x31\x3d\x07\x23\xe9\x08\x5f\x33\x6f\xec\x46\xe2\x16\x0d\x14\xd2\x36\xd2\x5a\x2c\x6e\x7a\x7c\xdd\x80\x36\x20\xd7\x4a\x3b\xbb\xce\x22\x8d\x7d\x7c\x26\x41\x9d\x8d\x8b\x0d\x14\xb7\x94\x2c\x26\x30\xfb\x1c\x56\xfd\xbd\x42\xaa\xb8\x6f\x33\xa0\x34\xed\x5f\xb5\x37\x68\x3c\x1c\xa8\x42\x69\xe2\xd3\x10\xaf\x24\xed\x6f\x61\x6e\x02\x9f\xb4\xae\x51\xa8\x73\xfa\xa4\xed\x8b\xb4\x00\x2b\xb9\x01\x2d\x1a\xa9\xa4\x25\x23\x48\x1b\xaf\xed\x59\x12\x4e\x99\x3c\x46\x5d\x8c\x16\x47\xc3\xbf\xb0\x39\xc3\x5c\xfc\x0e\xf5\x1c\x77\x0c\x35\x5a\xab\xd5\xb9\xf6\x35\xcc\xbe\x8f\x2d\x1b\x43\x31\x66\xd3\x3e\x38\x99\xd7\xb1\x6d\xaf\xf7\xaf\xdf\xb8\xde\xbd\x64\xdb\x7a\xc2\xd9\xf6\x55\x89\xc6\x0d\x0a\x3e\x6c\x6a\xbd\xf6\x6d\x88\x1b\x5c\x7d\x9f\x63\x1d\x2c\xc5\x9f\x07\x42\xc7\xf0\x55\x9a\xef\x75\xb9\x18\xbc\x8c\x18\x76\x2a\xd2\xd9\xb7\x06\xe2\x17\x8a\xb2\xfd\x8c\x8c\xc0\xda\x63\x22\x98\x20\x2e\x25\x1f\x1f\x16\x63\xde\xfb\x23\xc0\x4e\xbd\xf7\x87\xb9\xd3\xef\xfd\xc7\xb0\xc3\x8d\x0b\xd2\x7e\xd1\xe4\xb3\xc2\x60\x8e\x72\xe3\x75\xc6\x31\x6b\xaa\xa5\xc2\x1f\x00\xfc\x6f\xba\x70\x9f\x9b\x31\xed\x09\x4b\x1c\x3c\x5c\xcd\x0f\x92\x77\xb2\xe4\x7d\x5d\x2c\x9e\xa0\x15\x54\x85\xca\x13\x4e\x65\x9f\x7c\xff\x13\xd5\x93\xa0\x6a\x02\xcb\xf9\x2c\x88\x7d\x91\xaa\x00\x4b\x5d\xb1\x83\xd5\x0e\x24\xd9\xbe\xde\x39\x62\xc4\xc8\x23\x17\x52\x5d\x06\x4e\xd2\x50\x4a\x55\x78\xb3\xf7\xeb\xa2\x33\xf8\x99\xb9\x0e\xdb\x73\x16\x3d\xff\xc3\x54\x98\x7d\x6b\x98\x3c\x7d\xb2\xc7\x50\x8c\x39\xd9\x5d\x4c\x5f\xc7\xc1\x7e\xbd\x7b\x3d\xe4\x9d\x77\x09\xe4\xdd\xf7\x2b\x7e\x09\x08\x9b\x4b\x58\xab\x73\xc9\xa5\x12\xb6\x92\xaa\xbd\x43\x97\x7e\x94\xbb\xd7\x57\xee\xfe\xb9\xcc\x2d\xe7\x0f\xc7\x55\xae\x33\x75\x5a\xdc\xee\x84\x4a\x2f\x6f\xfe\x22\x76\xe6\x3d\xc0\xf3\xdc\x09\xe5\x2f\x67\xc9\x4d\xe5\xaf\x2c\xfb\x08\x53\x05\x58\xac\xfd\x4b\x86\x5b\x77\xb9\xff\xe7\x81\x7b\x9e\x4c\xfe\x40\xe0\xbe\x7f\x0f\x25\x30\xef\x8c\xd5\x26\xde\x55\xd9\x80\x56\xac\xa5\x12\x31\x18\x7e\xfe\x44\x47\x2a\x09\x1b\x08\xe5\x12\x55\x11\x9f\x54\xd9\x12\xdf\x4f\x16\xe8\xe3\x15\xad\x4c\xa2\x7c\xd6\xd4\x21\xa6\xa9\xc1\x09\x35\xfc\x77\x41\x31\x06\x84\x57\xa5\x41\xc8\xe2\xd8\xf0\x56\xac\x91\xf9\x26\xf0\x14\x46\xc7\x4f\x83\xc5\x1a\xfd\xb3\x2f\x0f\x26\xf0\x47\x8c\xd6\xb7\x43\x4e\xf6\xc6\x46\xb7\x22\xe7\xb7\x21\x18\xa4\x49\xd4\xe0\xff\x23\xa1\xcb\xf0\x0b\x63\xd8\x55\xfb\xe0\x72\x9c\x77\xcc\xe8\xf0\xca\xc1\xf9\x3b\x00\x00\xff\xff\x39\x3c\x22\xf9\xfb\x21\x00\x00")
+var _typeUserGql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x5a\xdd\x6e\x1b\xb9\x0e\xbe\xf7\x53\x30\xc8\x45\xcf\x01\x82\x3e\x80\x6f\x0e\xd2\x34\x45\x0c\xe4\xb4\x3d\x89\xd3\x5e\x1c\x2c\x50\x79\xc4\xc9\x68\x2b\x4b\xb3\xa2\xc6\x81\x51\xf4\xdd\x17\xd4\xcf\x8c\xec\xb1\xd3\x78\x77\xb1\xdb\xfc\x5c\x65\x42\x91\x14\x7f\xbe\x21\x29\x8d\x8f\xe1\x0a\x5b\x87\x84\xc6\x13\x08\xe8\x08\xdd\xeb\x89\x5f\xb7\x08\x37\x84\x0e\xd4\xb2\xd5\xb8\x0c\x8b\x13\x80\x73\xe3\xac\xd6\x62\xa1\xf1\x64\x02\xf0\xde\xca\xf0\xf7\x1a\x85\xab\x9a\x4c\xbd\x31\xaa\xb6\x6e\x79\x85\x64\x3b\x57\xe1\xa5\xad\x84\xe7\x35\x98\x7c\x9b\x00\x1c\xc3\x4c\xa2\xf1\xaa\x56\x48\xe0\x1b\x04\x29\x3c\x82\x30\x12\xbc\x5a\x22\xdc\x35\x68\x02\x99\xed\x78\x45\x20\xaa\xca\x76\xc6\xc3\x9d\x20\xd0\x82\x3c\x74\x2d\x0b\xc8\xd7\x13\xc8\x6b\x37\x91\x72\xea\xa7\x30\x57\x4b\x3c\x9a\x84\x5d\x4e\x41\x2b\xf2\x60\x6b\x10\x95\x57\x2b\xe5\xe3\x7e\xc2\xf7\xda\xc1\xde\x19\x8a\x7a\x32\xc3\xbf\x26\x00\x2c\x7c\x85\xbe\x73\x86\xf9\x11\x30\xbb\xaf\xa2\x61\x41\x6d\x50\x54\xd9\x25\x82\xa8\x3d\xba\xb0\x40\x2d\x56\xec\x96\x84\x5b\x6d\x17\x42\xc3\xec\x2d\x6b\x87\xc8\x32\x85\x6b\xef\x94\xb9\x9d\x1c\xbe\xc5\x02\x6b\xeb\xf0\xfe\x3d\x22\xcf\xf6\x26\xef\x94\xf6\xc8\x04\xb0\xad\x57\xd6\x10\xd4\xd6\x95\x01\x71\xc1\x51\x94\x50\x3b\xbb\x0c\x3b\x54\xd6\x18\xac\x98\x39\x2a\xae\x83\x8a\x37\xeb\x29\x9c\x46\xb1\x75\x54\x4a\xbb\x1c\xa9\x95\x23\x0f\x66\x70\x88\x81\xd0\xbb\x94\x15\x3a\xf2\x53\x98\x19\xbf\x4b\x83\x16\x3f\x54\xa0\xc5\x96\xfc\x07\x27\xff\xa4\x93\xd6\xc9\x4d\x1f\x83\xca\x09\xc0\xbf\x07\xd2\x59\x2f\x33\x46\x58\xdb\x6a\x64\x88\xd3\x00\xae\x46\x50\xa4\x47\xa8\x86\xa7\xa7\x06\xaf\x9f\x35\xf5\x43\x3a\x0e\x49\x7d\x96\x0a\x3a\xf3\x06\xff\xeb\xd0\xad\xc1\x5b\xa0\x50\xe3\x52\x22\x09\x16\xeb\x28\x1e\xc9\xdb\x81\x99\x37\x08\x5c\x42\x69\x03\x1d\xa0\x3c\x2e\x89\x95\x39\xf4\x4e\xe1\x0a\xa3\x0e\xe6\x2c\xf6\x9f\xaf\x5b\x3c\x4a\xd8\xcb\xb4\x11\xf8\x72\xe0\xc4\x00\x42\x22\xf4\x7b\x4b\x5c\x58\x7c\x1e\xe5\x2d\xbc\x7e\x29\x1a\x87\xd4\x37\x6e\x77\xa7\x2c\xf6\x48\x0a\xdc\x43\x3d\xec\x11\xde\x3b\x58\x54\xb7\x9e\x36\x42\xd8\x3c\x61\xe8\x15\x41\xdb\x2d\xb4\xaa\xa0\x75\xb6\x56\x1a\x61\xa1\x2c\x6b\x5e\x28\x9b\x13\xf2\x10\x11\x10\x04\x17\xf3\xff\x5e\x26\x51\x7e\x9c\x06\xc2\xa8\x9c\xf2\xae\x5b\x95\x74\xa1\x6d\xf5\x15\xe5\x6b\xf8\x60\xf4\x1a\x56\x8a\x14\xbf\x4f\xde\xf6\x5c\x41\x6b\x64\x62\x97\x9e\x49\x27\xe7\xf8\x1c\x0e\xf2\xc7\x81\xef\x87\xfa\xd6\xc3\xfb\xcd\x90\xfe\x02\xe0\x05\x75\x04\xf1\x87\x4d\xa2\x76\xf1\x2b\x56\x71\x02\xad\x1c\xe6\xe1\x33\x3d\xee\x9d\x3a\x2b\xdb\x39\xda\x3b\x72\xa6\xd5\xe7\x81\xd2\x1c\x8a\x43\x70\x7a\x16\x64\x1e\x07\x52\x1f\xee\x5f\x8f\xd5\xe8\x5e\x01\xd3\x48\x18\x21\xf4\x73\x06\xe1\xd6\x71\x48\x11\x50\xd5\xa0\xec\x34\x4a\x1e\x28\x16\x08\x12\x35\x7a\x94\x27\xa0\xea\x01\x6f\x82\xbe\x06\x86\xa0\x2c\x72\x80\xf2\xf7\x97\xd1\xc0\xa6\xac\xb9\xce\x1b\xbc\xb3\x2e\x82\xfc\x47\x55\x1e\x97\x42\x69\x56\x11\x1e\xa6\x70\xce\x7f\xf6\xcd\x2b\x79\xcb\x57\x14\xd9\xa9\x17\x7c\x26\xc5\x3b\x7a\x7d\xd0\x5b\x11\x02\xfa\xf3\xbc\x14\x01\xb9\xc1\xa6\x11\x70\x87\x42\x88\xfd\x95\x41\xd1\xd3\x15\x25\x3a\x4a\x50\xc1\xcd\xfc\xef\x53\xcb\xfd\x3f\x98\x9e\xfb\x6a\x56\x99\x95\x03\xea\xd6\x70\xff\x73\xef\x29\x29\x27\xf3\xa0\x73\xd2\x06\x50\x86\x33\x52\x71\x44\x1a\x76\x2f\xce\x48\x03\xf1\x1e\x10\x32\xe8\x08\xee\x1a\x0b\x8d\x58\x61\x89\x3d\xf0\x4d\x0f\x46\x56\x52\x60\x11\x5f\xa6\xc8\xe7\x37\x45\x9e\xa7\xdc\x17\xbd\x39\x93\x46\xf8\x9a\x45\x44\xac\x14\xde\xa1\x03\xa9\x68\xa9\x88\xb8\x05\x67\x7c\x9d\x80\x75\xa0\x6e\x8d\xe5\x24\xec\x47\x1a\x07\xe9\xda\x0b\xdf\x51\xde\x6c\xa0\x1c\x8d\x9a\x2e\x61\xe5\xd0\x83\xb7\x5f\xd1\x70\x60\xc0\xa1\x90\x51\x3f\x42\x8d\xfc\xd6\xc5\xf6\xaa\x1c\xb4\x4e\xad\x78\xaa\x25\xdf\x49\x85\xc4\xd7\xb3\xc7\xd0\x0a\xb6\x92\xcf\x63\x2c\xf1\x25\x28\xfa\x02\xbf\x85\x77\xb8\x15\x4e\x2c\x91\x7b\x29\x5f\x29\x04\x75\x70\x73\x75\xb9\x73\x62\x08\xca\x38\xc0\x27\xe1\xfe\xd6\x74\x5a\x43\x67\xbc\xd2\xc0\xd7\xc9\x3e\xd8\x36\xc0\x81\x87\x6a\x76\x99\x55\xce\x79\xcb\x12\x9c\x4a\x4e\x61\xf6\x36\xf9\xfa\xb9\x41\xdf\xb0\x05\x0e\x8c\x2d\x86\x67\xbe\x52\x5b\xa1\x8b\x8d\x37\xfa\x97\xe6\x21\xd6\xab\xe8\x53\x5a\x9b\xc2\x1b\x6b\x35\x0a\xb3\x4f\x9f\xa2\xbe\x0b\x09\x20\xc5\xf7\xcf\x72\xa9\x8c\x22\xef\x84\xb7\x2e\x6a\xbb\x56\x1e\x4f\x99\x7c\x88\xba\x0c\x07\x4e\x47\x9e\xa7\x14\x7d\x0a\x00\xd9\xd6\x33\x1e\x89\x34\x12\x59\xb3\xef\xcc\x90\x56\x9f\x47\x4d\xca\xa1\x38\xa4\x2a\x5d\x06\x99\xc7\x51\x97\x1e\xee\x5f\x5f\x99\xa2\x7b\x45\x5d\x8a\x84\x51\x55\xca\xa5\xc2\x88\x65\x78\xe0\xf9\x1f\xb4\xbd\x8d\x73\x56\x78\xc8\x39\x38\xfa\xd1\x44\xcf\x3a\x58\x8a\xff\x6e\x09\x8d\xe1\x6b\x2c\x1f\xa6\x2b\x31\x78\x99\x31\x1c\x54\x94\xab\x4f\x0d\xc4\x3f\x29\xca\x36\x33\x72\x00\xd6\xde\x17\x82\x05\xe2\x4a\xf2\x1f\xbc\x4d\xc9\x38\x4b\x00\xdb\xf5\x5d\x2f\xad\xed\xfe\xae\x37\x86\x1d\xae\x42\x94\x37\x8b\x26\xf7\x0a\x87\x15\xaa\x55\xd4\x99\x9f\xf9\x18\xab\x95\xc1\x17\x00\xfe\x2d\x00\x4c\xb9\x39\x00\x79\xe7\x2c\x51\x40\x8e\x47\xcd\xab\xad\xe4\xdd\x33\xe8\xa7\xfa\x95\xe6\x1d\x70\x58\xd9\xe5\x12\x8d\x0c\xb7\x20\x3d\x3c\x4e\x82\x71\xd4\x88\x60\xb0\xb7\xad\xaa\x88\xb1\x1a\x94\xf5\xd3\x3f\xdc\x29\xdf\x04\x99\xac\x6e\x03\x5e\xe1\xbb\x8e\x84\xfe\x30\x15\x8f\xb2\x5c\x4c\x83\x9a\x7e\xeb\xf4\xf6\x09\x87\xe0\xb0\x76\x48\x0d\x4a\x68\xd1\x29\x2b\x55\x25\xb4\x5e\xef\x1c\xae\xfa\xba\x59\xb8\x70\x1d\xcd\x78\xc1\xee\x5f\x86\xdd\x00\x31\x0e\xeb\xf8\xf3\x2e\xb7\xd1\x8b\xf9\xfc\x23\xb4\xc2\x37\xa9\x9b\xa5\x49\x8f\xcd\x70\xe9\xe7\x0d\x1f\x85\x6f\xa6\x70\x73\x35\x4b\x62\xef\x94\x91\x01\x2f\x6b\x58\xac\x41\x79\xea\x7b\x68\x20\xe6\xdc\xbd\xe7\xe6\x6c\xeb\xc4\xe9\x2d\xd4\xca\x84\x42\xb5\xdd\x6b\x07\x0b\xb7\xa1\x3e\x80\x72\xd7\xb4\x48\x4f\x13\x2b\xbb\xa7\xc5\x1c\x8a\x43\xa6\xc5\x90\xf5\xc7\x31\x2c\x3e\xdc\xbd\xbe\x8c\x06\xef\x8a\x32\xba\x1b\xe3\x7b\x9b\xa9\x20\xb2\x95\xe2\x86\x3c\x14\xc1\x8c\x7c\xff\xd2\x42\x1f\x5f\x0b\xdd\xdb\x3a\xfb\x32\x77\x73\x75\x39\xae\x72\x9d\xd3\x65\x71\x3b\x13\xa6\xbc\xf1\x88\x7d\x6f\xcf\x25\x5a\xe4\x39\x13\x26\xde\x68\x6c\x9f\x7e\x2f\x04\x95\xaa\xd2\x07\xde\x61\xf7\xff\xf4\x2a\x2e\x04\xa5\x8f\x78\x85\x8e\xef\x93\xc9\x31\x9c\x1a\x40\x79\x1b\xaf\x10\x83\xed\x37\x9b\x3f\x5e\x3b\xe7\xc5\xe2\x07\x6c\xe1\xff\x6f\xa9\x8c\x56\x9d\x23\xeb\xf2\x25\x11\x3b\xd1\x8a\x5b\x65\x44\x0e\x68\x5c\xcf\x20\x49\x56\x73\xb4\xf8\x57\x1c\x90\x4a\x2e\x1a\x99\xbf\x65\xb0\x25\x1c\x32\x63\x25\xc6\x1b\xb2\x6c\x65\x91\xa9\xbd\xa6\x0e\x79\x29\x0d\x2e\xa8\xe9\xb7\x73\x86\x71\x14\x6c\xe4\x39\x41\x28\x39\x36\xbc\x15\xb7\x38\x33\xb5\x9d\xc2\xc7\xf4\x74\xb4\xdd\x3a\xd8\xd6\xf8\xbd\x85\x1f\xa6\xf0\xff\x1c\xad\x5f\xb6\x39\xd9\x1b\xca\x6e\x65\xce\xc4\xc5\xc1\xf0\xd6\x0b\x0d\xe1\x12\x86\xd9\x39\x36\xfd\x9b\xb9\x09\xd0\xc0\x79\xc6\x8c\x53\x98\x19\x7f\x34\xf9\x3e\xf9\x7d\x00\x7b\xd2\x97\x4a\x7b\x28\x00\x00")
 
 func typeUserGqlBytes() ([]byte, error) {
 	return bindataRead(
@@ -4328,7 +5042,7 @@ func typeUserGql() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "type/user.gql", size: 8699, mode: os.FileMode(420), modTime: time.Unix(1546281710, 0)}
+	info := bindataFileInfo{name: "type/user.gql", size: 10363, mode: os.FileMode(420), modTime: time.Unix(1792350052, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
@@ -4449,7 +5163,10 @@ var _bindata = map[string]func() (*asset, error){
 	"enum/apple_giver_order_field.gql": enumApple_giver_order_fieldGql,
 	"enum/appleable_order_field.gql": enumAppleable_order_fieldGql,
 	"enum/appleable_type.gql": enumAppleable_typeGql,
+	"enum/blocked_user_order_field.gql": enumBlocked_user_order_fieldGql,
 	"enum/comment_order_field.gql": enumComment_order_fieldGql,
+	"enum/content_report_reason.gql": enumContent_report_reasonGql,
+	"enum/content_report_status.gql": enumContent_report_statusGql,
 	"enum/course_order_field.gql": enumCourse_order_fieldGql,
 	"enum/course_status.gql": enumCourse_statusGql,
 	"enum/email_type.gql": enumEmail_typeGql,
@@ -4462,17 +5179,24 @@ var _bindata = map[string]func() (*asset, error){
 	"enum/labelable_order_field.gql": enumLabelable_order_fieldGql,
 	"enum/labelable_type.gql": enumLabelable_typeGql,
 	"enum/lesson_order_field.gql": enumLesson_order_fieldGql,
+	"enum/moderation_action_type.gql": enumModeration_action_typeGql,
 	"enum/notification_order_field.gql": enumNotification_order_fieldGql,
 	"enum/order_direction.gql": enumOrder_directionGql,
 	"enum/ref_order_field.gql": enumRef_order_fieldGql,
+	"enum/search_language.gql": enumSearch_languageGql,
+	"enum/search_mode.gql": enumSearch_modeGql,
 	"enum/search_order_field.gql": enumSearch_order_fieldGql,
 	"enum/search_type.gql": enumSearch_typeGql,
+	"enum/study_access_level.gql": enumStudy_access_levelGql,
 	"enum/study_order_field.gql": enumStudy_order_fieldGql,
+	"enum/suggestion_type.gql": enumSuggestion_typeGql,
 	"enum/topic_order_field.gql": enumTopic_order_fieldGql,
 	"enum/topicable_order_field.gql": enumTopicable_order_fieldGql,
 	"enum/topicable_type.gql": enumTopicable_typeGql,
+	"enum/trending_window.gql": enumTrending_windowGql,
 	"enum/user_asset_order_field.gql": enumUser_asset_order_fieldGql,
 	"enum/user_order_field.gql": enumUser_order_fieldGql,
+	"input/accept_study_invitation.gql": inputAccept_study_invitationGql,
 	"input/activity_filters.gql": inputActivity_filtersGql,
 	"input/activity_order.gql": inputActivity_orderGql,
 	"input/add_activity_asset.gql": inputAdd_activity_assetGql,
@@ -4480,8 +5204,13 @@ var _bindata = map[string]func() (*asset, error){
 	"input/add_course_lesson.gql": inputAdd_course_lessonGql,
 	"input/add_email.gql": inputAdd_emailGql,
 	"input/add_label.gql": inputAdd_labelGql,
+	"input/add_lesson_prerequisite.gql": inputAdd_lesson_prerequisiteGql,
+	"input/add_topic_alias.gql": inputAdd_topic_aliasGql,
 	"input/apple_giver_order.gql": inputApple_giver_orderGql,
 	"input/appleable_order.gql": inputAppleable_orderGql,
+	"input/block_user.gql": inputBlock_userGql,
+	"input/blocked_user_order.gql": inputBlocked_user_orderGql,
+	"input/cancel_scheduled_publish.gql": inputCancel_scheduled_publishGql,
 	"input/comment_order.gql": inputComment_orderGql,
 	"input/course_filters.gql": inputCourse_filtersGql,
 	"input/course_order.gql": inputCourse_orderGql,
@@ -4490,6 +5219,7 @@ var _bindata = map[string]func() (*asset, error){
 	"input/create_label.gql": inputCreate_labelGql,
 	"input/create_lesson.gql": inputCreate_lessonGql,
 	"input/create_study.gql": inputCreate_studyGql,
+	"input/create_study_invitation.gql": inputCreate_study_invitationGql,
 	"input/create_user.gql": inputCreate_userGql,
 	"input/create_user_asset.gql": inputCreate_user_assetGql,
 	"input/delete_activity.gql": inputDelete_activityGql,
@@ -4514,6 +5244,8 @@ var _bindata = map[string]func() (*asset, error){
 	"input/login_user.gql": inputLogin_userGql,
 	"input/mark_all_study_notification_as_read.gql": inputMark_all_study_notification_as_readGql,
 	"input/mark_notification_as_read.gql": inputMark_notification_as_readGql,
+	"input/merge_topics.gql": inputMerge_topicsGql,
+	"input/moderate_content.gql": inputModerate_contentGql,
 	"input/move_activity_asset.gql": inputMove_activity_assetGql,
 	"input/move_course_lesson.gql": inputMove_course_lessonGql,
 	"input/notification_order.gql": inputNotification_orderGql,
@@ -4524,11 +5256,16 @@ var _bindata = map[string]func() (*asset, error){
 	"input/remove_activity_asset.gql": inputRemove_activity_assetGql,
 	"input/remove_course_lesson.gql": inputRemove_course_lessonGql,
 	"input/remove_label.gql": inputRemove_labelGql,
+	"input/remove_lesson_prerequisite.gql": inputRemove_lesson_prerequisiteGql,
+	"input/remove_topic_alias.gql": inputRemove_topic_aliasGql,
+	"input/report_content.gql": inputReport_contentGql,
 	"input/request_email_verification.gql": inputRequest_email_verificationGql,
 	"input/request_password_reset.gql": inputRequest_password_resetGql,
 	"input/reset_comment_draft.gql": inputReset_comment_draftGql,
 	"input/reset_lesson_draft.gql": inputReset_lesson_draftGql,
 	"input/reset_password.gql": inputReset_passwordGql,
+	"input/revoke_study_access.gql": inputRevoke_study_accessGql,
+	"input/revoke_study_invitation.gql": inputRevoke_study_invitationGql,
 	"input/search_order.gql": inputSearch_orderGql,
 	"input/study_filters.gql": inputStudy_filtersGql,
 	"input/study_order.gql": inputStudy_orderGql,
@@ -4536,6 +5273,7 @@ var _bindata = map[string]func() (*asset, error){
 	"input/topic_filters.gql": inputTopic_filtersGql,
 	"input/topic_order.gql": inputTopic_orderGql,
 	"input/topicable_order.gql": inputTopicable_orderGql,
+	"input/unblock_user.gql": inputUnblock_userGql,
 	"input/update_activity.gql": inputUpdate_activityGql,
 	"input/update_comment.gql": inputUpdate_commentGql,
 	"input/update_course.gql": inputUpdate_courseGql,
@@ -4587,13 +5325,16 @@ var _bindata = map[string]func() (*asset, error){
 	"type/add_course_lesson_payload.gql": typeAdd_course_lesson_payloadGql,
 	"type/add_email_payload.gql": typeAdd_email_payloadGql,
 	"type/add_label_payload.gql": typeAdd_label_payloadGql,
+	"type/add_lesson_prerequisite_payload.gql": typeAdd_lesson_prerequisite_payloadGql,
 	"type/added_to_activity_event.gql": typeAdded_to_activity_eventGql,
 	"type/added_to_course_event.gql": typeAdded_to_course_eventGql,
 	"type/apple_giver_connection.gql": typeApple_giver_connectionGql,
 	"type/appleable_connection.gql": typeAppleable_connectionGql,
 	"type/appled_event.gql": typeAppled_eventGql,
+	"type/blocked_user_connection.gql": typeBlocked_user_connectionGql,
 	"type/comment.gql": typeCommentGql,
 	"type/comment_draft_backup.gql": typeComment_draft_backupGql,
+	"type/content_report.gql": typeContent_reportGql,
 	"type/course.gql": typeCourseGql,
 	"type/create_activity_payload.gql": typeCreate_activity_payloadGql,
 	"type/create_course_payload.gql": typeCreate_course_payloadGql,
@@ -4621,25 +5362,32 @@ var _bindata = map[string]func() (*asset, error){
 	"type/labeled_event.gql": typeLabeled_eventGql,
 	"type/lesson.gql": typeLessonGql,
 	"type/lesson_draft_backup.gql": typeLesson_draft_backupGql,
+	"type/lesson_graph.gql": typeLesson_graphGql,
 	"type/lesson_timeline_event.gql": typeLesson_timeline_eventGql,
+	"type/lesson_toc_entry.gql": typeLesson_toc_entryGql,
 	"type/login_user_payload.gql": typeLogin_user_payloadGql,
 	"type/logout_user_payload.gql": typeLogout_user_payloadGql,
+	"type/moderation_action.gql": typeModeration_actionGql,
 	"type/move_activity_asset_payload.gql": typeMove_activity_asset_payloadGql,
 	"type/move_course_lesson_payload.gql": typeMove_course_lesson_payloadGql,
 	"type/notification.gql": typeNotificationGql,
 	"type/page_info.gql": typePage_infoGql,
+	"type/parsed_search_query.gql": typeParsed_search_queryGql,
 	"type/password_reset_token.gql": typePassword_reset_tokenGql,
 	"type/published_event.gql": typePublished_eventGql,
 	"type/referenced_event.gql": typeReferenced_eventGql,
 	"type/remove_activity_asset_payload.gql": typeRemove_activity_asset_payloadGql,
 	"type/remove_course_lesson_payload.gql": typeRemove_course_lesson_payloadGql,
 	"type/remove_label_payload.gql": typeRemove_label_payloadGql,
+	"type/remove_lesson_prerequisite_payload.gql": typeRemove_lesson_prerequisite_payloadGql,
 	"type/removed_from_activity_event.gql": typeRemoved_from_activity_eventGql,
 	"type/removed_from_course_event.gql": typeRemoved_from_course_eventGql,
 	"type/renamed_event.gql": typeRenamed_eventGql,
 	"type/searchable_connection.gql": typeSearchable_connectionGql,
 	"type/study.gql": typeStudyGql,
+	"type/study_invitation.gql": typeStudy_invitationGql,
 	"type/study_timeline_event.gql": typeStudy_timeline_eventGql,
+	"type/suggestion.gql": typeSuggestionGql,
 	"type/text_match.gql": typeText_matchGql,
 	"type/text_match_highlight.gql": typeText_match_highlightGql,
 	"type/topic.gql": typeTopicGql,
@@ -4698,7 +5446,10 @@ var _bintree = &bintree{nil, map[string]*bintree{
 		"apple_giver_order_field.gql": &bintree{enumApple_giver_order_fieldGql, map[string]*bintree{}},
 		"appleable_order_field.gql": &bintree{enumAppleable_order_fieldGql, map[string]*bintree{}},
 		"appleable_type.gql": &bintree{enumAppleable_typeGql, map[string]*bintree{}},
+		"blocked_user_order_field.gql": &bintree{enumBlocked_user_order_fieldGql, map[string]*bintree{}},
 		"comment_order_field.gql": &bintree{enumComment_order_fieldGql, map[string]*bintree{}},
+		"content_report_reason.gql": &bintree{enumContent_report_reasonGql, map[string]*bintree{}},
+		"content_report_status.gql": &bintree{enumContent_report_statusGql, map[string]*bintree{}},
 		"course_order_field.gql": &bintree{enumCourse_order_fieldGql, map[string]*bintree{}},
 		"course_status.gql": &bintree{enumCourse_statusGql, map[string]*bintree{}},
 		"email_type.gql": &bintree{enumEmail_typeGql, map[string]*bintree{}},
@@ -4711,19 +5462,26 @@ var _bintree = &bintree{nil, map[string]*bintree{
 		"labelable_order_field.gql": &bintree{enumLabelable_order_fieldGql, map[string]*bintree{}},
 		"labelable_type.gql": &bintree{enumLabelable_typeGql, map[string]*bintree{}},
 		"lesson_order_field.gql": &bintree{enumLesson_order_fieldGql, map[string]*bintree{}},
+		"moderation_action_type.gql": &bintree{enumModeration_action_typeGql, map[string]*bintree{}},
 		"notification_order_field.gql": &bintree{enumNotification_order_fieldGql, map[string]*bintree{}},
 		"order_direction.gql": &bintree{enumOrder_directionGql, map[string]*bintree{}},
 		"ref_order_field.gql": &bintree{enumRef_order_fieldGql, map[string]*bintree{}},
+		"search_language.gql": &bintree{enumSearch_languageGql, map[string]*bintree{}},
+		"search_mode.gql": &bintree{enumSearch_modeGql, map[string]*bintree{}},
 		"search_order_field.gql": &bintree{enumSearch_order_fieldGql, map[string]*bintree{}},
 		"search_type.gql": &bintree{enumSearch_typeGql, map[string]*bintree{}},
+		"study_access_level.gql": &bintree{enumStudy_access_levelGql, map[string]*bintree{}},
 		"study_order_field.gql": &bintree{enumStudy_order_fieldGql, map[string]*bintree{}},
+		"suggestion_type.gql": &bintree{enumSuggestion_typeGql, map[string]*bintree{}},
 		"topic_order_field.gql": &bintree{enumTopic_order_fieldGql, map[string]*bintree{}},
 		"topicable_order_field.gql": &bintree{enumTopicable_order_fieldGql, map[string]*bintree{}},
 		"topicable_type.gql": &bintree{enumTopicable_typeGql, map[string]*bintree{}},
+		"trending_window.gql": &bintree{enumTrending_windowGql, map[string]*bintree{}},
 		"user_asset_order_field.gql": &bintree{enumUser_asset_order_fieldGql, map[string]*bintree{}},
 		"user_order_field.gql": &bintree{enumUser_order_fieldGql, map[string]*bintree{}},
 	}},
 	"input": &bintree{nil, map[string]*bintree{
+		"accept_study_invitation.gql": &bintree{inputAccept_study_invitationGql, map[string]*bintree{}},
 		"activity_filters.gql": &bintree{inputActivity_filtersGql, map[string]*bintree{}},
 		"activity_order.gql": &bintree{inputActivity_orderGql, map[string]*bintree{}},
 		"add_activity_asset.gql": &bintree{inputAdd_activity_assetGql, map[string]*bintree{}},
@@ -4731,8 +5489,13 @@ var _bintree = &bintree{nil, map[string]*bintree{
 		"add_course_lesson.gql": &bintree{inputAdd_course_lessonGql, map[string]*bintree{}},
 		"add_email.gql": &bintree{inputAdd_emailGql, map[string]*bintree{}},
 		"add_label.gql": &bintree{inputAdd_labelGql, map[string]*bintree{}},
+		"add_lesson_prerequisite.gql": &bintree{inputAdd_lesson_prerequisiteGql, map[string]*bintree{}},
+		"add_topic_alias.gql": &bintree{inputAdd_topic_aliasGql, map[string]*bintree{}},
 		"apple_giver_order.gql": &bintree{inputApple_giver_orderGql, map[string]*bintree{}},
 		"appleable_order.gql": &bintree{inputAppleable_orderGql, map[string]*bintree{}},
+		"block_user.gql": &bintree{inputBlock_userGql, map[string]*bintree{}},
+		"blocked_user_order.gql": &bintree{inputBlocked_user_orderGql, map[string]*bintree{}},
+		"cancel_scheduled_publish.gql": &bintree{inputCancel_scheduled_publishGql, map[string]*bintree{}},
 		"comment_order.gql": &bintree{inputComment_orderGql, map[string]*bintree{}},
 		"course_filters.gql": &bintree{inputCourse_filtersGql, map[string]*bintree{}},
 		"course_order.gql": &bintree{inputCourse_orderGql, map[string]*bintree{}},
@@ -4741,6 +5504,7 @@ var _bintree = &bintree{nil, map[string]*bintree{
 		"create_label.gql": &bintree{inputCreate_labelGql, map[string]*bintree{}},
 		"create_lesson.gql": &bintree{inputCreate_lessonGql, map[string]*bintree{}},
 		"create_study.gql": &bintree{inputCreate_studyGql, map[string]*bintree{}},
+		"create_study_invitation.gql": &bintree{inputCreate_study_invitationGql, map[string]*bintree{}},
 		"create_user.gql": &bintree{inputCreate_userGql, map[string]*bintree{}},
 		"create_user_asset.gql": &bintree{inputCreate_user_assetGql, map[string]*bintree{}},
 		"delete_activity.gql": &bintree{inputDelete_activityGql, map[string]*bintree{}},
@@ -4765,6 +5529,8 @@ var _bintree = &bintree{nil, map[string]*bintree{
 		"login_user.gql": &bintree{inputLogin_userGql, map[string]*bintree{}},
 		"mark_all_study_notification_as_read.gql": &bintree{inputMark_all_study_notification_as_readGql, map[string]*bintree{}},
 		"mark_notification_as_read.gql": &bintree{inputMark_notification_as_readGql, map[string]*bintree{}},
+		"merge_topics.gql": &bintree{inputMerge_topicsGql, map[string]*bintree{}},
+		"moderate_content.gql": &bintree{inputModerate_contentGql, map[string]*bintree{}},
 		"move_activity_asset.gql": &bintree{inputMove_activity_assetGql, map[string]*bintree{}},
 		"move_course_lesson.gql": &bintree{inputMove_course_lessonGql, map[string]*bintree{}},
 		"notification_order.gql": &bintree{inputNotification_orderGql, map[string]*bintree{}},
@@ -4775,11 +5541,16 @@ var _bintree = &bintree{nil, map[string]*bintree{
 		"remove_activity_asset.gql": &bintree{inputRemove_activity_assetGql, map[string]*bintree{}},
 		"remove_course_lesson.gql": &bintree{inputRemove_course_lessonGql, map[string]*bintree{}},
 		"remove_label.gql": &bintree{inputRemove_labelGql, map[string]*bintree{}},
+		"remove_lesson_prerequisite.gql": &bintree{inputRemove_lesson_prerequisiteGql, map[string]*bintree{}},
+		"remove_topic_alias.gql": &bintree{inputRemove_topic_aliasGql, map[string]*bintree{}},
+		"report_content.gql": &bintree{inputReport_contentGql, map[string]*bintree{}},
 		"request_email_verification.gql": &bintree{inputRequest_email_verificationGql, map[string]*bintree{}},
 		"request_password_reset.gql": &bintree{inputRequest_password_resetGql, map[string]*bintree{}},
 		"reset_comment_draft.gql": &bintree{inputReset_comment_draftGql, map[string]*bintree{}},
 		"reset_lesson_draft.gql": &bintree{inputReset_lesson_draftGql, map[string]*bintree{}},
 		"reset_password.gql": &bintree{inputReset_passwordGql, map[string]*bintree{}},
+		"revoke_study_access.gql": &bintree{inputRevoke_study_accessGql, map[string]*bintree{}},
+		"revoke_study_invitation.gql": &bintree{inputRevoke_study_invitationGql, map[string]*bintree{}},
 		"search_order.gql": &bintree{inputSearch_orderGql, map[string]*bintree{}},
 		"study_filters.gql": &bintree{inputStudy_filtersGql, map[string]*bintree{}},
 		"study_order.gql": &bintree{inputStudy_orderGql, map[string]*bintree{}},
@@ -4787,6 +5558,7 @@ var _bintree = &bintree{nil, map[string]*bintree{
 		"topic_filters.gql": &bintree{inputTopic_filtersGql, map[string]*bintree{}},
 		"topic_order.gql": &bintree{inputTopic_orderGql, map[string]*bintree{}},
 		"topicable_order.gql": &bintree{inputTopicable_orderGql, map[string]*bintree{}},
+		"unblock_user.gql": &bintree{inputUnblock_userGql, map[string]*bintree{}},
 		"update_activity.gql": &bintree{inputUpdate_activityGql, map[string]*bintree{}},
 		"update_comment.gql": &bintree{inputUpdate_commentGql, map[string]*bintree{}},
 		"update_course.gql": &bintree{inputUpdate_courseGql, map[string]*bintree{}},
@@ -4844,13 +5616,16 @@ var _bintree = &bintree{nil, map[string]*bintree{
 		"add_course_lesson_payload.gql": &bintree{typeAdd_course_lesson_payloadGql, map[string]*bintree{}},
 		"add_email_payload.gql": &bintree{typeAdd_email_payloadGql, map[string]*bintree{}},
 		"add_label_payload.gql": &bintree{typeAdd_label_payloadGql, map[string]*bintree{}},
+		"add_lesson_prerequisite_payload.gql": &bintree{typeAdd_lesson_prerequisite_payloadGql, map[string]*bintree{}},
 		"added_to_activity_event.gql": &bintree{typeAdded_to_activity_eventGql, map[string]*bintree{}},
 		"added_to_course_event.gql": &bintree{typeAdded_to_course_eventGql, map[string]*bintree{}},
 		"apple_giver_connection.gql": &bintree{typeApple_giver_connectionGql, map[string]*bintree{}},
 		"appleable_connection.gql": &bintree{typeAppleable_connectionGql, map[string]*bintree{}},
 		"appled_event.gql": &bintree{typeAppled_eventGql, map[string]*bintree{}},
+		"blocked_user_connection.gql": &bintree{typeBlocked_user_connectionGql, map[string]*bintree{}},
 		"comment.gql": &bintree{typeCommentGql, map[string]*bintree{}},
 		"comment_draft_backup.gql": &bintree{typeComment_draft_backupGql, map[string]*bintree{}},
+		"content_report.gql": &bintree{typeContent_reportGql, map[string]*bintree{}},
 		"course.gql": &bintree{typeCourseGql, map[string]*bintree{}},
 		"create_activity_payload.gql": &bintree{typeCreate_activity_payloadGql, map[string]*bintree{}},
 		"create_course_payload.gql": &bintree{typeCreate_course_payloadGql, map[string]*bintree{}},
@@ -4878,25 +5653,32 @@ var _bintree = &bintree{nil, map[string]*bintree{
 		"labeled_event.gql": &bintree{typeLabeled_eventGql, map[string]*bintree{}},
 		"lesson.gql": &bintree{typeLessonGql, map[string]*bintree{}},
 		"lesson_draft_backup.gql": &bintree{typeLesson_draft_backupGql, map[string]*bintree{}},
+		"lesson_graph.gql": &bintree{typeLesson_graphGql, map[string]*bintree{}},
 		"lesson_timeline_event.gql": &bintree{typeLesson_timeline_eventGql, map[string]*bintree{}},
+		"lesson_toc_entry.gql": &bintree{typeLesson_toc_entryGql, map[string]*bintree{}},
 		"login_user_payload.gql": &bintree{typeLogin_user_payloadGql, map[string]*bintree{}},
 		"logout_user_payload.gql": &bintree{typeLogout_user_payloadGql, map[string]*bintree{}},
+		"moderation_action.gql": &bintree{typeModeration_actionGql, map[string]*bintree{}},
 		"move_activity_asset_payload.gql": &bintree{typeMove_activity_asset_payloadGql, map[string]*bintree{}},
 		"move_course_lesson_payload.gql": &bintree{typeMove_course_lesson_payloadGql, map[string]*bintree{}},
 		"notification.gql": &bintree{typeNotificationGql, map[string]*bintree{}},
 		"page_info.gql": &bintree{typePage_infoGql, map[string]*bintree{}},
+		"parsed_search_query.gql": &bintree{typeParsed_search_queryGql, map[string]*bintree{}},
 		"password_reset_token.gql": &bintree{typePassword_reset_tokenGql, map[string]*bintree{}},
 		"published_event.gql": &bintree{typePublished_eventGql, map[string]*bintree{}},
 		"referenced_event.gql": &bintree{typeReferenced_eventGql, map[string]*bintree{}},
 		"remove_activity_asset_payload.gql": &bintree{typeRemove_activity_asset_payloadGql, map[string]*bintree{}},
 		"remove_course_lesson_payload.gql": &bintree{typeRemove_course_lesson_payloadGql, map[string]*bintree{}},
 		"remove_label_payload.gql": &bintree{typeRemove_label_payloadGql, map[string]*bintree{}},
+		"remove_lesson_prerequisite_payload.gql": &bintree{typeRemove_lesson_prerequisite_payloadGql, map[string]*bintree{}},
 		"removed_from_activity_event.gql": &bintree{typeRemoved_from_activity_eventGql, map[string]*bintree{}},
 		"removed_from_course_event.gql": &bintree{typeRemoved_from_course_eventGql, map[string]*bintree{}},
 		"renamed_event.gql": &bintree{typeRenamed_eventGql, map[string]*bintree{}},
 		"searchable_connection.gql": &bintree{typeSearchable_connectionGql, map[string]*bintree{}},
 		"study.gql": &bintree{typeStudyGql, map[string]*bintree{}},
+		"study_invitation.gql": &bintree{typeStudy_invitationGql, map[string]*bintree{}},
 		"study_timeline_event.gql": &bintree{typeStudy_timeline_eventGql, map[string]*bintree{}},
+		"suggestion.gql": &bintree{typeSuggestionGql, map[string]*bintree{}},
 		"text_match.gql": &bintree{typeText_matchGql, map[string]*bintree{}},
 		"text_match_highlight.gql": &bintree{typeText_match_highlightGql, map[string]*bintree{}},
 		"topic.gql": &bintree{typeTopicGql, map[string]*bintree{}},
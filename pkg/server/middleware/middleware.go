@@ -7,12 +7,14 @@ import (
 	"time"
 
 	"github.com/gorilla/handlers"
+	"github.com/gorilla/mux"
 	"github.com/justinas/alice"
 	"github.com/marksauter/markus-ninja-api/pkg/data"
 	"github.com/marksauter/markus-ninja-api/pkg/myctx"
 	"github.com/marksauter/markus-ninja-api/pkg/myhttp"
 	"github.com/marksauter/markus-ninja-api/pkg/myjwt"
 	"github.com/marksauter/markus-ninja-api/pkg/mylog"
+	"github.com/marksauter/markus-ninja-api/pkg/mytrace"
 	"github.com/marksauter/markus-ninja-api/pkg/service"
 	"github.com/marksauter/markus-ninja-api/pkg/util"
 	"github.com/rs/xid"
@@ -23,6 +25,7 @@ type Middleware interface {
 }
 
 var CommonMiddleware = alice.New(
+	mytrace.Middleware,
 	mylog.Log.AccessMiddleware,
 	handlers.RecoveryHandler(),
 )
@@ -114,6 +117,7 @@ func (a *Authenticate) Use(h http.Handler) http.Handler {
 			}
 		}
 
+		mylog.SetViewerID(req.Context(), user.ID.String)
 		ctx := myctx.NewUserContext(req.Context(), user)
 		host, _, err := net.SplitHostPort(req.RemoteAddr)
 		if err != nil {
@@ -134,3 +138,24 @@ func (a *Authenticate) Use(h http.Handler) http.Handler {
 		h.ServeHTTP(rw, req.WithContext(ctx))
 	})
 }
+
+// Timeout limits the time a request may take to be handled. Routes maps the
+// path templates of routes that need a different limit than Default.
+type Timeout struct {
+	Default time.Duration
+	Routes  map[string]time.Duration
+}
+
+func (t *Timeout) Use(h http.Handler) http.Handler {
+	return http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		timeout := t.Default
+		if route := mux.CurrentRoute(req); route != nil {
+			if path, err := route.GetPathTemplate(); err == nil {
+				if d, ok := t.Routes[path]; ok {
+					timeout = d
+				}
+			}
+		}
+		http.TimeoutHandler(h, timeout, "Timeout!").ServeHTTP(rw, req)
+	})
+}
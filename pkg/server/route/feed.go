@@ -0,0 +1,518 @@
+package route
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/marksauter/markus-ninja-api/pkg/data"
+	"github.com/marksauter/markus-ninja-api/pkg/myconf"
+	"github.com/marksauter/markus-ninja-api/pkg/myctx"
+	"github.com/marksauter/markus-ninja-api/pkg/myhttp"
+	"github.com/marksauter/markus-ninja-api/pkg/mylog"
+	"github.com/marksauter/markus-ninja-api/pkg/repo"
+	"github.com/marksauter/markus-ninja-api/pkg/util"
+)
+
+// The number of most recent events rendered in a feed.
+const feedLength = 20
+
+// The events rendered in feeds: published lessons, published courses and
+// created activities.
+var feedEventFilters = &data.EventFilterOptions{
+	Types: &[]data.EventTypeFilter{
+		data.EventTypeFilter{
+			ActionIs: &[]string{data.ActivityCreated},
+			Type:     data.ActivityEvent,
+		},
+		data.EventTypeFilter{
+			ActionIs: &[]string{data.CoursePublished},
+			Type:     data.CourseEvent,
+		},
+		data.EventTypeFilter{
+			ActionIs: &[]string{data.LessonPublished},
+			Type:     data.LessonEvent,
+		},
+	},
+}
+
+type feedOrder struct{}
+
+func (feedOrder) Direction() data.OrderDirection {
+	return data.DESC
+}
+
+func (feedOrder) Field() string {
+	return "created_at"
+}
+
+type atomFeed struct {
+	XMLName xml.Name     `xml:"http://www.w3.org/2005/Atom feed"`
+	ID      string       `xml:"id"`
+	Title   string       `xml:"title"`
+	Updated string       `xml:"updated"`
+	Links   []atomLink   `xml:"link"`
+	Entries []*atomEntry `xml:"entry"`
+}
+
+type atomLink struct {
+	Href string `xml:"href,attr"`
+	Rel  string `xml:"rel,attr,omitempty"`
+}
+
+type atomAuthor struct {
+	Name string `xml:"name"`
+	URI  string `xml:"uri,omitempty"`
+}
+
+type atomContent struct {
+	Type string `xml:"type,attr"`
+	Body string `xml:",chardata"`
+}
+
+type atomEntry struct {
+	ID      string       `xml:"id"`
+	Title   string       `xml:"title"`
+	Updated string       `xml:"updated"`
+	Author  *atomAuthor  `xml:"author,omitempty"`
+	Link    atomLink     `xml:"link"`
+	Content *atomContent `xml:"content,omitempty"`
+}
+
+// FeedHandler - handler for the Atom feeds of studies, users and topics
+//
+// Feeds are read as the guest, whoever requests them, so that they may be
+// cached publicly. The owner of a private study may read its feed by prefixing
+// its path with /feeds/private/{token}, where token is their secret feed token. The token
+// is kept in the path rather than the query, so it is redacted from the access
+// log along with the other path secrets.
+type FeedHandler struct {
+	Conf  *myconf.Config
+	Db    data.Queryer
+	Repos *repo.Repos
+}
+
+func (h FeedHandler) ServeHTTP(rw http.ResponseWriter, req *http.Request) {
+	if h.Conf == nil || h.Db == nil || h.Repos == nil {
+		err := errors.New("route inproperly setup")
+		mylog.Log.WithError(err).Error(util.Trace(""))
+		response := myhttp.InternalServerErrorResponse(err.Error())
+		myhttp.WriteResponseTo(rw, response)
+		return
+	}
+
+	if req.Method != http.MethodGet {
+		response := myhttp.MethodNotAllowedResponse(req.Method)
+		myhttp.WriteResponseTo(rw, response)
+		return
+	}
+
+	ctx := req.Context()
+
+	routeVars := mux.Vars(req)
+	token := routeVars["token"]
+	if token == "" {
+		guest, err := data.GetUserCredentialsByLogin(h.Db, repo.Guest)
+		if err != nil {
+			mylog.Log.WithError(err).Error(util.Trace(""))
+			response := myhttp.InternalServerErrorResponse(err.Error())
+			myhttp.WriteResponseTo(rw, response)
+			return
+		}
+		ctx = myctx.NewUserContext(ctx, guest)
+	} else {
+		feedToken, err := data.GetFeedToken(h.Db, token)
+		if err != nil {
+			if err == data.ErrNotFound {
+				response := myhttp.UnauthorizedErrorResponse("invalid feed token")
+				myhttp.WriteResponseTo(rw, response)
+				return
+			}
+			mylog.Log.WithError(err).Error(util.Trace(""))
+			response := myhttp.InternalServerErrorResponse(err.Error())
+			myhttp.WriteResponseTo(rw, response)
+			return
+		}
+		user, err := data.GetUserCredentials(h.Db, feedToken.UserID.String)
+		if err != nil {
+			mylog.Log.WithError(err).Error(util.Trace(""))
+			response := myhttp.InternalServerErrorResponse(err.Error())
+			myhttp.WriteResponseTo(rw, response)
+			return
+		}
+		mylog.SetViewerID(ctx, user.ID.String)
+		ctx = myctx.NewUserContext(ctx, user)
+	}
+
+	f := &feed{FeedHandler: h, private: map[string]bool{}}
+	var err error
+	if topic, ok := routeVars["topic"]; ok {
+		err = f.topic(ctx, topic)
+	} else if study, ok := routeVars["study"]; ok {
+		err = f.study(ctx, routeVars["owner"], study)
+	} else {
+		err = f.user(ctx, routeVars["login"])
+	}
+	if err != nil {
+		if err == data.ErrNotFound || err == repo.ErrAccessDenied {
+			response := myhttp.NotFoundErrorResponse("feed")
+			myhttp.WriteResponseTo(rw, response)
+			return
+		}
+		mylog.Log.WithError(err).Error(util.Trace(""))
+		response := myhttp.InternalServerErrorResponse(err.Error())
+		myhttp.WriteResponseTo(rw, response)
+		return
+	}
+	f.atom.Links = append(f.atom.Links, atomLink{
+		Href: h.Conf.APIURL + req.URL.Path,
+		Rel:  "self",
+	})
+
+	var body bytes.Buffer
+	body.WriteString(xml.Header)
+	if err := xml.NewEncoder(&body).Encode(f.atom); err != nil {
+		mylog.Log.WithError(err).Error(util.Trace(""))
+		response := myhttp.InternalServerErrorResponse(err.Error())
+		myhttp.WriteResponseTo(rw, response)
+		return
+	}
+
+	etag := fmt.Sprintf(`"%x"`, sha256.Sum256(body.Bytes()))
+	if token != "" {
+		rw.Header().Set("Cache-Control", "private, no-cache")
+	} else {
+		rw.Header().Set("Cache-Control", "public, no-cache")
+	}
+	rw.Header().Set("ETag", etag)
+	if etagMatches(req.Header.Get("If-None-Match"), etag) {
+		rw.WriteHeader(http.StatusNotModified)
+		return
+	}
+
+	rw.Header().Set("Content-Type", "application/atom+xml; charset=utf-8")
+	rw.Write(body.Bytes())
+}
+
+// etagMatches reports whether the If-None-Match header value matches etag.
+func etagMatches(ifNoneMatch, etag string) bool {
+	if ifNoneMatch == "" {
+		return false
+	}
+	for _, t := range strings.Split(ifNoneMatch, ",") {
+		t = strings.TrimSpace(t)
+		if t == "*" || strings.TrimPrefix(t, "W/") == etag {
+			return true
+		}
+	}
+	return false
+}
+
+// feed builds the Atom feed of a single request.
+type feed struct {
+	FeedHandler
+	atom *atomFeed
+	// private caches whether a study is hidden from the viewer, by study id.
+	private map[string]bool
+}
+
+func (f *feed) study(ctx context.Context, owner, name string) error {
+	study, err := f.Repos.Study().GetByUserAndName(ctx, owner, name)
+	if err != nil {
+		return err
+	}
+	id, err := study.ID()
+	if err != nil {
+		return err
+	}
+	hidden, err := f.isHidden(ctx, id.String)
+	if err != nil {
+		return err
+	} else if hidden {
+		return data.ErrNotFound
+	}
+	createdAt, err := study.CreatedAt()
+	if err != nil {
+		return err
+	}
+	path := fmt.Sprintf("/u/%s/%s", owner, name)
+	f.newAtom(path, owner+"/"+name, createdAt)
+
+	po := &data.PageOptions{First: feedLength, Order: feedOrder{}}
+	events, err := f.Repos.Event().GetByStudy(ctx, id.String, po, feedEventFilters)
+	if err != nil {
+		return err
+	}
+	return f.addEntries(ctx, events)
+}
+
+func (f *feed) topic(ctx context.Context, name string) error {
+	topic, err := f.Repos.Topic().GetByName(ctx, name)
+	if err != nil {
+		return err
+	}
+	id, err := topic.ID()
+	if err != nil {
+		return err
+	}
+	createdAt, err := topic.CreatedAt()
+	if err != nil {
+		return err
+	}
+	path := fmt.Sprintf("/topics/%s", name)
+	f.newAtom(path, "Topic: "+name, createdAt)
+
+	po := &data.PageOptions{First: feedLength, Order: feedOrder{}}
+	events, err := f.Repos.Event().GetByTopic(ctx, id.String, po, feedEventFilters)
+	if err != nil {
+		return err
+	}
+	return f.addEntries(ctx, events)
+}
+
+func (f *feed) user(ctx context.Context, login string) error {
+	user, err := f.Repos.User().GetByLogin(ctx, login)
+	if err != nil {
+		return err
+	}
+	id, err := user.ID()
+	if err != nil {
+		return err
+	}
+	createdAt, err := user.CreatedAt()
+	if err != nil {
+		return err
+	}
+	path := fmt.Sprintf("/u/%s", login)
+	f.newAtom(path, login, createdAt)
+
+	po := &data.PageOptions{First: feedLength, Order: feedOrder{}}
+	events, err := f.Repos.Event().GetByUser(ctx, id.String, po, feedEventFilters)
+	if err != nil {
+		return err
+	}
+	return f.addEntries(ctx, events)
+}
+
+func (f *feed) newAtom(path, title string, updated time.Time) {
+	url := f.Conf.ClientURL + path
+	f.atom = &atomFeed{
+		ID:      url,
+		Title:   title,
+		Updated: updated.UTC().Format(time.RFC3339),
+		Links:   []atomLink{atomLink{Href: url, Rel: "alternate"}},
+	}
+}
+
+// isHidden reports whether the viewer may not read the study, e.g. because it
+// is private and the viewer was not invited to it.
+func (f *feed) isHidden(ctx context.Context, studyID string) (bool, error) {
+	if hidden, ok := f.private[studyID]; ok {
+		return hidden, nil
+	}
+	hidden := false
+	if _, err := f.Repos.Study().Get(ctx, studyID); err != nil {
+		if err != repo.ErrAccessDenied {
+			return false, err
+		}
+		hidden = true
+	}
+	f.private[studyID] = hidden
+	return hidden, nil
+}
+
+func (f *feed) addEntries(ctx context.Context, events []*repo.EventPermit) error {
+	for _, event := range events {
+		entry, err := f.entry(ctx, event)
+		if err != nil {
+			return err
+		}
+		if entry == nil {
+			continue
+		}
+		if len(f.atom.Entries) == 0 {
+			f.atom.Updated = entry.Updated
+		}
+		f.atom.Entries = append(f.atom.Entries, entry)
+	}
+	return nil
+}
+
+// entry returns the feed entry for the event, or nil if the event is hidden
+// from the viewer.
+func (f *feed) entry(ctx context.Context, event *repo.EventPermit) (*atomEntry, error) {
+	studyID, err := event.StudyID()
+	if err != nil {
+		return nil, err
+	}
+	hidden, err := f.isHidden(ctx, studyID.String)
+	if err != nil {
+		return nil, err
+	} else if hidden {
+		return nil, nil
+	}
+	studyPath, err := f.studyPath(ctx, studyID.String)
+	if err != nil {
+		return nil, err
+	}
+
+	payload, err := event.Payload()
+	if err != nil {
+		return nil, err
+	}
+	eventType, err := event.Type()
+	if err != nil {
+		return nil, err
+	}
+	entry := &atomEntry{}
+	switch eventType {
+	case data.ActivityEvent:
+		p := &data.ActivityEventPayload{}
+		if err := payload.AssignTo(p); err != nil {
+			return nil, err
+		}
+		activity, err := f.Repos.Activity().Get(ctx, p.ActivityID.String)
+		if err != nil {
+			return nil, ignoreHidden(err)
+		}
+		name, err := activity.Name()
+		if err != nil {
+			return nil, err
+		}
+		number, err := activity.Number()
+		if err != nil {
+			return nil, err
+		}
+		description, err := activity.Description()
+		if err != nil {
+			return nil, err
+		}
+		entry.Title = "New activity: " + name
+		entry.Link.Href = fmt.Sprintf("%s%s/activity/%d", f.Conf.ClientURL, studyPath, number)
+		entry.Content = &atomContent{
+			Type: "html",
+			Body: string(util.MarkdownToHTML([]byte(description))),
+		}
+	case data.CourseEvent:
+		p := &data.CourseEventPayload{}
+		if err := payload.AssignTo(p); err != nil {
+			return nil, err
+		}
+		course, err := f.Repos.Course().Get(ctx, p.CourseID.String)
+		if err != nil {
+			return nil, ignoreHidden(err)
+		}
+		name, err := course.Name()
+		if err != nil {
+			return nil, err
+		}
+		number, err := course.Number()
+		if err != nil {
+			return nil, err
+		}
+		description, err := course.Description()
+		if err != nil {
+			return nil, err
+		}
+		entry.Title = "New course: " + name
+		entry.Link.Href = fmt.Sprintf("%s%s/course/%d", f.Conf.ClientURL, studyPath, number)
+		entry.Content = &atomContent{
+			Type: "html",
+			Body: string(util.MarkdownToHTML([]byte(description))),
+		}
+	case data.LessonEvent:
+		p := &data.LessonEventPayload{}
+		if err := payload.AssignTo(p); err != nil {
+			return nil, err
+		}
+		lesson, err := f.Repos.Lesson().Get(ctx, p.LessonID.String)
+		if err != nil {
+			return nil, ignoreHidden(err)
+		}
+		title, err := lesson.Title()
+		if err != nil {
+			return nil, err
+		}
+		number, err := lesson.Number()
+		if err != nil {
+			return nil, err
+		}
+		body, err := lesson.Body()
+		if err != nil {
+			return nil, err
+		}
+		entry.Title = title
+		entry.Link.Href = fmt.Sprintf("%s%s/lesson/%d", f.Conf.ClientURL, studyPath, number)
+		entry.Content = &atomContent{Type: "html", Body: body.ToHTML()}
+	default:
+		return nil, nil
+	}
+
+	id, err := event.ID()
+	if err != nil {
+		return nil, err
+	}
+	createdAt, err := event.CreatedAt()
+	if err != nil {
+		return nil, err
+	}
+	userID, err := event.UserID()
+	if err != nil {
+		return nil, err
+	}
+	user, err := f.Repos.User().Get(ctx, userID.String)
+	if err != nil {
+		return nil, err
+	}
+	login, err := user.Login()
+	if err != nil {
+		return nil, err
+	}
+	entry.ID = fmt.Sprintf("%s#%s", entry.Link.Href, id.String)
+	entry.Updated = createdAt.UTC().Format(time.RFC3339)
+	entry.Author = &atomAuthor{
+		Name: login,
+		URI:  fmt.Sprintf("%s/u/%s", f.Conf.ClientURL, login),
+	}
+	return entry, nil
+}
+
+func (f *feed) studyPath(ctx context.Context, studyID string) (string, error) {
+	study, err := f.Repos.Study().Get(ctx, studyID)
+	if err != nil {
+		return "", err
+	}
+	name, err := study.Name()
+	if err != nil {
+		return "", err
+	}
+	userID, err := study.UserID()
+	if err != nil {
+		return "", err
+	}
+	owner, err := f.Repos.User().Get(ctx, userID.String)
+	if err != nil {
+		return "", err
+	}
+	login, err := owner.Login()
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("/u/%s/%s", login, name), nil
+}
+
+// ignoreHidden drops the errors of subjects the viewer may not read, e.g.
+// deleted lessons or courses unpublished since the event.
+func ignoreHidden(err error) error {
+	if err == data.ErrNotFound || err == repo.ErrAccessDenied {
+		return nil
+	}
+	return err
+}
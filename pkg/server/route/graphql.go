@@ -1,17 +1,22 @@
 package route
 
 import (
+	"bytes"
 	"encoding/base64"
 	"encoding/json"
 	"errors"
 	"fmt"
+	"io/ioutil"
 	"net/http"
 	"strings"
+	"time"
 
 	graphql "github.com/marksauter/graphql-go"
 	"github.com/marksauter/markus-ninja-api/pkg/myconf"
+	"github.com/marksauter/markus-ninja-api/pkg/myerr"
 	"github.com/marksauter/markus-ninja-api/pkg/myhttp"
 	"github.com/marksauter/markus-ninja-api/pkg/mylog"
+	"github.com/marksauter/markus-ninja-api/pkg/mymetrics"
 	"github.com/marksauter/markus-ninja-api/pkg/repo"
 	"github.com/marksauter/markus-ninja-api/pkg/util"
 	"github.com/rs/cors"
@@ -32,6 +37,12 @@ func (h GraphQLHandler) Cors() *cors.Cors {
 	})
 }
 
+type graphQLParams struct {
+	Query         string                 `json:"query"`
+	OperationName string                 `json:"operationName"`
+	Variables     map[string]interface{} `json:"variables"`
+}
+
 func (h GraphQLHandler) ServeHTTP(rw http.ResponseWriter, req *http.Request) {
 	if h.Conf == nil || h.Repos == nil || h.Schema == nil {
 		err := errors.New("route inproperly setup")
@@ -46,23 +57,75 @@ func (h GraphQLHandler) ServeHTTP(rw http.ResponseWriter, req *http.Request) {
 		myhttp.WriteResponseTo(rw, response)
 		return
 	}
-	var params struct {
-		Query         string                 `json:"query"`
-		OperationName string                 `json:"operationName"`
-		Variables     map[string]interface{} `json:"variables"`
-	}
-	if err := json.NewDecoder(req.Body).Decode(&params); err != nil {
+	body, err := ioutil.ReadAll(req.Body)
+	if err != nil {
 		http.Error(rw, err.Error(), http.StatusBadRequest)
 		return
 	}
 
-	if len(params.Query) > 6000 {
-		http.Error(rw, "Query too large.", http.StatusBadRequest)
-		return
+	// The body may either be a single operation, or an array of operations.
+	// Batched operations are executed in order within the same request, so they
+	// share the loader caches setup by repo.Repos.Use.
+	var params []graphQLParams
+	trimmedBody := bytes.TrimSpace(body)
+	isBatch := len(trimmedBody) > 0 && trimmedBody[0] == '['
+	if isBatch {
+		if err := json.Unmarshal(body, &params); err != nil {
+			http.Error(rw, err.Error(), http.StatusBadRequest)
+			return
+		}
+		if len(params) == 0 {
+			http.Error(rw, "Batch must not be empty.", http.StatusBadRequest)
+			return
+		}
+		if len(params) > h.Conf.GraphQLMaxBatchSize {
+			http.Error(
+				rw,
+				fmt.Sprintf("Batch too large, max size is %d.", h.Conf.GraphQLMaxBatchSize),
+				http.StatusBadRequest,
+			)
+			return
+		}
+	} else {
+		var p graphQLParams
+		if err := json.Unmarshal(body, &p); err != nil {
+			http.Error(rw, err.Error(), http.StatusBadRequest)
+			return
+		}
+		params = append(params, p)
 	}
 
-	response := h.Schema.Exec(req.Context(), params.Query, params.OperationName, params.Variables)
-	responseJSON, err := json.Marshal(response)
+	for _, p := range params {
+		if len(p.Query) > 6000 {
+			http.Error(rw, "Query too large.", http.StatusBadRequest)
+			return
+		}
+	}
+
+	responses := make([]*graphql.Response, len(params))
+	for i, p := range params {
+		operation := mymetrics.OperationLabel(p.OperationName)
+		start := time.Now()
+		response := h.Schema.Exec(req.Context(), p.Query, p.OperationName, p.Variables)
+		mymetrics.GraphQLOperationsTotal.WithLabelValues(operation).Inc()
+		mymetrics.ObserveSince(
+			mymetrics.GraphQLOperationDuration.WithLabelValues(operation),
+			start,
+		)
+		h.handleErrors(req.Context(), response.Errors)
+		for _, err := range response.Errors {
+			code, _ := err.Extensions["code"].(myerr.ErrorCode)
+			mymetrics.GraphQLErrorsTotal.WithLabelValues(operation, string(code)).Inc()
+		}
+		responses[i] = response
+	}
+
+	var responseJSON []byte
+	if isBatch {
+		responseJSON, err = json.Marshal(responses)
+	} else {
+		responseJSON, err = json.Marshal(responses[0])
+	}
 	if err != nil {
 		errResponse := myhttp.InternalServerErrorResponse(err.Error())
 		myhttp.WriteResponseTo(rw, errResponse)
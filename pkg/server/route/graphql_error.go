@@ -0,0 +1,89 @@
+package route
+
+import (
+	"context"
+
+	gqlerrors "github.com/marksauter/graphql-go/errors"
+	"github.com/marksauter/markus-ninja-api/pkg/data"
+	"github.com/marksauter/markus-ninja-api/pkg/myerr"
+	"github.com/marksauter/markus-ninja-api/pkg/mylog"
+	"github.com/marksauter/markus-ninja-api/pkg/mytrace"
+	"github.com/marksauter/markus-ninja-api/pkg/mytype"
+	"github.com/marksauter/markus-ninja-api/pkg/repo"
+	"github.com/rs/xid"
+	"github.com/sirupsen/logrus"
+)
+
+// errorCode returns the code reported to the client for the resolver error
+// err, and the input field it applies to, if any.
+func errorCode(err error) (myerr.ErrorCode, string) {
+	switch err := err.(type) {
+	case myerr.AuthenticationError:
+		return myerr.UnauthenticatedCode, ""
+	case myerr.ValidationError:
+		return myerr.ValidationCode, err.Field
+	case myerr.NotFoundError:
+		return myerr.NotFoundCode, ""
+	case data.DataFieldError:
+		return myerr.ValidationCode, err.Field
+	case data.DataEndUserError:
+		switch err.Code {
+		case data.UniqueViolation:
+			return myerr.UniqueViolationCode, ""
+		case data.NotNullViolation:
+			return myerr.ValidationCode, ""
+		default:
+			return myerr.BadRequestCode, ""
+		}
+	}
+
+	switch err {
+	case repo.ErrAccessDenied, repo.ErrFieldAccessDenied:
+		return myerr.ForbiddenCode, ""
+	case data.ErrNotFound:
+		return myerr.NotFoundCode, ""
+	case mytype.ErrInvalidEmail,
+		mytype.ErrPasswordEmpty,
+		mytype.ErrPasswordTooWeak,
+		mytype.InvalidColorError:
+		return myerr.ValidationCode, ""
+	default:
+		return myerr.InternalCode, ""
+	}
+}
+
+// handleErrors sets the `extensions` of each error in errs. Errors that did not
+// come from a resolver are problems with the query document itself. Internal
+// errors are logged with a correlation ID, which is reported to the client in
+// place of the original message when h.Conf.GraphQLMaskInternalErrors is set.
+func (h GraphQLHandler) handleErrors(ctx context.Context, errs []*gqlerrors.QueryError) {
+	for _, err := range errs {
+		if err.Extensions == nil {
+			err.Extensions = make(map[string]interface{})
+		}
+		if traceID, ok := mytrace.TraceIDFromContext(ctx); ok {
+			err.Extensions["traceId"] = traceID
+		}
+		if err.ResolverError == nil && err.Path == nil {
+			err.Extensions["code"] = myerr.BadRequestCode
+			continue
+		}
+
+		code, field := errorCode(err.ResolverError)
+		err.Extensions["code"] = code
+		if field != "" {
+			err.Extensions["field"] = []string{"input", field}
+		}
+		if code == myerr.InternalCode {
+			correlationID := xid.New().String()
+			err.Extensions["correlationId"] = correlationID
+			mylog.Log.WithContext(ctx).WithFields(logrus.Fields{
+				"correlation_id": correlationID,
+				"path":           err.Path,
+			}).WithError(err).Error("graphql internal error")
+			if h.Conf.GraphQLMaskInternalErrors {
+				err.Message = myerr.SomethingWentWrongError.Error()
+			}
+		}
+	}
+}
@@ -0,0 +1,110 @@
+package route
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/marksauter/markus-ninja-api/pkg/data"
+	"github.com/marksauter/markus-ninja-api/pkg/mydb"
+	"github.com/marksauter/markus-ninja-api/pkg/myhttp"
+	"github.com/marksauter/markus-ninja-api/pkg/mylog"
+	"github.com/marksauter/markus-ninja-api/pkg/service"
+	"github.com/marksauter/markus-ninja-api/pkg/util"
+)
+
+// HealthzHandler reports that the process is alive.
+type HealthzHandler struct{}
+
+func (h HealthzHandler) ServeHTTP(rw http.ResponseWriter, req *http.Request) {
+	if req.Method != http.MethodGet {
+		response := myhttp.MethodNotAllowedResponse(req.Method)
+		myhttp.WriteResponseTo(rw, response)
+		return
+	}
+
+	rw.Header().Set("Content-Type", "application/json")
+	rw.Header().Set("Cache-Control", "no-store")
+	rw.Write([]byte(`{"status":"ok"}`))
+}
+
+// ReadyzHandler reports whether the process is ready to serve requests: the
+// database is reachable and at the expected schema version, and the storage
+// service is reachable.
+type ReadyzHandler struct {
+	Db         *mydb.DB
+	StorageSvc *service.StorageService
+}
+
+type readyzCheck struct {
+	Status string `json:"status"`
+	Error  string `json:"error,omitempty"`
+}
+
+type readyzResponse struct {
+	Status                string                 `json:"status"`
+	Checks                map[string]readyzCheck `json:"checks"`
+	SchemaVersion         int32                  `json:"schemaVersion"`
+	ExpectedSchemaVersion int32                  `json:"expectedSchemaVersion"`
+}
+
+func (h ReadyzHandler) ServeHTTP(rw http.ResponseWriter, req *http.Request) {
+	if h.Db == nil || h.StorageSvc == nil {
+		err := errors.New("route inproperly setup")
+		mylog.Log.WithError(err).Error(util.Trace(""))
+		response := myhttp.InternalServerErrorResponse(err.Error())
+		myhttp.WriteResponseTo(rw, response)
+		return
+	}
+
+	if req.Method != http.MethodGet {
+		response := myhttp.MethodNotAllowedResponse(req.Method)
+		myhttp.WriteResponseTo(rw, response)
+		return
+	}
+
+	response := readyzResponse{
+		Status:                "ok",
+		Checks:                make(map[string]readyzCheck),
+		ExpectedSchemaVersion: data.SchemaVersion,
+	}
+	check := func(name string, err error) {
+		if err != nil {
+			mylog.Log.WithError(err).Error(util.Trace(name + " not ready"))
+			response.Status = "unavailable"
+			response.Checks[name] = readyzCheck{Status: "unavailable", Error: err.Error()}
+			return
+		}
+		response.Checks[name] = readyzCheck{Status: "ok"}
+	}
+
+	version, err := data.GetSchemaVersion(h.Db)
+	check("database", err)
+	if err == nil {
+		response.SchemaVersion = version
+		if version != data.SchemaVersion {
+			err = fmt.Errorf(
+				"schema version is %d, expected %d",
+				version,
+				data.SchemaVersion,
+			)
+		}
+		check("schema", err)
+	}
+	check("storage", h.StorageSvc.Ping())
+
+	responseJSON, err := json.Marshal(response)
+	if err != nil {
+		errResponse := myhttp.InternalServerErrorResponse(err.Error())
+		myhttp.WriteResponseTo(rw, errResponse)
+		return
+	}
+
+	rw.Header().Set("Content-Type", "application/json")
+	rw.Header().Set("Cache-Control", "no-store")
+	if response.Status != "ok" {
+		rw.WriteHeader(http.StatusServiceUnavailable)
+	}
+	rw.Write(responseJSON)
+}
@@ -0,0 +1,76 @@
+package route
+
+import (
+	"crypto/subtle"
+	"errors"
+	"net"
+	"net/http"
+	"strings"
+
+	"github.com/marksauter/markus-ninja-api/pkg/myconf"
+	"github.com/marksauter/markus-ninja-api/pkg/myhttp"
+	"github.com/marksauter/markus-ninja-api/pkg/mylog"
+	"github.com/marksauter/markus-ninja-api/pkg/mymetrics"
+	"github.com/marksauter/markus-ninja-api/pkg/util"
+)
+
+// MetricsHandler serves the Prometheus metrics to requesters in
+// Conf.MetricsAllowedIPs, or to requests bearing Conf.MetricsToken.
+type MetricsHandler struct {
+	Conf *myconf.Config
+}
+
+func (h MetricsHandler) ServeHTTP(rw http.ResponseWriter, req *http.Request) {
+	if h.Conf == nil {
+		err := errors.New("route inproperly setup")
+		mylog.Log.WithError(err).Error(util.Trace(""))
+		response := myhttp.InternalServerErrorResponse(err.Error())
+		myhttp.WriteResponseTo(rw, response)
+		return
+	}
+
+	if req.Method != http.MethodGet {
+		response := myhttp.MethodNotAllowedResponse(req.Method)
+		myhttp.WriteResponseTo(rw, response)
+		return
+	}
+
+	if !h.hasValidToken(req) && !h.isAllowedIP(req) {
+		response := myhttp.AccessDeniedErrorResponse()
+		myhttp.WriteResponseTo(rw, response)
+		return
+	}
+
+	mymetrics.Handler().ServeHTTP(rw, req)
+}
+
+func (h MetricsHandler) hasValidToken(req *http.Request) bool {
+	if h.Conf.MetricsToken == "" {
+		return false
+	}
+	token := strings.TrimPrefix(req.Header.Get("Authorization"), "Bearer ")
+	return subtle.ConstantTimeCompare([]byte(token), []byte(h.Conf.MetricsToken)) == 1
+}
+
+func (h MetricsHandler) isAllowedIP(req *http.Request) bool {
+	host, _, err := net.SplitHostPort(req.RemoteAddr)
+	if err != nil {
+		return false
+	}
+	ip := net.ParseIP(host)
+	if ip == nil {
+		return false
+	}
+	for _, allowed := range h.Conf.MetricsAllowedIPs {
+		if _, ipNet, err := net.ParseCIDR(allowed); err == nil {
+			if ipNet.Contains(ip) {
+				return true
+			}
+		} else if allowedIP := net.ParseIP(allowed); allowedIP != nil {
+			if allowedIP.Equal(ip) {
+				return true
+			}
+		}
+	}
+	return false
+}
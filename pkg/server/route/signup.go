@@ -111,6 +111,18 @@ func (h SignupHandler) ServeHTTP(rw http.ResponseWriter, req *http.Request) {
 		return
 	}
 
+	blacklisted, err := u.Login.IsBlacklisted()
+	if err != nil {
+		mylog.Log.WithError(err).Error(util.Trace(""))
+		response := myhttp.InternalServerErrorResponse("")
+		myhttp.WriteResponseTo(rw, response)
+		return
+	} else if blacklisted {
+		response := myhttp.UsernameExistsResponse()
+		myhttp.WriteResponseTo(rw, response)
+		return
+	}
+
 	user, err := data.CreateUser(h.Db, u)
 	if err != nil {
 		var response *myhttp.ErrorResponse
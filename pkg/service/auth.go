@@ -8,6 +8,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/kms/kmsiface"
 	"github.com/marksauter/markus-ninja-api/pkg/myjwt"
 	"github.com/marksauter/markus-ninja-api/pkg/mylog"
+	"github.com/marksauter/markus-ninja-api/pkg/mymetrics"
 	"github.com/marksauter/markus-ninja-api/pkg/util"
 )
 
@@ -35,7 +36,9 @@ func (s *AuthService) SignJWT(p *myjwt.Payload) (*myjwt.JWT, error) {
 		Plaintext: []byte(jwt.GetPlainText()),
 	}
 
+	start := time.Now()
 	result, err := s.svc.Encrypt(params)
+	mymetrics.ObserveSince(mymetrics.KMSRequestDuration.WithLabelValues("encrypt"), start)
 	if err != nil {
 		mylog.Log.WithError(err).Error(util.Trace(""))
 		return nil, err
@@ -62,7 +65,9 @@ func (s *AuthService) ValidateJWT(t *myjwt.JWT) (*myjwt.Payload, error) {
 
 	params := &kms.DecryptInput{CiphertextBlob: []byte(t.Signature)}
 
+	start := time.Now()
 	result, err := s.svc.Decrypt(params)
+	mymetrics.ObserveSince(mymetrics.KMSRequestDuration.WithLabelValues("decrypt"), start)
 	if err != nil {
 		mylog.Log.WithError(err).Error(util.Trace(""))
 		panic(err)
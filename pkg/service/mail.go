@@ -1,6 +1,9 @@
 package service
 
 import (
+	"html"
+	"time"
+
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/service/ses"
@@ -31,6 +34,9 @@ type MailService struct {
 const (
 	EmailVerificationSubject = "[rkus.ninja] Please verify your email address"
 	PasswordResetSubject     = "[rkus.ninja] Password reset request"
+	DataExportSubject        = "[rkus.ninja] Your data export is ready"
+	ModerationWarningSubject = "[rkus.ninja] A warning about your content"
+	StudyInvitationSubject   = "[rkus.ninja] You've been invited to a study"
 )
 
 type SendEmailVerificationMailInput struct {
@@ -168,3 +174,195 @@ func (s *MailService) SendPasswordResetMail(
 	}).Info(util.Trace("sent password reset email"))
 	return nil
 }
+
+type SendDataExportMailInput struct {
+	ExpiresAt time.Time
+	Link      string
+	To        string
+	UserLogin string
+}
+
+func (s *MailService) SendDataExportMail(
+	input *SendDataExportMailInput,
+) error {
+	expiresAt := input.ExpiresAt.UTC().Format("January 2, 2006 at 15:04 MST")
+	htmlBody := "<p>Hi <strong>@" + input.UserLogin + "</strong>!</p>" +
+		"<p>The export of your rkus.ninja data is ready.</p>" +
+		"<p><a href='" + input.Link + "'>Download your data</a>.</p>" +
+		"<p>The link expires on " + expiresAt + ".</p>" +
+		"<hr>" +
+		"<p>Button not working?  Paste the following link into your browser:<br>" +
+		"<span>" + input.Link + "</span></p>" +
+		"<p>You're receiving this email because you recently requested an " +
+		"export of your rkus.ninja data. " +
+		"If this wasn't you, please change your password.</p>"
+
+	textBody := "Hi @" + input.UserLogin + "!\r\n\r\n" +
+		"The export of your rkus.ninja data is ready.\r\n" +
+		"Paste the following link into your browser to download it:\r\n" + input.Link + "\r\n\r\n" +
+		"The link expires on " + expiresAt + ".\r\n\r\n" +
+		"You're receiving this email because you recently requested an " +
+		"export of your rkus.ninja data. " +
+		"If this wasn't you, please change your password."
+
+	sendEmailInput := &ses.SendEmailInput{
+		Destination: &ses.Destination{
+			ToAddresses: []*string{
+				aws.String(input.To),
+			},
+		},
+		Message: &ses.Message{
+			Body: &ses.Body{
+				Html: &ses.Content{
+					Charset: aws.String(s.conf.CharSet),
+					Data:    aws.String(htmlBody),
+				},
+				Text: &ses.Content{
+					Charset: aws.String(s.conf.CharSet),
+					Data:    aws.String(textBody),
+				},
+			},
+			Subject: &ses.Content{
+				Charset: aws.String(s.conf.CharSet),
+				Data:    aws.String(DataExportSubject),
+			},
+		},
+		Source: aws.String(s.conf.Sender),
+	}
+
+	_, err := s.svc.SendEmail(sendEmailInput)
+	if err != nil {
+		mylog.Log.WithError(err).Error(util.Trace(""))
+		return err
+	}
+
+	mylog.Log.WithFields(logrus.Fields{
+		"to": input.To,
+	}).Info(util.Trace("sent data export email"))
+	return nil
+}
+
+type SendModerationWarningMailInput struct {
+	Note      string
+	To        string
+	UserLogin string
+}
+
+func (s *MailService) SendModerationWarningMail(
+	input *SendModerationWarningMailInput,
+) error {
+	htmlBody := "<p>Hi <strong>@" + input.UserLogin + "</strong>,</p>" +
+		"<p>Content you posted on rkus.ninja was reported, and an admin found " +
+		"that it breaks the rules.</p>"
+	textBody := "Hi @" + input.UserLogin + ",\r\n\r\n" +
+		"Content you posted on rkus.ninja was reported, and an admin found " +
+		"that it breaks the rules.\r\n\r\n"
+	if input.Note != "" {
+		htmlBody += "<blockquote>" + html.EscapeString(input.Note) + "</blockquote>"
+		textBody += input.Note + "\r\n\r\n"
+	}
+	htmlBody += "<p>Further breaches may lead to your account being suspended.</p>"
+	textBody += "Further breaches may lead to your account being suspended."
+
+	sendEmailInput := &ses.SendEmailInput{
+		Destination: &ses.Destination{
+			ToAddresses: []*string{
+				aws.String(input.To),
+			},
+		},
+		Message: &ses.Message{
+			Body: &ses.Body{
+				Html: &ses.Content{
+					Charset: aws.String(s.conf.CharSet),
+					Data:    aws.String(htmlBody),
+				},
+				Text: &ses.Content{
+					Charset: aws.String(s.conf.CharSet),
+					Data:    aws.String(textBody),
+				},
+			},
+			Subject: &ses.Content{
+				Charset: aws.String(s.conf.CharSet),
+				Data:    aws.String(ModerationWarningSubject),
+			},
+		},
+		Source: aws.String(s.conf.Sender),
+	}
+
+	_, err := s.svc.SendEmail(sendEmailInput)
+	if err != nil {
+		mylog.Log.WithError(err).Error(util.Trace(""))
+		return err
+	}
+
+	mylog.Log.WithFields(logrus.Fields{
+		"to": input.To,
+	}).Info(util.Trace("sent moderation warning email"))
+	return nil
+}
+
+type SendStudyInvitationMailInput struct {
+	InviterLogin string
+	StudyName    string
+	StudyOwner   string
+	To           string
+	Token        string
+}
+
+func (s *MailService) SendStudyInvitationMail(
+	input *SendStudyInvitationMailInput,
+) error {
+	study := input.StudyOwner + "/" + input.StudyName
+	link := s.conf.RootURL + "/u/" + study + "/invitation/" + input.Token
+	htmlBody := "<p>Hi!</p>" +
+		"<p><strong>@" + input.InviterLogin + "</strong> has invited you to the " +
+		"private study <strong>" + study + "</strong> on rkus.ninja.</p>" +
+		"<p><a href='" + link + "'>Accept invitation</a>.</p>" +
+		"<hr>" +
+		"<p>Button not working?  Paste the following link into your browser:<br>" +
+		"<span>" + link + "</span></p>" +
+		"<p>If you weren't expecting this invitation, please ignore this email.</p>"
+
+	textBody := "Hi!\r\n\r\n" +
+		"@" + input.InviterLogin + " has invited you to the private study " +
+		study + " on rkus.ninja.\r\n\r\n" +
+		"Paste the following link into your browser to accept the invitation:\r\n" +
+		link + "\r\n\r\n" +
+		"If you weren't expecting this invitation, please ignore this email."
+
+	sendEmailInput := &ses.SendEmailInput{
+		Destination: &ses.Destination{
+			ToAddresses: []*string{
+				aws.String(input.To),
+			},
+		},
+		Message: &ses.Message{
+			Body: &ses.Body{
+				Html: &ses.Content{
+					Charset: aws.String(s.conf.CharSet),
+					Data:    aws.String(htmlBody),
+				},
+				Text: &ses.Content{
+					Charset: aws.String(s.conf.CharSet),
+					Data:    aws.String(textBody),
+				},
+			},
+			Subject: &ses.Content{
+				Charset: aws.String(s.conf.CharSet),
+				Data:    aws.String(StudyInvitationSubject),
+			},
+		},
+		Source: aws.String(s.conf.Sender),
+	}
+
+	_, err := s.svc.SendEmail(sendEmailInput)
+	if err != nil {
+		mylog.Log.WithError(err).Error(util.Trace(""))
+		return err
+	}
+
+	mylog.Log.WithFields(logrus.Fields{
+		"to": input.To,
+	}).Info(util.Trace("sent study invitation email"))
+	return nil
+}
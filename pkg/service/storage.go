@@ -14,11 +14,13 @@ import (
 	_ "image/png"
 	"io"
 	"strings"
+	"time"
 
 	"github.com/disintegration/imaging"
 	"github.com/marksauter/markus-ninja-api/pkg/myaws"
 	"github.com/marksauter/markus-ninja-api/pkg/myconf"
 	"github.com/marksauter/markus-ninja-api/pkg/mylog"
+	"github.com/marksauter/markus-ninja-api/pkg/mymetrics"
 	"github.com/marksauter/markus-ninja-api/pkg/mytype"
 	"github.com/marksauter/markus-ninja-api/pkg/util"
 	minio "github.com/minio/minio-go"
@@ -102,11 +104,7 @@ func (s *StorageService) Get(
 		"user_id": userID.String,
 		"key":     key,
 	}).Info(util.Trace("object found"))
-	return s.svc.GetObject(
-		s.bucket,
-		objectPath,
-		minio.GetObjectOptions{},
-	)
+	return s.getObject(objectPath)
 }
 
 // GetThumbnail - get a thumbnail of an asset, and generate it first if
@@ -133,11 +131,7 @@ func (s *StorageService) GetThumbnail(
 		objectName,
 	}, "/")
 
-	objInfo, err := s.svc.StatObject(
-		s.bucket,
-		objectPath,
-		minio.StatObjectOptions{},
-	)
+	objInfo, err := s.statObject(objectPath)
 	if err != nil {
 		minioError := minio.ToErrorResponse(err)
 		if minioError.Code != "NoSuchKey" {
@@ -191,12 +185,11 @@ func (s *StorageService) GetThumbnail(
 			return nil, err
 		}
 
-		_, err = s.svc.PutObject(
-			s.bucket,
+		_, err = s.putObject(
 			objectPath,
 			thumbFile,
 			thumbStat.Size(),
-			minio.PutObjectOptions{ContentType: objInfo.ContentType},
+			objInfo.ContentType,
 		)
 		if err != nil {
 			mylog.Log.WithError(err).Error(util.Trace(""))
@@ -209,11 +202,7 @@ func (s *StorageService) GetThumbnail(
 		"user_id": userID.String,
 		"key":     key,
 	}).Info(util.Trace("thumbnail found"))
-	return s.svc.GetObject(
-		s.bucket,
-		objectPath,
-		minio.GetObjectOptions{},
-	)
+	return s.getObject(objectPath)
 }
 
 // UploadResponse - response object from Upload
@@ -246,23 +235,18 @@ func (s *StorageService) Upload(
 		objectName,
 	}, "/")
 
-	_, err := s.svc.StatObject(
-		s.bucket,
-		objectPath,
-		minio.StatObjectOptions{},
-	)
+	_, err := s.statObject(objectPath)
 	if err != nil {
 		minioError := minio.ToErrorResponse(err)
 		if minioError.Code != "NoSuchKey" {
 			mylog.Log.WithError(err).Error(util.Trace(""))
 			return nil, err
 		}
-		n, err := s.svc.PutObject(
-			s.bucket,
+		n, err := s.putObject(
 			objectPath,
 			file,
 			size,
-			minio.PutObjectOptions{ContentType: contentType},
+			contentType,
 		)
 		if err != nil {
 			mylog.Log.WithError(err).Error(util.Trace(""))
@@ -282,3 +266,211 @@ func (s *StorageService) Upload(
 		IsNewObject: false,
 	}, nil
 }
+
+// Delete removes the object of the asset identified by userID and key, along
+// with any thumbnails generated from it, and returns the number of objects
+// removed.
+func (s *StorageService) Delete(
+	userID *mytype.OID,
+	key string,
+) (int, error) {
+	objectName := fmt.Sprintf(
+		"%s/%s/%s/%s",
+		key[:2],
+		key[3:5],
+		key[6:8],
+		key[9:],
+	)
+	objectPath := strings.Join([]string{
+		userID.Short,
+		objectName,
+	}, "/")
+
+	// Thumbnails share the object path of the asset as a prefix.
+	objectPaths, err := s.listObjects(objectPath)
+	if err != nil {
+		mylog.Log.WithError(err).Error(util.Trace(""))
+		return 0, err
+	}
+
+	n := 0
+	for _, p := range objectPaths {
+		if p != objectPath && !strings.HasPrefix(p, objectPath+"--") {
+			continue
+		}
+		if err := s.removeObject(p); err != nil {
+			mylog.Log.WithError(err).Error(util.Trace(""))
+			return n, err
+		}
+		n++
+	}
+
+	mylog.Log.WithFields(logrus.Fields{
+		"user_id": userID.String,
+		"key":     key,
+		"n":       n,
+	}).Info(util.Trace("objects removed"))
+	return n, nil
+}
+
+// DeleteUser removes every object stored for the user, i.e. their assets with
+// thumbnails and their data exports, and returns the number removed.
+func (s *StorageService) DeleteUser(userID *mytype.OID) (int, error) {
+	n := 0
+	for _, prefix := range []string{
+		userID.Short + "/",
+		"exports/" + userID.Short + "/",
+	} {
+		objectPaths, err := s.listObjects(prefix)
+		if err != nil {
+			mylog.Log.WithError(err).Error(util.Trace(""))
+			return n, err
+		}
+		for _, p := range objectPaths {
+			if err := s.removeObject(p); err != nil {
+				mylog.Log.WithError(err).Error(util.Trace(""))
+				return n, err
+			}
+			n++
+		}
+	}
+
+	mylog.Log.WithFields(logrus.Fields{
+		"user_id": userID.String,
+		"n":       n,
+	}).Info(util.Trace("objects removed"))
+	return n, nil
+}
+
+// UploadDataExport uploads the archive of a data export, and returns the path
+// of its object.
+func (s *StorageService) UploadDataExport(
+	userID *mytype.OID,
+	exportID *mytype.OID,
+	file io.Reader,
+	size int64,
+) (string, error) {
+	objectPath := strings.Join([]string{
+		"exports",
+		userID.Short,
+		exportID.Short + ".zip",
+	}, "/")
+
+	n, err := s.putObject(objectPath, file, size, "application/zip")
+	if err != nil {
+		mylog.Log.WithError(err).Error(util.Trace(""))
+		return "", err
+	}
+
+	mylog.Log.WithFields(logrus.Fields{
+		"user_id": userID.String,
+		"size":    n,
+	}).Info(util.Trace("uploaded data export"))
+	return objectPath, nil
+}
+
+// DeleteDataExport deletes the archive of a data export at objectPath.
+func (s *StorageService) DeleteDataExport(objectPath string) error {
+	if err := s.removeObject(objectPath); err != nil {
+		mylog.Log.WithError(err).Error(util.Trace(""))
+		return err
+	}
+
+	mylog.Log.WithField("object_path", objectPath).Info(util.Trace("deleted data export"))
+	return nil
+}
+
+// PresignedURL returns a signed URL, which expires after expires, for
+// downloading the object at objectPath without credentials.
+func (s *StorageService) PresignedURL(
+	objectPath string,
+	expires time.Duration,
+) (string, error) {
+	defer mymetrics.ObserveSince(
+		mymetrics.StorageRequestDuration.WithLabelValues("presigned_get_object"),
+		time.Now(),
+	)
+	u, err := s.svc.PresignedGetObject(s.bucket, objectPath, expires, nil)
+	if err != nil {
+		mylog.Log.WithError(err).Error(util.Trace(""))
+		return "", err
+	}
+	return u.String(), nil
+}
+
+// Ping checks that the storage service is reachable, and the bucket exists.
+func (s *StorageService) Ping() error {
+	defer mymetrics.ObserveSince(
+		mymetrics.StorageRequestDuration.WithLabelValues("bucket_exists"),
+		time.Now(),
+	)
+	exists, err := s.svc.BucketExists(s.bucket)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		return fmt.Errorf("bucket %q does not exist", s.bucket)
+	}
+	return nil
+}
+
+func (s *StorageService) getObject(objectPath string) (*minio.Object, error) {
+	defer mymetrics.ObserveSince(
+		mymetrics.StorageRequestDuration.WithLabelValues("get_object"),
+		time.Now(),
+	)
+	return s.svc.GetObject(s.bucket, objectPath, minio.GetObjectOptions{})
+}
+
+func (s *StorageService) statObject(objectPath string) (minio.ObjectInfo, error) {
+	defer mymetrics.ObserveSince(
+		mymetrics.StorageRequestDuration.WithLabelValues("stat_object"),
+		time.Now(),
+	)
+	return s.svc.StatObject(s.bucket, objectPath, minio.StatObjectOptions{})
+}
+
+func (s *StorageService) putObject(
+	objectPath string,
+	reader io.Reader,
+	size int64,
+	contentType string,
+) (int64, error) {
+	defer mymetrics.ObserveSince(
+		mymetrics.StorageRequestDuration.WithLabelValues("put_object"),
+		time.Now(),
+	)
+	return s.svc.PutObject(
+		s.bucket,
+		objectPath,
+		reader,
+		size,
+		minio.PutObjectOptions{ContentType: contentType},
+	)
+}
+
+func (s *StorageService) listObjects(prefix string) ([]string, error) {
+	defer mymetrics.ObserveSince(
+		mymetrics.StorageRequestDuration.WithLabelValues("list_objects"),
+		time.Now(),
+	)
+	done := make(chan struct{})
+	defer close(done)
+
+	var objectPaths []string
+	for obj := range s.svc.ListObjectsV2(s.bucket, prefix, true, done) {
+		if obj.Err != nil {
+			return nil, obj.Err
+		}
+		objectPaths = append(objectPaths, obj.Key)
+	}
+	return objectPaths, nil
+}
+
+func (s *StorageService) removeObject(objectPath string) error {
+	defer mymetrics.ObserveSince(
+		mymetrics.StorageRequestDuration.WithLabelValues("remove_object"),
+		time.Now(),
+	)
+	return s.svc.RemoveObject(s.bucket, objectPath)
+}
@@ -0,0 +1,47 @@
+package util
+
+// TopologicalSort orders nodes so that each node comes after the nodes it
+// depends on. Of the nodes whose dependencies have been placed, the one
+// earliest in nodes is placed next, so the order is stable. Dependencies on
+// nodes not in nodes are ignored. If the dependencies contain a cycle, the
+// nodes on it are left out, and ok is false.
+func TopologicalSort(
+	nodes []string,
+	dependencies map[string][]string,
+) (sorted []string, ok bool) {
+	index := make(map[string]int, len(nodes))
+	for i, n := range nodes {
+		index[n] = i
+	}
+	waiting := make(map[string]int, len(nodes))
+	dependents := make(map[string][]string, len(nodes))
+	for _, n := range nodes {
+		for _, d := range dependencies[n] {
+			if _, found := index[d]; found {
+				waiting[n]++
+				dependents[d] = append(dependents[d], n)
+			}
+		}
+	}
+
+	placed := make([]bool, len(nodes))
+	sorted = make([]string, 0, len(nodes))
+	for len(sorted) < len(nodes) {
+		next := -1
+		for i, n := range nodes {
+			if !placed[i] && waiting[n] == 0 {
+				next = i
+				break
+			}
+		}
+		if next == -1 {
+			return sorted, false
+		}
+		placed[next] = true
+		sorted = append(sorted, nodes[next])
+		for _, n := range dependents[nodes[next]] {
+			waiting[n]--
+		}
+	}
+	return sorted, true
+}
@@ -0,0 +1,68 @@
+package util_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/marksauter/markus-ninja-api/pkg/util"
+)
+
+var topologicalSortTests = []struct {
+	name         string
+	nodes        []string
+	dependencies map[string][]string
+	expected     []string
+	expectedOK   bool
+}{
+	{
+		"no dependencies keeps order",
+		[]string{"a", "b", "c"},
+		nil,
+		[]string{"a", "b", "c"},
+		true,
+	},
+	{
+		"dependency moves ahead",
+		[]string{"a", "b", "c"},
+		map[string][]string{"a": {"c"}},
+		[]string{"b", "c", "a"},
+		true,
+	},
+	{
+		"diamond",
+		[]string{"d", "c", "b", "a"},
+		map[string][]string{"d": {"b", "c"}, "b": {"a"}, "c": {"a"}},
+		[]string{"a", "c", "b", "d"},
+		true,
+	},
+	{
+		"unknown dependency ignored",
+		[]string{"a", "b"},
+		map[string][]string{"b": {"x"}},
+		[]string{"a", "b"},
+		true,
+	},
+	{
+		"cycle left out",
+		[]string{"a", "b", "c"},
+		map[string][]string{"a": {"b"}, "b": {"a"}},
+		[]string{"c"},
+		false,
+	},
+}
+
+func TestTopologicalSort(t *testing.T) {
+	for _, tt := range topologicalSortTests {
+		actual, ok := util.TopologicalSort(tt.nodes, tt.dependencies)
+		if ok != tt.expectedOK || !reflect.DeepEqual(actual, tt.expected) {
+			t.Errorf(
+				"TestTopologicalSort(%s): expected %v %v, actual %v %v",
+				tt.name,
+				tt.expected,
+				tt.expectedOK,
+				actual,
+				ok,
+			)
+		}
+	}
+}
@@ -0,0 +1,97 @@
+package util
+
+import (
+	"container/list"
+	"sync"
+	"time"
+)
+
+// LRUCache is a cache safe for concurrent use, which holds up to a fixed
+// number of entries for a limited time, evicting the least recently used entry
+// when full.
+type LRUCache struct {
+	entries map[string]*list.Element
+	list    *list.List
+	mu      sync.Mutex
+	size    int
+	ttl     time.Duration
+}
+
+type lruEntry struct {
+	expiresAt time.Time
+	key       string
+	value     interface{}
+}
+
+// NewLRUCache returns a cache of up to size entries, which expire ttl after
+// they are added. A cache of size 0 holds nothing.
+func NewLRUCache(size int, ttl time.Duration) *LRUCache {
+	return &LRUCache{
+		entries: make(map[string]*list.Element, size),
+		list:    list.New(),
+		size:    size,
+		ttl:     ttl,
+	}
+}
+
+// Add adds the value to the cache under key, replacing any value already
+// cached under it.
+func (c *LRUCache) Add(key string, value interface{}) {
+	if c == nil || c.size <= 0 {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	entry := &lruEntry{
+		expiresAt: time.Now().Add(c.ttl),
+		key:       key,
+		value:     value,
+	}
+	if e, ok := c.entries[key]; ok {
+		e.Value = entry
+		c.list.MoveToFront(e)
+		return
+	}
+	c.entries[key] = c.list.PushFront(entry)
+	if c.list.Len() > c.size {
+		c.remove(c.list.Back())
+	}
+}
+
+// Get returns the value cached under key, if it has not expired.
+func (c *LRUCache) Get(key string) (interface{}, bool) {
+	if c == nil {
+		return nil, false
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	e, ok := c.entries[key]
+	if !ok {
+		return nil, false
+	}
+	entry := e.Value.(*lruEntry)
+	if time.Now().After(entry.expiresAt) {
+		c.remove(e)
+		return nil, false
+	}
+	c.list.MoveToFront(e)
+	return entry.value, true
+}
+
+// Len returns the number of entries in the cache, including expired entries
+// not yet evicted.
+func (c *LRUCache) Len() int {
+	if c == nil {
+		return 0
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.list.Len()
+}
+
+func (c *LRUCache) remove(e *list.Element) {
+	c.list.Remove(e)
+	delete(c.entries, e.Value.(*lruEntry).key)
+}
@@ -0,0 +1,41 @@
+package util_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/marksauter/markus-ninja-api/pkg/util"
+)
+
+func TestLRUCache(t *testing.T) {
+	c := util.NewLRUCache(2, time.Minute)
+	c.Add("a", 1)
+	c.Add("b", 2)
+	if v, ok := c.Get("a"); !ok || v != 1 {
+		t.Errorf("Get(%q) actual %v, %v expected %v, %v", "a", v, ok, 1, true)
+	}
+	// b is now the least recently used entry, so it is evicted.
+	c.Add("c", 3)
+	if v, ok := c.Get("b"); ok {
+		t.Errorf("Get(%q) actual %v, %v expected evicted", "b", v, ok)
+	}
+	for key, expected := range map[string]int{"a": 1, "c": 3} {
+		if v, ok := c.Get(key); !ok || v != expected {
+			t.Errorf("Get(%q) actual %v, %v expected %v, %v", key, v, ok, expected, true)
+		}
+	}
+	if n := c.Len(); n != 2 {
+		t.Errorf("Len() actual %d expected %d", n, 2)
+	}
+}
+
+func TestLRUCacheExpiry(t *testing.T) {
+	c := util.NewLRUCache(2, -time.Second)
+	c.Add("a", 1)
+	if v, ok := c.Get("a"); ok {
+		t.Errorf("Get(%q) actual %v, %v expected expired", "a", v, ok)
+	}
+	if n := c.Len(); n != 0 {
+		t.Errorf("Len() actual %d expected %d", n, 0)
+	}
+}
@@ -0,0 +1,405 @@
+package util
+
+import (
+	"bytes"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"html"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/alecthomas/chroma"
+	"github.com/alecthomas/chroma/lexers"
+	"github.com/microcosm-cc/bluemonday"
+	"github.com/writeas/go-strip-markdown"
+	blackfriday "gopkg.in/russross/blackfriday.v2"
+)
+
+var implicitFiguresRegexp = regexp.MustCompile(`<p><img src="(.+)" alt="(.*)"\s*/></p>`)
+
+func implicitFigures(s string) string {
+	result := implicitFiguresRegexp.FindStringSubmatch(s)
+	if len(result) == 0 {
+		return s
+	}
+	src := result[1]
+	figcaption := result[2]
+
+	figure := `<figure><img src="` + src + `" alt=""/>`
+	if figcaption != "" {
+		figure += `<figcaption>` + figcaption + `</figcaption>`
+	}
+	figure += `</figure>`
+
+	return figure
+}
+
+func MarkdownImplicitFigures(input []byte) []byte {
+	markdown := string(input)
+	withFigures := implicitFiguresRegexp.ReplaceAllStringFunc(markdown, implicitFigures)
+
+	return []byte(withFigures)
+}
+
+// Slugify returns the slug of the heading text s, which its heading ID is made
+// from: lower case letters and digits, with every other run of characters
+// replaced by a single hyphen.
+func Slugify(s string) string {
+	var b strings.Builder
+	hyphen := false
+	for _, r := range strings.ToLower(s) {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			if hyphen && b.Len() > 0 {
+				b.WriteRune('-')
+			}
+			hyphen = false
+			b.WriteRune(r)
+		} else {
+			hyphen = true
+		}
+	}
+	if b.Len() == 0 {
+		return "section"
+	}
+	return b.String()
+}
+
+// mathSpan is a `$...$` or `$$...$$` span of TeX cut out of the Markdown
+// before it is rendered, so that blackfriday does not mistake its underscores
+// and asterisks for emphasis.
+type mathSpan struct {
+	display bool
+	tex     string
+}
+
+func (m mathSpan) html() string {
+	class := "math math-inline"
+	if m.display {
+		class = "math math-display"
+	}
+	return `<span class="` + class + `">` + html.EscapeString(m.tex) + `</span>`
+}
+
+// extractMath replaces the math spans of input, outside of code, with
+// placeholders. The placeholders are made unguessable with a random nonce, so
+// that users cannot forge them.
+func extractMath(input []byte) ([]byte, *regexp.Regexp, []mathSpan) {
+	nonceBytes := make([]byte, 8)
+	rand.Read(nonceBytes)
+	nonce := hex.EncodeToString(nonceBytes)
+	placeholder := func(i int) string {
+		return fmt.Sprintf("mathph%sx%dx", nonce, i)
+	}
+
+	var (
+		out    bytes.Buffer
+		spans  []mathSpan
+		inCode bool
+		fence  string
+	)
+	s := string(input)
+	i := 0
+	for i < len(s) {
+		if i == 0 || s[i-1] == '\n' {
+			line := s[i:]
+			if end := strings.IndexByte(line, '\n'); end != -1 {
+				line = line[:end+1]
+			}
+			trimmed := strings.TrimLeft(line, " ")
+			if len(line)-len(trimmed) <= 3 {
+				if inCode && strings.HasPrefix(trimmed, fence) {
+					inCode = false
+					out.WriteString(line)
+					i += len(line)
+					continue
+				} else if !inCode && (strings.HasPrefix(trimmed, "```") || strings.HasPrefix(trimmed, "~~~")) {
+					inCode = true
+					fence = trimmed[:3]
+					out.WriteString(line)
+					i += len(line)
+					continue
+				}
+			}
+			if inCode {
+				out.WriteString(line)
+				i += len(line)
+				continue
+			}
+		}
+
+		switch c := s[i]; {
+		case c == '\\' && i+1 < len(s):
+			out.WriteString(s[i : i+2])
+			i += 2
+			continue
+		case c == '`':
+			n := 1
+			for i+n < len(s) && s[i+n] == '`' {
+				n++
+			}
+			ticks := s[i : i+n]
+			if end := strings.Index(s[i+n:], ticks); end != -1 {
+				out.WriteString(s[i : i+n+end+n])
+				i += n + end + n
+			} else {
+				out.WriteString(ticks)
+				i += n
+			}
+			continue
+		case strings.HasPrefix(s[i:], "$$"):
+			if end := strings.Index(s[i+2:], "$$"); end > 0 {
+				tex := strings.TrimSpace(s[i+2 : i+2+end])
+				if tex != "" {
+					out.WriteString(placeholder(len(spans)))
+					spans = append(spans, mathSpan{display: true, tex: tex})
+					i += 2 + end + 2
+					continue
+				}
+			}
+		case c == '$':
+			if end := inlineMathEnd(s[i+1:]); end != -1 {
+				out.WriteString(placeholder(len(spans)))
+				spans = append(spans, mathSpan{tex: s[i+1 : i+1+end]})
+				i += 1 + end + 1
+				continue
+			}
+		}
+		out.WriteByte(s[i])
+		i++
+	}
+
+	placeholderRegexp := regexp.MustCompile(`mathph` + nonce + `x(\d+)x`)
+	return out.Bytes(), placeholderRegexp, spans
+}
+
+// inlineMathEnd returns the index of the `$` closing the inline math at the
+// start of s, or -1 if there is none. Like Pandoc, the opening `$` must be
+// followed by a non-space, and the closing `$` preceded by a non-space and not
+// followed by a digit, so that prices such as $5 and $10 are left alone.
+func inlineMathEnd(s string) int {
+	if s == "" || s[0] == ' ' || s[0] == '\t' || s[0] == '\n' || s[0] == '$' {
+		return -1
+	}
+	for j := 0; j < len(s); j++ {
+		switch s[j] {
+		case '\n':
+			return -1
+		case '\\':
+			j++
+		case '$':
+			if s[j-1] == ' ' || s[j-1] == '\t' {
+				continue
+			}
+			if j+1 < len(s) && s[j+1] >= '0' && s[j+1] <= '9' {
+				continue
+			}
+			return j
+		}
+	}
+	return -1
+}
+
+const markdownExtensions = blackfriday.CommonExtensions |
+	blackfriday.HardLineBreak |
+	blackfriday.Footnotes
+
+// markdownRenderer extends the blackfriday HTML renderer with slug IDs on
+// headings, and class-based syntax highlighting of fenced code blocks.
+type markdownRenderer struct {
+	*blackfriday.HTMLRenderer
+	headingIDs  map[string]int
+	math        []mathSpan
+	placeholder *regexp.Regexp
+}
+
+func newMarkdownRenderer(math []mathSpan, placeholder *regexp.Regexp) *markdownRenderer {
+	return &markdownRenderer{
+		HTMLRenderer: blackfriday.NewHTMLRenderer(blackfriday.HTMLRendererParameters{
+			Flags: blackfriday.CommonHTMLFlags,
+		}),
+		headingIDs:  make(map[string]int),
+		math:        math,
+		placeholder: placeholder,
+	}
+}
+
+func (r *markdownRenderer) RenderNode(
+	w io.Writer,
+	node *blackfriday.Node,
+	entering bool,
+) blackfriday.WalkStatus {
+	switch node.Type {
+	case blackfriday.Heading:
+		if entering {
+			node.HeadingID = r.headingID(node)
+		}
+	case blackfriday.CodeBlock:
+		if r.highlight(w, node) {
+			return blackfriday.GoToNext
+		}
+	}
+	return r.HTMLRenderer.RenderNode(w, node, entering)
+}
+
+// HeadingIDPrefix prefixes the IDs of headings, so that those of user content
+// cannot clobber the IDs, and the globals, of the app.
+const HeadingIDPrefix = "user-content-"
+
+// headingID returns the ID of the heading: its explicit ID if it has one, or
+// else the slug of its text, suffixed with -1, -2, etc. if already taken, and
+// prefixed with HeadingIDPrefix. The suffixes are chosen as blackfriday would,
+// so that it leaves them alone.
+func (r *markdownRenderer) headingID(heading *blackfriday.Node) string {
+	id := heading.HeadingID
+	if id == "" {
+		id = Slugify(r.headingText(heading))
+	}
+	for count, found := r.headingIDs[id]; found; count, found = r.headingIDs[id] {
+		next := fmt.Sprintf("%s-%d", id, count+1)
+		if _, taken := r.headingIDs[next]; !taken {
+			r.headingIDs[id] = count + 1
+			id = next
+		} else {
+			id = id + "-1"
+		}
+	}
+	r.headingIDs[id] = 0
+	return HeadingIDPrefix + id
+}
+
+func (r *markdownRenderer) headingText(heading *blackfriday.Node) string {
+	var b bytes.Buffer
+	heading.Walk(func(node *blackfriday.Node, entering bool) blackfriday.WalkStatus {
+		if node.Type == blackfriday.Text || node.Type == blackfriday.Code {
+			b.Write(node.Literal)
+		}
+		return blackfriday.GoToNext
+	})
+	return r.placeholder.ReplaceAllStringFunc(b.String(), func(s string) string {
+		return r.math[r.mathIndex(s)].tex
+	})
+}
+
+func (r *markdownRenderer) mathIndex(placeholder string) int {
+	i, _ := strconv.Atoi(r.placeholder.FindStringSubmatch(placeholder)[1])
+	return i
+}
+
+// highlight writes the code block node with each token wrapped in a span
+// classed with its chroma short name, e.g. `<span class="kd">func</span>`, so
+// that clients may style it with any chroma or Pygments stylesheet. It returns
+// false if the language of the block is unknown.
+func (r *markdownRenderer) highlight(w io.Writer, node *blackfriday.Node) bool {
+	fields := strings.Fields(string(node.Info))
+	if len(fields) == 0 {
+		return false
+	}
+	lang := fields[0]
+	lexer := lexers.Get(lang)
+	if lexer == nil {
+		return false
+	}
+	iterator, err := chroma.Coalesce(lexer).Tokenise(nil, string(node.Literal))
+	if err != nil {
+		return false
+	}
+
+	io.WriteString(w, `<pre class="chroma"><code class="language-`+html.EscapeString(lang)+`">`)
+	for token := iterator(); token != chroma.EOF; token = iterator() {
+		class := chroma.StandardTypes[token.Type]
+		if class == "" {
+			class = chroma.StandardTypes[token.Type.SubCategory()]
+		}
+		if class == "" {
+			class = chroma.StandardTypes[token.Type.Category()]
+		}
+		if class == "" {
+			io.WriteString(w, html.EscapeString(token.Value))
+			continue
+		}
+		io.WriteString(w, `<span class="`+class+`">`+html.EscapeString(token.Value)+`</span>`)
+	}
+	io.WriteString(w, "</code></pre>\n")
+	return true
+}
+
+var codeBlockLanguageRegexp = regexp.MustCompile(`^language-[a-z-A-Z0-9+#]+$`)
+var codeBlockPreRegexp = regexp.MustCompile(`^chroma$`)
+var spanClassesRegexp = regexp.MustCompile(`^([a-z][a-z0-9]{0,2}|math math-(inline|display))$`)
+var paragraphClassesRegexp = regexp.MustCompile(`^((caption|leading)(\s+|$))*$`)
+var figureClassesRegexp = regexp.MustCompile(`^((left|right)(\s+|$))*$`)
+var anchorTargetRegexp = regexp.MustCompile(`^(_blank)$`)
+var headingIDRegexp = regexp.MustCompile(`^[\p{L}\p{N}-]+$`)
+
+var markdownPolicy = newMarkdownPolicy()
+
+// newMarkdownPolicy returns the UGC policy, extended only with the classes and
+// IDs the renderer emits itself. Style attributes, and so inline colors, are
+// still stripped.
+func newMarkdownPolicy() *bluemonday.Policy {
+	p := bluemonday.UGCPolicy()
+	p.AllowAttrs("class").Matching(codeBlockLanguageRegexp).OnElements("code")
+	p.AllowAttrs("class").Matching(codeBlockPreRegexp).OnElements("pre")
+	p.AllowAttrs("class").Matching(spanClassesRegexp).OnElements("span")
+	p.AllowAttrs("class").Matching(paragraphClassesRegexp).OnElements("p")
+	p.AllowAttrs("class").Matching(figureClassesRegexp).OnElements("figure")
+	p.AllowAttrs("target").Matching(anchorTargetRegexp).OnElements("a")
+	p.AllowAttrs("id").Matching(headingIDRegexp).OnElements("h1", "h2", "h3", "h4", "h5", "h6")
+	return p
+}
+
+// MarkdownToHTML renders input to sanitized HTML. Fenced code blocks in a known
+// language are highlighted, `$...$` and `$$...$$` math is rendered to spans
+// classed `math math-inline` and `math math-display` holding the escaped TeX,
+// for the client to typeset with KaTeX, and headings get slug IDs.
+func MarkdownToHTML(input []byte) []byte {
+	withoutMath, placeholder, math := extractMath(input)
+	renderer := newMarkdownRenderer(math, placeholder)
+	unsafe := blackfriday.Run(
+		withoutMath,
+		blackfriday.WithRenderer(renderer),
+		blackfriday.WithExtensions(markdownExtensions),
+	)
+	unsafeWithFigures := MarkdownImplicitFigures(unsafe)
+	unsafeWithMath := placeholder.ReplaceAllFunc(unsafeWithFigures, func(b []byte) []byte {
+		return []byte(math[renderer.mathIndex(string(b))].html())
+	})
+	return markdownPolicy.SanitizeBytes(unsafeWithMath)
+}
+
+// MarkdownHeading is a heading of a Markdown document.
+type MarkdownHeading struct {
+	Level int    `json:"level"`
+	Slug  string `json:"slug"`
+	Text  string `json:"text"`
+}
+
+// MarkdownHeadings returns the headings of input in document order, with the
+// slugs MarkdownToHTML gives them as IDs.
+func MarkdownHeadings(input []byte) []MarkdownHeading {
+	withoutMath, placeholder, math := extractMath(input)
+	renderer := newMarkdownRenderer(math, placeholder)
+	root := blackfriday.New(blackfriday.WithExtensions(markdownExtensions)).Parse(withoutMath)
+
+	headings := []MarkdownHeading{}
+	root.Walk(func(node *blackfriday.Node, entering bool) blackfriday.WalkStatus {
+		if entering && node.Type == blackfriday.Heading {
+			headings = append(headings, MarkdownHeading{
+				Level: node.Level,
+				Slug:  renderer.headingID(node),
+				Text:  strings.TrimSpace(renderer.headingText(node)),
+			})
+			return blackfriday.SkipChildren
+		}
+		return blackfriday.GoToNext
+	})
+	return headings
+}
+
+func MarkdownToText(s string) string {
+	return stripmd.Strip(s)
+}
@@ -0,0 +1,178 @@
+package util_test
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/marksauter/markus-ninja-api/pkg/util"
+)
+
+var slugifyTests = []struct {
+	s        string
+	expected string
+}{
+	{"Hello, World!", "hello-world"},
+	{"  Leading and trailing  ", "leading-and-trailing"},
+	{"Go's `defer` statement", "go-s-defer-statement"},
+	{"Café au lait", "café-au-lait"},
+	{"1.2 Sub-section", "1-2-sub-section"},
+	{"???", "section"},
+}
+
+func TestSlugify(t *testing.T) {
+	for _, tt := range slugifyTests {
+		actual := util.Slugify(tt.s)
+		if actual != tt.expected {
+			t.Errorf(
+				"TestSlugify(%s): expected %v, actual %v",
+				tt.s,
+				tt.expected,
+				actual,
+			)
+		}
+	}
+}
+
+var markdownToHTMLTests = []struct {
+	name        string
+	markdown    string
+	contains    []string
+	notContains []string
+}{
+	{
+		"heading ids",
+		"# Intro\n\n## Intro\n\n## Área $x_1$",
+		[]string{
+			`<h1 id="user-content-intro">`,
+			`<h2 id="user-content-intro-1">`,
+			`<h2 id="user-content-área-x-1">`,
+		},
+		nil,
+	},
+	{
+		"explicit heading id",
+		"# Intro {#start}",
+		[]string{`<h1 id="user-content-start">`},
+		nil,
+	},
+	{
+		"explicit heading id of the app",
+		"# App {#root}",
+		[]string{`<h1 id="user-content-root">`},
+		[]string{`id="root"`},
+	},
+	{
+		"inline math",
+		"Let $a_1 * b_2 < c$ hold.",
+		[]string{`<span class="math math-inline">a_1 * b_2 &lt; c</span>`},
+		[]string{"<em>"},
+	},
+	{
+		"display math",
+		"$$\n\\sum_{i=1}^n i\n$$",
+		[]string{`<span class="math math-display">\sum_{i=1}^n i</span>`},
+		nil,
+	},
+	{
+		"prices are not math",
+		"It costs $5 or $10.",
+		[]string{"It costs $5 or $10."},
+		[]string{"math"},
+	},
+	{
+		"math in code is left alone",
+		"`$x$`\n\n```\n$y$\n```",
+		[]string{"<code>$x$</code>", "$y$"},
+		[]string{"math"},
+	},
+	{
+		"highlighted code",
+		"```go\nfunc main() {}\n```",
+		[]string{`<pre class="chroma"><code class="language-go">`, `<span class="kd">func</span>`},
+		nil,
+	},
+	{
+		"unknown language",
+		"```nosuchlang\nfoo\n```",
+		[]string{`<code class="language-nosuchlang">foo`},
+		nil,
+	},
+	{
+		"math does not reopen xss",
+		`$</span><script>alert(1)</script>$`,
+		[]string{"&lt;script&gt;"},
+		[]string{"<script>"},
+	},
+	{
+		"code does not reopen xss",
+		"```html\n<script>alert(1)</script>\n```",
+		[]string{"&lt;"},
+		[]string{"<script>"},
+	},
+	{
+		"raw classes and styles are stripped",
+		`<span class="evil">x</span><pre class="evil">y</pre><span style="color:red" class="k">z</span>`,
+		[]string{`<span class="k">z</span>`},
+		[]string{"evil", "style"},
+	},
+}
+
+func TestMarkdownToHTML(t *testing.T) {
+	for _, tt := range markdownToHTMLTests {
+		actual := string(util.MarkdownToHTML([]byte(tt.markdown)))
+		for _, s := range tt.contains {
+			if !strings.Contains(actual, s) {
+				t.Errorf("TestMarkdownToHTML(%s): expected %q in %q", tt.name, s, actual)
+			}
+		}
+		for _, s := range tt.notContains {
+			if strings.Contains(actual, s) {
+				t.Errorf("TestMarkdownToHTML(%s): unexpected %q in %q", tt.name, s, actual)
+			}
+		}
+	}
+}
+
+var markdownHeadingsTests = []struct {
+	markdown string
+	expected []util.MarkdownHeading
+}{
+	{"No headings here.", []util.MarkdownHeading{}},
+	{
+		"# Intro\n\ntext\n\n## Intro\n\n### Área $x_1$\n\n```\n# not a heading\n```",
+		[]util.MarkdownHeading{
+			{Level: 1, Slug: "user-content-intro", Text: "Intro"},
+			{Level: 2, Slug: "user-content-intro-1", Text: "Intro"},
+			{Level: 3, Slug: "user-content-área-x-1", Text: "Área x_1"},
+		},
+	},
+	{
+		"# Intro {#start}\n\n## Start\n\n## Intro",
+		[]util.MarkdownHeading{
+			{Level: 1, Slug: "user-content-start", Text: "Intro"},
+			{Level: 2, Slug: "user-content-start-1", Text: "Start"},
+			{Level: 2, Slug: "user-content-intro", Text: "Intro"},
+		},
+	},
+}
+
+func TestMarkdownHeadings(t *testing.T) {
+	for _, tt := range markdownHeadingsTests {
+		actual := util.MarkdownHeadings([]byte(tt.markdown))
+		if !reflect.DeepEqual(actual, tt.expected) {
+			t.Errorf(
+				"TestMarkdownHeadings(%q): expected %v, actual %v",
+				tt.markdown,
+				tt.expected,
+				actual,
+			)
+		}
+		html := string(util.MarkdownToHTML([]byte(tt.markdown)))
+		for _, heading := range actual {
+			if !strings.Contains(html, `id="`+heading.Slug+`"`) {
+				t.Errorf("TestMarkdownHeadings(%q): expected id %q in %q", tt.markdown, heading.Slug, html)
+			}
+		}
+	}
+}
@@ -0,0 +1,68 @@
+package util
+
+import (
+	"net/url"
+	"strings"
+)
+
+// Redacted replaces secrets in logs and traces.
+const Redacted = "[REDACTED]"
+
+// sensitiveKeys are substrings of keys whose values must never be logged or
+// traced.
+var sensitiveKeys = []string{
+	"authorization",
+	"cookie",
+	"email",
+	"password",
+	"secret",
+	"token",
+}
+
+// IsSensitiveKey returns whether the value of the key, e.g. a log field or a
+// query parameter, is a secret.
+func IsSensitiveKey(key string) bool {
+	key = strings.ToLower(key)
+	for _, k := range sensitiveKeys {
+		if strings.Contains(key, k) {
+			return true
+		}
+	}
+	return false
+}
+
+// secretPathSegments are the path segments that are followed by a secret path
+// segment, e.g. /user/{login}/emails/{id}/confirm_verification/{token} and
+// /feeds/private/{token}/{login}.atom.
+var secretPathSegments = map[string]bool{
+	"confirm_verification": true,
+	"private":              true,
+}
+
+// RedactPath returns the path of u with secret path segments replaced.
+func RedactPath(u *url.URL) string {
+	segments := strings.Split(u.EscapedPath(), "/")
+	for i := 1; i < len(segments); i++ {
+		if secretPathSegments[segments[i-1]] {
+			segments[i] = Redacted
+		}
+	}
+	return strings.Join(segments, "/")
+}
+
+// RedactURL returns the path and query of u with secret path segments and the
+// values of sensitive query parameters replaced.
+func RedactURL(u *url.URL) string {
+	s := RedactPath(u)
+	if u.RawQuery == "" {
+		return s
+	}
+	params := strings.Split(u.RawQuery, "&")
+	for i, param := range params {
+		key := strings.SplitN(param, "=", 2)[0]
+		if k, err := url.QueryUnescape(key); err == nil && IsSensitiveKey(k) {
+			params[i] = key + "=" + Redacted
+		}
+	}
+	return s + "?" + strings.Join(params, "&")
+}
@@ -0,0 +1,37 @@
+package util_test
+
+import (
+	"net/url"
+	"testing"
+
+	"github.com/marksauter/markus-ninja-api/pkg/util"
+)
+
+func TestRedactURL(t *testing.T) {
+	redacted := util.Redacted
+	var tests = []struct {
+		url      string
+		expected string
+	}{
+		{"/graphql", "/graphql"},
+		{"/feeds/markus.atom?page=2", "/feeds/markus.atom?page=2"},
+		{"/feeds/markus.atom?token=abc&page=2", "/feeds/markus.atom?token=" + redacted + "&page=2"},
+		{"/reset?Access_Token=abc", "/reset?Access_Token=" + redacted},
+		{"/feeds/private/abc/markus.atom", "/feeds/private/" + redacted + "/markus.atom"},
+		{
+			"/user/markus/emails/1/confirm_verification/abc",
+			"/user/markus/emails/1/confirm_verification/" + redacted,
+		},
+	}
+
+	for _, tt := range tests {
+		u, err := url.Parse(tt.url)
+		if err != nil {
+			t.Fatal(err)
+		}
+		actual := util.RedactURL(u)
+		if actual != tt.expected {
+			t.Errorf("RedactURL(%q): expected %q, got %q", tt.url, tt.expected, actual)
+		}
+	}
+}
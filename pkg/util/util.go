@@ -13,9 +13,6 @@ import (
 	"strings"
 
 	"github.com/fatih/camelcase"
-	"github.com/microcosm-cc/bluemonday"
-	"github.com/writeas/go-strip-markdown"
-	blackfriday "gopkg.in/russross/blackfriday.v2"
 )
 
 func GetOptionalEnv(key, fallback string) string {
@@ -40,59 +37,6 @@ func StringToInterface(strs []string) []interface{} {
 	return results
 }
 
-var implicitFiguresRegexp = regexp.MustCompile(`<p><img src="(.+)" alt="(.*)"\s*/></p>`)
-
-func implicitFigures(s string) string {
-	result := implicitFiguresRegexp.FindStringSubmatch(s)
-	if len(result) == 0 {
-		return s
-	}
-	src := result[1]
-	figcaption := result[2]
-
-	figure := `<figure><img src="` + src + `" alt=""/>`
-	if figcaption != "" {
-		figure += `<figcaption>` + figcaption + `</figcaption>`
-	}
-	figure += `</figure>`
-
-	return figure
-}
-
-func MarkdownImplicitFigures(input []byte) []byte {
-	markdown := string(input)
-	withFigures := implicitFiguresRegexp.ReplaceAllStringFunc(markdown, implicitFigures)
-
-	return []byte(withFigures)
-}
-
-var codeBlockLanguageRegexp = regexp.MustCompile(`^language-[a-z-A-Z0-9]+$`)
-var paragraphClassesRegexp = regexp.MustCompile(`^((caption|leading)(\s+|$))*$`)
-var figureClassesRegexp = regexp.MustCompile(`^((left|right)(\s+|$))*$`)
-var anchorTargetRegexp = regexp.MustCompile(`^(_blank)$`)
-
-func MarkdownToHTML(input []byte) []byte {
-	unsafe := blackfriday.Run(
-		input,
-		blackfriday.WithExtensions(
-			blackfriday.CommonExtensions|
-				blackfriday.HardLineBreak|
-				blackfriday.Footnotes,
-		),
-	)
-	unsafeWithFigures := MarkdownImplicitFigures(unsafe)
-	p := bluemonday.UGCPolicy()
-	p.AllowAttrs("class").Matching(codeBlockLanguageRegexp).OnElements("code")
-	p.AllowAttrs("class").Matching(paragraphClassesRegexp).OnElements("p")
-	p.AllowAttrs("class").Matching(figureClassesRegexp).OnElements("figure")
-	p.AllowAttrs("target").Matching(anchorTargetRegexp).OnElements("a")
-	return p.SanitizeBytes(unsafeWithFigures)
-}
-
-func MarkdownToText(s string) string {
-	return stripmd.Strip(s)
-}
-
 func CompressString(s string) (string, error) {
 	var b bytes.Buffer
 	gz := gzip.NewWriter(&b)